@@ -13,6 +13,7 @@ import (
 	"gitlab.ozon.dev/gojhw1/pkg/config"
 	"gitlab.ozon.dev/gojhw1/pkg/db"
 	"gitlab.ozon.dev/gojhw1/pkg/grpc"
+	"gitlab.ozon.dev/gojhw1/pkg/importer"
 	"gitlab.ozon.dev/gojhw1/pkg/kafka"
 	"gitlab.ozon.dev/gojhw1/pkg/logger"
 	"gitlab.ozon.dev/gojhw1/pkg/repository"
@@ -72,11 +73,11 @@ func main() {
 	defer kafkaCleanup()
 	logger.Debug("Kafka инициализирована успешно")
 
-	app := router.InitFiberApp(ctx, services.orderService, repos.userRepo, services.auditLogger)
+	app := router.InitFiberApp(ctx, services.orderService, repos.userRepo, repos.pickupPointRepo, services.storageService, services.returnPolicyService, services.shiftService, services.authService, services.apiKeyService, services.idempotencyService, services.importJobService, services.auditLogger, cfg.Auth.BasicAuthFallback)
 	serverShutdown := startServer(ctx, app, cfg.Server.Port)
 	defer serverShutdown()
 
-	grpcServerShutdown := startGrpcServer(cfg, repos.userRepo, services.orderService)
+	grpcServerShutdown := startGrpcServer(cfg, repos.userRepo, repos.pickupPointRepo, services.storageService, services.returnPolicyService, services.shiftService, services.authService, services.apiKeyService, services.idempotencyService, services.orderService, services.importJobService)
 	defer grpcServerShutdown()
 
 	waitForShutdownSignal()
@@ -86,15 +87,30 @@ func main() {
 
 // Структура для хранения всех репозиториев
 type repositories struct {
-	orderRepo *repository.PostgresOrderRepository
-	userRepo  *repository.PostgresUserRepository
-	auditRepo *repository.PostgresAuditRepository
+	orderRepo        *repository.PostgresOrderRepository
+	userRepo         *repository.PostgresUserRepository
+	auditRepo        *repository.PostgresAuditRepository
+	tokenRepo        *repository.PostgresTokenRepository
+	apiKeyRepo       *repository.PostgresAPIKeyRepository
+	pickupPointRepo  *repository.PostgresPickupPointRepository
+	storageCellRepo  *repository.PostgresStorageCellRepository
+	returnPolicyRepo *repository.PostgresReturnPolicyRepository
+	idempotencyRepo  *repository.PostgresIdempotencyRepository
+	importJobRepo    *repository.PostgresImportJobRepository
+	shiftRepo        *repository.PostgresShiftRepository
 }
 
 // Структура для хранения всех сервисов
 type services struct {
-	orderService *service.OrderService
-	auditLogger  *utils.AuditLogger
+	orderService        *service.OrderService
+	storageService      *service.StorageService
+	returnPolicyService *service.ReturnPolicyService
+	authService         *service.AuthService
+	apiKeyService       *service.APIKeyService
+	idempotencyService  *service.IdempotencyService
+	importJobService    *service.ImportJobService
+	shiftService        *service.ShiftService
+	auditLogger         *utils.AuditLogger
 }
 
 // Инициализация инфраструктуры (миграции, подключение к БД)
@@ -122,9 +138,17 @@ func initInfrastructure(ctx context.Context, cfg *config.Config) (*db.Pool, erro
 // Инициализация репозиториев
 func initRepositories(pool *db.Pool) repositories {
 	return repositories{
-		orderRepo: repository.NewPostgresOrderRepository(pool),
-		userRepo:  repository.NewPostgresUserRepository(pool),
-		auditRepo: repository.NewPostgresAuditRepository(pool),
+		orderRepo:        repository.NewPostgresOrderRepository(pool),
+		userRepo:         repository.NewPostgresUserRepository(pool),
+		auditRepo:        repository.NewPostgresAuditRepository(pool),
+		tokenRepo:        repository.NewPostgresTokenRepository(pool),
+		apiKeyRepo:       repository.NewPostgresAPIKeyRepository(pool),
+		pickupPointRepo:  repository.NewPostgresPickupPointRepository(pool),
+		storageCellRepo:  repository.NewPostgresStorageCellRepository(pool),
+		returnPolicyRepo: repository.NewPostgresReturnPolicyRepository(pool),
+		idempotencyRepo:  repository.NewPostgresIdempotencyRepository(pool),
+		importJobRepo:    repository.NewPostgresImportJobRepository(pool),
+		shiftRepo:        repository.NewPostgresShiftRepository(pool),
 	}
 }
 
@@ -142,7 +166,63 @@ func initServices(ctx context.Context, cfg *config.Config, repos repositories) (
 	logger.Infof("Настройка логгера аудита с параметрами: workers=%d, batchSize=%d", workersCount, batchSize)
 	auditLogger := utils.NewAuditLogger(ctx, repos.auditRepo, workersCount, batchSize, batchTimeout)
 
-	orderService := service.NewOrderService(repos.orderRepo, auditLogger, ordersCache)
+	importers, err := importer.NewDefaultRegistry(importer.TableOptions{
+		Delimiter: cfg.Import.CSV.Delimiter,
+		Columns:   cfg.Import.CSV.Columns,
+	})
+	if err != nil {
+		logger.Fatalf("ошибка настройки форматов импорта: %v", err)
+	}
+
+	logger.Debugf("Инициализация платежного терминала: %s", cfg.Payment.Terminal)
+	paymentTerminal, err := utils.NewPaymentTerminal(cfg)
+	if err != nil {
+		logger.Fatalf("ошибка инициализации платежного терминала: %v", err)
+	}
+
+	orderService := service.NewOrderService(repos.orderRepo, repos.storageCellRepo, auditLogger, ordersCache, importers, service.ExtensionPolicy{
+		MaxExtensions: cfg.Extension.MaxExtensions,
+		MaxDays:       cfg.Extension.MaxDays,
+		FeePerDay:     cfg.Extension.FeePerDay,
+	}, repos.returnPolicyRepo, paymentTerminal)
+	orderService.StartExpiry(ctx, time.Duration(cfg.Expiry.Interval)*time.Minute, cfg.Expiry.BatchSize)
+	storageService := service.NewStorageService(repos.storageCellRepo)
+	returnPolicyService := service.NewReturnPolicyService(repos.returnPolicyRepo)
+
+	logger.Debugf("Инициализация хранилища попыток входа типа: %s", cfg.CacheType.Name)
+	attempts, err := utils.NewAttemptStore(ctx, cfg)
+	if err != nil {
+		logger.Fatalf("ошибка инициализации хранилища попыток входа: %v", err)
+	}
+
+	lockout := cfg.Auth.Lockout
+	loginGuard := service.NewLoginGuard(repos.userRepo, attempts, auditLogger, service.LockoutPolicy{
+		MaxAttempts:   int64(lockout.MaxAttempts),
+		IPMaxAttempts: int64(lockout.IPMaxAttempts),
+		Window:        time.Duration(lockout.Window) * time.Minute,
+		LockDuration:  time.Duration(lockout.Duration) * time.Minute,
+		BaseDelay:     time.Duration(lockout.BaseDelay) * time.Millisecond,
+		MaxDelay:      time.Duration(lockout.MaxDelay) * time.Millisecond,
+	})
+
+	authService := service.NewAuthService(
+		repos.userRepo,
+		loginGuard,
+		repos.tokenRepo,
+		cfg.Auth.TokenSecret,
+		time.Duration(cfg.Auth.AccessTokenTTL)*time.Minute,
+		time.Duration(cfg.Auth.RefreshTokenTTL)*time.Hour,
+	)
+
+	apiKeyService := service.NewAPIKeyService(repos.apiKeyRepo, repos.userRepo)
+
+	idempotencyService := service.NewIdempotencyService(repos.idempotencyRepo, time.Duration(cfg.Idempotency.TTL)*time.Hour)
+	idempotencyService.StartCleanup(ctx, time.Duration(cfg.Idempotency.CleanupInterval)*time.Minute)
+
+	importJobService := service.NewImportJobService(repos.importJobRepo, repos.userRepo, orderService, time.Duration(cfg.Import.StaleAfter)*time.Minute)
+	importJobService.Start(ctx, cfg.Import.Workers, time.Duration(cfg.Import.PollInterval)*time.Millisecond)
+
+	shiftService := service.NewShiftService(repos.shiftRepo, auditLogger)
 
 	cleanup := func() {
 		logger.Debug("Остановка логгера аудита...")
@@ -151,8 +231,15 @@ func initServices(ctx context.Context, cfg *config.Config, repos repositories) (
 	}
 
 	return services{
-		orderService: orderService,
-		auditLogger:  auditLogger,
+		orderService:        orderService,
+		storageService:      storageService,
+		returnPolicyService: returnPolicyService,
+		authService:         authService,
+		apiKeyService:       apiKeyService,
+		idempotencyService:  idempotencyService,
+		importJobService:    importJobService,
+		shiftService:        shiftService,
+		auditLogger:         auditLogger,
 	}, cleanup
 }
 
@@ -213,9 +300,9 @@ func startServer(ctx context.Context, app *fiber.App, port string) func() {
 	}
 }
 
-func startGrpcServer(cfg *config.Config, userRepo *repository.PostgresUserRepository, orderService *service.OrderService) func() {
+func startGrpcServer(cfg *config.Config, userRepo *repository.PostgresUserRepository, pickupPointRepo *repository.PostgresPickupPointRepository, storageService *service.StorageService, returnPolicyService *service.ReturnPolicyService, shiftService *service.ShiftService, authService *service.AuthService, apiKeyService *service.APIKeyService, idempotencyService *service.IdempotencyService, orderService *service.OrderService, importJobService *service.ImportJobService) func() {
 	logger.Infof("Настройка gRPC сервера на хосте: %s, порт: %s", cfg.Database.Host, cfg.GrpcServer.Port)
-	server := grpc.NewServer(cfg.Database.Host, cfg.GrpcServer.Port, userRepo, orderService)
+	server := grpc.NewServer(cfg.Database.Host, cfg.GrpcServer.Port, userRepo, pickupPointRepo, storageService, returnPolicyService, shiftService, authService, apiKeyService, idempotencyService, orderService, importJobService, cfg.Auth.BasicAuthFallback)
 
 	go func() {
 		if err := server.Start(); err != nil {
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Роль "user" заменяется ролью оператора ПВЗ из модели прав
+UPDATE users SET role = 'operator' WHERE role = 'user';
+ALTER TABLE users ALTER COLUMN role SET DEFAULT 'operator';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users ALTER COLUMN role SET DEFAULT 'user';
+UPDATE users SET role = 'user' WHERE role = 'operator';
+-- +goose StatementEnd
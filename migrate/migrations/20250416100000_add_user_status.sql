@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Уже существующие пользователи считаются подтвержденными
+ALTER TABLE users ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'active';
+ALTER TABLE users ALTER COLUMN status SET DEFAULT 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN IF EXISTS status;
+-- +goose StatementEnd
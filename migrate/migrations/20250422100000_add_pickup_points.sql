@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE pickup_points (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    address TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- До появления нескольких ПВЗ все заказы и сотрудники относились к одному пункту
+INSERT INTO pickup_points (name, address) VALUES ('Основной ПВЗ', 'Адрес не указан');
+
+ALTER TABLE orders ADD COLUMN pickup_point_id INTEGER REFERENCES pickup_points(id);
+UPDATE orders SET pickup_point_id = (SELECT id FROM pickup_points WHERE name = 'Основной ПВЗ');
+ALTER TABLE orders ALTER COLUMN pickup_point_id SET NOT NULL;
+
+-- Индекс для выборки заказов ПВЗ
+CREATE INDEX idx_orders_pickup_point_id ON orders(pickup_point_id);
+
+-- Администратор может быть не привязан к ПВЗ и работать со всеми пунктами
+ALTER TABLE users ADD COLUMN pickup_point_id INTEGER REFERENCES pickup_points(id) ON DELETE SET NULL;
+UPDATE users SET pickup_point_id = (SELECT id FROM pickup_points WHERE name = 'Основной ПВЗ') WHERE role <> 'admin';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN IF EXISTS pickup_point_id;
+DROP INDEX IF EXISTS idx_orders_pickup_point_id;
+ALTER TABLE orders DROP COLUMN IF EXISTS pickup_point_id;
+DROP TABLE IF EXISTS pickup_points;
+-- +goose StatementEnd
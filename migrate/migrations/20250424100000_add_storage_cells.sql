@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE storage_cells (
+    id SERIAL PRIMARY KEY,
+    pickup_point_id INTEGER NOT NULL REFERENCES pickup_points(id) ON DELETE CASCADE,
+    rack VARCHAR(50) NOT NULL,
+    code VARCHAR(50) NOT NULL,
+    capacity INTEGER NOT NULL CHECK (capacity > 0),
+    max_weight DECIMAL(10, 2) NOT NULL CHECK (max_weight > 0),
+    -- NULL - универсальная ячейка для заказов любой упаковки
+    package_type_id INTEGER REFERENCES package_types(id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (pickup_point_id, code)
+);
+
+ALTER TABLE orders ADD COLUMN storage_cell_id INTEGER REFERENCES storage_cells(id);
+
+-- Индекс для подсчета заполненности ячеек
+CREATE INDEX idx_orders_storage_cell_id ON orders(storage_cell_id) WHERE storage_cell_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_orders_storage_cell_id;
+ALTER TABLE orders DROP COLUMN IF EXISTS storage_cell_id;
+DROP TABLE IF EXISTS storage_cells;
+-- +goose StatementEnd
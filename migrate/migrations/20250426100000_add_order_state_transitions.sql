@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+INSERT INTO order_states (name) VALUES
+    ('returned_to_courier'),
+    ('expired'),
+    ('lost');
+
+CREATE TABLE order_state_transitions (
+    id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    -- NULL - заказ только что принят в ПВЗ
+    from_state_id INTEGER REFERENCES order_states(id),
+    to_state_id INTEGER NOT NULL REFERENCES order_states(id),
+    changed_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_order_state_transitions_order_id ON order_state_transitions(order_id, changed_at);
+
+-- Для уже существующих заказов история начинается с их текущего статуса
+INSERT INTO order_state_transitions (order_id, to_state_id, changed_at)
+SELECT id, state_id, updated_at FROM orders;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_order_state_transitions_order_id;
+DROP TABLE IF EXISTS order_state_transitions;
+
+-- Заказы в новых статусах до этой миграции удалялись из базы
+DELETE FROM orders WHERE state_id IN (
+    SELECT id FROM order_states WHERE name IN ('returned_to_courier', 'expired', 'lost')
+);
+DELETE FROM order_states WHERE name IN ('returned_to_courier', 'expired', 'lost');
+-- +goose StatementEnd
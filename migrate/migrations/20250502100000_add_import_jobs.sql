@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE import_jobs (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    filename VARCHAR(255) NOT NULL,
+    -- pending, running, completed, failed
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    dry_run BOOLEAN NOT NULL DEFAULT FALSE,
+    bulk BOOLEAN NOT NULL DEFAULT FALSE,
+    -- Содержимое файла, удаляется после завершения задачи
+    content BYTEA,
+    total INTEGER NOT NULL DEFAULT 0,
+    processed INTEGER NOT NULL DEFAULT 0,
+    succeeded INTEGER NOT NULL DEFAULT 0,
+    failed INTEGER NOT NULL DEFAULT 0,
+    -- Записи файла, которые не удалось принять
+    errors JSONB NOT NULL DEFAULT '[]',
+    error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    started_at TIMESTAMP WITH TIME ZONE,
+    finished_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Индекс для выбора задач из очереди и поиска зависших задач
+CREATE INDEX idx_import_jobs_status ON import_jobs(status, id) WHERE status IN ('pending', 'running');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_import_jobs_status;
+DROP TABLE IF EXISTS import_jobs;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Формат файла задачи: json, ndjson, csv, xlsx. Задачи, созданные раньше, принимали только JSON
+ALTER TABLE import_jobs ADD COLUMN format VARCHAR(20) NOT NULL DEFAULT 'json';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE import_jobs DROP COLUMN IF EXISTS format;
+-- +goose StatementEnd
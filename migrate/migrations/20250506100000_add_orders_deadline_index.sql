@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Поиск заказов с истекшим сроком хранения: принятые заказы в порядке окончания срока
+CREATE INDEX idx_orders_state_deadline ON orders(state_id, deadline_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_orders_state_deadline;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE order_extensions (
+    id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    days INTEGER NOT NULL CHECK (days > 0),
+    fee DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    previous_deadline_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    deadline_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    extended_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    extended_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_order_extensions_order_id ON order_extensions(order_id, extended_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_order_extensions_order_id;
+DROP TABLE IF EXISTS order_extensions;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE return_policies (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    -- NULL - политика действует для заказов в любой упаковке
+    package_type_id INTEGER REFERENCES package_types(id),
+    -- NULL - политика действует для клиентов любого сегмента
+    customer_segment VARCHAR(50),
+    return_window_hours INTEGER NOT NULL CHECK (return_window_hours >= 0),
+    non_returnable BOOLEAN NOT NULL DEFAULT FALSE,
+    fee_percent DECIMAL(5, 2) NOT NULL DEFAULT 0 CHECK (fee_percent >= 0 AND fee_percent <= 100),
+    requires_inspection BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Для каждого сочетания упаковки и сегмента действует не больше одной политики
+CREATE UNIQUE INDEX idx_return_policies_scope ON return_policies(COALESCE(package_type_id, 0), COALESCE(customer_segment, ''));
+
+CREATE TABLE customer_segments (
+    customer_id BIGINT PRIMARY KEY,
+    segment VARCHAR(50) NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Политика по умолчанию сохраняет прежний срок возврата в 48 часов
+INSERT INTO return_policies (name, return_window_hours) VALUES ('По умолчанию', 48);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS customer_segments;
+DROP INDEX IF EXISTS idx_return_policies_scope;
+DROP TABLE IF EXISTS return_policies;
+-- +goose StatementEnd
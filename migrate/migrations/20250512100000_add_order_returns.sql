@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE order_returns (
+    id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT NOT NULL UNIQUE REFERENCES orders(id) ON DELETE CASCADE,
+    customer_id BIGINT NOT NULL,
+    reason VARCHAR(50) NOT NULL CHECK (reason IN ('defective', 'wrong_item', 'not_as_described', 'changed_mind', 'other')),
+    comment TEXT NOT NULL DEFAULT '',
+    condition VARCHAR(50) NOT NULL CHECK (condition IN ('intact', 'damaged', 'opened')),
+    photos TEXT[] NOT NULL DEFAULT '{}',
+    policy_id INTEGER REFERENCES return_policies(id) ON DELETE SET NULL,
+    policy VARCHAR(255) NOT NULL DEFAULT '',
+    fee DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    refund DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    requires_inspection BOOLEAN NOT NULL DEFAULT FALSE,
+    returned_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    returned_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_order_returns_reason_condition ON order_returns(reason, condition);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_order_returns_reason_condition;
+DROP TABLE IF EXISTS order_returns;
+-- +goose StatementEnd
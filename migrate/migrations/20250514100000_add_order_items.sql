@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE order_items (
+    id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    weight DECIMAL(10, 2) NOT NULL CHECK (weight > 0),
+    cost DECIMAL(10, 2) NOT NULL CHECK (cost > 0),
+    state VARCHAR(50) NOT NULL DEFAULT 'accepted' CHECK (state IN ('accepted', 'delivered', 'refused', 'returned')),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_order_items_order_id ON order_items(order_id);
+
+ALTER TABLE order_returns ADD COLUMN item_ids BIGINT[] NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE order_returns DROP COLUMN IF EXISTS item_ids;
+DROP INDEX IF EXISTS idx_order_items_order_id;
+DROP TABLE IF EXISTS order_items;
+-- +goose StatementEnd
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders ADD COLUMN payment_mode VARCHAR(50) NOT NULL DEFAULT 'prepaid' CHECK (payment_mode IN ('prepaid', 'on_pickup'));
+
+CREATE TABLE order_payments (
+    id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT NOT NULL UNIQUE REFERENCES orders(id) ON DELETE CASCADE,
+    method VARCHAR(50) NOT NULL CHECK (method IN ('cash', 'card', 'mixed')),
+    amount DECIMAL(10, 2) NOT NULL CHECK (amount > 0),
+    cash_amount DECIMAL(10, 2) NOT NULL DEFAULT 0 CHECK (cash_amount >= 0),
+    card_amount DECIMAL(10, 2) NOT NULL DEFAULT 0 CHECK (card_amount >= 0),
+    transaction_id VARCHAR(255),
+    operator_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    paid_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE order_refunds (
+    id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    return_id BIGINT NOT NULL REFERENCES order_returns(id) ON DELETE CASCADE,
+    payment_id BIGINT REFERENCES order_payments(id) ON DELETE SET NULL,
+    method VARCHAR(50) NOT NULL CHECK (method IN ('cash', 'card', 'mixed', 'online')),
+    amount DECIMAL(10, 2) NOT NULL CHECK (amount > 0),
+    cash_amount DECIMAL(10, 2) NOT NULL DEFAULT 0 CHECK (cash_amount >= 0),
+    card_amount DECIMAL(10, 2) NOT NULL DEFAULT 0 CHECK (card_amount >= 0),
+    transaction_id VARCHAR(255),
+    operator_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    refunded_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_order_refunds_order_id ON order_refunds(order_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_order_refunds_order_id;
+DROP TABLE IF EXISTS order_refunds;
+DROP TABLE IF EXISTS order_payments;
+ALTER TABLE orders DROP COLUMN IF EXISTS payment_mode;
+-- +goose StatementEnd
@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE shifts (
+    id BIGSERIAL PRIMARY KEY,
+    pickup_point_id INTEGER NOT NULL REFERENCES pickup_points(id) ON DELETE CASCADE,
+    opening_cash DECIMAL(10, 2) NOT NULL DEFAULT 0 CHECK (opening_cash >= 0),
+    counted_cash DECIMAL(10, 2) CHECK (counted_cash >= 0),
+    opened_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    opened_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    closed_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    -- NULL - смена открыта
+    closed_at TIMESTAMP WITH TIME ZONE,
+    -- отчет сверки, сохраняется при закрытии смены
+    report JSONB
+);
+
+-- В ПВЗ может быть открыта только одна смена
+CREATE UNIQUE INDEX idx_shifts_open ON shifts(pickup_point_id) WHERE closed_at IS NULL;
+CREATE INDEX idx_shifts_pickup_point_id ON shifts(pickup_point_id, opened_at);
+
+-- Закрытая смена не изменяется: ее отчет сверки окончательный
+CREATE FUNCTION forbid_closed_shift_update() RETURNS TRIGGER AS $$
+BEGIN
+    IF OLD.closed_at IS NOT NULL THEN
+        RAISE EXCEPTION 'смена % закрыта и не может быть изменена', OLD.id;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_shifts_locked
+    BEFORE UPDATE ON shifts
+    FOR EACH ROW EXECUTE FUNCTION forbid_closed_shift_update();
+
+CREATE INDEX idx_order_payments_paid_at ON order_payments(paid_at);
+CREATE INDEX idx_order_refunds_refunded_at ON order_refunds(refunded_at);
+CREATE INDEX idx_order_state_transitions_changed_at ON order_state_transitions(changed_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_order_state_transitions_changed_at;
+DROP INDEX IF EXISTS idx_order_refunds_refunded_at;
+DROP INDEX IF EXISTS idx_order_payments_paid_at;
+DROP TRIGGER IF EXISTS trg_shifts_locked ON shifts;
+DROP FUNCTION IF EXISTS forbid_closed_shift_update();
+DROP INDEX IF EXISTS idx_shifts_pickup_point_id;
+DROP INDEX IF EXISTS idx_shifts_open;
+DROP TABLE IF EXISTS shifts;
+-- +goose StatementEnd
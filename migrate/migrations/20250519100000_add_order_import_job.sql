@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Задача импорта, создавшая заказ. По ней продолжаемая после сбоя задача
+-- отличает заказы, принятые ею до сбоя, от заказов, существовавших до импорта.
+ALTER TABLE orders ADD COLUMN import_job_id BIGINT REFERENCES import_jobs(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_orders_import_job_id ON orders(import_job_id) WHERE import_job_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_orders_import_job_id;
+ALTER TABLE orders DROP COLUMN IF EXISTS import_job_id;
+-- +goose StatementEnd
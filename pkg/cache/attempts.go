@@ -0,0 +1,203 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/extra/redisotel/v9"
+	"github.com/redis/go-redis/v9"
+	"gitlab.ozon.dev/gojhw1/pkg/logger"
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	attemptsKeyPrefix = "auth:failures:"
+	lockKeyPrefix     = "auth:lock:"
+	attemptsPurgeTick = time.Minute
+)
+
+// attemptEntry - счетчик неудачных попыток входа с моментом истечения окна
+type attemptEntry struct {
+	count     int64
+	expiresAt time.Time
+}
+
+// InMemoryAttemptStore хранит счетчики неудачных попыток входа и блокировки в памяти процесса
+type InMemoryAttemptStore struct {
+	mu        sync.Mutex
+	failures  map[string]attemptEntry
+	locks     map[string]time.Time
+	lastPurge time.Time
+}
+
+// NewInMemoryAttemptStore создает хранилище попыток входа в памяти
+func NewInMemoryAttemptStore() *InMemoryAttemptStore {
+	logger.Info("Создание in-memory хранилища попыток входа")
+
+	return &InMemoryAttemptStore{
+		failures:  make(map[string]attemptEntry),
+		locks:     make(map[string]time.Time),
+		lastPurge: time.Now(),
+	}
+}
+
+// AddFailure увеличивает счетчик неудачных попыток для ключа.
+// Окно отсчитывается от первой неудачи, по его истечении счетчик сбрасывается.
+func (s *InMemoryAttemptStore) AddFailure(_ context.Context, key string, window time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.purgeExpired(now)
+
+	entry, ok := s.failures[key]
+	if !ok || now.After(entry.expiresAt) {
+		entry = attemptEntry{expiresAt: now.Add(window)}
+	}
+	entry.count++
+	s.failures[key] = entry
+
+	return entry.count, nil
+}
+
+// Lock блокирует ключ на указанное время
+func (s *InMemoryAttemptStore) Lock(_ context.Context, key string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.locks[key] = time.Now().Add(ttl)
+
+	return nil
+}
+
+// LockTTL возвращает оставшееся время блокировки ключа или 0, если ключ не заблокирован
+func (s *InMemoryAttemptStore) LockTTL(_ context.Context, key string) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	until, ok := s.locks[key]
+	if !ok {
+		return 0, nil
+	}
+
+	remaining := time.Until(until)
+	if remaining <= 0 {
+		delete(s.locks, key)
+		return 0, nil
+	}
+
+	return remaining, nil
+}
+
+// Reset сбрасывает счетчик неудачных попыток и блокировку ключа
+func (s *InMemoryAttemptStore) Reset(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.failures, key)
+	delete(s.locks, key)
+
+	return nil
+}
+
+// purgeExpired удаляет истекшие счетчики и блокировки не чаще раза в attemptsPurgeTick.
+// Вызывается под мьютексом.
+func (s *InMemoryAttemptStore) purgeExpired(now time.Time) {
+	if now.Sub(s.lastPurge) < attemptsPurgeTick {
+		return
+	}
+	s.lastPurge = now
+
+	for key, entry := range s.failures {
+		if now.After(entry.expiresAt) {
+			delete(s.failures, key)
+		}
+	}
+
+	for key, until := range s.locks {
+		if now.After(until) {
+			delete(s.locks, key)
+		}
+	}
+}
+
+// RedisAttemptStore хранит счетчики неудачных попыток входа и блокировки в Redis,
+// что позволяет разделять их между несколькими экземплярами приложения
+type RedisAttemptStore struct {
+	client *redis.Client
+}
+
+// NewRedisAttemptStore создает хранилище попыток входа в Redis
+// addr - адрес Redis-сервера
+func NewRedisAttemptStore(ctx context.Context, addr string) (*RedisAttemptStore, error) {
+	logger.Infof("Создание Redis-хранилища попыток входа с адресом: %s", addr)
+
+	client := redis.NewClient(&redis.Options{
+		Addr: addr,
+	})
+
+	if err := redisotel.InstrumentTracing(client, redisotel.WithTracerProvider(otel.GetTracerProvider())); err != nil {
+		logger.Errorf("Ошибка инструментирования Redis клиента для трейсинга: %v", err)
+	}
+
+	if err := client.Ping(ctx).Err(); err != nil {
+		logger.Errorf("Ошибка подключения к Redis: %v", err)
+		return nil, err
+	}
+
+	return &RedisAttemptStore{client: client}, nil
+}
+
+// AddFailure увеличивает счетчик неудачных попыток для ключа.
+// Окно отсчитывается от первой неудачи, по его истечении счетчик сбрасывается.
+func (s *RedisAttemptStore) AddFailure(ctx context.Context, key string, window time.Duration) (int64, error) {
+	pipe := s.client.TxPipeline()
+	incr := pipe.Incr(ctx, attemptsKeyPrefix+key)
+	pipe.ExpireNX(ctx, attemptsKeyPrefix+key, window)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("ошибка увеличения счетчика попыток входа: %w", err)
+	}
+
+	return incr.Val(), nil
+}
+
+// Lock блокирует ключ на указанное время
+func (s *RedisAttemptStore) Lock(ctx context.Context, key string, ttl time.Duration) error {
+	if err := s.client.Set(ctx, lockKeyPrefix+key, 1, ttl).Err(); err != nil {
+		return fmt.Errorf("ошибка установки блокировки входа: %w", err)
+	}
+
+	return nil
+}
+
+// LockTTL возвращает оставшееся время блокировки ключа или 0, если ключ не заблокирован
+func (s *RedisAttemptStore) LockTTL(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := s.client.PTTL(ctx, lockKeyPrefix+key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("ошибка получения блокировки входа: %w", err)
+	}
+
+	// Отрицательное значение означает, что ключ отсутствует или не имеет TTL
+	if ttl < 0 {
+		return 0, nil
+	}
+
+	return ttl, nil
+}
+
+// Reset сбрасывает счетчик неудачных попыток и блокировку ключа
+func (s *RedisAttemptStore) Reset(ctx context.Context, key string) error {
+	if err := s.client.Del(ctx, attemptsKeyPrefix+key, lockKeyPrefix+key).Err(); err != nil {
+		return fmt.Errorf("ошибка сброса попыток входа: %w", err)
+	}
+
+	return nil
+}
+
+// Close закрывает соединение с Redis
+func (s *RedisAttemptStore) Close() error {
+	return s.client.Close()
+}
@@ -13,7 +13,7 @@ import (
 
 // historyRepository определяет интерфейс для получения истории заказов из репозитория
 type historyRepository interface {
-	List(ctx context.Context, searchTerm string) ([]model.Order, error)
+	List(ctx context.Context, pickupPointID int64, searchTerm string) ([]model.Order, error)
 }
 
 // GetOrderHistory возвращает историю заказов из кэша
@@ -81,7 +81,8 @@ func (c *RedisCache) refreshHistoryCache(ctx context.Context, repo historyReposi
 // Возвращает список заказов и ошибку, если она произошла
 func (c *RedisCache) getHistoryFromRepo(ctx context.Context, repo historyRepository, searchTerm string) ([]model.Order, error) {
 	logger.Debugf("Получение истории заказов из репозитория с поисковым запросом: '%s'", searchTerm)
-	orders, err := repo.List(ctx, searchTerm)
+	// Кэш хранит историю всех ПВЗ, фильтрация по ПВЗ выполняется в сервисе
+	orders, err := repo.List(ctx, 0, searchTerm)
 	if err != nil {
 		return nil, err
 	}
@@ -409,7 +409,8 @@ func (c *InMemoryCache) refreshHistoryCache(ctx context.Context, repo historyRep
 // Возвращает список заказов и ошибку, если она произошла
 func (c *InMemoryCache) getHistoryFromRepo(ctx context.Context, repo historyRepository, searchTerm string) ([]model.Order, error) {
 	logger.Debugf("Получение истории заказов из репозитория с поисковым запросом: '%s'", searchTerm)
-	orders, err := repo.List(ctx, searchTerm)
+	// Кэш хранит историю всех ПВЗ, фильтрация по ПВЗ выполняется в сервисе
+	orders, err := repo.List(ctx, 0, searchTerm)
 	if err != nil {
 		return nil, err
 	}
@@ -9,14 +9,20 @@ import (
 
 // Config - основная структура конфигурации приложения
 type Config struct {
-	Database   DatabaseConfig   `json:"database"`
-	Server     ServerConfig     `json:"server"`
-	Redis      RedisConfig      `json:"redis"`
-	CacheType  CacheConfig      `json:"cache_type"`
-	Kafka      KafkaConfig      `json:"kafka"`
-	GrpcServer GrpcServerConfig `json:"grpc_server"`
-	Logger     LoggerConfig     `json:"logger"`
-	Jaeger     JaegerConfig     `json:"jaeger"`
+	Database    DatabaseConfig    `json:"database"`
+	Server      ServerConfig      `json:"server"`
+	Redis       RedisConfig       `json:"redis"`
+	CacheType   CacheConfig       `json:"cache_type"`
+	Kafka       KafkaConfig       `json:"kafka"`
+	GrpcServer  GrpcServerConfig  `json:"grpc_server"`
+	Logger      LoggerConfig      `json:"logger"`
+	Jaeger      JaegerConfig      `json:"jaeger"`
+	Auth        AuthConfig        `json:"auth"`
+	Idempotency IdempotencyConfig `json:"idempotency"`
+	Import      ImportConfig      `json:"import"`
+	Expiry      ExpiryConfig      `json:"expiry"`
+	Extension   ExtensionConfig   `json:"extension"`
+	Payment     PaymentConfig     `json:"payment"`
 }
 
 // DatabaseConfig - конфигурация базы данных
@@ -83,6 +89,65 @@ type JaegerConfig struct {
 	ServiceName  string `json:"service_name"`
 }
 
+// AuthConfig - конфигурация аутентификации
+type AuthConfig struct {
+	TokenSecret       string `json:"token_secret"`
+	AccessTokenTTL    int    `json:"access_token_ttl"`    // в минутах
+	RefreshTokenTTL   int    `json:"refresh_token_ttl"`   // в часах
+	BasicAuthFallback bool   `json:"basic_auth_fallback"` // принимать Basic Auth наряду с токенами
+
+	Lockout LockoutConfig `json:"lockout"`
+}
+
+// LockoutConfig - конфигурация защиты от перебора паролей
+type LockoutConfig struct {
+	MaxAttempts   int `json:"max_attempts"`    // неудачных попыток на имя пользователя до блокировки
+	IPMaxAttempts int `json:"ip_max_attempts"` // неудачных попыток с одного IP до блокировки
+	Window        int `json:"window"`          // окно подсчета попыток, в минутах
+	Duration      int `json:"duration"`        // длительность блокировки, в минутах
+	BaseDelay     int `json:"base_delay"`      // начальная задержка ответа после неудачи, в миллисекундах
+	MaxDelay      int `json:"max_delay"`       // максимальная задержка ответа, в миллисекундах
+}
+
+// IdempotencyConfig - конфигурация ключей идемпотентности
+type IdempotencyConfig struct {
+	TTL             int `json:"ttl"`              // время хранения ответа, в часах
+	CleanupInterval int `json:"cleanup_interval"` // интервал удаления просроченных ключей, в минутах
+}
+
+// ImportConfig - конфигурация фоновых задач импорта заказов
+type ImportConfig struct {
+	Workers      int             `json:"workers"`       // количество обработчиков задач
+	PollInterval int             `json:"poll_interval"` // интервал опроса очереди задач, в миллисекундах
+	StaleAfter   int             `json:"stale_after"`   // через сколько задача без изменений считается прерванной, в минутах
+	CSV          ImportCSVConfig `json:"csv"`           // чтение таблиц CSV и XLSX
+}
+
+// ImportCSVConfig - настройка чтения таблиц с заказами
+type ImportCSVConfig struct {
+	Delimiter string            `json:"delimiter"` // разделитель колонок CSV, по умолчанию запятая
+	Columns   map[string]string `json:"columns"`   // заголовки колонок для полей заказа, по умолчанию совпадают с именами полей
+}
+
+// ExpiryConfig - конфигурация обработки заказов с истекшим сроком хранения
+type ExpiryConfig struct {
+	Interval  int `json:"interval"`   // интервал поиска заказов с истекшим сроком хранения, в минутах
+	BatchSize int `json:"batch_size"` // количество заказов, изменяемых в одной транзакции
+}
+
+// ExtensionConfig - правила продления срока хранения заказа
+type ExtensionConfig struct {
+	MaxExtensions int     `json:"max_extensions"` // сколько раз можно продлить срок хранения одного заказа
+	MaxDays       int     `json:"max_days"`       // на сколько дней суммарно можно продлить срок хранения
+	FeePerDay     float64 `json:"fee_per_day"`    // плата за день продления, добавляется к стоимости заказа
+}
+
+// PaymentConfig - настройка платежного терминала, через который принимается оплата картой
+type PaymentConfig struct {
+	Terminal     string  `json:"terminal"`      // реализация терминала, поддерживается только локальный терминал fake
+	DeclineAbove float64 `json:"decline_above"` // локальный терминал отклоняет оплаты картой больше этой суммы, 0 - не отклоняет
+}
+
 // Load загружает конфигурацию из JSON-файла
 func Load(path string) (*Config, error) {
 	file, err := os.Open(path)
@@ -131,4 +196,58 @@ func setDefaults(cfg *Config) {
 	if cfg.CacheType.Name == "" {
 		cfg.CacheType.Name = "inmem"
 	}
+	if cfg.Auth.AccessTokenTTL == 0 {
+		cfg.Auth.AccessTokenTTL = 15 // 15 минут
+	}
+	if cfg.Auth.RefreshTokenTTL == 0 {
+		cfg.Auth.RefreshTokenTTL = 720 // 30 дней
+	}
+	if cfg.Auth.Lockout.MaxAttempts == 0 {
+		cfg.Auth.Lockout.MaxAttempts = 5
+	}
+	if cfg.Auth.Lockout.IPMaxAttempts == 0 {
+		cfg.Auth.Lockout.IPMaxAttempts = 20
+	}
+	if cfg.Auth.Lockout.Window == 0 {
+		cfg.Auth.Lockout.Window = 15 // 15 минут
+	}
+	if cfg.Auth.Lockout.Duration == 0 {
+		cfg.Auth.Lockout.Duration = 15 // 15 минут
+	}
+	if cfg.Auth.Lockout.BaseDelay == 0 {
+		cfg.Auth.Lockout.BaseDelay = 200 // 200 миллисекунд
+	}
+	if cfg.Auth.Lockout.MaxDelay == 0 {
+		cfg.Auth.Lockout.MaxDelay = 3000 // 3 секунды
+	}
+	if cfg.Idempotency.TTL == 0 {
+		cfg.Idempotency.TTL = 24 // 24 часа
+	}
+	if cfg.Idempotency.CleanupInterval == 0 {
+		cfg.Idempotency.CleanupInterval = 60 // 1 час
+	}
+	if cfg.Import.Workers == 0 {
+		cfg.Import.Workers = 2
+	}
+	if cfg.Import.PollInterval == 0 {
+		cfg.Import.PollInterval = 1000 // 1 секунда
+	}
+	if cfg.Import.StaleAfter == 0 {
+		cfg.Import.StaleAfter = 5 // 5 минут
+	}
+	if cfg.Expiry.Interval == 0 {
+		cfg.Expiry.Interval = 5 // 5 минут
+	}
+	if cfg.Expiry.BatchSize == 0 {
+		cfg.Expiry.BatchSize = 100
+	}
+	if cfg.Extension.MaxExtensions == 0 {
+		cfg.Extension.MaxExtensions = 2
+	}
+	if cfg.Extension.MaxDays == 0 {
+		cfg.Extension.MaxDays = 7
+	}
+	if cfg.Payment.Terminal == "" {
+		cfg.Payment.Terminal = "fake"
+	}
 }
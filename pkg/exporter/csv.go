@@ -0,0 +1,38 @@
+package exporter
+
+import (
+	"encoding/csv"
+	"io"
+
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+// csvWriter записывает заказы в CSV с заголовком в первой строке
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) (Writer, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(columns); err != nil {
+		return nil, err
+	}
+
+	return &csvWriter{w: writer}, nil
+}
+
+// Write записывает заказ строкой CSV
+func (w *csvWriter) Write(order model.Order) error {
+	return w.w.Write(orderRow(order))
+}
+
+// Flush передает записанные строки в нижележащий io.Writer
+func (w *csvWriter) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+// Close передает оставшиеся строки в нижележащий io.Writer
+func (w *csvWriter) Close() error {
+	return w.Flush()
+}
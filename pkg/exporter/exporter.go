@@ -0,0 +1,159 @@
+package exporter
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+// ErrUnsupportedFormat - формат выгрузки не поддерживается
+var ErrUnsupportedFormat = errors.New("неподдерживаемый формат выгрузки заказов")
+
+// Форматы выгрузки заказов
+const (
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+	FormatXLSX   = "xlsx"
+)
+
+// DefaultFormat - формат выгрузки, если он не указан
+const DefaultFormat = FormatCSV
+
+// timeLayout - формат дат в выгрузке, совпадает с форматом дат в файлах импорта
+const timeLayout = "2006-01-02T15:04:05"
+
+// Writer записывает заказы в файл выгрузки по одному
+type Writer interface {
+	// Write записывает заказ
+	Write(order model.Order) error
+	// Flush передает записанные заказы в нижележащий io.Writer
+	Flush() error
+	// Close завершает файл выгрузки. Нижележащий io.Writer не закрывается.
+	Close() error
+}
+
+type format struct {
+	contentType string
+	newWriter   func(w io.Writer) (Writer, error)
+}
+
+var formats = map[string]format{
+	FormatCSV:    {contentType: "text/csv; charset=utf-8", newWriter: newCSVWriter},
+	FormatNDJSON: {contentType: "application/x-ndjson", newWriter: newNDJSONWriter},
+	FormatXLSX:   {contentType: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", newWriter: newXLSXWriter},
+}
+
+// columns - колонки выгрузки. Названия колонок совпадают с полями файлов импорта,
+// поэтому выгрузку можно загрузить обратно.
+var columns = []string{
+	"id", "customer_id", "pickup_point_id", "state", "deadline_at", "weight", "cost",
+	"package_type", "wrapper", "updated_at", "delivered_at", "returned_at", "storage_cell_id", "version",
+}
+
+// numericColumns - колонки с числовыми значениями
+var numericColumns = map[string]bool{
+	"id": true, "customer_id": true, "pickup_point_id": true, "weight": true, "cost": true,
+	"storage_cell_id": true, "version": true,
+}
+
+// Resolve проверяет формат выгрузки. Пустой формат означает формат по умолчанию.
+func Resolve(name string) (string, error) {
+	if name == "" {
+		return DefaultFormat, nil
+	}
+
+	name = strings.ToLower(name)
+	if _, ok := formats[name]; !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedFormat, name)
+	}
+
+	return name, nil
+}
+
+// ContentType возвращает тип содержимого файла выгрузки
+func ContentType(name string) string {
+	return formats[name].contentType
+}
+
+// Filename возвращает имя файла выгрузки
+func Filename(name string) string {
+	return "orders." + name
+}
+
+// NewWriter создает Writer для формата выгрузки
+func NewWriter(name string, w io.Writer) (Writer, error) {
+	f, ok := formats[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, name)
+	}
+
+	return f.newWriter(w)
+}
+
+// orderRow возвращает значения колонок выгрузки для заказа
+func orderRow(order model.Order) []string {
+	row := make([]string, 0, len(columns))
+	for _, column := range columns {
+		row = append(row, orderValue(order, column))
+	}
+
+	return row
+}
+
+// orderValue возвращает значение колонки выгрузки для заказа, отсутствующее значение - пустая строка
+func orderValue(order model.Order, column string) string {
+	switch column {
+	case "id":
+		return strconv.FormatInt(order.ID, 10)
+	case "customer_id":
+		return strconv.FormatInt(order.CustomerID, 10)
+	case "pickup_point_id":
+		if order.PickupPointID > 0 {
+			return strconv.FormatInt(order.PickupPointID, 10)
+		}
+	case "state":
+		return string(order.State)
+	case "deadline_at":
+		return formatTime(&order.DeadlineAt)
+	case "weight":
+		return strconv.FormatFloat(order.Weight, 'f', -1, 64)
+	case "cost":
+		return strconv.FormatFloat(order.Cost, 'f', -1, 64)
+	case "package_type":
+		if order.PackageType != nil {
+			return string(*order.PackageType)
+		}
+	case "wrapper":
+		if order.Wrapper != nil {
+			return string(*order.Wrapper)
+		}
+	case "updated_at":
+		return formatTime(&order.UpdatedAt)
+	case "delivered_at":
+		return formatTime(order.DeliveredAt)
+	case "returned_at":
+		return formatTime(order.ReturnedAt)
+	case "storage_cell_id":
+		if order.StorageCellID != nil {
+			return strconv.FormatInt(*order.StorageCellID, 10)
+		}
+	case "version":
+		return strconv.FormatInt(order.Version, 10)
+	}
+
+	return ""
+}
+
+// formatTime форматирует время в UTC, nil - пустая строка
+func formatTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(timeLayout)
+}
@@ -0,0 +1,152 @@
+package exporter
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/gojhw1/pkg/importer"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		format         string
+		expectedFormat string
+		expectedErr    error
+	}{
+		{name: "default format", format: "", expectedFormat: FormatCSV},
+		{name: "case insensitive", format: "XLSX", expectedFormat: FormatXLSX},
+		{name: "ndjson", format: "ndjson", expectedFormat: FormatNDJSON},
+		{name: "unknown format", format: "json", expectedErr: ErrUnsupportedFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			format, err := Resolve(tt.format)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedFormat, format)
+		})
+	}
+}
+
+func TestWriter(t *testing.T) {
+	t.Parallel()
+
+	box := model.PackageBox
+	film := model.WrapperFilm
+	cellID := int64(5)
+	deliveredAt := time.Date(2030, 1, 3, 10, 0, 0, 0, time.UTC)
+	orders := []model.Order{
+		{
+			ID:            2,
+			CustomerID:    20,
+			PickupPointID: 3,
+			State:         model.StateDelivered,
+			Weight:        2,
+			Cost:          250.5,
+			PackageType:   &box,
+			Wrapper:       &film,
+			DeadlineAt:    time.Date(2030, 1, 2, 15, 4, 5, 0, time.UTC),
+			UpdatedAt:     deliveredAt,
+			DeliveredAt:   &deliveredAt,
+			Version:       3,
+		},
+		{
+			ID:            1,
+			CustomerID:    10,
+			State:         model.StateAccepted,
+			Weight:        1.5,
+			Cost:          100,
+			DeadlineAt:    time.Date(2030, 1, 2, 18, 4, 5, 0, time.FixedZone("MSK", 3*60*60)),
+			UpdatedAt:     time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC),
+			StorageCellID: &cellID,
+			Version:       1,
+		},
+	}
+
+	// Выгрузку можно загрузить обратно: поля файла импорта совпадают с колонками выгрузки
+	record := func(deadline1, deadline2 string) []importer.Record {
+		return []importer.Record{
+			{ID: 2, CustomerID: 20, PickupPointID: 3, DeadlineAt: deadline1, Weight: 2, Cost: 250.5, PackageType: "box", Wrapper: "film"},
+			{ID: 1, CustomerID: 10, DeadlineAt: deadline2, Weight: 1.5, Cost: 100},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		format          string
+		expectedOutput  string
+		expectedRecords []importer.Record
+	}{
+		{
+			name:   "csv",
+			format: FormatCSV,
+			expectedOutput: "id,customer_id,pickup_point_id,state,deadline_at,weight,cost,package_type,wrapper,updated_at,delivered_at,returned_at,storage_cell_id,version\n" +
+				"2,20,3,delivered,2030-01-02T15:04:05,2,250.5,box,film,2030-01-03T10:00:00,2030-01-03T10:00:00,,,3\n" +
+				"1,10,,accepted,2030-01-02T15:04:05,1.5,100,,,2030-01-01T09:00:00,,,5,1\n",
+			expectedRecords: record("2030-01-02T15:04:05", "2030-01-02T15:04:05"),
+		},
+		{
+			// В NDJSON заказ записывается как в ответах API, даты - в формате RFC 3339
+			name:            "ndjson",
+			format:          FormatNDJSON,
+			expectedRecords: record("2030-01-02T15:04:05Z", "2030-01-02T18:04:05+03:00"),
+		},
+		{
+			name:            "xlsx",
+			format:          FormatXLSX,
+			expectedRecords: record("2030-01-02T15:04:05", "2030-01-02T15:04:05"),
+		},
+	}
+
+	registry, err := importer.NewDefaultRegistry(importer.TableOptions{})
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			writer, err := NewWriter(tt.format, &buf)
+			require.NoError(t, err)
+
+			for _, order := range orders {
+				require.NoError(t, writer.Write(order))
+				require.NoError(t, writer.Flush())
+			}
+			require.NoError(t, writer.Close())
+
+			if tt.expectedOutput != "" {
+				assert.Equal(t, tt.expectedOutput, buf.String())
+			}
+
+			records, err := registry.Parse(tt.format, buf.Bytes())
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRecords, records)
+		})
+	}
+}
+
+func TestXLSXColumnName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "A", xlsxColumnName(0))
+	assert.Equal(t, "N", xlsxColumnName(13))
+	assert.Equal(t, "Z", xlsxColumnName(25))
+	assert.Equal(t, "AA", xlsxColumnName(26))
+	assert.Equal(t, "AZ", xlsxColumnName(51))
+	assert.Equal(t, "BA", xlsxColumnName(52))
+}
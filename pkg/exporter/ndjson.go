@@ -0,0 +1,32 @@
+package exporter
+
+import (
+	"encoding/json"
+	"io"
+
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+// ndjsonWriter записывает каждый заказ отдельной строкой JSON
+type ndjsonWriter struct {
+	enc *json.Encoder
+}
+
+func newNDJSONWriter(w io.Writer) (Writer, error) {
+	return &ndjsonWriter{enc: json.NewEncoder(w)}, nil
+}
+
+// Write записывает заказ строкой JSON
+func (w *ndjsonWriter) Write(order model.Order) error {
+	return w.enc.Encode(order)
+}
+
+// Flush ничего не делает: заказ передается в нижележащий io.Writer сразу при записи
+func (w *ndjsonWriter) Flush() error {
+	return nil
+}
+
+// Close ничего не делает: у NDJSON нет завершающей части
+func (w *ndjsonWriter) Close() error {
+	return nil
+}
@@ -0,0 +1,155 @@
+package exporter
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+// ErrUnsupportedReportFormat - формат отчета сверки смены не поддерживается
+var ErrUnsupportedReportFormat = errors.New("неподдерживаемый формат отчета сверки смены")
+
+// Форматы отчета сверки смены
+const (
+	ReportFormatJSON = "json"
+	ReportFormatCSV  = "csv"
+)
+
+var reportContentTypes = map[string]string{
+	ReportFormatJSON: "application/json",
+	ReportFormatCSV:  "text/csv; charset=utf-8",
+}
+
+// reportOperatorColumns - колонки итогов сотрудников в CSV-отчете сверки смены
+var reportOperatorColumns = []string{
+	"operator_id", "username", "handouts", "handout_amount", "payments", "cash_received", "card_received",
+	"returns", "return_amount", "cash_refunded", "card_refunded", "online_refunded", "courier_returns",
+}
+
+// reportCashColumns - колонки сверки кассы в CSV-отчете сверки смены
+var reportCashColumns = []string{
+	"shift_id", "pickup_point_id", "from", "to", "final",
+	"opening_cash", "expected_cash", "counted_cash", "cash_difference",
+}
+
+// ResolveReportFormat проверяет формат отчета сверки смены. Пустой формат означает JSON.
+func ResolveReportFormat(name string) (string, error) {
+	if name == "" {
+		return ReportFormatJSON, nil
+	}
+
+	name = strings.ToLower(name)
+	if _, ok := reportContentTypes[name]; !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedReportFormat, name)
+	}
+
+	return name, nil
+}
+
+// ReportContentType возвращает тип содержимого файла отчета сверки смены
+func ReportContentType(name string) string {
+	return reportContentTypes[name]
+}
+
+// ReportFilename возвращает имя файла отчета сверки смены
+func ReportFilename(shiftID int64, name string) string {
+	return fmt.Sprintf("shift-%d.%s", shiftID, name)
+}
+
+// WriteShiftReport записывает отчет сверки смены в формате name.
+// CSV состоит из двух таблиц, разделенных пустой строкой: итоги по сотрудникам со строкой total
+// и сверка кассы.
+func WriteShiftReport(w io.Writer, name string, report model.ShiftReport) error {
+	switch name {
+	case ReportFormatJSON:
+		return json.NewEncoder(w).Encode(report)
+	case ReportFormatCSV:
+		return writeShiftReportCSV(w, report)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedReportFormat, name)
+	}
+}
+
+func writeShiftReportCSV(w io.Writer, report model.ShiftReport) error {
+	writer := csv.NewWriter(w)
+
+	rows := make([][]string, 0, len(report.Operators)+6)
+	rows = append(rows, reportOperatorColumns)
+	for _, operator := range report.Operators {
+		operatorID := ""
+		if operator.OperatorID != nil {
+			operatorID = strconv.FormatInt(*operator.OperatorID, 10)
+		}
+		rows = append(rows, totalsRow(operatorID, operator.Username, operator.ShiftTotals))
+	}
+	rows = append(rows, totalsRow("", "total", report.Totals))
+
+	// Пустая строка отделяет итоги по сотрудникам от сверки кассы
+	rows = append(rows, nil, reportCashColumns, []string{
+		strconv.FormatInt(report.ShiftID, 10),
+		strconv.FormatInt(report.PickupPointID, 10),
+		formatTime(&report.From),
+		formatTime(&report.To),
+		strconv.FormatBool(report.Final),
+		formatAmount(report.OpeningCash),
+		formatAmount(report.ExpectedCash),
+		formatOptionalAmount(report.CountedCash),
+		formatOptionalAmount(report.CashDifference),
+	})
+
+	for _, row := range rows {
+		if row == nil {
+			// csv.Writer не записывает пустую строку, поэтому разделитель пишется напрямую
+			writer.Flush()
+			if _, err := io.WriteString(w, "\n"); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// totalsRow возвращает строку итогов CSV-отчета сверки смены
+func totalsRow(operatorID, username string, totals model.ShiftTotals) []string {
+	return []string{
+		operatorID,
+		username,
+		strconv.Itoa(totals.Handouts),
+		formatAmount(totals.HandoutAmount),
+		strconv.Itoa(totals.Payments),
+		formatAmount(totals.CashReceived),
+		formatAmount(totals.CardReceived),
+		strconv.Itoa(totals.Returns),
+		formatAmount(totals.ReturnAmount),
+		formatAmount(totals.CashRefunded),
+		formatAmount(totals.CardRefunded),
+		formatAmount(totals.OnlineRefunded),
+		strconv.Itoa(totals.CourierReturns),
+	}
+}
+
+// formatAmount форматирует сумму с копейками
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
+
+// formatOptionalAmount форматирует сумму с копейками, nil - пустая строка
+func formatOptionalAmount(amount *float64) string {
+	if amount == nil {
+		return ""
+	}
+
+	return formatAmount(*amount)
+}
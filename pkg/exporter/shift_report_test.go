@@ -0,0 +1,112 @@
+package exporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+func TestResolveReportFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		format         string
+		expectedFormat string
+		expectedErr    error
+	}{
+		{name: "default format", format: "", expectedFormat: ReportFormatJSON},
+		{name: "case insensitive", format: "CSV", expectedFormat: ReportFormatCSV},
+		{name: "unknown format", format: "pdf", expectedErr: ErrUnsupportedReportFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			format, err := ResolveReportFormat(tt.format)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedFormat, format)
+		})
+	}
+}
+
+func TestWriteShiftReport(t *testing.T) {
+	t.Parallel()
+
+	operatorID := int64(7)
+	counted := 1450.0
+	difference := -50.0
+	report := model.ShiftReport{
+		ShiftID:       3,
+		PickupPointID: 1,
+		From:          time.Date(2030, 1, 3, 9, 0, 0, 0, time.UTC),
+		To:            time.Date(2030, 1, 3, 21, 0, 0, 0, time.UTC),
+		Final:         true,
+		Operators: []model.ShiftOperatorTotals{
+			{
+				OperatorID: &operatorID,
+				Username:   "operator",
+				ShiftTotals: model.ShiftTotals{
+					Handouts: 2, HandoutAmount: 3000, Payments: 1, CashReceived: 1000, CardReceived: 500,
+					Returns: 1, ReturnAmount: 400, CashRefunded: 500, OnlineRefunded: 400,
+				},
+			},
+			{ShiftTotals: model.ShiftTotals{CourierReturns: 3}},
+		},
+		Totals: model.ShiftTotals{
+			Handouts: 2, HandoutAmount: 3000, Payments: 1, CashReceived: 1000, CardReceived: 500,
+			Returns: 1, ReturnAmount: 400, CashRefunded: 500, OnlineRefunded: 400, CourierReturns: 3,
+		},
+		OpeningCash:    1000,
+		ExpectedCash:   1500,
+		CountedCash:    &counted,
+		CashDifference: &difference,
+	}
+
+	t.Run("csv", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		require.NoError(t, WriteShiftReport(&buf, ReportFormatCSV, report))
+
+		expected := "operator_id,username,handouts,handout_amount,payments,cash_received,card_received," +
+			"returns,return_amount,cash_refunded,card_refunded,online_refunded,courier_returns\n" +
+			"7,operator,2,3000.00,1,1000.00,500.00,1,400.00,500.00,0.00,400.00,0\n" +
+			",,0,0.00,0,0.00,0.00,0,0.00,0.00,0.00,0.00,3\n" +
+			",total,2,3000.00,1,1000.00,500.00,1,400.00,500.00,0.00,400.00,3\n" +
+			"\n" +
+			"shift_id,pickup_point_id,from,to,final,opening_cash,expected_cash,counted_cash,cash_difference\n" +
+			"3,1,2030-01-03T09:00:00,2030-01-03T21:00:00,true,1000.00,1500.00,1450.00,-50.00\n"
+		assert.Equal(t, expected, buf.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		require.NoError(t, WriteShiftReport(&buf, ReportFormatJSON, report))
+
+		var decoded model.ShiftReport
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+		assert.Equal(t, report.Totals, decoded.Totals)
+		assert.Equal(t, -50.0, *decoded.CashDifference)
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		assert.ErrorIs(t, WriteShiftReport(&buf, "pdf", report), ErrUnsupportedReportFormat)
+	})
+}
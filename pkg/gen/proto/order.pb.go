@@ -27,10 +27,13 @@ const (
 type OrderState int32
 
 const (
-	OrderState_ORDER_STATE_UNSPECIFIED OrderState = 0
-	OrderState_ORDER_STATE_ACCEPTED    OrderState = 1
-	OrderState_ORDER_STATE_DELIVERED   OrderState = 2
-	OrderState_ORDER_STATE_RETURNED    OrderState = 3
+	OrderState_ORDER_STATE_UNSPECIFIED         OrderState = 0
+	OrderState_ORDER_STATE_ACCEPTED            OrderState = 1
+	OrderState_ORDER_STATE_DELIVERED           OrderState = 2
+	OrderState_ORDER_STATE_RETURNED            OrderState = 3
+	OrderState_ORDER_STATE_RETURNED_TO_COURIER OrderState = 4
+	OrderState_ORDER_STATE_EXPIRED             OrderState = 5
+	OrderState_ORDER_STATE_LOST                OrderState = 6
 )
 
 // Enum value maps for OrderState.
@@ -40,12 +43,18 @@ var (
 		1: "ORDER_STATE_ACCEPTED",
 		2: "ORDER_STATE_DELIVERED",
 		3: "ORDER_STATE_RETURNED",
+		4: "ORDER_STATE_RETURNED_TO_COURIER",
+		5: "ORDER_STATE_EXPIRED",
+		6: "ORDER_STATE_LOST",
 	}
 	OrderState_value = map[string]int32{
-		"ORDER_STATE_UNSPECIFIED": 0,
-		"ORDER_STATE_ACCEPTED":    1,
-		"ORDER_STATE_DELIVERED":   2,
-		"ORDER_STATE_RETURNED":    3,
+		"ORDER_STATE_UNSPECIFIED":         0,
+		"ORDER_STATE_ACCEPTED":            1,
+		"ORDER_STATE_DELIVERED":           2,
+		"ORDER_STATE_RETURNED":            3,
+		"ORDER_STATE_RETURNED_TO_COURIER": 4,
+		"ORDER_STATE_EXPIRED":             5,
+		"ORDER_STATE_LOST":                6,
 	}
 )
 
@@ -186,6 +195,9 @@ type CreateOrderRequest struct {
 	Cost          float64                `protobuf:"fixed64,5,opt,name=cost,proto3" json:"cost,omitempty"`
 	PackageType   PackageType            `protobuf:"varint,6,opt,name=package_type,json=packageType,proto3,enum=proto.PackageType" json:"package_type,omitempty"`
 	Wrapper       WrapperType            `protobuf:"varint,7,opt,name=wrapper,proto3,enum=proto.WrapperType" json:"wrapper,omitempty"`
+	PickupPointId int64                  `protobuf:"varint,8,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"` // если не указан, заказ принимается в ПВЗ сотрудника
+	Items         []*OrderItem           `protobuf:"bytes,9,rep,name=items,proto3" json:"items,omitempty"`                                         // если указаны, вес и стоимость заказа считаются по товарам
+	PaymentMode   string                 `protobuf:"bytes,10,opt,name=payment_mode,json=paymentMode,proto3" json:"payment_mode,omitempty"`         // prepaid или on_pickup, по умолчанию prepaid
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -269,6 +281,104 @@ func (x *CreateOrderRequest) GetWrapper() WrapperType {
 	return WrapperType_WRAPPER_TYPE_UNSPECIFIED
 }
 
+func (x *CreateOrderRequest) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetPaymentMode() string {
+	if x != nil {
+		return x.PaymentMode
+	}
+	return ""
+}
+
+// Товар в составе заказа
+type OrderItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Weight        float64                `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	Cost          float64                `protobuf:"fixed64,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	State         string                 `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"` // accepted, delivered, refused или returned
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderItem) Reset() {
+	*x = OrderItem{}
+	mi := &file_proto_order_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItem) ProtoMessage() {}
+
+func (x *OrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OrderItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OrderItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrderItem) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *OrderItem) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *OrderItem) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
 // Модель заказа
 type Order struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -283,13 +393,18 @@ type Order struct {
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	DeliveredAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
 	ReturnedAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=returned_at,json=returnedAt,proto3" json:"returned_at,omitempty"`
+	PickupPointId int64                  `protobuf:"varint,12,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	StorageCellId int64                  `protobuf:"varint,13,opt,name=storage_cell_id,json=storageCellId,proto3" json:"storage_cell_id,omitempty"` // 0 - заказ не размещен в ячейке
+	Version       int64                  `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`
+	Items         []*OrderItem           `protobuf:"bytes,15,rep,name=items,proto3" json:"items,omitempty"`
+	PaymentMode   string                 `protobuf:"bytes,16,opt,name=payment_mode,json=paymentMode,proto3" json:"payment_mode,omitempty"` // prepaid или on_pickup
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Order) Reset() {
 	*x = Order{}
-	mi := &file_proto_order_proto_msgTypes[1]
+	mi := &file_proto_order_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -301,7 +416,7 @@ func (x *Order) String() string {
 func (*Order) ProtoMessage() {}
 
 func (x *Order) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_proto_msgTypes[1]
+	mi := &file_proto_order_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -314,7 +429,7 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Order.ProtoReflect.Descriptor instead.
 func (*Order) Descriptor() ([]byte, []int) {
-	return file_proto_order_proto_rawDescGZIP(), []int{1}
+	return file_proto_order_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Order) GetId() int64 {
@@ -394,6 +509,41 @@ func (x *Order) GetReturnedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Order) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *Order) GetStorageCellId() int64 {
+	if x != nil {
+		return x.StorageCellId
+	}
+	return 0
+}
+
+func (x *Order) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Order) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Order) GetPaymentMode() string {
+	if x != nil {
+		return x.PaymentMode
+	}
+	return ""
+}
+
 // Запрос на получение информации о заказе по ID
 type GetOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -404,7 +554,7 @@ type GetOrderRequest struct {
 
 func (x *GetOrderRequest) Reset() {
 	*x = GetOrderRequest{}
-	mi := &file_proto_order_proto_msgTypes[2]
+	mi := &file_proto_order_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -416,7 +566,7 @@ func (x *GetOrderRequest) String() string {
 func (*GetOrderRequest) ProtoMessage() {}
 
 func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_proto_msgTypes[2]
+	mi := &file_proto_order_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -429,7 +579,7 @@ func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderRequest) Descriptor() ([]byte, []int) {
-	return file_proto_order_proto_rawDescGZIP(), []int{2}
+	return file_proto_order_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetOrderRequest) GetId() int64 {
@@ -443,13 +593,14 @@ func (x *GetOrderRequest) GetId() int64 {
 type ReturnToCourierRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // если указана, заказ возвращается только при совпадении версии
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ReturnToCourierRequest) Reset() {
 	*x = ReturnToCourierRequest{}
-	mi := &file_proto_order_proto_msgTypes[3]
+	mi := &file_proto_order_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -461,7 +612,7 @@ func (x *ReturnToCourierRequest) String() string {
 func (*ReturnToCourierRequest) ProtoMessage() {}
 
 func (x *ReturnToCourierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_proto_msgTypes[3]
+	mi := &file_proto_order_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -474,7 +625,7 @@ func (x *ReturnToCourierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReturnToCourierRequest.ProtoReflect.Descriptor instead.
 func (*ReturnToCourierRequest) Descriptor() ([]byte, []int) {
-	return file_proto_order_proto_rawDescGZIP(), []int{3}
+	return file_proto_order_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ReturnToCourierRequest) GetId() int64 {
@@ -484,6 +635,13 @@ func (x *ReturnToCourierRequest) GetId() int64 {
 	return 0
 }
 
+func (x *ReturnToCourierRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // Ответ на запрос о возврате заказа курьеру
 type ReturnToCourierResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -494,7 +652,7 @@ type ReturnToCourierResponse struct {
 
 func (x *ReturnToCourierResponse) Reset() {
 	*x = ReturnToCourierResponse{}
-	mi := &file_proto_order_proto_msgTypes[4]
+	mi := &file_proto_order_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -506,7 +664,7 @@ func (x *ReturnToCourierResponse) String() string {
 func (*ReturnToCourierResponse) ProtoMessage() {}
 
 func (x *ReturnToCourierResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_proto_msgTypes[4]
+	mi := &file_proto_order_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -519,7 +677,7 @@ func (x *ReturnToCourierResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReturnToCourierResponse.ProtoReflect.Descriptor instead.
 func (*ReturnToCourierResponse) Descriptor() ([]byte, []int) {
-	return file_proto_order_proto_rawDescGZIP(), []int{4}
+	return file_proto_order_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ReturnToCourierResponse) GetMessage() string {
@@ -529,31 +687,31 @@ func (x *ReturnToCourierResponse) GetMessage() string {
 	return ""
 }
 
-// Запрос на обработку действий с заказами для указанного клиента
-type ProcessCustomerRequest struct {
+// Запрос на продление срока хранения заказа
+type ExtendStorageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CustomerId    int64                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // "handout" или "return"
-	OrderIds      []int64                `protobuf:"varint,3,rep,packed,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	Version       int64                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"` // если указана, срок продлевается только при совпадении версии
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessCustomerRequest) Reset() {
-	*x = ProcessCustomerRequest{}
-	mi := &file_proto_order_proto_msgTypes[5]
+func (x *ExtendStorageRequest) Reset() {
+	*x = ExtendStorageRequest{}
+	mi := &file_proto_order_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessCustomerRequest) String() string {
+func (x *ExtendStorageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessCustomerRequest) ProtoMessage() {}
+func (*ExtendStorageRequest) ProtoMessage() {}
 
-func (x *ProcessCustomerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_proto_msgTypes[5]
+func (x *ExtendStorageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -564,61 +722,61 @@ func (x *ProcessCustomerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessCustomerRequest.ProtoReflect.Descriptor instead.
-func (*ProcessCustomerRequest) Descriptor() ([]byte, []int) {
-	return file_proto_order_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ExtendStorageRequest.ProtoReflect.Descriptor instead.
+func (*ExtendStorageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ProcessCustomerRequest) GetCustomerId() int64 {
+func (x *ExtendStorageRequest) GetId() int64 {
 	if x != nil {
-		return x.CustomerId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ProcessCustomerRequest) GetAction() string {
+func (x *ExtendStorageRequest) GetDays() int32 {
 	if x != nil {
-		return x.Action
+		return x.Days
 	}
-	return ""
+	return 0
 }
 
-func (x *ProcessCustomerRequest) GetOrderIds() []int64 {
+func (x *ExtendStorageRequest) GetVersion() int64 {
 	if x != nil {
-		return x.OrderIds
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
-// Результат обработки конкретного заказа
-type ProcessingResult struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	OrderId int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	// Types that are valid to be assigned to Result:
-	//
-	//	*ProcessingResult_Message
-	//	*ProcessingResult_Error
-	Result        isProcessingResult_Result `protobuf_oneof:"result"`
-	Status        int32                     `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Продление срока хранения заказа
+type OrderExtension struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	OrderId            int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Days               int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	Fee                float64                `protobuf:"fixed64,3,opt,name=fee,proto3" json:"fee,omitempty"` // плата за продление, добавлена к стоимости заказа
+	PreviousDeadlineAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=previous_deadline_at,json=previousDeadlineAt,proto3" json:"previous_deadline_at,omitempty"`
+	DeadlineAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deadline_at,json=deadlineAt,proto3" json:"deadline_at,omitempty"`
+	ExtendedBy         int64                  `protobuf:"varint,6,opt,name=extended_by,json=extendedBy,proto3" json:"extended_by,omitempty"`
+	ExtendedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=extended_at,json=extendedAt,proto3" json:"extended_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *ProcessingResult) Reset() {
-	*x = ProcessingResult{}
-	mi := &file_proto_order_proto_msgTypes[6]
+func (x *OrderExtension) Reset() {
+	*x = OrderExtension{}
+	mi := &file_proto_order_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessingResult) String() string {
+func (x *OrderExtension) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessingResult) ProtoMessage() {}
+func (*OrderExtension) ProtoMessage() {}
 
-func (x *ProcessingResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_proto_msgTypes[6]
+func (x *OrderExtension) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,89 +787,84 @@ func (x *ProcessingResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessingResult.ProtoReflect.Descriptor instead.
-func (*ProcessingResult) Descriptor() ([]byte, []int) {
-	return file_proto_order_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use OrderExtension.ProtoReflect.Descriptor instead.
+func (*OrderExtension) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ProcessingResult) GetOrderId() int64 {
+func (x *OrderExtension) GetOrderId() int64 {
 	if x != nil {
 		return x.OrderId
 	}
 	return 0
 }
 
-func (x *ProcessingResult) GetResult() isProcessingResult_Result {
+func (x *OrderExtension) GetDays() int32 {
 	if x != nil {
-		return x.Result
+		return x.Days
 	}
-	return nil
+	return 0
 }
 
-func (x *ProcessingResult) GetMessage() string {
+func (x *OrderExtension) GetFee() float64 {
 	if x != nil {
-		if x, ok := x.Result.(*ProcessingResult_Message); ok {
-			return x.Message
-		}
+		return x.Fee
 	}
-	return ""
+	return 0
 }
 
-func (x *ProcessingResult) GetError() string {
+func (x *OrderExtension) GetPreviousDeadlineAt() *timestamppb.Timestamp {
 	if x != nil {
-		if x, ok := x.Result.(*ProcessingResult_Error); ok {
-			return x.Error
-		}
+		return x.PreviousDeadlineAt
 	}
-	return ""
+	return nil
 }
 
-func (x *ProcessingResult) GetStatus() int32 {
+func (x *OrderExtension) GetDeadlineAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Status
+		return x.DeadlineAt
 	}
-	return 0
-}
-
-type isProcessingResult_Result interface {
-	isProcessingResult_Result()
+	return nil
 }
 
-type ProcessingResult_Message struct {
-	Message string `protobuf:"bytes,2,opt,name=message,proto3,oneof"`
+func (x *OrderExtension) GetExtendedBy() int64 {
+	if x != nil {
+		return x.ExtendedBy
+	}
+	return 0
 }
 
-type ProcessingResult_Error struct {
-	Error string `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+func (x *OrderExtension) GetExtendedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExtendedAt
+	}
+	return nil
 }
 
-func (*ProcessingResult_Message) isProcessingResult_Result() {}
-
-func (*ProcessingResult_Error) isProcessingResult_Result() {}
-
-// Ответ на запрос обработки действий с заказами
-type ProcessCustomerResponse struct {
+// Ответ с заказом после продления срока хранения
+type ExtendStorageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Results       []*ProcessingResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Extension     *OrderExtension        `protobuf:"bytes,2,opt,name=extension,proto3" json:"extension,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessCustomerResponse) Reset() {
-	*x = ProcessCustomerResponse{}
-	mi := &file_proto_order_proto_msgTypes[7]
+func (x *ExtendStorageResponse) Reset() {
+	*x = ExtendStorageResponse{}
+	mi := &file_proto_order_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessCustomerResponse) String() string {
+func (x *ExtendStorageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessCustomerResponse) ProtoMessage() {}
+func (*ExtendStorageResponse) ProtoMessage() {}
 
-func (x *ProcessCustomerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_proto_msgTypes[7]
+func (x *ExtendStorageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -722,45 +875,1559 @@ func (x *ProcessCustomerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessCustomerResponse.ProtoReflect.Descriptor instead.
-func (*ProcessCustomerResponse) Descriptor() ([]byte, []int) {
-	return file_proto_order_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ExtendStorageResponse.ProtoReflect.Descriptor instead.
+func (*ExtendStorageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ProcessCustomerResponse) GetResults() []*ProcessingResult {
+func (x *ExtendStorageResponse) GetOrder() *Order {
 	if x != nil {
-		return x.Results
+		return x.Order
 	}
 	return nil
 }
 
-// Запрос на получение списка заказов с курсорной пагинацией
-type ListOrdersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CursorId      int64                  `protobuf:"varint,1,opt,name=cursor_id,json=cursorId,proto3" json:"cursor_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	CustomerId    int64                  `protobuf:"varint,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	FilterPvz     bool                   `protobuf:"varint,4,opt,name=filter_pvz,json=filterPvz,proto3" json:"filter_pvz,omitempty"`
-	SearchTerm    string                 `protobuf:"bytes,5,opt,name=search_term,json=searchTerm,proto3" json:"search_term,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ExtendStorageResponse) GetExtension() *OrderExtension {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
 }
 
-func (x *ListOrdersRequest) Reset() {
-	*x = ListOrdersRequest{}
-	mi := &file_proto_order_proto_msgTypes[8]
+// Запрос на обработку действий с заказами для указанного клиента
+type ProcessCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    int64                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // "handout" или "return"
+	OrderIds      []int64                `protobuf:"varint,3,rep,packed,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	Atomic        bool                   `protobuf:"varint,4,opt,name=atomic,proto3" json:"atomic,omitempty"`                         // обработать все заказы в одной транзакции или не обрабатывать ни один
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                          // причина возврата, обязательна для "return"
+	Comment       string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`                        // комментарий клиента к возврату
+	Condition     string                 `protobuf:"bytes,7,opt,name=condition,proto3" json:"condition,omitempty"`                    // состояние возвращаемого заказа: intact, damaged или opened, обязательно для "return"
+	Photos        []string               `protobuf:"bytes,8,rep,name=photos,proto3" json:"photos,omitempty"`                          // ссылки на фотографии возвращаемого заказа
+	ItemIds       []int64                `protobuf:"varint,9,rep,packed,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"` // выдаваемые или возвращаемые товары, по умолчанию все товары
+	Payment       *PaymentDetails        `protobuf:"bytes,10,opt,name=payment,proto3" json:"payment,omitempty"`                       // оплата при выдаче заказов с оплатой при получении
+	Version       int64                  `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`                      // если указана, заказ обрабатывается только при совпадении версии; заказ в запросе должен быть один
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessCustomerRequest) Reset() {
+	*x = ProcessCustomerRequest{}
+	mi := &file_proto_order_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessCustomerRequest) ProtoMessage() {}
+
+func (x *ProcessCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessCustomerRequest.ProtoReflect.Descriptor instead.
+func (*ProcessCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ProcessCustomerRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *ProcessCustomerRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ProcessCustomerRequest) GetOrderIds() []int64 {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+func (x *ProcessCustomerRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *ProcessCustomerRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ProcessCustomerRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ProcessCustomerRequest) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *ProcessCustomerRequest) GetPhotos() []string {
+	if x != nil {
+		return x.Photos
+	}
+	return nil
+}
+
+func (x *ProcessCustomerRequest) GetItemIds() []int64 {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+func (x *ProcessCustomerRequest) GetPayment() *PaymentDetails {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *ProcessCustomerRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Оплата, которую сотрудник ПВЗ принимает при выдаче заказов
+type PaymentDetails struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`                             // cash, card или mixed
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`                           // сумма оплаты, должна совпадать с суммой к оплате
+	CashAmount    float64                `protobuf:"fixed64,3,opt,name=cash_amount,json=cashAmount,proto3" json:"cash_amount,omitempty"` // часть суммы наличными при смешанной оплате
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentDetails) Reset() {
+	*x = PaymentDetails{}
+	mi := &file_proto_order_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentDetails) ProtoMessage() {}
+
+func (x *PaymentDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentDetails.ProtoReflect.Descriptor instead.
+func (*PaymentDetails) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PaymentDetails) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *PaymentDetails) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PaymentDetails) GetCashAmount() float64 {
+	if x != nil {
+		return x.CashAmount
+	}
+	return 0
+}
+
+// Результат обработки конкретного заказа
+type ProcessingResult struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*ProcessingResult_Message
+	//	*ProcessingResult_Error
+	Result        isProcessingResult_Result `protobuf_oneof:"result"`
+	Status        int32                     `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessingResult) Reset() {
+	*x = ProcessingResult{}
+	mi := &file_proto_order_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessingResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessingResult) ProtoMessage() {}
+
+func (x *ProcessingResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessingResult.ProtoReflect.Descriptor instead.
+func (*ProcessingResult) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProcessingResult) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ProcessingResult) GetResult() isProcessingResult_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ProcessingResult) GetMessage() string {
+	if x != nil {
+		if x, ok := x.Result.(*ProcessingResult_Message); ok {
+			return x.Message
+		}
+	}
+	return ""
+}
+
+func (x *ProcessingResult) GetError() string {
+	if x != nil {
+		if x, ok := x.Result.(*ProcessingResult_Error); ok {
+			return x.Error
+		}
+	}
+	return ""
+}
+
+func (x *ProcessingResult) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type isProcessingResult_Result interface {
+	isProcessingResult_Result()
+}
+
+type ProcessingResult_Message struct {
+	Message string `protobuf:"bytes,2,opt,name=message,proto3,oneof"`
+}
+
+type ProcessingResult_Error struct {
+	Error string `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*ProcessingResult_Message) isProcessingResult_Result() {}
+
+func (*ProcessingResult_Error) isProcessingResult_Result() {}
+
+// Ответ на запрос обработки действий с заказами
+type ProcessCustomerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*ProcessingResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessCustomerResponse) Reset() {
+	*x = ProcessCustomerResponse{}
+	mi := &file_proto_order_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessCustomerResponse) ProtoMessage() {}
+
+func (x *ProcessCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessCustomerResponse.ProtoReflect.Descriptor instead.
+func (*ProcessCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ProcessCustomerResponse) GetResults() []*ProcessingResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Запрос на получение списка заказов с курсорной пагинацией
+type ListOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CursorId      int64                  `protobuf:"varint,1,opt,name=cursor_id,json=cursorId,proto3" json:"cursor_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	CustomerId    int64                  `protobuf:"varint,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	FilterPvz     bool                   `protobuf:"varint,4,opt,name=filter_pvz,json=filterPvz,proto3" json:"filter_pvz,omitempty"`
+	SearchTerm    string                 `protobuf:"bytes,5,opt,name=search_term,json=searchTerm,proto3" json:"search_term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_proto_order_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListOrdersRequest) GetCursorId() int64 {
+	if x != nil {
+		return x.CursorId
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetFilterPvz() bool {
+	if x != nil {
+		return x.FilterPvz
+	}
+	return false
+}
+
+func (x *ListOrdersRequest) GetSearchTerm() string {
+	if x != nil {
+		return x.SearchTerm
+	}
+	return ""
+}
+
+// Ответ со списком заказов и курсорной пагинацией
+type ListOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursor    int64                  `protobuf:"varint,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_proto_order_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListOrdersResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListOrdersResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+// Запрос на получение списка возвращенных заказов с курсорной пагинацией
+type ListReturnsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CursorId      int64                  `protobuf:"varint,1,opt,name=cursor_id,json=cursorId,proto3" json:"cursor_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	SearchTerm    string                 `protobuf:"bytes,3,opt,name=search_term,json=searchTerm,proto3" json:"search_term,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`       // если указана, только возвраты с этой причиной
+	Condition     string                 `protobuf:"bytes,5,opt,name=condition,proto3" json:"condition,omitempty"` // если указано, только возвраты заказов в этом состоянии
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReturnsRequest) Reset() {
+	*x = ListReturnsRequest{}
+	mi := &file_proto_order_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReturnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReturnsRequest) ProtoMessage() {}
+
+func (x *ListReturnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReturnsRequest.ProtoReflect.Descriptor instead.
+func (*ListReturnsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListReturnsRequest) GetCursorId() int64 {
+	if x != nil {
+		return x.CursorId
+	}
+	return 0
+}
+
+func (x *ListReturnsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListReturnsRequest) GetSearchTerm() string {
+	if x != nil {
+		return x.SearchTerm
+	}
+	return ""
+}
+
+func (x *ListReturnsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ListReturnsRequest) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+// Сведения о возврате заказа клиентом
+type OrderReturn struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	OrderId            int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId         int64                  `protobuf:"varint,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Reason             string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Comment            string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	Condition          string                 `protobuf:"bytes,5,opt,name=condition,proto3" json:"condition,omitempty"`
+	Photos             []string               `protobuf:"bytes,6,rep,name=photos,proto3" json:"photos,omitempty"`
+	PolicyId           int64                  `protobuf:"varint,7,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Policy             string                 `protobuf:"bytes,8,opt,name=policy,proto3" json:"policy,omitempty"`
+	Fee                float64                `protobuf:"fixed64,9,opt,name=fee,proto3" json:"fee,omitempty"`        // удержание за возврат
+	Refund             float64                `protobuf:"fixed64,10,opt,name=refund,proto3" json:"refund,omitempty"` // сумма к возврату клиенту
+	RequiresInspection bool                   `protobuf:"varint,11,opt,name=requires_inspection,json=requiresInspection,proto3" json:"requires_inspection,omitempty"`
+	ReturnedBy         int64                  `protobuf:"varint,12,opt,name=returned_by,json=returnedBy,proto3" json:"returned_by,omitempty"`
+	ReturnedAt         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=returned_at,json=returnedAt,proto3" json:"returned_at,omitempty"`
+	ItemIds            []int64                `protobuf:"varint,14,rep,packed,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"` // возвращенные товары заказа с товарами
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *OrderReturn) Reset() {
+	*x = OrderReturn{}
+	mi := &file_proto_order_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderReturn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderReturn) ProtoMessage() {}
+
+func (x *OrderReturn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderReturn.ProtoReflect.Descriptor instead.
+func (*OrderReturn) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *OrderReturn) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OrderReturn) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *OrderReturn) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OrderReturn) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *OrderReturn) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *OrderReturn) GetPhotos() []string {
+	if x != nil {
+		return x.Photos
+	}
+	return nil
+}
+
+func (x *OrderReturn) GetPolicyId() int64 {
+	if x != nil {
+		return x.PolicyId
+	}
+	return 0
+}
+
+func (x *OrderReturn) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *OrderReturn) GetFee() float64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *OrderReturn) GetRefund() float64 {
+	if x != nil {
+		return x.Refund
+	}
+	return 0
+}
+
+func (x *OrderReturn) GetRequiresInspection() bool {
+	if x != nil {
+		return x.RequiresInspection
+	}
+	return false
+}
+
+func (x *OrderReturn) GetReturnedBy() int64 {
+	if x != nil {
+		return x.ReturnedBy
+	}
+	return 0
+}
+
+func (x *OrderReturn) GetReturnedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReturnedAt
+	}
+	return nil
+}
+
+func (x *OrderReturn) GetItemIds() []int64 {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+// Ответ со списком возвращенных заказов и курсорной пагинацией
+type ListReturnsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Returns       []*Order               `protobuf:"bytes,1,rep,name=returns,proto3" json:"returns,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursor    int64                  `protobuf:"varint,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Details       []*OrderReturn         `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"` // сведения о возвратах заказов из returns, если они есть
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReturnsResponse) Reset() {
+	*x = ListReturnsResponse{}
+	mi := &file_proto_order_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReturnsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReturnsResponse) ProtoMessage() {}
+
+func (x *ListReturnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReturnsResponse.ProtoReflect.Descriptor instead.
+func (*ListReturnsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListReturnsResponse) GetReturns() []*Order {
+	if x != nil {
+		return x.Returns
+	}
+	return nil
+}
+
+func (x *ListReturnsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListReturnsResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *ListReturnsResponse) GetDetails() []*OrderReturn {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+// Запрос на получение списка заказов для возврата курьеру с курсорной пагинацией
+type ListCourierReturnsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CursorId      int64                  `protobuf:"varint,1,opt,name=cursor_id,json=cursorId,proto3" json:"cursor_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCourierReturnsRequest) Reset() {
+	*x = ListCourierReturnsRequest{}
+	mi := &file_proto_order_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCourierReturnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCourierReturnsRequest) ProtoMessage() {}
+
+func (x *ListCourierReturnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCourierReturnsRequest.ProtoReflect.Descriptor instead.
+func (*ListCourierReturnsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListCourierReturnsRequest) GetCursorId() int64 {
+	if x != nil {
+		return x.CursorId
+	}
+	return 0
+}
+
+func (x *ListCourierReturnsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Ответ со списком заказов с истекшим или истекающим сегодня сроком хранения
+type ListCourierReturnsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursor    int64                  `protobuf:"varint,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCourierReturnsResponse) Reset() {
+	*x = ListCourierReturnsResponse{}
+	mi := &file_proto_order_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCourierReturnsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCourierReturnsResponse) ProtoMessage() {}
+
+func (x *ListCourierReturnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCourierReturnsResponse.ProtoReflect.Descriptor instead.
+func (*ListCourierReturnsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListCourierReturnsResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListCourierReturnsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListCourierReturnsResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+// Запрос на получение истории всех заказов
+type OrderHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SearchTerm    string                 `protobuf:"bytes,1,opt,name=search_term,json=searchTerm,proto3" json:"search_term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderHistoryRequest) Reset() {
+	*x = OrderHistoryRequest{}
+	mi := &file_proto_order_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderHistoryRequest) ProtoMessage() {}
+
+func (x *OrderHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderHistoryRequest.ProtoReflect.Descriptor instead.
+func (*OrderHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *OrderHistoryRequest) GetSearchTerm() string {
+	if x != nil {
+		return x.SearchTerm
+	}
+	return ""
+}
+
+// Ответ с историей заказов
+type OrderHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderHistoryResponse) Reset() {
+	*x = OrderHistoryResponse{}
+	mi := &file_proto_order_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderHistoryResponse) ProtoMessage() {}
+
+func (x *OrderHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderHistoryResponse.ProtoReflect.Descriptor instead.
+func (*OrderHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *OrderHistoryResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *OrderHistoryResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Запрос на получение истории смены статусов заказа
+type OrderTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderTimelineRequest) Reset() {
+	*x = OrderTimelineRequest{}
+	mi := &file_proto_order_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderTimelineRequest) ProtoMessage() {}
+
+func (x *OrderTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderTimelineRequest.ProtoReflect.Descriptor instead.
+func (*OrderTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *OrderTimelineRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Смена статуса заказа
+type OrderStateTransition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	FromState     OrderState             `protobuf:"varint,3,opt,name=from_state,json=fromState,proto3,enum=proto.OrderState" json:"from_state,omitempty"` // не указан для первого статуса заказа
+	ToState       OrderState             `protobuf:"varint,4,opt,name=to_state,json=toState,proto3,enum=proto.OrderState" json:"to_state,omitempty"`
+	ChangedBy     int64                  `protobuf:"varint,5,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"` // 0 - статус изменен системой
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderStateTransition) Reset() {
+	*x = OrderStateTransition{}
+	mi := &file_proto_order_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderStateTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStateTransition) ProtoMessage() {}
+
+func (x *OrderStateTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStateTransition.ProtoReflect.Descriptor instead.
+func (*OrderStateTransition) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *OrderStateTransition) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OrderStateTransition) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OrderStateTransition) GetFromState() OrderState {
+	if x != nil {
+		return x.FromState
+	}
+	return OrderState_ORDER_STATE_UNSPECIFIED
+}
+
+func (x *OrderStateTransition) GetToState() OrderState {
+	if x != nil {
+		return x.ToState
+	}
+	return OrderState_ORDER_STATE_UNSPECIFIED
+}
+
+func (x *OrderStateTransition) GetChangedBy() int64 {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return 0
+}
+
+func (x *OrderStateTransition) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+// Ответ с историей смены статусов заказа
+type OrderTimelineResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	OrderId       int64                   `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Transitions   []*OrderStateTransition `protobuf:"bytes,2,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderTimelineResponse) Reset() {
+	*x = OrderTimelineResponse{}
+	mi := &file_proto_order_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderTimelineResponse) ProtoMessage() {}
+
+func (x *OrderTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderTimelineResponse.ProtoReflect.Descriptor instead.
+func (*OrderTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *OrderTimelineResponse) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OrderTimelineResponse) GetTransitions() []*OrderStateTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+// Запрос на получение оплаты заказа
+type GetOrderPaymentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderPaymentsRequest) Reset() {
+	*x = GetOrderPaymentsRequest{}
+	mi := &file_proto_order_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderPaymentsRequest) ProtoMessage() {}
+
+func (x *GetOrderPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetOrderPaymentsRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Оплата заказа клиентом при получении
+type Payment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"` // cash, card или mixed
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	CashAmount    float64                `protobuf:"fixed64,5,opt,name=cash_amount,json=cashAmount,proto3" json:"cash_amount,omitempty"`
+	CardAmount    float64                `protobuf:"fixed64,6,opt,name=card_amount,json=cardAmount,proto3" json:"card_amount,omitempty"`
+	TransactionId string                 `protobuf:"bytes,7,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"` // операция платежного терминала, пуста при оплате наличными
+	OperatorId    int64                  `protobuf:"varint,8,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	PaidAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	mi := &file_proto_order_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Payment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Payment) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *Payment) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Payment) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Payment) GetCashAmount() float64 {
+	if x != nil {
+		return x.CashAmount
+	}
+	return 0
+}
+
+func (x *Payment) GetCardAmount() float64 {
+	if x != nil {
+		return x.CardAmount
+	}
+	return 0
+}
+
+func (x *Payment) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *Payment) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *Payment) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+// Возврат денег клиенту за возвращенный заказ
+type Refund struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ReturnId      int64                  `protobuf:"varint,3,opt,name=return_id,json=returnId,proto3" json:"return_id,omitempty"`
+	PaymentId     int64                  `protobuf:"varint,4,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"` // 0 - предоплаченный заказ
+	Method        string                 `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`                         // cash, card, mixed или online
+	Amount        float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	CashAmount    float64                `protobuf:"fixed64,7,opt,name=cash_amount,json=cashAmount,proto3" json:"cash_amount,omitempty"`
+	CardAmount    float64                `protobuf:"fixed64,8,opt,name=card_amount,json=cardAmount,proto3" json:"card_amount,omitempty"`
+	TransactionId string                 `protobuf:"bytes,9,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"` // операция платежного терминала
+	OperatorId    int64                  `protobuf:"varint,10,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	RefundedAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=refunded_at,json=refundedAt,proto3" json:"refunded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Refund) Reset() {
+	*x = Refund{}
+	mi := &file_proto_order_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Refund) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Refund) ProtoMessage() {}
+
+func (x *Refund) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Refund.ProtoReflect.Descriptor instead.
+func (*Refund) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Refund) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Refund) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *Refund) GetReturnId() int64 {
+	if x != nil {
+		return x.ReturnId
+	}
+	return 0
+}
+
+func (x *Refund) GetPaymentId() int64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+func (x *Refund) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Refund) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Refund) GetCashAmount() float64 {
+	if x != nil {
+		return x.CashAmount
+	}
+	return 0
+}
+
+func (x *Refund) GetCardAmount() float64 {
+	if x != nil {
+		return x.CardAmount
+	}
+	return 0
+}
+
+func (x *Refund) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *Refund) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *Refund) GetRefundedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefundedAt
+	}
+	return nil
+}
+
+// Оплата заказа и возвраты денег по нему
+type OrderPayments struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	PaymentMode   string                 `protobuf:"bytes,2,opt,name=payment_mode,json=paymentMode,proto3" json:"payment_mode,omitempty"`
+	Payment       *Payment               `protobuf:"bytes,3,opt,name=payment,proto3" json:"payment,omitempty"` // не указана у предоплаченных и еще не выданных заказов
+	Refunds       []*Refund              `protobuf:"bytes,4,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderPayments) Reset() {
+	*x = OrderPayments{}
+	mi := &file_proto_order_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderPayments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderPayments) ProtoMessage() {}
+
+func (x *OrderPayments) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderPayments.ProtoReflect.Descriptor instead.
+func (*OrderPayments) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *OrderPayments) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OrderPayments) GetPaymentMode() string {
+	if x != nil {
+		return x.PaymentMode
+	}
+	return ""
+}
+
+func (x *OrderPayments) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *OrderPayments) GetRefunds() []*Refund {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
+// Запрос на загрузку заказов из файла
+type AcceptOrdersFromFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileContent   []byte                 `protobuf:"bytes,1,opt,name=file_content,json=fileContent,proto3" json:"file_content,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`               // только проверить записи, не создавая заказы
+	Bulk          bool                   `protobuf:"varint,4,opt,name=bulk,proto3" json:"bulk,omitempty"`                                 // принять все заказы одной транзакцией или не принимать ни один
+	ContentType   string                 `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // тип содержимого файла, по нему определяется формат, если его нет в имени файла
+	Format        string                 `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`                              // "json", "ndjson", "csv" или "xlsx"; если не указан, определяется по имени и типу содержимого
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptOrdersFromFileRequest) Reset() {
+	*x = AcceptOrdersFromFileRequest{}
+	mi := &file_proto_order_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersRequest) String() string {
+func (x *AcceptOrdersFromFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersRequest) ProtoMessage() {}
+func (*AcceptOrdersFromFileRequest) ProtoMessage() {}
 
-func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_proto_msgTypes[8]
+func (x *AcceptOrdersFromFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -771,71 +2438,179 @@ func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
-func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_order_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use AcceptOrdersFromFileRequest.ProtoReflect.Descriptor instead.
+func (*AcceptOrdersFromFileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ListOrdersRequest) GetCursorId() int64 {
+func (x *AcceptOrdersFromFileRequest) GetFileContent() []byte {
 	if x != nil {
-		return x.CursorId
+		return x.FileContent
 	}
-	return 0
+	return nil
 }
 
-func (x *ListOrdersRequest) GetLimit() int32 {
+func (x *AcceptOrdersFromFileRequest) GetFilename() string {
 	if x != nil {
-		return x.Limit
+		return x.Filename
 	}
-	return 0
+	return ""
 }
 
-func (x *ListOrdersRequest) GetCustomerId() int64 {
+func (x *AcceptOrdersFromFileRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *AcceptOrdersFromFileRequest) GetBulk() bool {
+	if x != nil {
+		return x.Bulk
+	}
+	return false
+}
+
+func (x *AcceptOrdersFromFileRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *AcceptOrdersFromFileRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// Запрос выгрузки заказов
+type ExportOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // "csv", "ndjson" или "xlsx", по умолчанию "csv"
+	CustomerId    int64                  `protobuf:"varint,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	PickupPointId int64                  `protobuf:"varint,3,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"` // для сотрудника ПВЗ всегда его ПВЗ
+	InPickupPoint bool                   `protobuf:"varint,4,opt,name=in_pickup_point,json=inPickupPoint,proto3" json:"in_pickup_point,omitempty"` // только заказы, которые хранятся в ПВЗ и доступны к выдаче
+	States        []OrderState           `protobuf:"varint,5,rep,packed,name=states,proto3,enum=proto.OrderState" json:"states,omitempty"`
+	UpdatedFrom   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_from,json=updatedFrom,proto3" json:"updated_from,omitempty"` // заказы, измененные не раньше этого времени
+	UpdatedTo     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_to,json=updatedTo,proto3" json:"updated_to,omitempty"`       // заказы, измененные раньше этого времени
+	Search        string                 `protobuf:"bytes,8,opt,name=search,proto3" json:"search,omitempty"`                              // подстрока ID заказа или ID клиента
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportOrdersRequest) Reset() {
+	*x = ExportOrdersRequest{}
+	mi := &file_proto_order_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOrdersRequest) ProtoMessage() {}
+
+func (x *ExportOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ExportOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ExportOrdersRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportOrdersRequest) GetCustomerId() int64 {
 	if x != nil {
 		return x.CustomerId
 	}
 	return 0
 }
 
-func (x *ListOrdersRequest) GetFilterPvz() bool {
+func (x *ExportOrdersRequest) GetPickupPointId() int64 {
 	if x != nil {
-		return x.FilterPvz
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *ExportOrdersRequest) GetInPickupPoint() bool {
+	if x != nil {
+		return x.InPickupPoint
 	}
 	return false
 }
 
-func (x *ListOrdersRequest) GetSearchTerm() string {
+func (x *ExportOrdersRequest) GetStates() []OrderState {
 	if x != nil {
-		return x.SearchTerm
+		return x.States
+	}
+	return nil
+}
+
+func (x *ExportOrdersRequest) GetUpdatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedFrom
+	}
+	return nil
+}
+
+func (x *ExportOrdersRequest) GetUpdatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedTo
+	}
+	return nil
+}
+
+func (x *ExportOrdersRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
 	}
 	return ""
 }
 
-// Ответ со списком заказов и курсорной пагинацией
-type ListOrdersResponse struct {
+// Часть файла выгрузки. Первое сообщение содержит только тип содержимого и имя файла
+type ExportOrdersChunk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
-	NextCursor    int64                  `protobuf:"varint,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOrdersResponse) Reset() {
-	*x = ListOrdersResponse{}
-	mi := &file_proto_order_proto_msgTypes[9]
+func (x *ExportOrdersChunk) Reset() {
+	*x = ExportOrdersChunk{}
+	mi := &file_proto_order_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersResponse) String() string {
+func (x *ExportOrdersChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersResponse) ProtoMessage() {}
+func (*ExportOrdersChunk) ProtoMessage() {}
 
-func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_proto_msgTypes[9]
+func (x *ExportOrdersChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -846,57 +2621,236 @@ func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
-func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_order_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ExportOrdersChunk.ProtoReflect.Descriptor instead.
+func (*ExportOrdersChunk) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ListOrdersResponse) GetOrders() []*Order {
+func (x *ExportOrdersChunk) GetData() []byte {
 	if x != nil {
-		return x.Orders
+		return x.Data
 	}
 	return nil
 }
 
-func (x *ListOrdersResponse) GetHasMore() bool {
+func (x *ExportOrdersChunk) GetContentType() string {
 	if x != nil {
-		return x.HasMore
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportOrdersChunk) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+// Параметры потоковой загрузки заказов
+type ImportOrdersOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`               // только проверить записи, не создавая заказы
+	Bulk          bool                   `protobuf:"varint,2,opt,name=bulk,proto3" json:"bulk,omitempty"`                                 // принять все заказы одной транзакцией или не принимать ни один
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`                          // имя файла, если заказы передаются частями файла
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // тип содержимого файла
+	Format        string                 `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty"`                              // "json", "ndjson", "csv" или "xlsx"; если не указан, определяется по имени и типу содержимого
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOrdersOptions) Reset() {
+	*x = ImportOrdersOptions{}
+	mi := &file_proto_order_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOrdersOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOrdersOptions) ProtoMessage() {}
+
+func (x *ImportOrdersOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOrdersOptions.ProtoReflect.Descriptor instead.
+func (*ImportOrdersOptions) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ImportOrdersOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
 	}
 	return false
 }
 
-func (x *ListOrdersResponse) GetNextCursor() int64 {
+func (x *ImportOrdersOptions) GetBulk() bool {
 	if x != nil {
-		return x.NextCursor
+		return x.Bulk
 	}
-	return 0
+	return false
+}
+
+func (x *ImportOrdersOptions) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ImportOrdersOptions) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ImportOrdersOptions) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// Сообщение потоковой загрузки заказов. Параметры передаются только в первом сообщении,
+// в одном потоке передаются либо заказы, либо части файла
+type ImportOrdersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportOrdersRequest_Options
+	//	*ImportOrdersRequest_Order
+	//	*ImportOrdersRequest_Chunk
+	Payload       isImportOrdersRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOrdersRequest) Reset() {
+	*x = ImportOrdersRequest{}
+	mi := &file_proto_order_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOrdersRequest) ProtoMessage() {}
+
+func (x *ImportOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ImportOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ImportOrdersRequest) GetPayload() isImportOrdersRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportOrdersRequest) GetOptions() *ImportOrdersOptions {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportOrdersRequest_Options); ok {
+			return x.Options
+		}
+	}
+	return nil
+}
+
+func (x *ImportOrdersRequest) GetOrder() *CreateOrderRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportOrdersRequest_Order); ok {
+			return x.Order
+		}
+	}
+	return nil
+}
+
+func (x *ImportOrdersRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportOrdersRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isImportOrdersRequest_Payload interface {
+	isImportOrdersRequest_Payload()
+}
+
+type ImportOrdersRequest_Options struct {
+	Options *ImportOrdersOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type ImportOrdersRequest_Order struct {
+	Order *CreateOrderRequest `protobuf:"bytes,2,opt,name=order,proto3,oneof"`
+}
+
+type ImportOrdersRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,3,opt,name=chunk,proto3,oneof"` // очередная часть файла с заказами
 }
 
-// Запрос на получение списка возвращенных заказов с курсорной пагинацией
-type ListReturnsRequest struct {
+func (*ImportOrdersRequest_Options) isImportOrdersRequest_Payload() {}
+
+func (*ImportOrdersRequest_Order) isImportOrdersRequest_Payload() {}
+
+func (*ImportOrdersRequest_Chunk) isImportOrdersRequest_Payload() {}
+
+// Результат обработки одной записи файла импорта
+type ImportRowResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CursorId      int64                  `protobuf:"varint,1,opt,name=cursor_id,json=cursorId,proto3" json:"cursor_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	SearchTerm    string                 `protobuf:"bytes,3,opt,name=search_term,json=searchTerm,proto3" json:"search_term,omitempty"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"` // номер записи в файле, начиная с 1
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // "accepted", "valid", "failed" или "skipped"
+	Code          string                 `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`     // машиночитаемый код ошибки
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListReturnsRequest) Reset() {
-	*x = ListReturnsRequest{}
-	mi := &file_proto_order_proto_msgTypes[10]
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
+	mi := &file_proto_order_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListReturnsRequest) String() string {
+func (x *ImportRowResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReturnsRequest) ProtoMessage() {}
+func (*ImportRowResult) ProtoMessage() {}
 
-func (x *ListReturnsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_proto_msgTypes[10]
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -907,57 +2861,76 @@ func (x *ListReturnsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReturnsRequest.ProtoReflect.Descriptor instead.
-func (*ListReturnsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_order_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ListReturnsRequest) GetCursorId() int64 {
+func (x *ImportRowResult) GetRow() int32 {
 	if x != nil {
-		return x.CursorId
+		return x.Row
 	}
 	return 0
 }
 
-func (x *ListReturnsRequest) GetLimit() int32 {
+func (x *ImportRowResult) GetOrderId() int64 {
 	if x != nil {
-		return x.Limit
+		return x.OrderId
 	}
 	return 0
 }
 
-func (x *ListReturnsRequest) GetSearchTerm() string {
+func (x *ImportRowResult) GetStatus() string {
 	if x != nil {
-		return x.SearchTerm
+		return x.Status
 	}
 	return ""
 }
 
-// Ответ со списком возвращенных заказов и курсорной пагинацией
-type ListReturnsResponse struct {
+func (x *ImportRowResult) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Ответ на запрос загрузки заказов из файла
+type AcceptOrdersFromFileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Returns       []*Order               `protobuf:"bytes,1,rep,name=returns,proto3" json:"returns,omitempty"`
-	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
-	NextCursor    int64                  `protobuf:"varint,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,4,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	Rows          []*ImportRowResult     `protobuf:"bytes,6,rep,name=rows,proto3" json:"rows,omitempty"`
+	Bulk          bool                   `protobuf:"varint,7,opt,name=bulk,proto3" json:"bulk,omitempty"`
+	Format        string                 `protobuf:"bytes,8,opt,name=format,proto3" json:"format,omitempty"` // формат, в котором был прочитан файл
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListReturnsResponse) Reset() {
-	*x = ListReturnsResponse{}
-	mi := &file_proto_order_proto_msgTypes[11]
+func (x *AcceptOrdersFromFileResponse) Reset() {
+	*x = AcceptOrdersFromFileResponse{}
+	mi := &file_proto_order_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListReturnsResponse) String() string {
+func (x *AcceptOrdersFromFileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReturnsResponse) ProtoMessage() {}
+func (*AcceptOrdersFromFileResponse) ProtoMessage() {}
 
-func (x *ListReturnsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_proto_msgTypes[11]
+func (x *AcceptOrdersFromFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -968,55 +2941,106 @@ func (x *ListReturnsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReturnsResponse.ProtoReflect.Descriptor instead.
-func (*ListReturnsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_order_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use AcceptOrdersFromFileResponse.ProtoReflect.Descriptor instead.
+func (*AcceptOrdersFromFileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ListReturnsResponse) GetReturns() []*Order {
+func (x *AcceptOrdersFromFileResponse) GetMessage() string {
 	if x != nil {
-		return x.Returns
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-func (x *ListReturnsResponse) GetHasMore() bool {
+func (x *AcceptOrdersFromFileResponse) GetDryRun() bool {
 	if x != nil {
-		return x.HasMore
+		return x.DryRun
 	}
 	return false
 }
 
-func (x *ListReturnsResponse) GetNextCursor() int64 {
+func (x *AcceptOrdersFromFileResponse) GetTotal() int32 {
 	if x != nil {
-		return x.NextCursor
+		return x.Total
 	}
 	return 0
 }
 
-// Запрос на получение истории всех заказов
-type OrderHistoryRequest struct {
+func (x *AcceptOrdersFromFileResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *AcceptOrdersFromFileResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *AcceptOrdersFromFileResponse) GetRows() []*ImportRowResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *AcceptOrdersFromFileResponse) GetBulk() bool {
+	if x != nil {
+		return x.Bulk
+	}
+	return false
+}
+
+func (x *AcceptOrdersFromFileResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// Фоновая задача импорта заказов из файла
+type ImportJob struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SearchTerm    string                 `protobuf:"bytes,1,opt,name=search_term,json=searchTerm,proto3" json:"search_term,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // "pending", "running", "completed" или "failed"
+	DryRun        bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Bulk          bool                   `protobuf:"varint,6,opt,name=bulk,proto3" json:"bulk,omitempty"`
+	Total         int32                  `protobuf:"varint,7,opt,name=total,proto3" json:"total,omitempty"`
+	Processed     int32                  `protobuf:"varint,8,opt,name=processed,proto3" json:"processed,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,9,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,10,opt,name=failed,proto3" json:"failed,omitempty"`
+	Errors        []*ImportRowResult     `protobuf:"bytes,11,rep,name=errors,proto3" json:"errors,omitempty"` // записи, которые не удалось принять
+	Error         string                 `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`   // причина, по которой задача завершилась ошибкой
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Format        string                 `protobuf:"bytes,17,opt,name=format,proto3" json:"format,omitempty"` // формат файла
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderHistoryRequest) Reset() {
-	*x = OrderHistoryRequest{}
-	mi := &file_proto_order_proto_msgTypes[12]
+func (x *ImportJob) Reset() {
+	*x = ImportJob{}
+	mi := &file_proto_order_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderHistoryRequest) String() string {
+func (x *ImportJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderHistoryRequest) ProtoMessage() {}
+func (*ImportJob) ProtoMessage() {}
 
-func (x *OrderHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_proto_msgTypes[12]
+func (x *ImportJob) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1027,147 +3051,153 @@ func (x *OrderHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderHistoryRequest.ProtoReflect.Descriptor instead.
-func (*OrderHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_order_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ImportJob.ProtoReflect.Descriptor instead.
+func (*ImportJob) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *OrderHistoryRequest) GetSearchTerm() string {
+func (x *ImportJob) GetId() int64 {
 	if x != nil {
-		return x.SearchTerm
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-// Ответ с историей заказов
-type OrderHistoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ImportJob) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
 }
 
-func (x *OrderHistoryResponse) Reset() {
-	*x = OrderHistoryResponse{}
-	mi := &file_proto_order_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ImportJob) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
 }
 
-func (x *OrderHistoryResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ImportJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (*OrderHistoryResponse) ProtoMessage() {}
+func (x *ImportJob) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
 
-func (x *OrderHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_proto_msgTypes[13]
+func (x *ImportJob) GetBulk() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Bulk
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use OrderHistoryResponse.ProtoReflect.Descriptor instead.
-func (*OrderHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_order_proto_rawDescGZIP(), []int{13}
+func (x *ImportJob) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
 }
 
-func (x *OrderHistoryResponse) GetOrders() []*Order {
+func (x *ImportJob) GetProcessed() int32 {
 	if x != nil {
-		return x.Orders
+		return x.Processed
 	}
-	return nil
+	return 0
 }
 
-func (x *OrderHistoryResponse) GetTotal() int32 {
+func (x *ImportJob) GetSucceeded() int32 {
 	if x != nil {
-		return x.Total
+		return x.Succeeded
 	}
 	return 0
 }
 
-// Запрос на загрузку заказов из файла
-type AcceptOrdersFromFileRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FileContent   []byte                 `protobuf:"bytes,1,opt,name=file_content,json=fileContent,proto3" json:"file_content,omitempty"`
-	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ImportJob) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
 }
 
-func (x *AcceptOrdersFromFileRequest) Reset() {
-	*x = AcceptOrdersFromFileRequest{}
-	mi := &file_proto_order_proto_msgTypes[14]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ImportJob) GetErrors() []*ImportRowResult {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
 }
 
-func (x *AcceptOrdersFromFileRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ImportJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-func (*AcceptOrdersFromFileRequest) ProtoMessage() {}
+func (x *ImportJob) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
 
-func (x *AcceptOrdersFromFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_proto_msgTypes[14]
+func (x *ImportJob) GetStartedAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.StartedAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use AcceptOrdersFromFileRequest.ProtoReflect.Descriptor instead.
-func (*AcceptOrdersFromFileRequest) Descriptor() ([]byte, []int) {
-	return file_proto_order_proto_rawDescGZIP(), []int{14}
+func (x *ImportJob) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
 }
 
-func (x *AcceptOrdersFromFileRequest) GetFileContent() []byte {
+func (x *ImportJob) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FileContent
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-func (x *AcceptOrdersFromFileRequest) GetFilename() string {
+func (x *ImportJob) GetFormat() string {
 	if x != nil {
-		return x.Filename
+		return x.Format
 	}
 	return ""
 }
 
-// Ответ на запрос загрузки заказов из файла
-type AcceptOrdersFromFileResponse struct {
+// Запрос задачи импорта
+type GetImportJobRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AcceptOrdersFromFileResponse) Reset() {
-	*x = AcceptOrdersFromFileResponse{}
-	mi := &file_proto_order_proto_msgTypes[15]
+func (x *GetImportJobRequest) Reset() {
+	*x = GetImportJobRequest{}
+	mi := &file_proto_order_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AcceptOrdersFromFileResponse) String() string {
+func (x *GetImportJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptOrdersFromFileResponse) ProtoMessage() {}
+func (*GetImportJobRequest) ProtoMessage() {}
 
-func (x *AcceptOrdersFromFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_proto_msgTypes[15]
+func (x *GetImportJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1178,16 +3208,16 @@ func (x *AcceptOrdersFromFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptOrdersFromFileResponse.ProtoReflect.Descriptor instead.
-func (*AcceptOrdersFromFileResponse) Descriptor() ([]byte, []int) {
-	return file_proto_order_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetImportJobRequest.ProtoReflect.Descriptor instead.
+func (*GetImportJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *AcceptOrdersFromFileResponse) GetMessage() string {
+func (x *GetImportJobRequest) GetId() int64 {
 	if x != nil {
-		return x.Message
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
 // Ответ на запрос очистки базы данных
@@ -1200,7 +3230,7 @@ type ClearDatabaseResponse struct {
 
 func (x *ClearDatabaseResponse) Reset() {
 	*x = ClearDatabaseResponse{}
-	mi := &file_proto_order_proto_msgTypes[16]
+	mi := &file_proto_order_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1212,7 +3242,7 @@ func (x *ClearDatabaseResponse) String() string {
 func (*ClearDatabaseResponse) ProtoMessage() {}
 
 func (x *ClearDatabaseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_proto_msgTypes[16]
+	mi := &file_proto_order_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1225,7 +3255,7 @@ func (x *ClearDatabaseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClearDatabaseResponse.ProtoReflect.Descriptor instead.
 func (*ClearDatabaseResponse) Descriptor() ([]byte, []int) {
-	return file_proto_order_proto_rawDescGZIP(), []int{16}
+	return file_proto_order_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ClearDatabaseResponse) GetMessage() string {
@@ -1239,7 +3269,7 @@ var File_proto_order_proto protoreflect.FileDescriptor
 
 const file_proto_order_proto_rawDesc = "" +
 	"\n" +
-	"\x11proto/order.proto\x12\x05proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xf7\x01\n" +
+	"\x11proto/order.proto\x12\x05proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xea\x02\n" +
 	"\x12CreateOrderRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vcustomer_id\x18\x02 \x01(\x03R\n" +
@@ -1249,7 +3279,17 @@ const file_proto_order_proto_rawDesc = "" +
 	"\x06weight\x18\x04 \x01(\x01R\x06weight\x12\x12\n" +
 	"\x04cost\x18\x05 \x01(\x01R\x04cost\x125\n" +
 	"\fpackage_type\x18\x06 \x01(\x0e2\x12.proto.PackageTypeR\vpackageType\x12,\n" +
-	"\awrapper\x18\a \x01(\x0e2\x12.proto.WrapperTypeR\awrapper\"\xe6\x03\n" +
+	"\awrapper\x18\a \x01(\x0e2\x12.proto.WrapperTypeR\awrapper\x12&\n" +
+	"\x0fpickup_point_id\x18\b \x01(\x03R\rpickupPointId\x12&\n" +
+	"\x05items\x18\t \x03(\v2\x10.proto.OrderItemR\x05items\x12!\n" +
+	"\fpayment_mode\x18\n" +
+	" \x01(\tR\vpaymentMode\"q\n" +
+	"\tOrderItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x01R\x06weight\x12\x12\n" +
+	"\x04cost\x18\x04 \x01(\x01R\x04cost\x12\x14\n" +
+	"\x05state\x18\x05 \x01(\tR\x05state\"\x9b\x05\n" +
 	"\x05Order\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vcustomer_id\x18\x02 \x01(\x03R\n" +
@@ -1266,18 +3306,56 @@ const file_proto_order_proto_rawDesc = "" +
 	"\fdelivered_at\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\x12;\n" +
 	"\vreturned_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"returnedAt\"!\n" +
+	"returnedAt\x12&\n" +
+	"\x0fpickup_point_id\x18\f \x01(\x03R\rpickupPointId\x12&\n" +
+	"\x0fstorage_cell_id\x18\r \x01(\x03R\rstorageCellId\x12\x18\n" +
+	"\aversion\x18\x0e \x01(\x03R\aversion\x12&\n" +
+	"\x05items\x18\x0f \x03(\v2\x10.proto.OrderItemR\x05items\x12!\n" +
+	"\fpayment_mode\x18\x10 \x01(\tR\vpaymentMode\"!\n" +
 	"\x0fGetOrderRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"(\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"B\n" +
 	"\x16ReturnToCourierRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"3\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"3\n" +
 	"\x17ReturnToCourierResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"n\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"T\n" +
+	"\x14ExtendStorageRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x03R\aversion\"\xba\x02\n" +
+	"\x0eOrderExtension\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\x12\x10\n" +
+	"\x03fee\x18\x03 \x01(\x01R\x03fee\x12L\n" +
+	"\x14previous_deadline_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x12previousDeadlineAt\x12;\n" +
+	"\vdeadline_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"deadlineAt\x12\x1f\n" +
+	"\vextended_by\x18\x06 \x01(\x03R\n" +
+	"extendedBy\x12;\n" +
+	"\vextended_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"extendedAt\"p\n" +
+	"\x15ExtendStorageResponse\x12\"\n" +
+	"\x05order\x18\x01 \x01(\v2\f.proto.OrderR\x05order\x123\n" +
+	"\textension\x18\x02 \x01(\v2\x15.proto.OrderExtensionR\textension\"\xd4\x02\n" +
 	"\x16ProcessCustomerRequest\x12\x1f\n" +
 	"\vcustomer_id\x18\x01 \x01(\x03R\n" +
 	"customerId\x12\x16\n" +
 	"\x06action\x18\x02 \x01(\tR\x06action\x12\x1b\n" +
-	"\torder_ids\x18\x03 \x03(\x03R\borderIds\"\x83\x01\n" +
+	"\torder_ids\x18\x03 \x03(\x03R\borderIds\x12\x16\n" +
+	"\x06atomic\x18\x04 \x01(\bR\x06atomic\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x18\n" +
+	"\acomment\x18\x06 \x01(\tR\acomment\x12\x1c\n" +
+	"\tcondition\x18\a \x01(\tR\tcondition\x12\x16\n" +
+	"\x06photos\x18\b \x03(\tR\x06photos\x12\x19\n" +
+	"\bitem_ids\x18\t \x03(\x03R\aitemIds\x12/\n" +
+	"\apayment\x18\n" +
+	" \x01(\v2\x15.proto.PaymentDetailsR\apayment\x12\x18\n" +
+	"\aversion\x18\v \x01(\x03R\aversion\"a\n" +
+	"\x0ePaymentDetails\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1f\n" +
+	"\vcash_amount\x18\x03 \x01(\x01R\n" +
+	"cashAmount\"\x83\x01\n" +
 	"\x10ProcessingResult\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x1a\n" +
 	"\amessage\x18\x02 \x01(\tH\x00R\amessage\x12\x16\n" +
@@ -1299,36 +3377,190 @@ const file_proto_order_proto_rawDesc = "" +
 	"\x06orders\x18\x01 \x03(\v2\f.proto.OrderR\x06orders\x12\x19\n" +
 	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\x1f\n" +
 	"\vnext_cursor\x18\x03 \x01(\x03R\n" +
-	"nextCursor\"h\n" +
+	"nextCursor\"\x9e\x01\n" +
 	"\x12ListReturnsRequest\x12\x1b\n" +
 	"\tcursor_id\x18\x01 \x01(\x03R\bcursorId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1f\n" +
 	"\vsearch_term\x18\x03 \x01(\tR\n" +
-	"searchTerm\"y\n" +
+	"searchTerm\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1c\n" +
+	"\tcondition\x18\x05 \x01(\tR\tcondition\"\xba\x03\n" +
+	"\vOrderReturn\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\x03R\n" +
+	"customerId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\x12\x1c\n" +
+	"\tcondition\x18\x05 \x01(\tR\tcondition\x12\x16\n" +
+	"\x06photos\x18\x06 \x03(\tR\x06photos\x12\x1b\n" +
+	"\tpolicy_id\x18\a \x01(\x03R\bpolicyId\x12\x16\n" +
+	"\x06policy\x18\b \x01(\tR\x06policy\x12\x10\n" +
+	"\x03fee\x18\t \x01(\x01R\x03fee\x12\x16\n" +
+	"\x06refund\x18\n" +
+	" \x01(\x01R\x06refund\x12/\n" +
+	"\x13requires_inspection\x18\v \x01(\bR\x12requiresInspection\x12\x1f\n" +
+	"\vreturned_by\x18\f \x01(\x03R\n" +
+	"returnedBy\x12;\n" +
+	"\vreturned_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"returnedAt\x12\x19\n" +
+	"\bitem_ids\x18\x0e \x03(\x03R\aitemIds\"\xa7\x01\n" +
 	"\x13ListReturnsResponse\x12&\n" +
 	"\areturns\x18\x01 \x03(\v2\f.proto.OrderR\areturns\x12\x19\n" +
 	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\x1f\n" +
 	"\vnext_cursor\x18\x03 \x01(\x03R\n" +
+	"nextCursor\x12,\n" +
+	"\adetails\x18\x04 \x03(\v2\x12.proto.OrderReturnR\adetails\"N\n" +
+	"\x19ListCourierReturnsRequest\x12\x1b\n" +
+	"\tcursor_id\x18\x01 \x01(\x03R\bcursorId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"~\n" +
+	"\x1aListCourierReturnsResponse\x12$\n" +
+	"\x06orders\x18\x01 \x03(\v2\f.proto.OrderR\x06orders\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\x03R\n" +
 	"nextCursor\"6\n" +
 	"\x13OrderHistoryRequest\x12\x1f\n" +
 	"\vsearch_term\x18\x01 \x01(\tR\n" +
 	"searchTerm\"R\n" +
 	"\x14OrderHistoryResponse\x12$\n" +
 	"\x06orders\x18\x01 \x03(\v2\f.proto.OrderR\x06orders\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"\\\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"&\n" +
+	"\x14OrderTimelineRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xfb\x01\n" +
+	"\x14OrderStateTransition\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x120\n" +
+	"\n" +
+	"from_state\x18\x03 \x01(\x0e2\x11.proto.OrderStateR\tfromState\x12,\n" +
+	"\bto_state\x18\x04 \x01(\x0e2\x11.proto.OrderStateR\atoState\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x05 \x01(\x03R\tchangedBy\x129\n" +
+	"\n" +
+	"changed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"q\n" +
+	"\x15OrderTimelineResponse\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12=\n" +
+	"\vtransitions\x18\x02 \x03(\v2\x1b.proto.OrderStateTransitionR\vtransitions\")\n" +
+	"\x17GetOrderPaymentsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xa3\x02\n" +
+	"\aPayment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x1f\n" +
+	"\vcash_amount\x18\x05 \x01(\x01R\n" +
+	"cashAmount\x12\x1f\n" +
+	"\vcard_amount\x18\x06 \x01(\x01R\n" +
+	"cardAmount\x12%\n" +
+	"\x0etransaction_id\x18\a \x01(\tR\rtransactionId\x12\x1f\n" +
+	"\voperator_id\x18\b \x01(\x03R\n" +
+	"operatorId\x123\n" +
+	"\apaid_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x06paidAt\"\xe6\x02\n" +
+	"\x06Refund\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x1b\n" +
+	"\treturn_id\x18\x03 \x01(\x03R\breturnId\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x04 \x01(\x03R\tpaymentId\x12\x16\n" +
+	"\x06method\x18\x05 \x01(\tR\x06method\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12\x1f\n" +
+	"\vcash_amount\x18\a \x01(\x01R\n" +
+	"cashAmount\x12\x1f\n" +
+	"\vcard_amount\x18\b \x01(\x01R\n" +
+	"cardAmount\x12%\n" +
+	"\x0etransaction_id\x18\t \x01(\tR\rtransactionId\x12\x1f\n" +
+	"\voperator_id\x18\n" +
+	" \x01(\x03R\n" +
+	"operatorId\x12;\n" +
+	"\vrefunded_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"refundedAt\"\xa0\x01\n" +
+	"\rOrderPayments\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12!\n" +
+	"\fpayment_mode\x18\x02 \x01(\tR\vpaymentMode\x12(\n" +
+	"\apayment\x18\x03 \x01(\v2\x0e.proto.PaymentR\apayment\x12'\n" +
+	"\arefunds\x18\x04 \x03(\v2\r.proto.RefundR\arefunds\"\xc4\x01\n" +
 	"\x1bAcceptOrdersFromFileRequest\x12!\n" +
 	"\ffile_content\x18\x01 \x01(\fR\vfileContent\x12\x1a\n" +
-	"\bfilename\x18\x02 \x01(\tR\bfilename\"8\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x12\n" +
+	"\x04bulk\x18\x04 \x01(\bR\x04bulk\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12\x16\n" +
+	"\x06format\x18\x06 \x01(\tR\x06format\"\xdb\x02\n" +
+	"\x13ExportOrdersRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\x03R\n" +
+	"customerId\x12&\n" +
+	"\x0fpickup_point_id\x18\x03 \x01(\x03R\rpickupPointId\x12&\n" +
+	"\x0fin_pickup_point\x18\x04 \x01(\bR\rinPickupPoint\x12)\n" +
+	"\x06states\x18\x05 \x03(\x0e2\x11.proto.OrderStateR\x06states\x12=\n" +
+	"\fupdated_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedFrom\x129\n" +
+	"\n" +
+	"updated_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedTo\x12\x16\n" +
+	"\x06search\x18\b \x01(\tR\x06search\"f\n" +
+	"\x11ExportOrdersChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\"\x99\x01\n" +
+	"\x13ImportOrdersOptions\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x12\n" +
+	"\x04bulk\x18\x02 \x01(\bR\x04bulk\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x16\n" +
+	"\x06format\x18\x05 \x01(\tR\x06format\"\xa3\x01\n" +
+	"\x13ImportOrdersRequest\x126\n" +
+	"\aoptions\x18\x01 \x01(\v2\x1a.proto.ImportOrdersOptionsH\x00R\aoptions\x121\n" +
+	"\x05order\x18\x02 \x01(\v2\x19.proto.CreateOrderRequestH\x00R\x05order\x12\x16\n" +
+	"\x05chunk\x18\x03 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"\x80\x01\n" +
+	"\x0fImportRowResult\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\tR\x04code\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\xf5\x01\n" +
 	"\x1cAcceptOrdersFromFileResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"1\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x1c\n" +
+	"\tsucceeded\x18\x04 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x12*\n" +
+	"\x04rows\x18\x06 \x03(\v2\x16.proto.ImportRowResultR\x04rows\x12\x12\n" +
+	"\x04bulk\x18\a \x01(\bR\x04bulk\x12\x16\n" +
+	"\x06format\x18\b \x01(\tR\x06format\"\xcb\x04\n" +
+	"\tImportJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\x12\x12\n" +
+	"\x04bulk\x18\x06 \x01(\bR\x04bulk\x12\x14\n" +
+	"\x05total\x18\a \x01(\x05R\x05total\x12\x1c\n" +
+	"\tprocessed\x18\b \x01(\x05R\tprocessed\x12\x1c\n" +
+	"\tsucceeded\x18\t \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\n" +
+	" \x01(\x05R\x06failed\x12.\n" +
+	"\x06errors\x18\v \x03(\v2\x16.proto.ImportRowResultR\x06errors\x12\x14\n" +
+	"\x05error\x18\f \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"started_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
+	"\x06format\x18\x11 \x01(\tR\x06format\"%\n" +
+	"\x13GetImportJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"1\n" +
 	"\x15ClearDatabaseResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage*x\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage*\xcc\x01\n" +
 	"\n" +
 	"OrderState\x12\x1b\n" +
 	"\x17ORDER_STATE_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14ORDER_STATE_ACCEPTED\x10\x01\x12\x19\n" +
 	"\x15ORDER_STATE_DELIVERED\x10\x02\x12\x18\n" +
-	"\x14ORDER_STATE_RETURNED\x10\x03*n\n" +
+	"\x14ORDER_STATE_RETURNED\x10\x03\x12#\n" +
+	"\x1fORDER_STATE_RETURNED_TO_COURIER\x10\x04\x12\x17\n" +
+	"\x13ORDER_STATE_EXPIRED\x10\x05\x12\x14\n" +
+	"\x10ORDER_STATE_LOST\x10\x06*n\n" +
 	"\vPackageType\x12\x1c\n" +
 	"\x18PACKAGE_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10PACKAGE_TYPE_BAG\x10\x01\x12\x14\n" +
@@ -1336,17 +3568,27 @@ const file_proto_order_proto_rawDesc = "" +
 	"\x11PACKAGE_TYPE_FILM\x10\x03*B\n" +
 	"\vWrapperType\x12\x1c\n" +
 	"\x18WRAPPER_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
-	"\x11WRAPPER_TYPE_FILM\x10\x012\xab\x05\n" +
+	"\x11WRAPPER_TYPE_FILM\x10\x012\xde\n" +
+	"\n" +
 	"\x0fOrderRPCHandler\x128\n" +
 	"\vCreateOrder\x12\x19.proto.CreateOrderRequest\x1a\f.proto.Order\"\x00\x122\n" +
 	"\bGetOrder\x12\x16.proto.GetOrderRequest\x1a\f.proto.Order\"\x00\x12R\n" +
-	"\x0fReturnToCourier\x12\x1d.proto.ReturnToCourierRequest\x1a\x1e.proto.ReturnToCourierResponse\"\x00\x12R\n" +
+	"\x0fReturnToCourier\x12\x1d.proto.ReturnToCourierRequest\x1a\x1e.proto.ReturnToCourierResponse\"\x00\x12L\n" +
+	"\rExtendStorage\x12\x1b.proto.ExtendStorageRequest\x1a\x1c.proto.ExtendStorageResponse\"\x00\x12R\n" +
 	"\x0fProcessCustomer\x12\x1d.proto.ProcessCustomerRequest\x1a\x1e.proto.ProcessCustomerResponse\"\x00\x12C\n" +
 	"\n" +
 	"ListOrders\x12\x18.proto.ListOrdersRequest\x1a\x19.proto.ListOrdersResponse\"\x00\x12F\n" +
-	"\vListReturns\x12\x19.proto.ListReturnsRequest\x1a\x1a.proto.ListReturnsResponse\"\x00\x12I\n" +
-	"\fOrderHistory\x12\x1a.proto.OrderHistoryRequest\x1a\x1b.proto.OrderHistoryResponse\"\x00\x12a\n" +
-	"\x14AcceptOrdersFromFile\x12\".proto.AcceptOrdersFromFileRequest\x1a#.proto.AcceptOrdersFromFileResponse\"\x00\x12G\n" +
+	"\vListReturns\x12\x19.proto.ListReturnsRequest\x1a\x1a.proto.ListReturnsResponse\"\x00\x12[\n" +
+	"\x12ListCourierReturns\x12 .proto.ListCourierReturnsRequest\x1a!.proto.ListCourierReturnsResponse\"\x00\x12I\n" +
+	"\fOrderHistory\x12\x1a.proto.OrderHistoryRequest\x1a\x1b.proto.OrderHistoryResponse\"\x00\x12L\n" +
+	"\rOrderTimeline\x12\x1b.proto.OrderTimelineRequest\x1a\x1c.proto.OrderTimelineResponse\"\x00\x12J\n" +
+	"\x10GetOrderPayments\x12\x1e.proto.GetOrderPaymentsRequest\x1a\x14.proto.OrderPayments\"\x00\x12H\n" +
+	"\fExportOrders\x12\x1a.proto.ExportOrdersRequest\x1a\x18.proto.ExportOrdersChunk\"\x000\x01\x12a\n" +
+	"\x14AcceptOrdersFromFile\x12\".proto.AcceptOrdersFromFileRequest\x1a#.proto.AcceptOrdersFromFileResponse\"\x00\x12S\n" +
+	"\fImportOrders\x12\x1a.proto.ImportOrdersRequest\x1a#.proto.AcceptOrdersFromFileResponse\"\x00(\x01\x12I\n" +
+	"\x0fSubmitImportJob\x12\".proto.AcceptOrdersFromFileRequest\x1a\x10.proto.ImportJob\"\x00\x12>\n" +
+	"\fGetImportJob\x12\x1a.proto.GetImportJobRequest\x1a\x10.proto.ImportJob\"\x00\x12B\n" +
+	"\x0eWatchImportJob\x12\x1a.proto.GetImportJobRequest\x1a\x10.proto.ImportJob\"\x000\x01\x12G\n" +
 	"\rClearDatabase\x12\x16.google.protobuf.Empty\x1a\x1c.proto.ClearDatabaseResponse\"\x00B#Z!gitlab.ozon.dev/gojhw1/pkg/gen;pbb\x06proto3"
 
 var (
@@ -1362,68 +3604,138 @@ func file_proto_order_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_order_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_proto_order_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_proto_order_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_proto_order_proto_goTypes = []any{
 	(OrderState)(0),                      // 0: proto.OrderState
 	(PackageType)(0),                     // 1: proto.PackageType
 	(WrapperType)(0),                     // 2: proto.WrapperType
 	(*CreateOrderRequest)(nil),           // 3: proto.CreateOrderRequest
-	(*Order)(nil),                        // 4: proto.Order
-	(*GetOrderRequest)(nil),              // 5: proto.GetOrderRequest
-	(*ReturnToCourierRequest)(nil),       // 6: proto.ReturnToCourierRequest
-	(*ReturnToCourierResponse)(nil),      // 7: proto.ReturnToCourierResponse
-	(*ProcessCustomerRequest)(nil),       // 8: proto.ProcessCustomerRequest
-	(*ProcessingResult)(nil),             // 9: proto.ProcessingResult
-	(*ProcessCustomerResponse)(nil),      // 10: proto.ProcessCustomerResponse
-	(*ListOrdersRequest)(nil),            // 11: proto.ListOrdersRequest
-	(*ListOrdersResponse)(nil),           // 12: proto.ListOrdersResponse
-	(*ListReturnsRequest)(nil),           // 13: proto.ListReturnsRequest
-	(*ListReturnsResponse)(nil),          // 14: proto.ListReturnsResponse
-	(*OrderHistoryRequest)(nil),          // 15: proto.OrderHistoryRequest
-	(*OrderHistoryResponse)(nil),         // 16: proto.OrderHistoryResponse
-	(*AcceptOrdersFromFileRequest)(nil),  // 17: proto.AcceptOrdersFromFileRequest
-	(*AcceptOrdersFromFileResponse)(nil), // 18: proto.AcceptOrdersFromFileResponse
-	(*ClearDatabaseResponse)(nil),        // 19: proto.ClearDatabaseResponse
-	(*timestamppb.Timestamp)(nil),        // 20: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                // 21: google.protobuf.Empty
+	(*OrderItem)(nil),                    // 4: proto.OrderItem
+	(*Order)(nil),                        // 5: proto.Order
+	(*GetOrderRequest)(nil),              // 6: proto.GetOrderRequest
+	(*ReturnToCourierRequest)(nil),       // 7: proto.ReturnToCourierRequest
+	(*ReturnToCourierResponse)(nil),      // 8: proto.ReturnToCourierResponse
+	(*ExtendStorageRequest)(nil),         // 9: proto.ExtendStorageRequest
+	(*OrderExtension)(nil),               // 10: proto.OrderExtension
+	(*ExtendStorageResponse)(nil),        // 11: proto.ExtendStorageResponse
+	(*ProcessCustomerRequest)(nil),       // 12: proto.ProcessCustomerRequest
+	(*PaymentDetails)(nil),               // 13: proto.PaymentDetails
+	(*ProcessingResult)(nil),             // 14: proto.ProcessingResult
+	(*ProcessCustomerResponse)(nil),      // 15: proto.ProcessCustomerResponse
+	(*ListOrdersRequest)(nil),            // 16: proto.ListOrdersRequest
+	(*ListOrdersResponse)(nil),           // 17: proto.ListOrdersResponse
+	(*ListReturnsRequest)(nil),           // 18: proto.ListReturnsRequest
+	(*OrderReturn)(nil),                  // 19: proto.OrderReturn
+	(*ListReturnsResponse)(nil),          // 20: proto.ListReturnsResponse
+	(*ListCourierReturnsRequest)(nil),    // 21: proto.ListCourierReturnsRequest
+	(*ListCourierReturnsResponse)(nil),   // 22: proto.ListCourierReturnsResponse
+	(*OrderHistoryRequest)(nil),          // 23: proto.OrderHistoryRequest
+	(*OrderHistoryResponse)(nil),         // 24: proto.OrderHistoryResponse
+	(*OrderTimelineRequest)(nil),         // 25: proto.OrderTimelineRequest
+	(*OrderStateTransition)(nil),         // 26: proto.OrderStateTransition
+	(*OrderTimelineResponse)(nil),        // 27: proto.OrderTimelineResponse
+	(*GetOrderPaymentsRequest)(nil),      // 28: proto.GetOrderPaymentsRequest
+	(*Payment)(nil),                      // 29: proto.Payment
+	(*Refund)(nil),                       // 30: proto.Refund
+	(*OrderPayments)(nil),                // 31: proto.OrderPayments
+	(*AcceptOrdersFromFileRequest)(nil),  // 32: proto.AcceptOrdersFromFileRequest
+	(*ExportOrdersRequest)(nil),          // 33: proto.ExportOrdersRequest
+	(*ExportOrdersChunk)(nil),            // 34: proto.ExportOrdersChunk
+	(*ImportOrdersOptions)(nil),          // 35: proto.ImportOrdersOptions
+	(*ImportOrdersRequest)(nil),          // 36: proto.ImportOrdersRequest
+	(*ImportRowResult)(nil),              // 37: proto.ImportRowResult
+	(*AcceptOrdersFromFileResponse)(nil), // 38: proto.AcceptOrdersFromFileResponse
+	(*ImportJob)(nil),                    // 39: proto.ImportJob
+	(*GetImportJobRequest)(nil),          // 40: proto.GetImportJobRequest
+	(*ClearDatabaseResponse)(nil),        // 41: proto.ClearDatabaseResponse
+	(*timestamppb.Timestamp)(nil),        // 42: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                // 43: google.protobuf.Empty
 }
 var file_proto_order_proto_depIdxs = []int32{
 	1,  // 0: proto.CreateOrderRequest.package_type:type_name -> proto.PackageType
 	2,  // 1: proto.CreateOrderRequest.wrapper:type_name -> proto.WrapperType
-	0,  // 2: proto.Order.state:type_name -> proto.OrderState
-	1,  // 3: proto.Order.package_type:type_name -> proto.PackageType
-	2,  // 4: proto.Order.wrapper:type_name -> proto.WrapperType
-	20, // 5: proto.Order.deadline_at:type_name -> google.protobuf.Timestamp
-	20, // 6: proto.Order.updated_at:type_name -> google.protobuf.Timestamp
-	20, // 7: proto.Order.delivered_at:type_name -> google.protobuf.Timestamp
-	20, // 8: proto.Order.returned_at:type_name -> google.protobuf.Timestamp
-	9,  // 9: proto.ProcessCustomerResponse.results:type_name -> proto.ProcessingResult
-	4,  // 10: proto.ListOrdersResponse.orders:type_name -> proto.Order
-	4,  // 11: proto.ListReturnsResponse.returns:type_name -> proto.Order
-	4,  // 12: proto.OrderHistoryResponse.orders:type_name -> proto.Order
-	3,  // 13: proto.OrderRPCHandler.CreateOrder:input_type -> proto.CreateOrderRequest
-	5,  // 14: proto.OrderRPCHandler.GetOrder:input_type -> proto.GetOrderRequest
-	6,  // 15: proto.OrderRPCHandler.ReturnToCourier:input_type -> proto.ReturnToCourierRequest
-	8,  // 16: proto.OrderRPCHandler.ProcessCustomer:input_type -> proto.ProcessCustomerRequest
-	11, // 17: proto.OrderRPCHandler.ListOrders:input_type -> proto.ListOrdersRequest
-	13, // 18: proto.OrderRPCHandler.ListReturns:input_type -> proto.ListReturnsRequest
-	15, // 19: proto.OrderRPCHandler.OrderHistory:input_type -> proto.OrderHistoryRequest
-	17, // 20: proto.OrderRPCHandler.AcceptOrdersFromFile:input_type -> proto.AcceptOrdersFromFileRequest
-	21, // 21: proto.OrderRPCHandler.ClearDatabase:input_type -> google.protobuf.Empty
-	4,  // 22: proto.OrderRPCHandler.CreateOrder:output_type -> proto.Order
-	4,  // 23: proto.OrderRPCHandler.GetOrder:output_type -> proto.Order
-	7,  // 24: proto.OrderRPCHandler.ReturnToCourier:output_type -> proto.ReturnToCourierResponse
-	10, // 25: proto.OrderRPCHandler.ProcessCustomer:output_type -> proto.ProcessCustomerResponse
-	12, // 26: proto.OrderRPCHandler.ListOrders:output_type -> proto.ListOrdersResponse
-	14, // 27: proto.OrderRPCHandler.ListReturns:output_type -> proto.ListReturnsResponse
-	16, // 28: proto.OrderRPCHandler.OrderHistory:output_type -> proto.OrderHistoryResponse
-	18, // 29: proto.OrderRPCHandler.AcceptOrdersFromFile:output_type -> proto.AcceptOrdersFromFileResponse
-	19, // 30: proto.OrderRPCHandler.ClearDatabase:output_type -> proto.ClearDatabaseResponse
-	22, // [22:31] is the sub-list for method output_type
-	13, // [13:22] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	4,  // 2: proto.CreateOrderRequest.items:type_name -> proto.OrderItem
+	0,  // 3: proto.Order.state:type_name -> proto.OrderState
+	1,  // 4: proto.Order.package_type:type_name -> proto.PackageType
+	2,  // 5: proto.Order.wrapper:type_name -> proto.WrapperType
+	42, // 6: proto.Order.deadline_at:type_name -> google.protobuf.Timestamp
+	42, // 7: proto.Order.updated_at:type_name -> google.protobuf.Timestamp
+	42, // 8: proto.Order.delivered_at:type_name -> google.protobuf.Timestamp
+	42, // 9: proto.Order.returned_at:type_name -> google.protobuf.Timestamp
+	4,  // 10: proto.Order.items:type_name -> proto.OrderItem
+	42, // 11: proto.OrderExtension.previous_deadline_at:type_name -> google.protobuf.Timestamp
+	42, // 12: proto.OrderExtension.deadline_at:type_name -> google.protobuf.Timestamp
+	42, // 13: proto.OrderExtension.extended_at:type_name -> google.protobuf.Timestamp
+	5,  // 14: proto.ExtendStorageResponse.order:type_name -> proto.Order
+	10, // 15: proto.ExtendStorageResponse.extension:type_name -> proto.OrderExtension
+	13, // 16: proto.ProcessCustomerRequest.payment:type_name -> proto.PaymentDetails
+	14, // 17: proto.ProcessCustomerResponse.results:type_name -> proto.ProcessingResult
+	5,  // 18: proto.ListOrdersResponse.orders:type_name -> proto.Order
+	42, // 19: proto.OrderReturn.returned_at:type_name -> google.protobuf.Timestamp
+	5,  // 20: proto.ListReturnsResponse.returns:type_name -> proto.Order
+	19, // 21: proto.ListReturnsResponse.details:type_name -> proto.OrderReturn
+	5,  // 22: proto.ListCourierReturnsResponse.orders:type_name -> proto.Order
+	5,  // 23: proto.OrderHistoryResponse.orders:type_name -> proto.Order
+	0,  // 24: proto.OrderStateTransition.from_state:type_name -> proto.OrderState
+	0,  // 25: proto.OrderStateTransition.to_state:type_name -> proto.OrderState
+	42, // 26: proto.OrderStateTransition.changed_at:type_name -> google.protobuf.Timestamp
+	26, // 27: proto.OrderTimelineResponse.transitions:type_name -> proto.OrderStateTransition
+	42, // 28: proto.Payment.paid_at:type_name -> google.protobuf.Timestamp
+	42, // 29: proto.Refund.refunded_at:type_name -> google.protobuf.Timestamp
+	29, // 30: proto.OrderPayments.payment:type_name -> proto.Payment
+	30, // 31: proto.OrderPayments.refunds:type_name -> proto.Refund
+	0,  // 32: proto.ExportOrdersRequest.states:type_name -> proto.OrderState
+	42, // 33: proto.ExportOrdersRequest.updated_from:type_name -> google.protobuf.Timestamp
+	42, // 34: proto.ExportOrdersRequest.updated_to:type_name -> google.protobuf.Timestamp
+	35, // 35: proto.ImportOrdersRequest.options:type_name -> proto.ImportOrdersOptions
+	3,  // 36: proto.ImportOrdersRequest.order:type_name -> proto.CreateOrderRequest
+	37, // 37: proto.AcceptOrdersFromFileResponse.rows:type_name -> proto.ImportRowResult
+	37, // 38: proto.ImportJob.errors:type_name -> proto.ImportRowResult
+	42, // 39: proto.ImportJob.created_at:type_name -> google.protobuf.Timestamp
+	42, // 40: proto.ImportJob.started_at:type_name -> google.protobuf.Timestamp
+	42, // 41: proto.ImportJob.finished_at:type_name -> google.protobuf.Timestamp
+	42, // 42: proto.ImportJob.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 43: proto.OrderRPCHandler.CreateOrder:input_type -> proto.CreateOrderRequest
+	6,  // 44: proto.OrderRPCHandler.GetOrder:input_type -> proto.GetOrderRequest
+	7,  // 45: proto.OrderRPCHandler.ReturnToCourier:input_type -> proto.ReturnToCourierRequest
+	9,  // 46: proto.OrderRPCHandler.ExtendStorage:input_type -> proto.ExtendStorageRequest
+	12, // 47: proto.OrderRPCHandler.ProcessCustomer:input_type -> proto.ProcessCustomerRequest
+	16, // 48: proto.OrderRPCHandler.ListOrders:input_type -> proto.ListOrdersRequest
+	18, // 49: proto.OrderRPCHandler.ListReturns:input_type -> proto.ListReturnsRequest
+	21, // 50: proto.OrderRPCHandler.ListCourierReturns:input_type -> proto.ListCourierReturnsRequest
+	23, // 51: proto.OrderRPCHandler.OrderHistory:input_type -> proto.OrderHistoryRequest
+	25, // 52: proto.OrderRPCHandler.OrderTimeline:input_type -> proto.OrderTimelineRequest
+	28, // 53: proto.OrderRPCHandler.GetOrderPayments:input_type -> proto.GetOrderPaymentsRequest
+	33, // 54: proto.OrderRPCHandler.ExportOrders:input_type -> proto.ExportOrdersRequest
+	32, // 55: proto.OrderRPCHandler.AcceptOrdersFromFile:input_type -> proto.AcceptOrdersFromFileRequest
+	36, // 56: proto.OrderRPCHandler.ImportOrders:input_type -> proto.ImportOrdersRequest
+	32, // 57: proto.OrderRPCHandler.SubmitImportJob:input_type -> proto.AcceptOrdersFromFileRequest
+	40, // 58: proto.OrderRPCHandler.GetImportJob:input_type -> proto.GetImportJobRequest
+	40, // 59: proto.OrderRPCHandler.WatchImportJob:input_type -> proto.GetImportJobRequest
+	43, // 60: proto.OrderRPCHandler.ClearDatabase:input_type -> google.protobuf.Empty
+	5,  // 61: proto.OrderRPCHandler.CreateOrder:output_type -> proto.Order
+	5,  // 62: proto.OrderRPCHandler.GetOrder:output_type -> proto.Order
+	8,  // 63: proto.OrderRPCHandler.ReturnToCourier:output_type -> proto.ReturnToCourierResponse
+	11, // 64: proto.OrderRPCHandler.ExtendStorage:output_type -> proto.ExtendStorageResponse
+	15, // 65: proto.OrderRPCHandler.ProcessCustomer:output_type -> proto.ProcessCustomerResponse
+	17, // 66: proto.OrderRPCHandler.ListOrders:output_type -> proto.ListOrdersResponse
+	20, // 67: proto.OrderRPCHandler.ListReturns:output_type -> proto.ListReturnsResponse
+	22, // 68: proto.OrderRPCHandler.ListCourierReturns:output_type -> proto.ListCourierReturnsResponse
+	24, // 69: proto.OrderRPCHandler.OrderHistory:output_type -> proto.OrderHistoryResponse
+	27, // 70: proto.OrderRPCHandler.OrderTimeline:output_type -> proto.OrderTimelineResponse
+	31, // 71: proto.OrderRPCHandler.GetOrderPayments:output_type -> proto.OrderPayments
+	34, // 72: proto.OrderRPCHandler.ExportOrders:output_type -> proto.ExportOrdersChunk
+	38, // 73: proto.OrderRPCHandler.AcceptOrdersFromFile:output_type -> proto.AcceptOrdersFromFileResponse
+	38, // 74: proto.OrderRPCHandler.ImportOrders:output_type -> proto.AcceptOrdersFromFileResponse
+	39, // 75: proto.OrderRPCHandler.SubmitImportJob:output_type -> proto.ImportJob
+	39, // 76: proto.OrderRPCHandler.GetImportJob:output_type -> proto.ImportJob
+	39, // 77: proto.OrderRPCHandler.WatchImportJob:output_type -> proto.ImportJob
+	41, // 78: proto.OrderRPCHandler.ClearDatabase:output_type -> proto.ClearDatabaseResponse
+	61, // [61:79] is the sub-list for method output_type
+	43, // [43:61] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_proto_order_proto_init() }
@@ -1431,17 +3743,22 @@ func file_proto_order_proto_init() {
 	if File_proto_order_proto != nil {
 		return
 	}
-	file_proto_order_proto_msgTypes[6].OneofWrappers = []any{
+	file_proto_order_proto_msgTypes[11].OneofWrappers = []any{
 		(*ProcessingResult_Message)(nil),
 		(*ProcessingResult_Error)(nil),
 	}
+	file_proto_order_proto_msgTypes[33].OneofWrappers = []any{
+		(*ImportOrdersRequest_Options)(nil),
+		(*ImportOrdersRequest_Order)(nil),
+		(*ImportOrdersRequest_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_order_proto_rawDesc), len(file_proto_order_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   17,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,11 +23,20 @@ const (
 	OrderRPCHandler_CreateOrder_FullMethodName          = "/proto.OrderRPCHandler/CreateOrder"
 	OrderRPCHandler_GetOrder_FullMethodName             = "/proto.OrderRPCHandler/GetOrder"
 	OrderRPCHandler_ReturnToCourier_FullMethodName      = "/proto.OrderRPCHandler/ReturnToCourier"
+	OrderRPCHandler_ExtendStorage_FullMethodName        = "/proto.OrderRPCHandler/ExtendStorage"
 	OrderRPCHandler_ProcessCustomer_FullMethodName      = "/proto.OrderRPCHandler/ProcessCustomer"
 	OrderRPCHandler_ListOrders_FullMethodName           = "/proto.OrderRPCHandler/ListOrders"
 	OrderRPCHandler_ListReturns_FullMethodName          = "/proto.OrderRPCHandler/ListReturns"
+	OrderRPCHandler_ListCourierReturns_FullMethodName   = "/proto.OrderRPCHandler/ListCourierReturns"
 	OrderRPCHandler_OrderHistory_FullMethodName         = "/proto.OrderRPCHandler/OrderHistory"
+	OrderRPCHandler_OrderTimeline_FullMethodName        = "/proto.OrderRPCHandler/OrderTimeline"
+	OrderRPCHandler_GetOrderPayments_FullMethodName     = "/proto.OrderRPCHandler/GetOrderPayments"
+	OrderRPCHandler_ExportOrders_FullMethodName         = "/proto.OrderRPCHandler/ExportOrders"
 	OrderRPCHandler_AcceptOrdersFromFile_FullMethodName = "/proto.OrderRPCHandler/AcceptOrdersFromFile"
+	OrderRPCHandler_ImportOrders_FullMethodName         = "/proto.OrderRPCHandler/ImportOrders"
+	OrderRPCHandler_SubmitImportJob_FullMethodName      = "/proto.OrderRPCHandler/SubmitImportJob"
+	OrderRPCHandler_GetImportJob_FullMethodName         = "/proto.OrderRPCHandler/GetImportJob"
+	OrderRPCHandler_WatchImportJob_FullMethodName       = "/proto.OrderRPCHandler/WatchImportJob"
 	OrderRPCHandler_ClearDatabase_FullMethodName        = "/proto.OrderRPCHandler/ClearDatabase"
 )
 
@@ -43,16 +52,34 @@ type OrderRPCHandlerClient interface {
 	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*Order, error)
 	// Возврат заказа курьеру
 	ReturnToCourier(ctx context.Context, in *ReturnToCourierRequest, opts ...grpc.CallOption) (*ReturnToCourierResponse, error)
+	// Продление срока хранения заказа по просьбе клиента
+	ExtendStorage(ctx context.Context, in *ExtendStorageRequest, opts ...grpc.CallOption) (*ExtendStorageResponse, error)
 	// Обработка действий с заказами для указанного клиента
 	ProcessCustomer(ctx context.Context, in *ProcessCustomerRequest, opts ...grpc.CallOption) (*ProcessCustomerResponse, error)
 	// Получение списка заказов с курсорной пагинацией
 	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
 	// Получение списка возвращенных заказов с курсорной пагинацией
 	ListReturns(ctx context.Context, in *ListReturnsRequest, opts ...grpc.CallOption) (*ListReturnsResponse, error)
+	// Получение списка заказов, которые нужно вернуть курьеру сегодня, с курсорной пагинацией
+	ListCourierReturns(ctx context.Context, in *ListCourierReturnsRequest, opts ...grpc.CallOption) (*ListCourierReturnsResponse, error)
 	// Получение истории всех заказов
 	OrderHistory(ctx context.Context, in *OrderHistoryRequest, opts ...grpc.CallOption) (*OrderHistoryResponse, error)
+	// Получение истории смены статусов заказа
+	OrderTimeline(ctx context.Context, in *OrderTimelineRequest, opts ...grpc.CallOption) (*OrderTimelineResponse, error)
+	// Получение оплаты заказа и возвратов денег по нему
+	GetOrderPayments(ctx context.Context, in *GetOrderPaymentsRequest, opts ...grpc.CallOption) (*OrderPayments, error)
+	// Выгрузка заказов в файл CSV, NDJSON или XLSX по частям
+	ExportOrders(ctx context.Context, in *ExportOrdersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportOrdersChunk], error)
 	// Загрузка заказов из файла
 	AcceptOrdersFromFile(ctx context.Context, in *AcceptOrdersFromFileRequest, opts ...grpc.CallOption) (*AcceptOrdersFromFileResponse, error)
+	// Потоковая загрузка заказов: клиент передает параметры, затем заказы или части файла
+	ImportOrders(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportOrdersRequest, AcceptOrdersFromFileResponse], error)
+	// Постановка загрузки заказов из файла в очередь фоновых задач
+	SubmitImportJob(ctx context.Context, in *AcceptOrdersFromFileRequest, opts ...grpc.CallOption) (*ImportJob, error)
+	// Получение хода и результата задачи импорта
+	GetImportJob(ctx context.Context, in *GetImportJobRequest, opts ...grpc.CallOption) (*ImportJob, error)
+	// Отслеживание хода задачи импорта до ее завершения
+	WatchImportJob(ctx context.Context, in *GetImportJobRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ImportJob], error)
 	// Очистка базы данных
 	ClearDatabase(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClearDatabaseResponse, error)
 }
@@ -95,6 +122,16 @@ func (c *orderRPCHandlerClient) ReturnToCourier(ctx context.Context, in *ReturnT
 	return out, nil
 }
 
+func (c *orderRPCHandlerClient) ExtendStorage(ctx context.Context, in *ExtendStorageRequest, opts ...grpc.CallOption) (*ExtendStorageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendStorageResponse)
+	err := c.cc.Invoke(ctx, OrderRPCHandler_ExtendStorage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderRPCHandlerClient) ProcessCustomer(ctx context.Context, in *ProcessCustomerRequest, opts ...grpc.CallOption) (*ProcessCustomerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ProcessCustomerResponse)
@@ -125,6 +162,16 @@ func (c *orderRPCHandlerClient) ListReturns(ctx context.Context, in *ListReturns
 	return out, nil
 }
 
+func (c *orderRPCHandlerClient) ListCourierReturns(ctx context.Context, in *ListCourierReturnsRequest, opts ...grpc.CallOption) (*ListCourierReturnsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCourierReturnsResponse)
+	err := c.cc.Invoke(ctx, OrderRPCHandler_ListCourierReturns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderRPCHandlerClient) OrderHistory(ctx context.Context, in *OrderHistoryRequest, opts ...grpc.CallOption) (*OrderHistoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(OrderHistoryResponse)
@@ -135,6 +182,45 @@ func (c *orderRPCHandlerClient) OrderHistory(ctx context.Context, in *OrderHisto
 	return out, nil
 }
 
+func (c *orderRPCHandlerClient) OrderTimeline(ctx context.Context, in *OrderTimelineRequest, opts ...grpc.CallOption) (*OrderTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderTimelineResponse)
+	err := c.cc.Invoke(ctx, OrderRPCHandler_OrderTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderRPCHandlerClient) GetOrderPayments(ctx context.Context, in *GetOrderPaymentsRequest, opts ...grpc.CallOption) (*OrderPayments, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderPayments)
+	err := c.cc.Invoke(ctx, OrderRPCHandler_GetOrderPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderRPCHandlerClient) ExportOrders(ctx context.Context, in *ExportOrdersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportOrdersChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrderRPCHandler_ServiceDesc.Streams[0], OrderRPCHandler_ExportOrders_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportOrdersRequest, ExportOrdersChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderRPCHandler_ExportOrdersClient = grpc.ServerStreamingClient[ExportOrdersChunk]
+
 func (c *orderRPCHandlerClient) AcceptOrdersFromFile(ctx context.Context, in *AcceptOrdersFromFileRequest, opts ...grpc.CallOption) (*AcceptOrdersFromFileResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AcceptOrdersFromFileResponse)
@@ -145,6 +231,58 @@ func (c *orderRPCHandlerClient) AcceptOrdersFromFile(ctx context.Context, in *Ac
 	return out, nil
 }
 
+func (c *orderRPCHandlerClient) ImportOrders(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportOrdersRequest, AcceptOrdersFromFileResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrderRPCHandler_ServiceDesc.Streams[1], OrderRPCHandler_ImportOrders_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportOrdersRequest, AcceptOrdersFromFileResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderRPCHandler_ImportOrdersClient = grpc.ClientStreamingClient[ImportOrdersRequest, AcceptOrdersFromFileResponse]
+
+func (c *orderRPCHandlerClient) SubmitImportJob(ctx context.Context, in *AcceptOrdersFromFileRequest, opts ...grpc.CallOption) (*ImportJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportJob)
+	err := c.cc.Invoke(ctx, OrderRPCHandler_SubmitImportJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderRPCHandlerClient) GetImportJob(ctx context.Context, in *GetImportJobRequest, opts ...grpc.CallOption) (*ImportJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportJob)
+	err := c.cc.Invoke(ctx, OrderRPCHandler_GetImportJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderRPCHandlerClient) WatchImportJob(ctx context.Context, in *GetImportJobRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ImportJob], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrderRPCHandler_ServiceDesc.Streams[2], OrderRPCHandler_WatchImportJob_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetImportJobRequest, ImportJob]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderRPCHandler_WatchImportJobClient = grpc.ServerStreamingClient[ImportJob]
+
 func (c *orderRPCHandlerClient) ClearDatabase(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClearDatabaseResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ClearDatabaseResponse)
@@ -167,16 +305,34 @@ type OrderRPCHandlerServer interface {
 	GetOrder(context.Context, *GetOrderRequest) (*Order, error)
 	// Возврат заказа курьеру
 	ReturnToCourier(context.Context, *ReturnToCourierRequest) (*ReturnToCourierResponse, error)
+	// Продление срока хранения заказа по просьбе клиента
+	ExtendStorage(context.Context, *ExtendStorageRequest) (*ExtendStorageResponse, error)
 	// Обработка действий с заказами для указанного клиента
 	ProcessCustomer(context.Context, *ProcessCustomerRequest) (*ProcessCustomerResponse, error)
 	// Получение списка заказов с курсорной пагинацией
 	ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
 	// Получение списка возвращенных заказов с курсорной пагинацией
 	ListReturns(context.Context, *ListReturnsRequest) (*ListReturnsResponse, error)
+	// Получение списка заказов, которые нужно вернуть курьеру сегодня, с курсорной пагинацией
+	ListCourierReturns(context.Context, *ListCourierReturnsRequest) (*ListCourierReturnsResponse, error)
 	// Получение истории всех заказов
 	OrderHistory(context.Context, *OrderHistoryRequest) (*OrderHistoryResponse, error)
+	// Получение истории смены статусов заказа
+	OrderTimeline(context.Context, *OrderTimelineRequest) (*OrderTimelineResponse, error)
+	// Получение оплаты заказа и возвратов денег по нему
+	GetOrderPayments(context.Context, *GetOrderPaymentsRequest) (*OrderPayments, error)
+	// Выгрузка заказов в файл CSV, NDJSON или XLSX по частям
+	ExportOrders(*ExportOrdersRequest, grpc.ServerStreamingServer[ExportOrdersChunk]) error
 	// Загрузка заказов из файла
 	AcceptOrdersFromFile(context.Context, *AcceptOrdersFromFileRequest) (*AcceptOrdersFromFileResponse, error)
+	// Потоковая загрузка заказов: клиент передает параметры, затем заказы или части файла
+	ImportOrders(grpc.ClientStreamingServer[ImportOrdersRequest, AcceptOrdersFromFileResponse]) error
+	// Постановка загрузки заказов из файла в очередь фоновых задач
+	SubmitImportJob(context.Context, *AcceptOrdersFromFileRequest) (*ImportJob, error)
+	// Получение хода и результата задачи импорта
+	GetImportJob(context.Context, *GetImportJobRequest) (*ImportJob, error)
+	// Отслеживание хода задачи импорта до ее завершения
+	WatchImportJob(*GetImportJobRequest, grpc.ServerStreamingServer[ImportJob]) error
 	// Очистка базы данных
 	ClearDatabase(context.Context, *emptypb.Empty) (*ClearDatabaseResponse, error)
 	mustEmbedUnimplementedOrderRPCHandlerServer()
@@ -198,6 +354,9 @@ func (UnimplementedOrderRPCHandlerServer) GetOrder(context.Context, *GetOrderReq
 func (UnimplementedOrderRPCHandlerServer) ReturnToCourier(context.Context, *ReturnToCourierRequest) (*ReturnToCourierResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReturnToCourier not implemented")
 }
+func (UnimplementedOrderRPCHandlerServer) ExtendStorage(context.Context, *ExtendStorageRequest) (*ExtendStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendStorage not implemented")
+}
 func (UnimplementedOrderRPCHandlerServer) ProcessCustomer(context.Context, *ProcessCustomerRequest) (*ProcessCustomerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessCustomer not implemented")
 }
@@ -207,12 +366,36 @@ func (UnimplementedOrderRPCHandlerServer) ListOrders(context.Context, *ListOrder
 func (UnimplementedOrderRPCHandlerServer) ListReturns(context.Context, *ListReturnsRequest) (*ListReturnsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListReturns not implemented")
 }
+func (UnimplementedOrderRPCHandlerServer) ListCourierReturns(context.Context, *ListCourierReturnsRequest) (*ListCourierReturnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCourierReturns not implemented")
+}
 func (UnimplementedOrderRPCHandlerServer) OrderHistory(context.Context, *OrderHistoryRequest) (*OrderHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OrderHistory not implemented")
 }
+func (UnimplementedOrderRPCHandlerServer) OrderTimeline(context.Context, *OrderTimelineRequest) (*OrderTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderTimeline not implemented")
+}
+func (UnimplementedOrderRPCHandlerServer) GetOrderPayments(context.Context, *GetOrderPaymentsRequest) (*OrderPayments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderPayments not implemented")
+}
+func (UnimplementedOrderRPCHandlerServer) ExportOrders(*ExportOrdersRequest, grpc.ServerStreamingServer[ExportOrdersChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportOrders not implemented")
+}
 func (UnimplementedOrderRPCHandlerServer) AcceptOrdersFromFile(context.Context, *AcceptOrdersFromFileRequest) (*AcceptOrdersFromFileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AcceptOrdersFromFile not implemented")
 }
+func (UnimplementedOrderRPCHandlerServer) ImportOrders(grpc.ClientStreamingServer[ImportOrdersRequest, AcceptOrdersFromFileResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportOrders not implemented")
+}
+func (UnimplementedOrderRPCHandlerServer) SubmitImportJob(context.Context, *AcceptOrdersFromFileRequest) (*ImportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitImportJob not implemented")
+}
+func (UnimplementedOrderRPCHandlerServer) GetImportJob(context.Context, *GetImportJobRequest) (*ImportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportJob not implemented")
+}
+func (UnimplementedOrderRPCHandlerServer) WatchImportJob(*GetImportJobRequest, grpc.ServerStreamingServer[ImportJob]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchImportJob not implemented")
+}
 func (UnimplementedOrderRPCHandlerServer) ClearDatabase(context.Context, *emptypb.Empty) (*ClearDatabaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearDatabase not implemented")
 }
@@ -291,6 +474,24 @@ func _OrderRPCHandler_ReturnToCourier_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderRPCHandler_ExtendStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRPCHandlerServer).ExtendStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRPCHandler_ExtendStorage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRPCHandlerServer).ExtendStorage(ctx, req.(*ExtendStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderRPCHandler_ProcessCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProcessCustomerRequest)
 	if err := dec(in); err != nil {
@@ -345,6 +546,24 @@ func _OrderRPCHandler_ListReturns_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderRPCHandler_ListCourierReturns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCourierReturnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRPCHandlerServer).ListCourierReturns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRPCHandler_ListCourierReturns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRPCHandlerServer).ListCourierReturns(ctx, req.(*ListCourierReturnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderRPCHandler_OrderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OrderHistoryRequest)
 	if err := dec(in); err != nil {
@@ -363,6 +582,53 @@ func _OrderRPCHandler_OrderHistory_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderRPCHandler_OrderTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRPCHandlerServer).OrderTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRPCHandler_OrderTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRPCHandlerServer).OrderTimeline(ctx, req.(*OrderTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderRPCHandler_GetOrderPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRPCHandlerServer).GetOrderPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRPCHandler_GetOrderPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRPCHandlerServer).GetOrderPayments(ctx, req.(*GetOrderPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderRPCHandler_ExportOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportOrdersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrderRPCHandlerServer).ExportOrders(m, &grpc.GenericServerStream[ExportOrdersRequest, ExportOrdersChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderRPCHandler_ExportOrdersServer = grpc.ServerStreamingServer[ExportOrdersChunk]
+
 func _OrderRPCHandler_AcceptOrdersFromFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AcceptOrdersFromFileRequest)
 	if err := dec(in); err != nil {
@@ -381,6 +647,60 @@ func _OrderRPCHandler_AcceptOrdersFromFile_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderRPCHandler_ImportOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(OrderRPCHandlerServer).ImportOrders(&grpc.GenericServerStream[ImportOrdersRequest, AcceptOrdersFromFileResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderRPCHandler_ImportOrdersServer = grpc.ClientStreamingServer[ImportOrdersRequest, AcceptOrdersFromFileResponse]
+
+func _OrderRPCHandler_SubmitImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptOrdersFromFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRPCHandlerServer).SubmitImportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRPCHandler_SubmitImportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRPCHandlerServer).SubmitImportJob(ctx, req.(*AcceptOrdersFromFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderRPCHandler_GetImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRPCHandlerServer).GetImportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRPCHandler_GetImportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRPCHandlerServer).GetImportJob(ctx, req.(*GetImportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderRPCHandler_WatchImportJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetImportJobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrderRPCHandlerServer).WatchImportJob(m, &grpc.GenericServerStream[GetImportJobRequest, ImportJob]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderRPCHandler_WatchImportJobServer = grpc.ServerStreamingServer[ImportJob]
+
 func _OrderRPCHandler_ClearDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -418,6 +738,10 @@ var OrderRPCHandler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReturnToCourier",
 			Handler:    _OrderRPCHandler_ReturnToCourier_Handler,
 		},
+		{
+			MethodName: "ExtendStorage",
+			Handler:    _OrderRPCHandler_ExtendStorage_Handler,
+		},
 		{
 			MethodName: "ProcessCustomer",
 			Handler:    _OrderRPCHandler_ProcessCustomer_Handler,
@@ -430,19 +754,55 @@ var OrderRPCHandler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListReturns",
 			Handler:    _OrderRPCHandler_ListReturns_Handler,
 		},
+		{
+			MethodName: "ListCourierReturns",
+			Handler:    _OrderRPCHandler_ListCourierReturns_Handler,
+		},
 		{
 			MethodName: "OrderHistory",
 			Handler:    _OrderRPCHandler_OrderHistory_Handler,
 		},
+		{
+			MethodName: "OrderTimeline",
+			Handler:    _OrderRPCHandler_OrderTimeline_Handler,
+		},
+		{
+			MethodName: "GetOrderPayments",
+			Handler:    _OrderRPCHandler_GetOrderPayments_Handler,
+		},
 		{
 			MethodName: "AcceptOrdersFromFile",
 			Handler:    _OrderRPCHandler_AcceptOrdersFromFile_Handler,
 		},
+		{
+			MethodName: "SubmitImportJob",
+			Handler:    _OrderRPCHandler_SubmitImportJob_Handler,
+		},
+		{
+			MethodName: "GetImportJob",
+			Handler:    _OrderRPCHandler_GetImportJob_Handler,
+		},
 		{
 			MethodName: "ClearDatabase",
 			Handler:    _OrderRPCHandler_ClearDatabase_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportOrders",
+			Handler:       _OrderRPCHandler_ExportOrders_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportOrders",
+			Handler:       _OrderRPCHandler_ImportOrders_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchImportJob",
+			Handler:       _OrderRPCHandler_WatchImportJob_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "proto/order.proto",
 }
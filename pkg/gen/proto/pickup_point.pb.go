@@ -0,0 +1,593 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: proto/pickup_point.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Модель ПВЗ
+type PickupPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupPoint) Reset() {
+	*x = PickupPoint{}
+	mi := &file_proto_pickup_point_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupPoint) ProtoMessage() {}
+
+func (x *PickupPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pickup_point_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupPoint.ProtoReflect.Descriptor instead.
+func (*PickupPoint) Descriptor() ([]byte, []int) {
+	return file_proto_pickup_point_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PickupPoint) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PickupPoint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PickupPoint) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PickupPoint) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PickupPoint) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Запрос на создание ПВЗ
+type CreatePickupPointRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePickupPointRequest) Reset() {
+	*x = CreatePickupPointRequest{}
+	mi := &file_proto_pickup_point_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePickupPointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePickupPointRequest) ProtoMessage() {}
+
+func (x *CreatePickupPointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pickup_point_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePickupPointRequest.ProtoReflect.Descriptor instead.
+func (*CreatePickupPointRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pickup_point_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePickupPointRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePickupPointRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// Запрос на получение ПВЗ по ID
+type GetPickupPointRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPickupPointRequest) Reset() {
+	*x = GetPickupPointRequest{}
+	mi := &file_proto_pickup_point_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPickupPointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPickupPointRequest) ProtoMessage() {}
+
+func (x *GetPickupPointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pickup_point_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPickupPointRequest.ProtoReflect.Descriptor instead.
+func (*GetPickupPointRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pickup_point_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetPickupPointRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Запрос на получение списка ПВЗ
+type ListPickupPointsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPickupPointsRequest) Reset() {
+	*x = ListPickupPointsRequest{}
+	mi := &file_proto_pickup_point_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPickupPointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPickupPointsRequest) ProtoMessage() {}
+
+func (x *ListPickupPointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pickup_point_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPickupPointsRequest.ProtoReflect.Descriptor instead.
+func (*ListPickupPointsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pickup_point_proto_rawDescGZIP(), []int{3}
+}
+
+// Ответ со списком ПВЗ
+type ListPickupPointsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPoints  []*PickupPoint         `protobuf:"bytes,1,rep,name=pickup_points,json=pickupPoints,proto3" json:"pickup_points,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPickupPointsResponse) Reset() {
+	*x = ListPickupPointsResponse{}
+	mi := &file_proto_pickup_point_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPickupPointsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPickupPointsResponse) ProtoMessage() {}
+
+func (x *ListPickupPointsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pickup_point_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPickupPointsResponse.ProtoReflect.Descriptor instead.
+func (*ListPickupPointsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pickup_point_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListPickupPointsResponse) GetPickupPoints() []*PickupPoint {
+	if x != nil {
+		return x.PickupPoints
+	}
+	return nil
+}
+
+func (x *ListPickupPointsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Запрос на изменение ПВЗ
+type UpdatePickupPointRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`       // если не указано, сохраняется текущее название
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"` // если не указано, сохраняется текущий адрес
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePickupPointRequest) Reset() {
+	*x = UpdatePickupPointRequest{}
+	mi := &file_proto_pickup_point_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePickupPointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePickupPointRequest) ProtoMessage() {}
+
+func (x *UpdatePickupPointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pickup_point_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePickupPointRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePickupPointRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pickup_point_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdatePickupPointRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdatePickupPointRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePickupPointRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// Ответ на запрос изменения ПВЗ
+type UpdatePickupPointResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePickupPointResponse) Reset() {
+	*x = UpdatePickupPointResponse{}
+	mi := &file_proto_pickup_point_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePickupPointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePickupPointResponse) ProtoMessage() {}
+
+func (x *UpdatePickupPointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pickup_point_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePickupPointResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePickupPointResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pickup_point_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdatePickupPointResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Запрос на удаление ПВЗ
+type DeletePickupPointRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePickupPointRequest) Reset() {
+	*x = DeletePickupPointRequest{}
+	mi := &file_proto_pickup_point_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePickupPointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePickupPointRequest) ProtoMessage() {}
+
+func (x *DeletePickupPointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pickup_point_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePickupPointRequest.ProtoReflect.Descriptor instead.
+func (*DeletePickupPointRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pickup_point_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeletePickupPointRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Ответ на запрос удаления ПВЗ
+type DeletePickupPointResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePickupPointResponse) Reset() {
+	*x = DeletePickupPointResponse{}
+	mi := &file_proto_pickup_point_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePickupPointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePickupPointResponse) ProtoMessage() {}
+
+func (x *DeletePickupPointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pickup_point_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePickupPointResponse.ProtoReflect.Descriptor instead.
+func (*DeletePickupPointResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pickup_point_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeletePickupPointResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_proto_pickup_point_proto protoreflect.FileDescriptor
+
+const file_proto_pickup_point_proto_rawDesc = "" +
+	"\n" +
+	"\x18proto/pickup_point.proto\x12\x05proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc1\x01\n" +
+	"\vPickupPoint\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"H\n" +
+	"\x18CreatePickupPointRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\"'\n" +
+	"\x15GetPickupPointRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x19\n" +
+	"\x17ListPickupPointsRequest\"i\n" +
+	"\x18ListPickupPointsResponse\x127\n" +
+	"\rpickup_points\x18\x01 \x03(\v2\x12.proto.PickupPointR\fpickupPoints\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"X\n" +
+	"\x18UpdatePickupPointRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\"5\n" +
+	"\x19UpdatePickupPointResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"*\n" +
+	"\x18DeletePickupPointRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"5\n" +
+	"\x19DeletePickupPointResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage2\xb4\x03\n" +
+	"\x15PickupPointRPCHandler\x12J\n" +
+	"\x11CreatePickupPoint\x12\x1f.proto.CreatePickupPointRequest\x1a\x12.proto.PickupPoint\"\x00\x12D\n" +
+	"\x0eGetPickupPoint\x12\x1c.proto.GetPickupPointRequest\x1a\x12.proto.PickupPoint\"\x00\x12U\n" +
+	"\x10ListPickupPoints\x12\x1e.proto.ListPickupPointsRequest\x1a\x1f.proto.ListPickupPointsResponse\"\x00\x12X\n" +
+	"\x11UpdatePickupPoint\x12\x1f.proto.UpdatePickupPointRequest\x1a .proto.UpdatePickupPointResponse\"\x00\x12X\n" +
+	"\x11DeletePickupPoint\x12\x1f.proto.DeletePickupPointRequest\x1a .proto.DeletePickupPointResponse\"\x00B#Z!gitlab.ozon.dev/gojhw1/pkg/gen;pbb\x06proto3"
+
+var (
+	file_proto_pickup_point_proto_rawDescOnce sync.Once
+	file_proto_pickup_point_proto_rawDescData []byte
+)
+
+func file_proto_pickup_point_proto_rawDescGZIP() []byte {
+	file_proto_pickup_point_proto_rawDescOnce.Do(func() {
+		file_proto_pickup_point_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_pickup_point_proto_rawDesc), len(file_proto_pickup_point_proto_rawDesc)))
+	})
+	return file_proto_pickup_point_proto_rawDescData
+}
+
+var file_proto_pickup_point_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_pickup_point_proto_goTypes = []any{
+	(*PickupPoint)(nil),               // 0: proto.PickupPoint
+	(*CreatePickupPointRequest)(nil),  // 1: proto.CreatePickupPointRequest
+	(*GetPickupPointRequest)(nil),     // 2: proto.GetPickupPointRequest
+	(*ListPickupPointsRequest)(nil),   // 3: proto.ListPickupPointsRequest
+	(*ListPickupPointsResponse)(nil),  // 4: proto.ListPickupPointsResponse
+	(*UpdatePickupPointRequest)(nil),  // 5: proto.UpdatePickupPointRequest
+	(*UpdatePickupPointResponse)(nil), // 6: proto.UpdatePickupPointResponse
+	(*DeletePickupPointRequest)(nil),  // 7: proto.DeletePickupPointRequest
+	(*DeletePickupPointResponse)(nil), // 8: proto.DeletePickupPointResponse
+	(*timestamppb.Timestamp)(nil),     // 9: google.protobuf.Timestamp
+}
+var file_proto_pickup_point_proto_depIdxs = []int32{
+	9, // 0: proto.PickupPoint.created_at:type_name -> google.protobuf.Timestamp
+	9, // 1: proto.PickupPoint.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 2: proto.ListPickupPointsResponse.pickup_points:type_name -> proto.PickupPoint
+	1, // 3: proto.PickupPointRPCHandler.CreatePickupPoint:input_type -> proto.CreatePickupPointRequest
+	2, // 4: proto.PickupPointRPCHandler.GetPickupPoint:input_type -> proto.GetPickupPointRequest
+	3, // 5: proto.PickupPointRPCHandler.ListPickupPoints:input_type -> proto.ListPickupPointsRequest
+	5, // 6: proto.PickupPointRPCHandler.UpdatePickupPoint:input_type -> proto.UpdatePickupPointRequest
+	7, // 7: proto.PickupPointRPCHandler.DeletePickupPoint:input_type -> proto.DeletePickupPointRequest
+	0, // 8: proto.PickupPointRPCHandler.CreatePickupPoint:output_type -> proto.PickupPoint
+	0, // 9: proto.PickupPointRPCHandler.GetPickupPoint:output_type -> proto.PickupPoint
+	4, // 10: proto.PickupPointRPCHandler.ListPickupPoints:output_type -> proto.ListPickupPointsResponse
+	6, // 11: proto.PickupPointRPCHandler.UpdatePickupPoint:output_type -> proto.UpdatePickupPointResponse
+	8, // 12: proto.PickupPointRPCHandler.DeletePickupPoint:output_type -> proto.DeletePickupPointResponse
+	8, // [8:13] is the sub-list for method output_type
+	3, // [3:8] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_proto_pickup_point_proto_init() }
+func file_proto_pickup_point_proto_init() {
+	if File_proto_pickup_point_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_pickup_point_proto_rawDesc), len(file_proto_pickup_point_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_pickup_point_proto_goTypes,
+		DependencyIndexes: file_proto_pickup_point_proto_depIdxs,
+		MessageInfos:      file_proto_pickup_point_proto_msgTypes,
+	}.Build()
+	File_proto_pickup_point_proto = out.File
+	file_proto_pickup_point_proto_goTypes = nil
+	file_proto_pickup_point_proto_depIdxs = nil
+}
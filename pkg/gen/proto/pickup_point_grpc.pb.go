@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: proto/pickup_point.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PickupPointRPCHandler_CreatePickupPoint_FullMethodName = "/proto.PickupPointRPCHandler/CreatePickupPoint"
+	PickupPointRPCHandler_GetPickupPoint_FullMethodName    = "/proto.PickupPointRPCHandler/GetPickupPoint"
+	PickupPointRPCHandler_ListPickupPoints_FullMethodName  = "/proto.PickupPointRPCHandler/ListPickupPoints"
+	PickupPointRPCHandler_UpdatePickupPoint_FullMethodName = "/proto.PickupPointRPCHandler/UpdatePickupPoint"
+	PickupPointRPCHandler_DeletePickupPoint_FullMethodName = "/proto.PickupPointRPCHandler/DeletePickupPoint"
+)
+
+// PickupPointRPCHandlerClient is the client API for PickupPointRPCHandler service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Сервис для работы с пунктами выдачи заказов
+type PickupPointRPCHandlerClient interface {
+	// Создание нового ПВЗ
+	CreatePickupPoint(ctx context.Context, in *CreatePickupPointRequest, opts ...grpc.CallOption) (*PickupPoint, error)
+	// Получение ПВЗ по ID
+	GetPickupPoint(ctx context.Context, in *GetPickupPointRequest, opts ...grpc.CallOption) (*PickupPoint, error)
+	// Получение списка ПВЗ
+	ListPickupPoints(ctx context.Context, in *ListPickupPointsRequest, opts ...grpc.CallOption) (*ListPickupPointsResponse, error)
+	// Изменение названия и адреса ПВЗ
+	UpdatePickupPoint(ctx context.Context, in *UpdatePickupPointRequest, opts ...grpc.CallOption) (*UpdatePickupPointResponse, error)
+	// Удаление ПВЗ без заказов
+	DeletePickupPoint(ctx context.Context, in *DeletePickupPointRequest, opts ...grpc.CallOption) (*DeletePickupPointResponse, error)
+}
+
+type pickupPointRPCHandlerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPickupPointRPCHandlerClient(cc grpc.ClientConnInterface) PickupPointRPCHandlerClient {
+	return &pickupPointRPCHandlerClient{cc}
+}
+
+func (c *pickupPointRPCHandlerClient) CreatePickupPoint(ctx context.Context, in *CreatePickupPointRequest, opts ...grpc.CallOption) (*PickupPoint, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupPoint)
+	err := c.cc.Invoke(ctx, PickupPointRPCHandler_CreatePickupPoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pickupPointRPCHandlerClient) GetPickupPoint(ctx context.Context, in *GetPickupPointRequest, opts ...grpc.CallOption) (*PickupPoint, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupPoint)
+	err := c.cc.Invoke(ctx, PickupPointRPCHandler_GetPickupPoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pickupPointRPCHandlerClient) ListPickupPoints(ctx context.Context, in *ListPickupPointsRequest, opts ...grpc.CallOption) (*ListPickupPointsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPickupPointsResponse)
+	err := c.cc.Invoke(ctx, PickupPointRPCHandler_ListPickupPoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pickupPointRPCHandlerClient) UpdatePickupPoint(ctx context.Context, in *UpdatePickupPointRequest, opts ...grpc.CallOption) (*UpdatePickupPointResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePickupPointResponse)
+	err := c.cc.Invoke(ctx, PickupPointRPCHandler_UpdatePickupPoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pickupPointRPCHandlerClient) DeletePickupPoint(ctx context.Context, in *DeletePickupPointRequest, opts ...grpc.CallOption) (*DeletePickupPointResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePickupPointResponse)
+	err := c.cc.Invoke(ctx, PickupPointRPCHandler_DeletePickupPoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PickupPointRPCHandlerServer is the server API for PickupPointRPCHandler service.
+// All implementations must embed UnimplementedPickupPointRPCHandlerServer
+// for forward compatibility.
+//
+// Сервис для работы с пунктами выдачи заказов
+type PickupPointRPCHandlerServer interface {
+	// Создание нового ПВЗ
+	CreatePickupPoint(context.Context, *CreatePickupPointRequest) (*PickupPoint, error)
+	// Получение ПВЗ по ID
+	GetPickupPoint(context.Context, *GetPickupPointRequest) (*PickupPoint, error)
+	// Получение списка ПВЗ
+	ListPickupPoints(context.Context, *ListPickupPointsRequest) (*ListPickupPointsResponse, error)
+	// Изменение названия и адреса ПВЗ
+	UpdatePickupPoint(context.Context, *UpdatePickupPointRequest) (*UpdatePickupPointResponse, error)
+	// Удаление ПВЗ без заказов
+	DeletePickupPoint(context.Context, *DeletePickupPointRequest) (*DeletePickupPointResponse, error)
+	mustEmbedUnimplementedPickupPointRPCHandlerServer()
+}
+
+// UnimplementedPickupPointRPCHandlerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPickupPointRPCHandlerServer struct{}
+
+func (UnimplementedPickupPointRPCHandlerServer) CreatePickupPoint(context.Context, *CreatePickupPointRequest) (*PickupPoint, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePickupPoint not implemented")
+}
+func (UnimplementedPickupPointRPCHandlerServer) GetPickupPoint(context.Context, *GetPickupPointRequest) (*PickupPoint, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPickupPoint not implemented")
+}
+func (UnimplementedPickupPointRPCHandlerServer) ListPickupPoints(context.Context, *ListPickupPointsRequest) (*ListPickupPointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPickupPoints not implemented")
+}
+func (UnimplementedPickupPointRPCHandlerServer) UpdatePickupPoint(context.Context, *UpdatePickupPointRequest) (*UpdatePickupPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePickupPoint not implemented")
+}
+func (UnimplementedPickupPointRPCHandlerServer) DeletePickupPoint(context.Context, *DeletePickupPointRequest) (*DeletePickupPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePickupPoint not implemented")
+}
+func (UnimplementedPickupPointRPCHandlerServer) mustEmbedUnimplementedPickupPointRPCHandlerServer() {}
+func (UnimplementedPickupPointRPCHandlerServer) testEmbeddedByValue()                               {}
+
+// UnsafePickupPointRPCHandlerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PickupPointRPCHandlerServer will
+// result in compilation errors.
+type UnsafePickupPointRPCHandlerServer interface {
+	mustEmbedUnimplementedPickupPointRPCHandlerServer()
+}
+
+func RegisterPickupPointRPCHandlerServer(s grpc.ServiceRegistrar, srv PickupPointRPCHandlerServer) {
+	// If the following call pancis, it indicates UnimplementedPickupPointRPCHandlerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PickupPointRPCHandler_ServiceDesc, srv)
+}
+
+func _PickupPointRPCHandler_CreatePickupPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePickupPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PickupPointRPCHandlerServer).CreatePickupPoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PickupPointRPCHandler_CreatePickupPoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PickupPointRPCHandlerServer).CreatePickupPoint(ctx, req.(*CreatePickupPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PickupPointRPCHandler_GetPickupPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPickupPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PickupPointRPCHandlerServer).GetPickupPoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PickupPointRPCHandler_GetPickupPoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PickupPointRPCHandlerServer).GetPickupPoint(ctx, req.(*GetPickupPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PickupPointRPCHandler_ListPickupPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPickupPointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PickupPointRPCHandlerServer).ListPickupPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PickupPointRPCHandler_ListPickupPoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PickupPointRPCHandlerServer).ListPickupPoints(ctx, req.(*ListPickupPointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PickupPointRPCHandler_UpdatePickupPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePickupPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PickupPointRPCHandlerServer).UpdatePickupPoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PickupPointRPCHandler_UpdatePickupPoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PickupPointRPCHandlerServer).UpdatePickupPoint(ctx, req.(*UpdatePickupPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PickupPointRPCHandler_DeletePickupPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePickupPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PickupPointRPCHandlerServer).DeletePickupPoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PickupPointRPCHandler_DeletePickupPoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PickupPointRPCHandlerServer).DeletePickupPoint(ctx, req.(*DeletePickupPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PickupPointRPCHandler_ServiceDesc is the grpc.ServiceDesc for PickupPointRPCHandler service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PickupPointRPCHandler_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.PickupPointRPCHandler",
+	HandlerType: (*PickupPointRPCHandlerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePickupPoint",
+			Handler:    _PickupPointRPCHandler_CreatePickupPoint_Handler,
+		},
+		{
+			MethodName: "GetPickupPoint",
+			Handler:    _PickupPointRPCHandler_GetPickupPoint_Handler,
+		},
+		{
+			MethodName: "ListPickupPoints",
+			Handler:    _PickupPointRPCHandler_ListPickupPoints_Handler,
+		},
+		{
+			MethodName: "UpdatePickupPoint",
+			Handler:    _PickupPointRPCHandler_UpdatePickupPoint_Handler,
+		},
+		{
+			MethodName: "DeletePickupPoint",
+			Handler:    _PickupPointRPCHandler_DeletePickupPoint_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/pickup_point.proto",
+}
@@ -0,0 +1,760 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: proto/return_policy.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Модель политики возврата
+type ReturnPolicy struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PackageType        string                 `protobuf:"bytes,3,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`             // пусто - заказы в любой упаковке
+	CustomerSegment    string                 `protobuf:"bytes,4,opt,name=customer_segment,json=customerSegment,proto3" json:"customer_segment,omitempty"` // пусто - клиенты любого сегмента
+	ReturnWindowHours  int32                  `protobuf:"varint,5,opt,name=return_window_hours,json=returnWindowHours,proto3" json:"return_window_hours,omitempty"`
+	NonReturnable      bool                   `protobuf:"varint,6,opt,name=non_returnable,json=nonReturnable,proto3" json:"non_returnable,omitempty"`
+	FeePercent         float64                `protobuf:"fixed64,7,opt,name=fee_percent,json=feePercent,proto3" json:"fee_percent,omitempty"` // удерживается из стоимости заказа при возврате
+	RequiresInspection bool                   `protobuf:"varint,8,opt,name=requires_inspection,json=requiresInspection,proto3" json:"requires_inspection,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ReturnPolicy) Reset() {
+	*x = ReturnPolicy{}
+	mi := &file_proto_return_policy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnPolicy) ProtoMessage() {}
+
+func (x *ReturnPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_return_policy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnPolicy.ProtoReflect.Descriptor instead.
+func (*ReturnPolicy) Descriptor() ([]byte, []int) {
+	return file_proto_return_policy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReturnPolicy) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReturnPolicy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReturnPolicy) GetPackageType() string {
+	if x != nil {
+		return x.PackageType
+	}
+	return ""
+}
+
+func (x *ReturnPolicy) GetCustomerSegment() string {
+	if x != nil {
+		return x.CustomerSegment
+	}
+	return ""
+}
+
+func (x *ReturnPolicy) GetReturnWindowHours() int32 {
+	if x != nil {
+		return x.ReturnWindowHours
+	}
+	return 0
+}
+
+func (x *ReturnPolicy) GetNonReturnable() bool {
+	if x != nil {
+		return x.NonReturnable
+	}
+	return false
+}
+
+func (x *ReturnPolicy) GetFeePercent() float64 {
+	if x != nil {
+		return x.FeePercent
+	}
+	return 0
+}
+
+func (x *ReturnPolicy) GetRequiresInspection() bool {
+	if x != nil {
+		return x.RequiresInspection
+	}
+	return false
+}
+
+func (x *ReturnPolicy) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ReturnPolicy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Параметры политики возврата для создания и изменения
+type ReturnPolicyRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PackageType        string                 `protobuf:"bytes,2,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`             // bag, box или film; пусто - заказы в любой упаковке
+	CustomerSegment    string                 `protobuf:"bytes,3,opt,name=customer_segment,json=customerSegment,proto3" json:"customer_segment,omitempty"` // пусто - клиенты любого сегмента
+	ReturnWindowHours  int32                  `protobuf:"varint,4,opt,name=return_window_hours,json=returnWindowHours,proto3" json:"return_window_hours,omitempty"`
+	NonReturnable      bool                   `protobuf:"varint,5,opt,name=non_returnable,json=nonReturnable,proto3" json:"non_returnable,omitempty"`
+	FeePercent         float64                `protobuf:"fixed64,6,opt,name=fee_percent,json=feePercent,proto3" json:"fee_percent,omitempty"`
+	RequiresInspection bool                   `protobuf:"varint,7,opt,name=requires_inspection,json=requiresInspection,proto3" json:"requires_inspection,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ReturnPolicyRequest) Reset() {
+	*x = ReturnPolicyRequest{}
+	mi := &file_proto_return_policy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnPolicyRequest) ProtoMessage() {}
+
+func (x *ReturnPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_return_policy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnPolicyRequest.ProtoReflect.Descriptor instead.
+func (*ReturnPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_return_policy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReturnPolicyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReturnPolicyRequest) GetPackageType() string {
+	if x != nil {
+		return x.PackageType
+	}
+	return ""
+}
+
+func (x *ReturnPolicyRequest) GetCustomerSegment() string {
+	if x != nil {
+		return x.CustomerSegment
+	}
+	return ""
+}
+
+func (x *ReturnPolicyRequest) GetReturnWindowHours() int32 {
+	if x != nil {
+		return x.ReturnWindowHours
+	}
+	return 0
+}
+
+func (x *ReturnPolicyRequest) GetNonReturnable() bool {
+	if x != nil {
+		return x.NonReturnable
+	}
+	return false
+}
+
+func (x *ReturnPolicyRequest) GetFeePercent() float64 {
+	if x != nil {
+		return x.FeePercent
+	}
+	return 0
+}
+
+func (x *ReturnPolicyRequest) GetRequiresInspection() bool {
+	if x != nil {
+		return x.RequiresInspection
+	}
+	return false
+}
+
+// Запрос на получение политики возврата
+type GetReturnPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReturnPolicyRequest) Reset() {
+	*x = GetReturnPolicyRequest{}
+	mi := &file_proto_return_policy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReturnPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReturnPolicyRequest) ProtoMessage() {}
+
+func (x *GetReturnPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_return_policy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReturnPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetReturnPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_return_policy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetReturnPolicyRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Запрос на получение списка политик возврата
+type ListReturnPoliciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReturnPoliciesRequest) Reset() {
+	*x = ListReturnPoliciesRequest{}
+	mi := &file_proto_return_policy_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReturnPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReturnPoliciesRequest) ProtoMessage() {}
+
+func (x *ListReturnPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_return_policy_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReturnPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListReturnPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_return_policy_proto_rawDescGZIP(), []int{3}
+}
+
+// Ответ со списком политик возврата
+type ListReturnPoliciesResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ReturnPolicies []*ReturnPolicy        `protobuf:"bytes,1,rep,name=return_policies,json=returnPolicies,proto3" json:"return_policies,omitempty"`
+	Total          int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListReturnPoliciesResponse) Reset() {
+	*x = ListReturnPoliciesResponse{}
+	mi := &file_proto_return_policy_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReturnPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReturnPoliciesResponse) ProtoMessage() {}
+
+func (x *ListReturnPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_return_policy_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReturnPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListReturnPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_return_policy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListReturnPoliciesResponse) GetReturnPolicies() []*ReturnPolicy {
+	if x != nil {
+		return x.ReturnPolicies
+	}
+	return nil
+}
+
+func (x *ListReturnPoliciesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Запрос на изменение политики возврата
+type UpdateReturnPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Policy        *ReturnPolicyRequest   `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReturnPolicyRequest) Reset() {
+	*x = UpdateReturnPolicyRequest{}
+	mi := &file_proto_return_policy_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReturnPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReturnPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateReturnPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_return_policy_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReturnPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReturnPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_return_policy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateReturnPolicyRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateReturnPolicyRequest) GetPolicy() *ReturnPolicyRequest {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// Запрос на удаление политики возврата
+type DeleteReturnPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReturnPolicyRequest) Reset() {
+	*x = DeleteReturnPolicyRequest{}
+	mi := &file_proto_return_policy_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReturnPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReturnPolicyRequest) ProtoMessage() {}
+
+func (x *DeleteReturnPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_return_policy_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReturnPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReturnPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_return_policy_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteReturnPolicyRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Ответ на запрос удаления политики возврата
+type DeleteReturnPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReturnPolicyResponse) Reset() {
+	*x = DeleteReturnPolicyResponse{}
+	mi := &file_proto_return_policy_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReturnPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReturnPolicyResponse) ProtoMessage() {}
+
+func (x *DeleteReturnPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_return_policy_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReturnPolicyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReturnPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_return_policy_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteReturnPolicyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Запрос на изменение сегмента клиента
+type SetCustomerSegmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    int64                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Segment       string                 `protobuf:"bytes,2,opt,name=segment,proto3" json:"segment,omitempty"` // пусто - клиент снимается с сегмента
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCustomerSegmentRequest) Reset() {
+	*x = SetCustomerSegmentRequest{}
+	mi := &file_proto_return_policy_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCustomerSegmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCustomerSegmentRequest) ProtoMessage() {}
+
+func (x *SetCustomerSegmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_return_policy_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCustomerSegmentRequest.ProtoReflect.Descriptor instead.
+func (*SetCustomerSegmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_return_policy_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetCustomerSegmentRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *SetCustomerSegmentRequest) GetSegment() string {
+	if x != nil {
+		return x.Segment
+	}
+	return ""
+}
+
+// Сегмент клиента
+type CustomerSegment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    int64                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Segment       string                 `protobuf:"bytes,2,opt,name=segment,proto3" json:"segment,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerSegment) Reset() {
+	*x = CustomerSegment{}
+	mi := &file_proto_return_policy_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerSegment) ProtoMessage() {}
+
+func (x *CustomerSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_return_policy_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerSegment.ProtoReflect.Descriptor instead.
+func (*CustomerSegment) Descriptor() ([]byte, []int) {
+	return file_proto_return_policy_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CustomerSegment) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *CustomerSegment) GetSegment() string {
+	if x != nil {
+		return x.Segment
+	}
+	return ""
+}
+
+func (x *CustomerSegment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_proto_return_policy_proto protoreflect.FileDescriptor
+
+const file_proto_return_policy_proto_rawDesc = "" +
+	"\n" +
+	"\x19proto/return_policy.proto\x12\x05proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9f\x03\n" +
+	"\fReturnPolicy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\fpackage_type\x18\x03 \x01(\tR\vpackageType\x12)\n" +
+	"\x10customer_segment\x18\x04 \x01(\tR\x0fcustomerSegment\x12.\n" +
+	"\x13return_window_hours\x18\x05 \x01(\x05R\x11returnWindowHours\x12%\n" +
+	"\x0enon_returnable\x18\x06 \x01(\bR\rnonReturnable\x12\x1f\n" +
+	"\vfee_percent\x18\a \x01(\x01R\n" +
+	"feePercent\x12/\n" +
+	"\x13requires_inspection\x18\b \x01(\bR\x12requiresInspection\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa0\x02\n" +
+	"\x13ReturnPolicyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fpackage_type\x18\x02 \x01(\tR\vpackageType\x12)\n" +
+	"\x10customer_segment\x18\x03 \x01(\tR\x0fcustomerSegment\x12.\n" +
+	"\x13return_window_hours\x18\x04 \x01(\x05R\x11returnWindowHours\x12%\n" +
+	"\x0enon_returnable\x18\x05 \x01(\bR\rnonReturnable\x12\x1f\n" +
+	"\vfee_percent\x18\x06 \x01(\x01R\n" +
+	"feePercent\x12/\n" +
+	"\x13requires_inspection\x18\a \x01(\bR\x12requiresInspection\"(\n" +
+	"\x16GetReturnPolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x1b\n" +
+	"\x19ListReturnPoliciesRequest\"p\n" +
+	"\x1aListReturnPoliciesResponse\x12<\n" +
+	"\x0freturn_policies\x18\x01 \x03(\v2\x13.proto.ReturnPolicyR\x0ereturnPolicies\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"_\n" +
+	"\x19UpdateReturnPolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x122\n" +
+	"\x06policy\x18\x02 \x01(\v2\x1a.proto.ReturnPolicyRequestR\x06policy\"+\n" +
+	"\x19DeleteReturnPolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"6\n" +
+	"\x1aDeleteReturnPolicyResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"V\n" +
+	"\x19SetCustomerSegmentRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\x03R\n" +
+	"customerId\x12\x18\n" +
+	"\asegment\x18\x02 \x01(\tR\asegment\"\x87\x01\n" +
+	"\x0fCustomerSegment\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\x03R\n" +
+	"customerId\x12\x18\n" +
+	"\asegment\x18\x02 \x01(\tR\asegment\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt2\x85\x04\n" +
+	"\x16ReturnPolicyRPCHandler\x12G\n" +
+	"\x12CreateReturnPolicy\x12\x1a.proto.ReturnPolicyRequest\x1a\x13.proto.ReturnPolicy\"\x00\x12G\n" +
+	"\x0fGetReturnPolicy\x12\x1d.proto.GetReturnPolicyRequest\x1a\x13.proto.ReturnPolicy\"\x00\x12[\n" +
+	"\x12ListReturnPolicies\x12 .proto.ListReturnPoliciesRequest\x1a!.proto.ListReturnPoliciesResponse\"\x00\x12M\n" +
+	"\x12UpdateReturnPolicy\x12 .proto.UpdateReturnPolicyRequest\x1a\x13.proto.ReturnPolicy\"\x00\x12[\n" +
+	"\x12DeleteReturnPolicy\x12 .proto.DeleteReturnPolicyRequest\x1a!.proto.DeleteReturnPolicyResponse\"\x00\x12P\n" +
+	"\x12SetCustomerSegment\x12 .proto.SetCustomerSegmentRequest\x1a\x16.proto.CustomerSegment\"\x00B#Z!gitlab.ozon.dev/gojhw1/pkg/gen;pbb\x06proto3"
+
+var (
+	file_proto_return_policy_proto_rawDescOnce sync.Once
+	file_proto_return_policy_proto_rawDescData []byte
+)
+
+func file_proto_return_policy_proto_rawDescGZIP() []byte {
+	file_proto_return_policy_proto_rawDescOnce.Do(func() {
+		file_proto_return_policy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_return_policy_proto_rawDesc), len(file_proto_return_policy_proto_rawDesc)))
+	})
+	return file_proto_return_policy_proto_rawDescData
+}
+
+var file_proto_return_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_proto_return_policy_proto_goTypes = []any{
+	(*ReturnPolicy)(nil),               // 0: proto.ReturnPolicy
+	(*ReturnPolicyRequest)(nil),        // 1: proto.ReturnPolicyRequest
+	(*GetReturnPolicyRequest)(nil),     // 2: proto.GetReturnPolicyRequest
+	(*ListReturnPoliciesRequest)(nil),  // 3: proto.ListReturnPoliciesRequest
+	(*ListReturnPoliciesResponse)(nil), // 4: proto.ListReturnPoliciesResponse
+	(*UpdateReturnPolicyRequest)(nil),  // 5: proto.UpdateReturnPolicyRequest
+	(*DeleteReturnPolicyRequest)(nil),  // 6: proto.DeleteReturnPolicyRequest
+	(*DeleteReturnPolicyResponse)(nil), // 7: proto.DeleteReturnPolicyResponse
+	(*SetCustomerSegmentRequest)(nil),  // 8: proto.SetCustomerSegmentRequest
+	(*CustomerSegment)(nil),            // 9: proto.CustomerSegment
+	(*timestamppb.Timestamp)(nil),      // 10: google.protobuf.Timestamp
+}
+var file_proto_return_policy_proto_depIdxs = []int32{
+	10, // 0: proto.ReturnPolicy.created_at:type_name -> google.protobuf.Timestamp
+	10, // 1: proto.ReturnPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: proto.ListReturnPoliciesResponse.return_policies:type_name -> proto.ReturnPolicy
+	1,  // 3: proto.UpdateReturnPolicyRequest.policy:type_name -> proto.ReturnPolicyRequest
+	10, // 4: proto.CustomerSegment.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 5: proto.ReturnPolicyRPCHandler.CreateReturnPolicy:input_type -> proto.ReturnPolicyRequest
+	2,  // 6: proto.ReturnPolicyRPCHandler.GetReturnPolicy:input_type -> proto.GetReturnPolicyRequest
+	3,  // 7: proto.ReturnPolicyRPCHandler.ListReturnPolicies:input_type -> proto.ListReturnPoliciesRequest
+	5,  // 8: proto.ReturnPolicyRPCHandler.UpdateReturnPolicy:input_type -> proto.UpdateReturnPolicyRequest
+	6,  // 9: proto.ReturnPolicyRPCHandler.DeleteReturnPolicy:input_type -> proto.DeleteReturnPolicyRequest
+	8,  // 10: proto.ReturnPolicyRPCHandler.SetCustomerSegment:input_type -> proto.SetCustomerSegmentRequest
+	0,  // 11: proto.ReturnPolicyRPCHandler.CreateReturnPolicy:output_type -> proto.ReturnPolicy
+	0,  // 12: proto.ReturnPolicyRPCHandler.GetReturnPolicy:output_type -> proto.ReturnPolicy
+	4,  // 13: proto.ReturnPolicyRPCHandler.ListReturnPolicies:output_type -> proto.ListReturnPoliciesResponse
+	0,  // 14: proto.ReturnPolicyRPCHandler.UpdateReturnPolicy:output_type -> proto.ReturnPolicy
+	7,  // 15: proto.ReturnPolicyRPCHandler.DeleteReturnPolicy:output_type -> proto.DeleteReturnPolicyResponse
+	9,  // 16: proto.ReturnPolicyRPCHandler.SetCustomerSegment:output_type -> proto.CustomerSegment
+	11, // [11:17] is the sub-list for method output_type
+	5,  // [5:11] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_proto_return_policy_proto_init() }
+func file_proto_return_policy_proto_init() {
+	if File_proto_return_policy_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_return_policy_proto_rawDesc), len(file_proto_return_policy_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_return_policy_proto_goTypes,
+		DependencyIndexes: file_proto_return_policy_proto_depIdxs,
+		MessageInfos:      file_proto_return_policy_proto_msgTypes,
+	}.Build()
+	File_proto_return_policy_proto = out.File
+	file_proto_return_policy_proto_goTypes = nil
+	file_proto_return_policy_proto_depIdxs = nil
+}
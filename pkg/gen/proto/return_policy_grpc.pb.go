@@ -0,0 +1,328 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: proto/return_policy.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ReturnPolicyRPCHandler_CreateReturnPolicy_FullMethodName = "/proto.ReturnPolicyRPCHandler/CreateReturnPolicy"
+	ReturnPolicyRPCHandler_GetReturnPolicy_FullMethodName    = "/proto.ReturnPolicyRPCHandler/GetReturnPolicy"
+	ReturnPolicyRPCHandler_ListReturnPolicies_FullMethodName = "/proto.ReturnPolicyRPCHandler/ListReturnPolicies"
+	ReturnPolicyRPCHandler_UpdateReturnPolicy_FullMethodName = "/proto.ReturnPolicyRPCHandler/UpdateReturnPolicy"
+	ReturnPolicyRPCHandler_DeleteReturnPolicy_FullMethodName = "/proto.ReturnPolicyRPCHandler/DeleteReturnPolicy"
+	ReturnPolicyRPCHandler_SetCustomerSegment_FullMethodName = "/proto.ReturnPolicyRPCHandler/SetCustomerSegment"
+)
+
+// ReturnPolicyRPCHandlerClient is the client API for ReturnPolicyRPCHandler service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Сервис для настройки политик возврата заказов
+type ReturnPolicyRPCHandlerClient interface {
+	// Создание политики возврата
+	CreateReturnPolicy(ctx context.Context, in *ReturnPolicyRequest, opts ...grpc.CallOption) (*ReturnPolicy, error)
+	// Получение политики возврата по ID
+	GetReturnPolicy(ctx context.Context, in *GetReturnPolicyRequest, opts ...grpc.CallOption) (*ReturnPolicy, error)
+	// Получение списка политик возврата
+	ListReturnPolicies(ctx context.Context, in *ListReturnPoliciesRequest, opts ...grpc.CallOption) (*ListReturnPoliciesResponse, error)
+	// Изменение всех параметров политики возврата
+	UpdateReturnPolicy(ctx context.Context, in *UpdateReturnPolicyRequest, opts ...grpc.CallOption) (*ReturnPolicy, error)
+	// Удаление политики возврата
+	DeleteReturnPolicy(ctx context.Context, in *DeleteReturnPolicyRequest, opts ...grpc.CallOption) (*DeleteReturnPolicyResponse, error)
+	// Изменение сегмента клиента, по которому выбирается политика возврата
+	SetCustomerSegment(ctx context.Context, in *SetCustomerSegmentRequest, opts ...grpc.CallOption) (*CustomerSegment, error)
+}
+
+type returnPolicyRPCHandlerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReturnPolicyRPCHandlerClient(cc grpc.ClientConnInterface) ReturnPolicyRPCHandlerClient {
+	return &returnPolicyRPCHandlerClient{cc}
+}
+
+func (c *returnPolicyRPCHandlerClient) CreateReturnPolicy(ctx context.Context, in *ReturnPolicyRequest, opts ...grpc.CallOption) (*ReturnPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnPolicy)
+	err := c.cc.Invoke(ctx, ReturnPolicyRPCHandler_CreateReturnPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *returnPolicyRPCHandlerClient) GetReturnPolicy(ctx context.Context, in *GetReturnPolicyRequest, opts ...grpc.CallOption) (*ReturnPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnPolicy)
+	err := c.cc.Invoke(ctx, ReturnPolicyRPCHandler_GetReturnPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *returnPolicyRPCHandlerClient) ListReturnPolicies(ctx context.Context, in *ListReturnPoliciesRequest, opts ...grpc.CallOption) (*ListReturnPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReturnPoliciesResponse)
+	err := c.cc.Invoke(ctx, ReturnPolicyRPCHandler_ListReturnPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *returnPolicyRPCHandlerClient) UpdateReturnPolicy(ctx context.Context, in *UpdateReturnPolicyRequest, opts ...grpc.CallOption) (*ReturnPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnPolicy)
+	err := c.cc.Invoke(ctx, ReturnPolicyRPCHandler_UpdateReturnPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *returnPolicyRPCHandlerClient) DeleteReturnPolicy(ctx context.Context, in *DeleteReturnPolicyRequest, opts ...grpc.CallOption) (*DeleteReturnPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteReturnPolicyResponse)
+	err := c.cc.Invoke(ctx, ReturnPolicyRPCHandler_DeleteReturnPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *returnPolicyRPCHandlerClient) SetCustomerSegment(ctx context.Context, in *SetCustomerSegmentRequest, opts ...grpc.CallOption) (*CustomerSegment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CustomerSegment)
+	err := c.cc.Invoke(ctx, ReturnPolicyRPCHandler_SetCustomerSegment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReturnPolicyRPCHandlerServer is the server API for ReturnPolicyRPCHandler service.
+// All implementations must embed UnimplementedReturnPolicyRPCHandlerServer
+// for forward compatibility.
+//
+// Сервис для настройки политик возврата заказов
+type ReturnPolicyRPCHandlerServer interface {
+	// Создание политики возврата
+	CreateReturnPolicy(context.Context, *ReturnPolicyRequest) (*ReturnPolicy, error)
+	// Получение политики возврата по ID
+	GetReturnPolicy(context.Context, *GetReturnPolicyRequest) (*ReturnPolicy, error)
+	// Получение списка политик возврата
+	ListReturnPolicies(context.Context, *ListReturnPoliciesRequest) (*ListReturnPoliciesResponse, error)
+	// Изменение всех параметров политики возврата
+	UpdateReturnPolicy(context.Context, *UpdateReturnPolicyRequest) (*ReturnPolicy, error)
+	// Удаление политики возврата
+	DeleteReturnPolicy(context.Context, *DeleteReturnPolicyRequest) (*DeleteReturnPolicyResponse, error)
+	// Изменение сегмента клиента, по которому выбирается политика возврата
+	SetCustomerSegment(context.Context, *SetCustomerSegmentRequest) (*CustomerSegment, error)
+	mustEmbedUnimplementedReturnPolicyRPCHandlerServer()
+}
+
+// UnimplementedReturnPolicyRPCHandlerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReturnPolicyRPCHandlerServer struct{}
+
+func (UnimplementedReturnPolicyRPCHandlerServer) CreateReturnPolicy(context.Context, *ReturnPolicyRequest) (*ReturnPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReturnPolicy not implemented")
+}
+func (UnimplementedReturnPolicyRPCHandlerServer) GetReturnPolicy(context.Context, *GetReturnPolicyRequest) (*ReturnPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReturnPolicy not implemented")
+}
+func (UnimplementedReturnPolicyRPCHandlerServer) ListReturnPolicies(context.Context, *ListReturnPoliciesRequest) (*ListReturnPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReturnPolicies not implemented")
+}
+func (UnimplementedReturnPolicyRPCHandlerServer) UpdateReturnPolicy(context.Context, *UpdateReturnPolicyRequest) (*ReturnPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReturnPolicy not implemented")
+}
+func (UnimplementedReturnPolicyRPCHandlerServer) DeleteReturnPolicy(context.Context, *DeleteReturnPolicyRequest) (*DeleteReturnPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReturnPolicy not implemented")
+}
+func (UnimplementedReturnPolicyRPCHandlerServer) SetCustomerSegment(context.Context, *SetCustomerSegmentRequest) (*CustomerSegment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCustomerSegment not implemented")
+}
+func (UnimplementedReturnPolicyRPCHandlerServer) mustEmbedUnimplementedReturnPolicyRPCHandlerServer() {
+}
+func (UnimplementedReturnPolicyRPCHandlerServer) testEmbeddedByValue() {}
+
+// UnsafeReturnPolicyRPCHandlerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReturnPolicyRPCHandlerServer will
+// result in compilation errors.
+type UnsafeReturnPolicyRPCHandlerServer interface {
+	mustEmbedUnimplementedReturnPolicyRPCHandlerServer()
+}
+
+func RegisterReturnPolicyRPCHandlerServer(s grpc.ServiceRegistrar, srv ReturnPolicyRPCHandlerServer) {
+	// If the following call pancis, it indicates UnimplementedReturnPolicyRPCHandlerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReturnPolicyRPCHandler_ServiceDesc, srv)
+}
+
+func _ReturnPolicyRPCHandler_CreateReturnPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReturnPolicyRPCHandlerServer).CreateReturnPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReturnPolicyRPCHandler_CreateReturnPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReturnPolicyRPCHandlerServer).CreateReturnPolicy(ctx, req.(*ReturnPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReturnPolicyRPCHandler_GetReturnPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReturnPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReturnPolicyRPCHandlerServer).GetReturnPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReturnPolicyRPCHandler_GetReturnPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReturnPolicyRPCHandlerServer).GetReturnPolicy(ctx, req.(*GetReturnPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReturnPolicyRPCHandler_ListReturnPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReturnPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReturnPolicyRPCHandlerServer).ListReturnPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReturnPolicyRPCHandler_ListReturnPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReturnPolicyRPCHandlerServer).ListReturnPolicies(ctx, req.(*ListReturnPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReturnPolicyRPCHandler_UpdateReturnPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReturnPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReturnPolicyRPCHandlerServer).UpdateReturnPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReturnPolicyRPCHandler_UpdateReturnPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReturnPolicyRPCHandlerServer).UpdateReturnPolicy(ctx, req.(*UpdateReturnPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReturnPolicyRPCHandler_DeleteReturnPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReturnPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReturnPolicyRPCHandlerServer).DeleteReturnPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReturnPolicyRPCHandler_DeleteReturnPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReturnPolicyRPCHandlerServer).DeleteReturnPolicy(ctx, req.(*DeleteReturnPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReturnPolicyRPCHandler_SetCustomerSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCustomerSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReturnPolicyRPCHandlerServer).SetCustomerSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReturnPolicyRPCHandler_SetCustomerSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReturnPolicyRPCHandlerServer).SetCustomerSegment(ctx, req.(*SetCustomerSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReturnPolicyRPCHandler_ServiceDesc is the grpc.ServiceDesc for ReturnPolicyRPCHandler service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReturnPolicyRPCHandler_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.ReturnPolicyRPCHandler",
+	HandlerType: (*ReturnPolicyRPCHandlerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateReturnPolicy",
+			Handler:    _ReturnPolicyRPCHandler_CreateReturnPolicy_Handler,
+		},
+		{
+			MethodName: "GetReturnPolicy",
+			Handler:    _ReturnPolicyRPCHandler_GetReturnPolicy_Handler,
+		},
+		{
+			MethodName: "ListReturnPolicies",
+			Handler:    _ReturnPolicyRPCHandler_ListReturnPolicies_Handler,
+		},
+		{
+			MethodName: "UpdateReturnPolicy",
+			Handler:    _ReturnPolicyRPCHandler_UpdateReturnPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteReturnPolicy",
+			Handler:    _ReturnPolicyRPCHandler_DeleteReturnPolicy_Handler,
+		},
+		{
+			MethodName: "SetCustomerSegment",
+			Handler:    _ReturnPolicyRPCHandler_SetCustomerSegment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/return_policy.proto",
+}
@@ -0,0 +1,1039 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: proto/shift.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Итоги операций ПВЗ за смену
+type ShiftTotals struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Handouts       int32                  `protobuf:"varint,1,opt,name=handouts,proto3" json:"handouts,omitempty"`
+	HandoutAmount  float64                `protobuf:"fixed64,2,opt,name=handout_amount,json=handoutAmount,proto3" json:"handout_amount,omitempty"`
+	Payments       int32                  `protobuf:"varint,3,opt,name=payments,proto3" json:"payments,omitempty"`
+	CashReceived   float64                `protobuf:"fixed64,4,opt,name=cash_received,json=cashReceived,proto3" json:"cash_received,omitempty"`
+	CardReceived   float64                `protobuf:"fixed64,5,opt,name=card_received,json=cardReceived,proto3" json:"card_received,omitempty"`
+	Returns        int32                  `protobuf:"varint,6,opt,name=returns,proto3" json:"returns,omitempty"`
+	ReturnAmount   float64                `protobuf:"fixed64,7,opt,name=return_amount,json=returnAmount,proto3" json:"return_amount,omitempty"`
+	CashRefunded   float64                `protobuf:"fixed64,8,opt,name=cash_refunded,json=cashRefunded,proto3" json:"cash_refunded,omitempty"`
+	CardRefunded   float64                `protobuf:"fixed64,9,opt,name=card_refunded,json=cardRefunded,proto3" json:"card_refunded,omitempty"`
+	OnlineRefunded float64                `protobuf:"fixed64,10,opt,name=online_refunded,json=onlineRefunded,proto3" json:"online_refunded,omitempty"`
+	CourierReturns int32                  `protobuf:"varint,11,opt,name=courier_returns,json=courierReturns,proto3" json:"courier_returns,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ShiftTotals) Reset() {
+	*x = ShiftTotals{}
+	mi := &file_proto_shift_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShiftTotals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShiftTotals) ProtoMessage() {}
+
+func (x *ShiftTotals) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shift_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShiftTotals.ProtoReflect.Descriptor instead.
+func (*ShiftTotals) Descriptor() ([]byte, []int) {
+	return file_proto_shift_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ShiftTotals) GetHandouts() int32 {
+	if x != nil {
+		return x.Handouts
+	}
+	return 0
+}
+
+func (x *ShiftTotals) GetHandoutAmount() float64 {
+	if x != nil {
+		return x.HandoutAmount
+	}
+	return 0
+}
+
+func (x *ShiftTotals) GetPayments() int32 {
+	if x != nil {
+		return x.Payments
+	}
+	return 0
+}
+
+func (x *ShiftTotals) GetCashReceived() float64 {
+	if x != nil {
+		return x.CashReceived
+	}
+	return 0
+}
+
+func (x *ShiftTotals) GetCardReceived() float64 {
+	if x != nil {
+		return x.CardReceived
+	}
+	return 0
+}
+
+func (x *ShiftTotals) GetReturns() int32 {
+	if x != nil {
+		return x.Returns
+	}
+	return 0
+}
+
+func (x *ShiftTotals) GetReturnAmount() float64 {
+	if x != nil {
+		return x.ReturnAmount
+	}
+	return 0
+}
+
+func (x *ShiftTotals) GetCashRefunded() float64 {
+	if x != nil {
+		return x.CashRefunded
+	}
+	return 0
+}
+
+func (x *ShiftTotals) GetCardRefunded() float64 {
+	if x != nil {
+		return x.CardRefunded
+	}
+	return 0
+}
+
+func (x *ShiftTotals) GetOnlineRefunded() float64 {
+	if x != nil {
+		return x.OnlineRefunded
+	}
+	return 0
+}
+
+func (x *ShiftTotals) GetCourierReturns() int32 {
+	if x != nil {
+		return x.CourierReturns
+	}
+	return 0
+}
+
+// Итоги операций сотрудника за смену
+type ShiftOperatorTotals struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId    int64                  `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 0 - операции без сотрудника
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Totals        *ShiftTotals           `protobuf:"bytes,3,opt,name=totals,proto3" json:"totals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShiftOperatorTotals) Reset() {
+	*x = ShiftOperatorTotals{}
+	mi := &file_proto_shift_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShiftOperatorTotals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShiftOperatorTotals) ProtoMessage() {}
+
+func (x *ShiftOperatorTotals) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shift_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShiftOperatorTotals.ProtoReflect.Descriptor instead.
+func (*ShiftOperatorTotals) Descriptor() ([]byte, []int) {
+	return file_proto_shift_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ShiftOperatorTotals) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *ShiftOperatorTotals) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ShiftOperatorTotals) GetTotals() *ShiftTotals {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+// Отчет сверки кассы за смену
+type ShiftReport struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ShiftId        int64                  `protobuf:"varint,1,opt,name=shift_id,json=shiftId,proto3" json:"shift_id,omitempty"`
+	PickupPointId  int64                  `protobuf:"varint,2,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	From           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To             *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Final          bool                   `protobuf:"varint,5,opt,name=final,proto3" json:"final,omitempty"` // false - предварительный отчет открытой смены
+	Operators      []*ShiftOperatorTotals `protobuf:"bytes,6,rep,name=operators,proto3" json:"operators,omitempty"`
+	Totals         *ShiftTotals           `protobuf:"bytes,7,opt,name=totals,proto3" json:"totals,omitempty"`
+	OpeningCash    float64                `protobuf:"fixed64,8,opt,name=opening_cash,json=openingCash,proto3" json:"opening_cash,omitempty"`
+	ExpectedCash   float64                `protobuf:"fixed64,9,opt,name=expected_cash,json=expectedCash,proto3" json:"expected_cash,omitempty"`
+	CountedCash    float64                `protobuf:"fixed64,10,opt,name=counted_cash,json=countedCash,proto3" json:"counted_cash,omitempty"`          // заполняется только в окончательном отчете
+	CashDifference float64                `protobuf:"fixed64,11,opt,name=cash_difference,json=cashDifference,proto3" json:"cash_difference,omitempty"` // пересчитанные наличные минус ожидаемые
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ShiftReport) Reset() {
+	*x = ShiftReport{}
+	mi := &file_proto_shift_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShiftReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShiftReport) ProtoMessage() {}
+
+func (x *ShiftReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shift_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShiftReport.ProtoReflect.Descriptor instead.
+func (*ShiftReport) Descriptor() ([]byte, []int) {
+	return file_proto_shift_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ShiftReport) GetShiftId() int64 {
+	if x != nil {
+		return x.ShiftId
+	}
+	return 0
+}
+
+func (x *ShiftReport) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *ShiftReport) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ShiftReport) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ShiftReport) GetFinal() bool {
+	if x != nil {
+		return x.Final
+	}
+	return false
+}
+
+func (x *ShiftReport) GetOperators() []*ShiftOperatorTotals {
+	if x != nil {
+		return x.Operators
+	}
+	return nil
+}
+
+func (x *ShiftReport) GetTotals() *ShiftTotals {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+func (x *ShiftReport) GetOpeningCash() float64 {
+	if x != nil {
+		return x.OpeningCash
+	}
+	return 0
+}
+
+func (x *ShiftReport) GetExpectedCash() float64 {
+	if x != nil {
+		return x.ExpectedCash
+	}
+	return 0
+}
+
+func (x *ShiftReport) GetCountedCash() float64 {
+	if x != nil {
+		return x.CountedCash
+	}
+	return 0
+}
+
+func (x *ShiftReport) GetCashDifference() float64 {
+	if x != nil {
+		return x.CashDifference
+	}
+	return 0
+}
+
+// Модель смены ПВЗ
+type Shift struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PickupPointId int64                  `protobuf:"varint,2,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"` // open или closed
+	OpeningCash   float64                `protobuf:"fixed64,4,opt,name=opening_cash,json=openingCash,proto3" json:"opening_cash,omitempty"`
+	CountedCash   float64                `protobuf:"fixed64,5,opt,name=counted_cash,json=countedCash,proto3" json:"counted_cash,omitempty"` // заполняется при закрытии смены
+	OpenedBy      int64                  `protobuf:"varint,6,opt,name=opened_by,json=openedBy,proto3" json:"opened_by,omitempty"`           // 0 - смена открыта внутренним вызовом
+	OpenedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`
+	ClosedBy      int64                  `protobuf:"varint,8,opt,name=closed_by,json=closedBy,proto3" json:"closed_by,omitempty"`
+	ClosedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	Report        *ShiftReport           `protobuf:"bytes,10,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shift) Reset() {
+	*x = Shift{}
+	mi := &file_proto_shift_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shift) ProtoMessage() {}
+
+func (x *Shift) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shift_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shift.ProtoReflect.Descriptor instead.
+func (*Shift) Descriptor() ([]byte, []int) {
+	return file_proto_shift_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Shift) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Shift) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *Shift) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Shift) GetOpeningCash() float64 {
+	if x != nil {
+		return x.OpeningCash
+	}
+	return 0
+}
+
+func (x *Shift) GetCountedCash() float64 {
+	if x != nil {
+		return x.CountedCash
+	}
+	return 0
+}
+
+func (x *Shift) GetOpenedBy() int64 {
+	if x != nil {
+		return x.OpenedBy
+	}
+	return 0
+}
+
+func (x *Shift) GetOpenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpenedAt
+	}
+	return nil
+}
+
+func (x *Shift) GetClosedBy() int64 {
+	if x != nil {
+		return x.ClosedBy
+	}
+	return 0
+}
+
+func (x *Shift) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *Shift) GetReport() *ShiftReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// Запрос на открытие смены
+type OpenShiftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPointId int64                  `protobuf:"varint,1,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"` // если не указан, смена открывается в ПВЗ сотрудника
+	OpeningCash   float64                `protobuf:"fixed64,2,opt,name=opening_cash,json=openingCash,proto3" json:"opening_cash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenShiftRequest) Reset() {
+	*x = OpenShiftRequest{}
+	mi := &file_proto_shift_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenShiftRequest) ProtoMessage() {}
+
+func (x *OpenShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shift_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenShiftRequest.ProtoReflect.Descriptor instead.
+func (*OpenShiftRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shift_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OpenShiftRequest) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *OpenShiftRequest) GetOpeningCash() float64 {
+	if x != nil {
+		return x.OpeningCash
+	}
+	return 0
+}
+
+// Запрос на получение открытой смены
+type GetCurrentShiftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPointId int64                  `protobuf:"varint,1,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"` // если не указан, используется ПВЗ сотрудника
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrentShiftRequest) Reset() {
+	*x = GetCurrentShiftRequest{}
+	mi := &file_proto_shift_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrentShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentShiftRequest) ProtoMessage() {}
+
+func (x *GetCurrentShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shift_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentShiftRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentShiftRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shift_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetCurrentShiftRequest) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+// Запрос на получение смены по ID
+type GetShiftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShiftRequest) Reset() {
+	*x = GetShiftRequest{}
+	mi := &file_proto_shift_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShiftRequest) ProtoMessage() {}
+
+func (x *GetShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shift_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShiftRequest.ProtoReflect.Descriptor instead.
+func (*GetShiftRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shift_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetShiftRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Запрос на получение списка смен
+type ListShiftsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CursorId      int64                  `protobuf:"varint,1,opt,name=cursor_id,json=cursorId,proto3" json:"cursor_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShiftsRequest) Reset() {
+	*x = ListShiftsRequest{}
+	mi := &file_proto_shift_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShiftsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShiftsRequest) ProtoMessage() {}
+
+func (x *ListShiftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shift_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShiftsRequest.ProtoReflect.Descriptor instead.
+func (*ListShiftsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shift_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListShiftsRequest) GetCursorId() int64 {
+	if x != nil {
+		return x.CursorId
+	}
+	return 0
+}
+
+func (x *ListShiftsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Ответ со списком смен
+type ListShiftsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shifts        []*Shift               `protobuf:"bytes,1,rep,name=shifts,proto3" json:"shifts,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursor    int64                  `protobuf:"varint,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShiftsResponse) Reset() {
+	*x = ListShiftsResponse{}
+	mi := &file_proto_shift_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShiftsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShiftsResponse) ProtoMessage() {}
+
+func (x *ListShiftsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shift_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShiftsResponse.ProtoReflect.Descriptor instead.
+func (*ListShiftsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shift_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListShiftsResponse) GetShifts() []*Shift {
+	if x != nil {
+		return x.Shifts
+	}
+	return nil
+}
+
+func (x *ListShiftsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListShiftsResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+// Запрос на закрытие смены
+type CloseShiftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CountedCash   float64                `protobuf:"fixed64,2,opt,name=counted_cash,json=countedCash,proto3" json:"counted_cash,omitempty"` // наличные в кассе по пересчету
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseShiftRequest) Reset() {
+	*x = CloseShiftRequest{}
+	mi := &file_proto_shift_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseShiftRequest) ProtoMessage() {}
+
+func (x *CloseShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shift_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseShiftRequest.ProtoReflect.Descriptor instead.
+func (*CloseShiftRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shift_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CloseShiftRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CloseShiftRequest) GetCountedCash() float64 {
+	if x != nil {
+		return x.CountedCash
+	}
+	return 0
+}
+
+// Запрос на выгрузку отчета сверки смены
+type ExportShiftReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // json или csv, по умолчанию json
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportShiftReportRequest) Reset() {
+	*x = ExportShiftReportRequest{}
+	mi := &file_proto_shift_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportShiftReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportShiftReportRequest) ProtoMessage() {}
+
+func (x *ExportShiftReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shift_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportShiftReportRequest.ProtoReflect.Descriptor instead.
+func (*ExportShiftReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shift_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ExportShiftReportRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExportShiftReportRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// Файл отчета сверки смены
+type ShiftReportFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShiftReportFile) Reset() {
+	*x = ShiftReportFile{}
+	mi := &file_proto_shift_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShiftReportFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShiftReportFile) ProtoMessage() {}
+
+func (x *ShiftReportFile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shift_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShiftReportFile.ProtoReflect.Descriptor instead.
+func (*ShiftReportFile) Descriptor() ([]byte, []int) {
+	return file_proto_shift_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ShiftReportFile) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ShiftReportFile) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ShiftReportFile) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+var File_proto_shift_proto protoreflect.FileDescriptor
+
+const file_proto_shift_proto_rawDesc = "" +
+	"\n" +
+	"\x11proto/shift.proto\x12\x05proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x91\x03\n" +
+	"\vShiftTotals\x12\x1a\n" +
+	"\bhandouts\x18\x01 \x01(\x05R\bhandouts\x12%\n" +
+	"\x0ehandout_amount\x18\x02 \x01(\x01R\rhandoutAmount\x12\x1a\n" +
+	"\bpayments\x18\x03 \x01(\x05R\bpayments\x12#\n" +
+	"\rcash_received\x18\x04 \x01(\x01R\fcashReceived\x12#\n" +
+	"\rcard_received\x18\x05 \x01(\x01R\fcardReceived\x12\x18\n" +
+	"\areturns\x18\x06 \x01(\x05R\areturns\x12#\n" +
+	"\rreturn_amount\x18\a \x01(\x01R\freturnAmount\x12#\n" +
+	"\rcash_refunded\x18\b \x01(\x01R\fcashRefunded\x12#\n" +
+	"\rcard_refunded\x18\t \x01(\x01R\fcardRefunded\x12'\n" +
+	"\x0fonline_refunded\x18\n" +
+	" \x01(\x01R\x0eonlineRefunded\x12'\n" +
+	"\x0fcourier_returns\x18\v \x01(\x05R\x0ecourierReturns\"~\n" +
+	"\x13ShiftOperatorTotals\x12\x1f\n" +
+	"\voperator_id\x18\x01 \x01(\x03R\n" +
+	"operatorId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12*\n" +
+	"\x06totals\x18\x03 \x01(\v2\x12.proto.ShiftTotalsR\x06totals\"\xbc\x03\n" +
+	"\vShiftReport\x12\x19\n" +
+	"\bshift_id\x18\x01 \x01(\x03R\ashiftId\x12&\n" +
+	"\x0fpickup_point_id\x18\x02 \x01(\x03R\rpickupPointId\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x14\n" +
+	"\x05final\x18\x05 \x01(\bR\x05final\x128\n" +
+	"\toperators\x18\x06 \x03(\v2\x1a.proto.ShiftOperatorTotalsR\toperators\x12*\n" +
+	"\x06totals\x18\a \x01(\v2\x12.proto.ShiftTotalsR\x06totals\x12!\n" +
+	"\fopening_cash\x18\b \x01(\x01R\vopeningCash\x12#\n" +
+	"\rexpected_cash\x18\t \x01(\x01R\fexpectedCash\x12!\n" +
+	"\fcounted_cash\x18\n" +
+	" \x01(\x01R\vcountedCash\x12'\n" +
+	"\x0fcash_difference\x18\v \x01(\x01R\x0ecashDifference\"\xf3\x02\n" +
+	"\x05Shift\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12&\n" +
+	"\x0fpickup_point_id\x18\x02 \x01(\x03R\rpickupPointId\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12!\n" +
+	"\fopening_cash\x18\x04 \x01(\x01R\vopeningCash\x12!\n" +
+	"\fcounted_cash\x18\x05 \x01(\x01R\vcountedCash\x12\x1b\n" +
+	"\topened_by\x18\x06 \x01(\x03R\bopenedBy\x127\n" +
+	"\topened_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bopenedAt\x12\x1b\n" +
+	"\tclosed_by\x18\b \x01(\x03R\bclosedBy\x127\n" +
+	"\tclosed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\x12*\n" +
+	"\x06report\x18\n" +
+	" \x01(\v2\x12.proto.ShiftReportR\x06report\"]\n" +
+	"\x10OpenShiftRequest\x12&\n" +
+	"\x0fpickup_point_id\x18\x01 \x01(\x03R\rpickupPointId\x12!\n" +
+	"\fopening_cash\x18\x02 \x01(\x01R\vopeningCash\"@\n" +
+	"\x16GetCurrentShiftRequest\x12&\n" +
+	"\x0fpickup_point_id\x18\x01 \x01(\x03R\rpickupPointId\"!\n" +
+	"\x0fGetShiftRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"F\n" +
+	"\x11ListShiftsRequest\x12\x1b\n" +
+	"\tcursor_id\x18\x01 \x01(\x03R\bcursorId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"v\n" +
+	"\x12ListShiftsResponse\x12$\n" +
+	"\x06shifts\x18\x01 \x03(\v2\f.proto.ShiftR\x06shifts\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\x03R\n" +
+	"nextCursor\"F\n" +
+	"\x11CloseShiftRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\fcounted_cash\x18\x02 \x01(\x01R\vcountedCash\"B\n" +
+	"\x18ExportShiftReportRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"j\n" +
+	"\x0fShiftReportFile\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent2\x8a\x03\n" +
+	"\x0fShiftRPCHandler\x124\n" +
+	"\tOpenShift\x12\x17.proto.OpenShiftRequest\x1a\f.proto.Shift\"\x00\x12@\n" +
+	"\x0fGetCurrentShift\x12\x1d.proto.GetCurrentShiftRequest\x1a\f.proto.Shift\"\x00\x122\n" +
+	"\bGetShift\x12\x16.proto.GetShiftRequest\x1a\f.proto.Shift\"\x00\x12C\n" +
+	"\n" +
+	"ListShifts\x12\x18.proto.ListShiftsRequest\x1a\x19.proto.ListShiftsResponse\"\x00\x126\n" +
+	"\n" +
+	"CloseShift\x12\x18.proto.CloseShiftRequest\x1a\f.proto.Shift\"\x00\x12N\n" +
+	"\x11ExportShiftReport\x12\x1f.proto.ExportShiftReportRequest\x1a\x16.proto.ShiftReportFile\"\x00B#Z!gitlab.ozon.dev/gojhw1/pkg/gen;pbb\x06proto3"
+
+var (
+	file_proto_shift_proto_rawDescOnce sync.Once
+	file_proto_shift_proto_rawDescData []byte
+)
+
+func file_proto_shift_proto_rawDescGZIP() []byte {
+	file_proto_shift_proto_rawDescOnce.Do(func() {
+		file_proto_shift_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_shift_proto_rawDesc), len(file_proto_shift_proto_rawDesc)))
+	})
+	return file_proto_shift_proto_rawDescData
+}
+
+var file_proto_shift_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_proto_shift_proto_goTypes = []any{
+	(*ShiftTotals)(nil),              // 0: proto.ShiftTotals
+	(*ShiftOperatorTotals)(nil),      // 1: proto.ShiftOperatorTotals
+	(*ShiftReport)(nil),              // 2: proto.ShiftReport
+	(*Shift)(nil),                    // 3: proto.Shift
+	(*OpenShiftRequest)(nil),         // 4: proto.OpenShiftRequest
+	(*GetCurrentShiftRequest)(nil),   // 5: proto.GetCurrentShiftRequest
+	(*GetShiftRequest)(nil),          // 6: proto.GetShiftRequest
+	(*ListShiftsRequest)(nil),        // 7: proto.ListShiftsRequest
+	(*ListShiftsResponse)(nil),       // 8: proto.ListShiftsResponse
+	(*CloseShiftRequest)(nil),        // 9: proto.CloseShiftRequest
+	(*ExportShiftReportRequest)(nil), // 10: proto.ExportShiftReportRequest
+	(*ShiftReportFile)(nil),          // 11: proto.ShiftReportFile
+	(*timestamppb.Timestamp)(nil),    // 12: google.protobuf.Timestamp
+}
+var file_proto_shift_proto_depIdxs = []int32{
+	0,  // 0: proto.ShiftOperatorTotals.totals:type_name -> proto.ShiftTotals
+	12, // 1: proto.ShiftReport.from:type_name -> google.protobuf.Timestamp
+	12, // 2: proto.ShiftReport.to:type_name -> google.protobuf.Timestamp
+	1,  // 3: proto.ShiftReport.operators:type_name -> proto.ShiftOperatorTotals
+	0,  // 4: proto.ShiftReport.totals:type_name -> proto.ShiftTotals
+	12, // 5: proto.Shift.opened_at:type_name -> google.protobuf.Timestamp
+	12, // 6: proto.Shift.closed_at:type_name -> google.protobuf.Timestamp
+	2,  // 7: proto.Shift.report:type_name -> proto.ShiftReport
+	3,  // 8: proto.ListShiftsResponse.shifts:type_name -> proto.Shift
+	4,  // 9: proto.ShiftRPCHandler.OpenShift:input_type -> proto.OpenShiftRequest
+	5,  // 10: proto.ShiftRPCHandler.GetCurrentShift:input_type -> proto.GetCurrentShiftRequest
+	6,  // 11: proto.ShiftRPCHandler.GetShift:input_type -> proto.GetShiftRequest
+	7,  // 12: proto.ShiftRPCHandler.ListShifts:input_type -> proto.ListShiftsRequest
+	9,  // 13: proto.ShiftRPCHandler.CloseShift:input_type -> proto.CloseShiftRequest
+	10, // 14: proto.ShiftRPCHandler.ExportShiftReport:input_type -> proto.ExportShiftReportRequest
+	3,  // 15: proto.ShiftRPCHandler.OpenShift:output_type -> proto.Shift
+	3,  // 16: proto.ShiftRPCHandler.GetCurrentShift:output_type -> proto.Shift
+	3,  // 17: proto.ShiftRPCHandler.GetShift:output_type -> proto.Shift
+	8,  // 18: proto.ShiftRPCHandler.ListShifts:output_type -> proto.ListShiftsResponse
+	3,  // 19: proto.ShiftRPCHandler.CloseShift:output_type -> proto.Shift
+	11, // 20: proto.ShiftRPCHandler.ExportShiftReport:output_type -> proto.ShiftReportFile
+	15, // [15:21] is the sub-list for method output_type
+	9,  // [9:15] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_proto_shift_proto_init() }
+func file_proto_shift_proto_init() {
+	if File_proto_shift_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_shift_proto_rawDesc), len(file_proto_shift_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_shift_proto_goTypes,
+		DependencyIndexes: file_proto_shift_proto_depIdxs,
+		MessageInfos:      file_proto_shift_proto_msgTypes,
+	}.Build()
+	File_proto_shift_proto = out.File
+	file_proto_shift_proto_goTypes = nil
+	file_proto_shift_proto_depIdxs = nil
+}
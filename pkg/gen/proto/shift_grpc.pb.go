@@ -0,0 +1,327 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: proto/shift.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ShiftRPCHandler_OpenShift_FullMethodName         = "/proto.ShiftRPCHandler/OpenShift"
+	ShiftRPCHandler_GetCurrentShift_FullMethodName   = "/proto.ShiftRPCHandler/GetCurrentShift"
+	ShiftRPCHandler_GetShift_FullMethodName          = "/proto.ShiftRPCHandler/GetShift"
+	ShiftRPCHandler_ListShifts_FullMethodName        = "/proto.ShiftRPCHandler/ListShifts"
+	ShiftRPCHandler_CloseShift_FullMethodName        = "/proto.ShiftRPCHandler/CloseShift"
+	ShiftRPCHandler_ExportShiftReport_FullMethodName = "/proto.ShiftRPCHandler/ExportShiftReport"
+)
+
+// ShiftRPCHandlerClient is the client API for ShiftRPCHandler service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Сервис смен ПВЗ и сверки кассы
+type ShiftRPCHandlerClient interface {
+	// Открытие смены в ПВЗ
+	OpenShift(ctx context.Context, in *OpenShiftRequest, opts ...grpc.CallOption) (*Shift, error)
+	// Получение открытой смены ПВЗ с предварительным отчетом сверки
+	GetCurrentShift(ctx context.Context, in *GetCurrentShiftRequest, opts ...grpc.CallOption) (*Shift, error)
+	// Получение смены по ID с отчетом сверки
+	GetShift(ctx context.Context, in *GetShiftRequest, opts ...grpc.CallOption) (*Shift, error)
+	// Получение списка смен ПВЗ с курсорной пагинацией
+	ListShifts(ctx context.Context, in *ListShiftsRequest, opts ...grpc.CallOption) (*ListShiftsResponse, error)
+	// Закрытие смены со сверкой кассы
+	CloseShift(ctx context.Context, in *CloseShiftRequest, opts ...grpc.CallOption) (*Shift, error)
+	// Выгрузка отчета сверки смены в файл JSON или CSV
+	ExportShiftReport(ctx context.Context, in *ExportShiftReportRequest, opts ...grpc.CallOption) (*ShiftReportFile, error)
+}
+
+type shiftRPCHandlerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewShiftRPCHandlerClient(cc grpc.ClientConnInterface) ShiftRPCHandlerClient {
+	return &shiftRPCHandlerClient{cc}
+}
+
+func (c *shiftRPCHandlerClient) OpenShift(ctx context.Context, in *OpenShiftRequest, opts ...grpc.CallOption) (*Shift, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shift)
+	err := c.cc.Invoke(ctx, ShiftRPCHandler_OpenShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftRPCHandlerClient) GetCurrentShift(ctx context.Context, in *GetCurrentShiftRequest, opts ...grpc.CallOption) (*Shift, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shift)
+	err := c.cc.Invoke(ctx, ShiftRPCHandler_GetCurrentShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftRPCHandlerClient) GetShift(ctx context.Context, in *GetShiftRequest, opts ...grpc.CallOption) (*Shift, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shift)
+	err := c.cc.Invoke(ctx, ShiftRPCHandler_GetShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftRPCHandlerClient) ListShifts(ctx context.Context, in *ListShiftsRequest, opts ...grpc.CallOption) (*ListShiftsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShiftsResponse)
+	err := c.cc.Invoke(ctx, ShiftRPCHandler_ListShifts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftRPCHandlerClient) CloseShift(ctx context.Context, in *CloseShiftRequest, opts ...grpc.CallOption) (*Shift, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shift)
+	err := c.cc.Invoke(ctx, ShiftRPCHandler_CloseShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftRPCHandlerClient) ExportShiftReport(ctx context.Context, in *ExportShiftReportRequest, opts ...grpc.CallOption) (*ShiftReportFile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShiftReportFile)
+	err := c.cc.Invoke(ctx, ShiftRPCHandler_ExportShiftReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShiftRPCHandlerServer is the server API for ShiftRPCHandler service.
+// All implementations must embed UnimplementedShiftRPCHandlerServer
+// for forward compatibility.
+//
+// Сервис смен ПВЗ и сверки кассы
+type ShiftRPCHandlerServer interface {
+	// Открытие смены в ПВЗ
+	OpenShift(context.Context, *OpenShiftRequest) (*Shift, error)
+	// Получение открытой смены ПВЗ с предварительным отчетом сверки
+	GetCurrentShift(context.Context, *GetCurrentShiftRequest) (*Shift, error)
+	// Получение смены по ID с отчетом сверки
+	GetShift(context.Context, *GetShiftRequest) (*Shift, error)
+	// Получение списка смен ПВЗ с курсорной пагинацией
+	ListShifts(context.Context, *ListShiftsRequest) (*ListShiftsResponse, error)
+	// Закрытие смены со сверкой кассы
+	CloseShift(context.Context, *CloseShiftRequest) (*Shift, error)
+	// Выгрузка отчета сверки смены в файл JSON или CSV
+	ExportShiftReport(context.Context, *ExportShiftReportRequest) (*ShiftReportFile, error)
+	mustEmbedUnimplementedShiftRPCHandlerServer()
+}
+
+// UnimplementedShiftRPCHandlerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedShiftRPCHandlerServer struct{}
+
+func (UnimplementedShiftRPCHandlerServer) OpenShift(context.Context, *OpenShiftRequest) (*Shift, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenShift not implemented")
+}
+func (UnimplementedShiftRPCHandlerServer) GetCurrentShift(context.Context, *GetCurrentShiftRequest) (*Shift, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentShift not implemented")
+}
+func (UnimplementedShiftRPCHandlerServer) GetShift(context.Context, *GetShiftRequest) (*Shift, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShift not implemented")
+}
+func (UnimplementedShiftRPCHandlerServer) ListShifts(context.Context, *ListShiftsRequest) (*ListShiftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShifts not implemented")
+}
+func (UnimplementedShiftRPCHandlerServer) CloseShift(context.Context, *CloseShiftRequest) (*Shift, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseShift not implemented")
+}
+func (UnimplementedShiftRPCHandlerServer) ExportShiftReport(context.Context, *ExportShiftReportRequest) (*ShiftReportFile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportShiftReport not implemented")
+}
+func (UnimplementedShiftRPCHandlerServer) mustEmbedUnimplementedShiftRPCHandlerServer() {}
+func (UnimplementedShiftRPCHandlerServer) testEmbeddedByValue()                         {}
+
+// UnsafeShiftRPCHandlerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ShiftRPCHandlerServer will
+// result in compilation errors.
+type UnsafeShiftRPCHandlerServer interface {
+	mustEmbedUnimplementedShiftRPCHandlerServer()
+}
+
+func RegisterShiftRPCHandlerServer(s grpc.ServiceRegistrar, srv ShiftRPCHandlerServer) {
+	// If the following call pancis, it indicates UnimplementedShiftRPCHandlerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ShiftRPCHandler_ServiceDesc, srv)
+}
+
+func _ShiftRPCHandler_OpenShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftRPCHandlerServer).OpenShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftRPCHandler_OpenShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftRPCHandlerServer).OpenShift(ctx, req.(*OpenShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftRPCHandler_GetCurrentShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrentShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftRPCHandlerServer).GetCurrentShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftRPCHandler_GetCurrentShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftRPCHandlerServer).GetCurrentShift(ctx, req.(*GetCurrentShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftRPCHandler_GetShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftRPCHandlerServer).GetShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftRPCHandler_GetShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftRPCHandlerServer).GetShift(ctx, req.(*GetShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftRPCHandler_ListShifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShiftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftRPCHandlerServer).ListShifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftRPCHandler_ListShifts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftRPCHandlerServer).ListShifts(ctx, req.(*ListShiftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftRPCHandler_CloseShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftRPCHandlerServer).CloseShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftRPCHandler_CloseShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftRPCHandlerServer).CloseShift(ctx, req.(*CloseShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftRPCHandler_ExportShiftReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportShiftReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftRPCHandlerServer).ExportShiftReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftRPCHandler_ExportShiftReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftRPCHandlerServer).ExportShiftReport(ctx, req.(*ExportShiftReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ShiftRPCHandler_ServiceDesc is the grpc.ServiceDesc for ShiftRPCHandler service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ShiftRPCHandler_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.ShiftRPCHandler",
+	HandlerType: (*ShiftRPCHandlerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OpenShift",
+			Handler:    _ShiftRPCHandler_OpenShift_Handler,
+		},
+		{
+			MethodName: "GetCurrentShift",
+			Handler:    _ShiftRPCHandler_GetCurrentShift_Handler,
+		},
+		{
+			MethodName: "GetShift",
+			Handler:    _ShiftRPCHandler_GetShift_Handler,
+		},
+		{
+			MethodName: "ListShifts",
+			Handler:    _ShiftRPCHandler_ListShifts_Handler,
+		},
+		{
+			MethodName: "CloseShift",
+			Handler:    _ShiftRPCHandler_CloseShift_Handler,
+		},
+		{
+			MethodName: "ExportShiftReport",
+			Handler:    _ShiftRPCHandler_ExportShiftReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/shift.proto",
+}
@@ -0,0 +1,606 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: proto/storage.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Модель ячейки хранения
+type StorageCell struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PickupPointId int64                  `protobuf:"varint,2,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	Rack          string                 `protobuf:"bytes,3,opt,name=rack,proto3" json:"rack,omitempty"`
+	Code          string                 `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	Capacity      int32                  `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	MaxWeight     float64                `protobuf:"fixed64,6,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
+	PackageType   string                 `protobuf:"bytes,7,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"` // пусто - универсальная ячейка
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StorageCell) Reset() {
+	*x = StorageCell{}
+	mi := &file_proto_storage_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorageCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageCell) ProtoMessage() {}
+
+func (x *StorageCell) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageCell.ProtoReflect.Descriptor instead.
+func (*StorageCell) Descriptor() ([]byte, []int) {
+	return file_proto_storage_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StorageCell) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StorageCell) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *StorageCell) GetRack() string {
+	if x != nil {
+		return x.Rack
+	}
+	return ""
+}
+
+func (x *StorageCell) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *StorageCell) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *StorageCell) GetMaxWeight() float64 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
+}
+
+func (x *StorageCell) GetPackageType() string {
+	if x != nil {
+		return x.PackageType
+	}
+	return ""
+}
+
+func (x *StorageCell) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Ячейка хранения с заполненностью
+type StorageCellOccupancy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cell          *StorageCell           `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	OrdersCount   int32                  `protobuf:"varint,2,opt,name=orders_count,json=ordersCount,proto3" json:"orders_count,omitempty"`
+	Weight        float64                `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StorageCellOccupancy) Reset() {
+	*x = StorageCellOccupancy{}
+	mi := &file_proto_storage_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorageCellOccupancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageCellOccupancy) ProtoMessage() {}
+
+func (x *StorageCellOccupancy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageCellOccupancy.ProtoReflect.Descriptor instead.
+func (*StorageCellOccupancy) Descriptor() ([]byte, []int) {
+	return file_proto_storage_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StorageCellOccupancy) GetCell() *StorageCell {
+	if x != nil {
+		return x.Cell
+	}
+	return nil
+}
+
+func (x *StorageCellOccupancy) GetOrdersCount() int32 {
+	if x != nil {
+		return x.OrdersCount
+	}
+	return 0
+}
+
+func (x *StorageCellOccupancy) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// Запрос на создание ячейки хранения
+type CreateStorageCellRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPointId int64                  `protobuf:"varint,1,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"` // если не указан, ячейка создается в ПВЗ сотрудника
+	Rack          string                 `protobuf:"bytes,2,opt,name=rack,proto3" json:"rack,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Capacity      int32                  `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	MaxWeight     float64                `protobuf:"fixed64,5,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
+	PackageType   string                 `protobuf:"bytes,6,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"` // bag, box или film; пусто - универсальная ячейка
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateStorageCellRequest) Reset() {
+	*x = CreateStorageCellRequest{}
+	mi := &file_proto_storage_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStorageCellRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStorageCellRequest) ProtoMessage() {}
+
+func (x *CreateStorageCellRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStorageCellRequest.ProtoReflect.Descriptor instead.
+func (*CreateStorageCellRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateStorageCellRequest) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *CreateStorageCellRequest) GetRack() string {
+	if x != nil {
+		return x.Rack
+	}
+	return ""
+}
+
+func (x *CreateStorageCellRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateStorageCellRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *CreateStorageCellRequest) GetMaxWeight() float64 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
+}
+
+func (x *CreateStorageCellRequest) GetPackageType() string {
+	if x != nil {
+		return x.PackageType
+	}
+	return ""
+}
+
+// Запрос на удаление ячейки хранения
+type DeleteStorageCellRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteStorageCellRequest) Reset() {
+	*x = DeleteStorageCellRequest{}
+	mi := &file_proto_storage_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStorageCellRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStorageCellRequest) ProtoMessage() {}
+
+func (x *DeleteStorageCellRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStorageCellRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStorageCellRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteStorageCellRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Ответ на запрос удаления ячейки хранения
+type DeleteStorageCellResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteStorageCellResponse) Reset() {
+	*x = DeleteStorageCellResponse{}
+	mi := &file_proto_storage_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStorageCellResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStorageCellResponse) ProtoMessage() {}
+
+func (x *DeleteStorageCellResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStorageCellResponse.ProtoReflect.Descriptor instead.
+func (*DeleteStorageCellResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteStorageCellResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Запрос на получение заполненности ячеек
+type ListStorageCellsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStorageCellsRequest) Reset() {
+	*x = ListStorageCellsRequest{}
+	mi := &file_proto_storage_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStorageCellsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStorageCellsRequest) ProtoMessage() {}
+
+func (x *ListStorageCellsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStorageCellsRequest.ProtoReflect.Descriptor instead.
+func (*ListStorageCellsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_proto_rawDescGZIP(), []int{5}
+}
+
+// Ответ с заполненностью ячеек
+type ListStorageCellsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Cells         []*StorageCellOccupancy `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+	Total         int32                   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStorageCellsResponse) Reset() {
+	*x = ListStorageCellsResponse{}
+	mi := &file_proto_storage_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStorageCellsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStorageCellsResponse) ProtoMessage() {}
+
+func (x *ListStorageCellsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStorageCellsResponse.ProtoReflect.Descriptor instead.
+func (*ListStorageCellsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListStorageCellsResponse) GetCells() []*StorageCellOccupancy {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *ListStorageCellsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Запрос на поиск ячейки заказа
+type LocateOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocateOrderRequest) Reset() {
+	*x = LocateOrderRequest{}
+	mi := &file_proto_storage_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocateOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocateOrderRequest) ProtoMessage() {}
+
+func (x *LocateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocateOrderRequest.ProtoReflect.Descriptor instead.
+func (*LocateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LocateOrderRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+var File_proto_storage_proto protoreflect.FileDescriptor
+
+const file_proto_storage_proto_rawDesc = "" +
+	"\n" +
+	"\x13proto/storage.proto\x12\x05proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x86\x02\n" +
+	"\vStorageCell\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12&\n" +
+	"\x0fpickup_point_id\x18\x02 \x01(\x03R\rpickupPointId\x12\x12\n" +
+	"\x04rack\x18\x03 \x01(\tR\x04rack\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\tR\x04code\x12\x1a\n" +
+	"\bcapacity\x18\x05 \x01(\x05R\bcapacity\x12\x1d\n" +
+	"\n" +
+	"max_weight\x18\x06 \x01(\x01R\tmaxWeight\x12!\n" +
+	"\fpackage_type\x18\a \x01(\tR\vpackageType\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"y\n" +
+	"\x14StorageCellOccupancy\x12&\n" +
+	"\x04cell\x18\x01 \x01(\v2\x12.proto.StorageCellR\x04cell\x12!\n" +
+	"\forders_count\x18\x02 \x01(\x05R\vordersCount\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x01R\x06weight\"\xc8\x01\n" +
+	"\x18CreateStorageCellRequest\x12&\n" +
+	"\x0fpickup_point_id\x18\x01 \x01(\x03R\rpickupPointId\x12\x12\n" +
+	"\x04rack\x18\x02 \x01(\tR\x04rack\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x1a\n" +
+	"\bcapacity\x18\x04 \x01(\x05R\bcapacity\x12\x1d\n" +
+	"\n" +
+	"max_weight\x18\x05 \x01(\x01R\tmaxWeight\x12!\n" +
+	"\fpackage_type\x18\x06 \x01(\tR\vpackageType\"*\n" +
+	"\x18DeleteStorageCellRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"5\n" +
+	"\x19DeleteStorageCellResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x19\n" +
+	"\x17ListStorageCellsRequest\"c\n" +
+	"\x18ListStorageCellsResponse\x121\n" +
+	"\x05cells\x18\x01 \x03(\v2\x1b.proto.StorageCellOccupancyR\x05cells\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"/\n" +
+	"\x12LocateOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId2\xd0\x02\n" +
+	"\x11StorageRPCHandler\x12J\n" +
+	"\x11CreateStorageCell\x12\x1f.proto.CreateStorageCellRequest\x1a\x12.proto.StorageCell\"\x00\x12X\n" +
+	"\x11DeleteStorageCell\x12\x1f.proto.DeleteStorageCellRequest\x1a .proto.DeleteStorageCellResponse\"\x00\x12U\n" +
+	"\x10ListStorageCells\x12\x1e.proto.ListStorageCellsRequest\x1a\x1f.proto.ListStorageCellsResponse\"\x00\x12>\n" +
+	"\vLocateOrder\x12\x19.proto.LocateOrderRequest\x1a\x12.proto.StorageCell\"\x00B#Z!gitlab.ozon.dev/gojhw1/pkg/gen;pbb\x06proto3"
+
+var (
+	file_proto_storage_proto_rawDescOnce sync.Once
+	file_proto_storage_proto_rawDescData []byte
+)
+
+func file_proto_storage_proto_rawDescGZIP() []byte {
+	file_proto_storage_proto_rawDescOnce.Do(func() {
+		file_proto_storage_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_storage_proto_rawDesc), len(file_proto_storage_proto_rawDesc)))
+	})
+	return file_proto_storage_proto_rawDescData
+}
+
+var file_proto_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_storage_proto_goTypes = []any{
+	(*StorageCell)(nil),               // 0: proto.StorageCell
+	(*StorageCellOccupancy)(nil),      // 1: proto.StorageCellOccupancy
+	(*CreateStorageCellRequest)(nil),  // 2: proto.CreateStorageCellRequest
+	(*DeleteStorageCellRequest)(nil),  // 3: proto.DeleteStorageCellRequest
+	(*DeleteStorageCellResponse)(nil), // 4: proto.DeleteStorageCellResponse
+	(*ListStorageCellsRequest)(nil),   // 5: proto.ListStorageCellsRequest
+	(*ListStorageCellsResponse)(nil),  // 6: proto.ListStorageCellsResponse
+	(*LocateOrderRequest)(nil),        // 7: proto.LocateOrderRequest
+	(*timestamppb.Timestamp)(nil),     // 8: google.protobuf.Timestamp
+}
+var file_proto_storage_proto_depIdxs = []int32{
+	8, // 0: proto.StorageCell.created_at:type_name -> google.protobuf.Timestamp
+	0, // 1: proto.StorageCellOccupancy.cell:type_name -> proto.StorageCell
+	1, // 2: proto.ListStorageCellsResponse.cells:type_name -> proto.StorageCellOccupancy
+	2, // 3: proto.StorageRPCHandler.CreateStorageCell:input_type -> proto.CreateStorageCellRequest
+	3, // 4: proto.StorageRPCHandler.DeleteStorageCell:input_type -> proto.DeleteStorageCellRequest
+	5, // 5: proto.StorageRPCHandler.ListStorageCells:input_type -> proto.ListStorageCellsRequest
+	7, // 6: proto.StorageRPCHandler.LocateOrder:input_type -> proto.LocateOrderRequest
+	0, // 7: proto.StorageRPCHandler.CreateStorageCell:output_type -> proto.StorageCell
+	4, // 8: proto.StorageRPCHandler.DeleteStorageCell:output_type -> proto.DeleteStorageCellResponse
+	6, // 9: proto.StorageRPCHandler.ListStorageCells:output_type -> proto.ListStorageCellsResponse
+	0, // 10: proto.StorageRPCHandler.LocateOrder:output_type -> proto.StorageCell
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_proto_storage_proto_init() }
+func file_proto_storage_proto_init() {
+	if File_proto_storage_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_storage_proto_rawDesc), len(file_proto_storage_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_storage_proto_goTypes,
+		DependencyIndexes: file_proto_storage_proto_depIdxs,
+		MessageInfos:      file_proto_storage_proto_msgTypes,
+	}.Build()
+	File_proto_storage_proto = out.File
+	file_proto_storage_proto_goTypes = nil
+	file_proto_storage_proto_depIdxs = nil
+}
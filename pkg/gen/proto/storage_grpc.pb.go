@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: proto/storage.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	StorageRPCHandler_CreateStorageCell_FullMethodName = "/proto.StorageRPCHandler/CreateStorageCell"
+	StorageRPCHandler_DeleteStorageCell_FullMethodName = "/proto.StorageRPCHandler/DeleteStorageCell"
+	StorageRPCHandler_ListStorageCells_FullMethodName  = "/proto.StorageRPCHandler/ListStorageCells"
+	StorageRPCHandler_LocateOrder_FullMethodName       = "/proto.StorageRPCHandler/LocateOrder"
+)
+
+// StorageRPCHandlerClient is the client API for StorageRPCHandler service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Сервис для работы с ячейками хранения заказов
+type StorageRPCHandlerClient interface {
+	// Создание ячейки хранения в ПВЗ
+	CreateStorageCell(ctx context.Context, in *CreateStorageCellRequest, opts ...grpc.CallOption) (*StorageCell, error)
+	// Удаление пустой ячейки хранения
+	DeleteStorageCell(ctx context.Context, in *DeleteStorageCellRequest, opts ...grpc.CallOption) (*DeleteStorageCellResponse, error)
+	// Заполненность ячеек ПВЗ вызывающего пользователя
+	ListStorageCells(ctx context.Context, in *ListStorageCellsRequest, opts ...grpc.CallOption) (*ListStorageCellsResponse, error)
+	// Поиск ячейки, в которой лежит заказ
+	LocateOrder(ctx context.Context, in *LocateOrderRequest, opts ...grpc.CallOption) (*StorageCell, error)
+}
+
+type storageRPCHandlerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStorageRPCHandlerClient(cc grpc.ClientConnInterface) StorageRPCHandlerClient {
+	return &storageRPCHandlerClient{cc}
+}
+
+func (c *storageRPCHandlerClient) CreateStorageCell(ctx context.Context, in *CreateStorageCellRequest, opts ...grpc.CallOption) (*StorageCell, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StorageCell)
+	err := c.cc.Invoke(ctx, StorageRPCHandler_CreateStorageCell_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageRPCHandlerClient) DeleteStorageCell(ctx context.Context, in *DeleteStorageCellRequest, opts ...grpc.CallOption) (*DeleteStorageCellResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteStorageCellResponse)
+	err := c.cc.Invoke(ctx, StorageRPCHandler_DeleteStorageCell_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageRPCHandlerClient) ListStorageCells(ctx context.Context, in *ListStorageCellsRequest, opts ...grpc.CallOption) (*ListStorageCellsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStorageCellsResponse)
+	err := c.cc.Invoke(ctx, StorageRPCHandler_ListStorageCells_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageRPCHandlerClient) LocateOrder(ctx context.Context, in *LocateOrderRequest, opts ...grpc.CallOption) (*StorageCell, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StorageCell)
+	err := c.cc.Invoke(ctx, StorageRPCHandler_LocateOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StorageRPCHandlerServer is the server API for StorageRPCHandler service.
+// All implementations must embed UnimplementedStorageRPCHandlerServer
+// for forward compatibility.
+//
+// Сервис для работы с ячейками хранения заказов
+type StorageRPCHandlerServer interface {
+	// Создание ячейки хранения в ПВЗ
+	CreateStorageCell(context.Context, *CreateStorageCellRequest) (*StorageCell, error)
+	// Удаление пустой ячейки хранения
+	DeleteStorageCell(context.Context, *DeleteStorageCellRequest) (*DeleteStorageCellResponse, error)
+	// Заполненность ячеек ПВЗ вызывающего пользователя
+	ListStorageCells(context.Context, *ListStorageCellsRequest) (*ListStorageCellsResponse, error)
+	// Поиск ячейки, в которой лежит заказ
+	LocateOrder(context.Context, *LocateOrderRequest) (*StorageCell, error)
+	mustEmbedUnimplementedStorageRPCHandlerServer()
+}
+
+// UnimplementedStorageRPCHandlerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStorageRPCHandlerServer struct{}
+
+func (UnimplementedStorageRPCHandlerServer) CreateStorageCell(context.Context, *CreateStorageCellRequest) (*StorageCell, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStorageCell not implemented")
+}
+func (UnimplementedStorageRPCHandlerServer) DeleteStorageCell(context.Context, *DeleteStorageCellRequest) (*DeleteStorageCellResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStorageCell not implemented")
+}
+func (UnimplementedStorageRPCHandlerServer) ListStorageCells(context.Context, *ListStorageCellsRequest) (*ListStorageCellsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStorageCells not implemented")
+}
+func (UnimplementedStorageRPCHandlerServer) LocateOrder(context.Context, *LocateOrderRequest) (*StorageCell, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocateOrder not implemented")
+}
+func (UnimplementedStorageRPCHandlerServer) mustEmbedUnimplementedStorageRPCHandlerServer() {}
+func (UnimplementedStorageRPCHandlerServer) testEmbeddedByValue()                           {}
+
+// UnsafeStorageRPCHandlerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StorageRPCHandlerServer will
+// result in compilation errors.
+type UnsafeStorageRPCHandlerServer interface {
+	mustEmbedUnimplementedStorageRPCHandlerServer()
+}
+
+func RegisterStorageRPCHandlerServer(s grpc.ServiceRegistrar, srv StorageRPCHandlerServer) {
+	// If the following call pancis, it indicates UnimplementedStorageRPCHandlerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StorageRPCHandler_ServiceDesc, srv)
+}
+
+func _StorageRPCHandler_CreateStorageCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStorageCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageRPCHandlerServer).CreateStorageCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageRPCHandler_CreateStorageCell_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageRPCHandlerServer).CreateStorageCell(ctx, req.(*CreateStorageCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageRPCHandler_DeleteStorageCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStorageCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageRPCHandlerServer).DeleteStorageCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageRPCHandler_DeleteStorageCell_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageRPCHandlerServer).DeleteStorageCell(ctx, req.(*DeleteStorageCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageRPCHandler_ListStorageCells_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStorageCellsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageRPCHandlerServer).ListStorageCells(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageRPCHandler_ListStorageCells_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageRPCHandlerServer).ListStorageCells(ctx, req.(*ListStorageCellsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageRPCHandler_LocateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageRPCHandlerServer).LocateOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageRPCHandler_LocateOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageRPCHandlerServer).LocateOrder(ctx, req.(*LocateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StorageRPCHandler_ServiceDesc is the grpc.ServiceDesc for StorageRPCHandler service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StorageRPCHandler_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.StorageRPCHandler",
+	HandlerType: (*StorageRPCHandlerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateStorageCell",
+			Handler:    _StorageRPCHandler_CreateStorageCell_Handler,
+		},
+		{
+			MethodName: "DeleteStorageCell",
+			Handler:    _StorageRPCHandler_DeleteStorageCell_Handler,
+		},
+		{
+			MethodName: "ListStorageCells",
+			Handler:    _StorageRPCHandler_ListStorageCells_Handler,
+		},
+		{
+			MethodName: "LocateOrder",
+			Handler:    _StorageRPCHandler_LocateOrder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/storage.proto",
+}
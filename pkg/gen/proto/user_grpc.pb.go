@@ -19,12 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserRPCHandler_CreateUser_FullMethodName     = "/proto.UserRPCHandler/CreateUser"
-	UserRPCHandler_GetUser_FullMethodName        = "/proto.UserRPCHandler/GetUser"
-	UserRPCHandler_ListUsers_FullMethodName      = "/proto.UserRPCHandler/ListUsers"
-	UserRPCHandler_UpdateUser_FullMethodName     = "/proto.UserRPCHandler/UpdateUser"
-	UserRPCHandler_UpdatePassword_FullMethodName = "/proto.UserRPCHandler/UpdatePassword"
-	UserRPCHandler_DeleteUser_FullMethodName     = "/proto.UserRPCHandler/DeleteUser"
+	UserRPCHandler_CreateUser_FullMethodName        = "/proto.UserRPCHandler/CreateUser"
+	UserRPCHandler_GetUser_FullMethodName           = "/proto.UserRPCHandler/GetUser"
+	UserRPCHandler_ListUsers_FullMethodName         = "/proto.UserRPCHandler/ListUsers"
+	UserRPCHandler_UpdateUser_FullMethodName        = "/proto.UserRPCHandler/UpdateUser"
+	UserRPCHandler_UpdatePassword_FullMethodName    = "/proto.UserRPCHandler/UpdatePassword"
+	UserRPCHandler_DeleteUser_FullMethodName        = "/proto.UserRPCHandler/DeleteUser"
+	UserRPCHandler_ApproveUser_FullMethodName       = "/proto.UserRPCHandler/ApproveUser"
+	UserRPCHandler_PromoteUser_FullMethodName       = "/proto.UserRPCHandler/PromoteUser"
+	UserRPCHandler_CreateAPIKey_FullMethodName      = "/proto.UserRPCHandler/CreateAPIKey"
+	UserRPCHandler_ListAPIKeys_FullMethodName       = "/proto.UserRPCHandler/ListAPIKeys"
+	UserRPCHandler_RevokeAPIKey_FullMethodName      = "/proto.UserRPCHandler/RevokeAPIKey"
+	UserRPCHandler_AssignPickupPoint_FullMethodName = "/proto.UserRPCHandler/AssignPickupPoint"
 )
 
 // UserRPCHandlerClient is the client API for UserRPCHandler service.
@@ -45,6 +51,18 @@ type UserRPCHandlerClient interface {
 	UpdatePassword(ctx context.Context, in *UpdatePasswordRequest, opts ...grpc.CallOption) (*UpdatePasswordResponse, error)
 	// Удаление пользователя
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	// Подтверждение зарегистрированного пользователя администратором
+	ApproveUser(ctx context.Context, in *ApproveUserRequest, opts ...grpc.CallOption) (*ApproveUserResponse, error)
+	// Назначение пользователю новой роли
+	PromoteUser(ctx context.Context, in *PromoteUserRequest, opts ...grpc.CallOption) (*PromoteUserResponse, error)
+	// Выпуск API-ключа для пользователя
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	// Получение списка API-ключей пользователя
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	// Отзыв API-ключа пользователя
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
+	// Привязка пользователя к ПВЗ
+	AssignPickupPoint(ctx context.Context, in *AssignPickupPointRequest, opts ...grpc.CallOption) (*AssignPickupPointResponse, error)
 }
 
 type userRPCHandlerClient struct {
@@ -115,6 +133,66 @@ func (c *userRPCHandlerClient) DeleteUser(ctx context.Context, in *DeleteUserReq
 	return out, nil
 }
 
+func (c *userRPCHandlerClient) ApproveUser(ctx context.Context, in *ApproveUserRequest, opts ...grpc.CallOption) (*ApproveUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveUserResponse)
+	err := c.cc.Invoke(ctx, UserRPCHandler_ApproveUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRPCHandlerClient) PromoteUser(ctx context.Context, in *PromoteUserRequest, opts ...grpc.CallOption) (*PromoteUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromoteUserResponse)
+	err := c.cc.Invoke(ctx, UserRPCHandler_PromoteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRPCHandlerClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, UserRPCHandler_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRPCHandlerClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, UserRPCHandler_ListAPIKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRPCHandlerClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, UserRPCHandler_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRPCHandlerClient) AssignPickupPoint(ctx context.Context, in *AssignPickupPointRequest, opts ...grpc.CallOption) (*AssignPickupPointResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignPickupPointResponse)
+	err := c.cc.Invoke(ctx, UserRPCHandler_AssignPickupPoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserRPCHandlerServer is the server API for UserRPCHandler service.
 // All implementations must embed UnimplementedUserRPCHandlerServer
 // for forward compatibility.
@@ -133,6 +211,18 @@ type UserRPCHandlerServer interface {
 	UpdatePassword(context.Context, *UpdatePasswordRequest) (*UpdatePasswordResponse, error)
 	// Удаление пользователя
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	// Подтверждение зарегистрированного пользователя администратором
+	ApproveUser(context.Context, *ApproveUserRequest) (*ApproveUserResponse, error)
+	// Назначение пользователю новой роли
+	PromoteUser(context.Context, *PromoteUserRequest) (*PromoteUserResponse, error)
+	// Выпуск API-ключа для пользователя
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	// Получение списка API-ключей пользователя
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	// Отзыв API-ключа пользователя
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
+	// Привязка пользователя к ПВЗ
+	AssignPickupPoint(context.Context, *AssignPickupPointRequest) (*AssignPickupPointResponse, error)
 	mustEmbedUnimplementedUserRPCHandlerServer()
 }
 
@@ -161,6 +251,24 @@ func (UnimplementedUserRPCHandlerServer) UpdatePassword(context.Context, *Update
 func (UnimplementedUserRPCHandlerServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
+func (UnimplementedUserRPCHandlerServer) ApproveUser(context.Context, *ApproveUserRequest) (*ApproveUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveUser not implemented")
+}
+func (UnimplementedUserRPCHandlerServer) PromoteUser(context.Context, *PromoteUserRequest) (*PromoteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteUser not implemented")
+}
+func (UnimplementedUserRPCHandlerServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedUserRPCHandlerServer) ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedUserRPCHandlerServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedUserRPCHandlerServer) AssignPickupPoint(context.Context, *AssignPickupPointRequest) (*AssignPickupPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignPickupPoint not implemented")
+}
 func (UnimplementedUserRPCHandlerServer) mustEmbedUnimplementedUserRPCHandlerServer() {}
 func (UnimplementedUserRPCHandlerServer) testEmbeddedByValue()                        {}
 
@@ -290,6 +398,114 @@ func _UserRPCHandler_DeleteUser_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserRPCHandler_ApproveUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRPCHandlerServer).ApproveUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRPCHandler_ApproveUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRPCHandlerServer).ApproveUser(ctx, req.(*ApproveUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRPCHandler_PromoteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRPCHandlerServer).PromoteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRPCHandler_PromoteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRPCHandlerServer).PromoteUser(ctx, req.(*PromoteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRPCHandler_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRPCHandlerServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRPCHandler_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRPCHandlerServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRPCHandler_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRPCHandlerServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRPCHandler_ListAPIKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRPCHandlerServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRPCHandler_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRPCHandlerServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRPCHandler_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRPCHandlerServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRPCHandler_AssignPickupPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignPickupPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRPCHandlerServer).AssignPickupPoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRPCHandler_AssignPickupPoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRPCHandlerServer).AssignPickupPoint(ctx, req.(*AssignPickupPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserRPCHandler_ServiceDesc is the grpc.ServiceDesc for UserRPCHandler service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -321,6 +537,30 @@ var UserRPCHandler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _UserRPCHandler_DeleteUser_Handler,
 		},
+		{
+			MethodName: "ApproveUser",
+			Handler:    _UserRPCHandler_ApproveUser_Handler,
+		},
+		{
+			MethodName: "PromoteUser",
+			Handler:    _UserRPCHandler_PromoteUser_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _UserRPCHandler_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _UserRPCHandler_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _UserRPCHandler_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "AssignPickupPoint",
+			Handler:    _UserRPCHandler_AssignPickupPoint_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/user.proto",
@@ -0,0 +1,130 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	pb "gitlab.ozon.dev/gojhw1/pkg/gen/proto"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Заголовки метаданных с ключом идемпотентности и признаком ответа, восстановленного по этому ключу
+const (
+	idempotencyKeyMetadata     = "idempotency-key"
+	idempotentReplayedMetadata = "idempotent-replayed"
+)
+
+// idempotentMethods - изменяющие методы, которые можно повторять с ключом идемпотентности,
+// и конструкторы их ответов для восстановления сохраненного ответа
+var idempotentMethods = map[string]func() proto.Message{
+	pb.OrderRPCHandler_CreateOrder_FullMethodName:          func() proto.Message { return &pb.Order{} },
+	pb.OrderRPCHandler_ReturnToCourier_FullMethodName:      func() proto.Message { return &pb.ReturnToCourierResponse{} },
+	pb.OrderRPCHandler_ProcessCustomer_FullMethodName:      func() proto.Message { return &pb.ProcessCustomerResponse{} },
+	pb.OrderRPCHandler_AcceptOrdersFromFile_FullMethodName: func() proto.Message { return &pb.AcceptOrdersFromFileResponse{} },
+	pb.OrderRPCHandler_SubmitImportJob_FullMethodName:      func() proto.Message { return &pb.ImportJob{} },
+}
+
+// idempotencyService хранит ключи идемпотентности и ответы на выполненные запросы
+type idempotencyService interface {
+	Begin(ctx context.Context, username, key string, request []byte) (*model.IdempotencyRecord, error)
+	Complete(ctx context.Context, username, key string, statusCode int, response []byte) error
+	Release(ctx context.Context, username, key string) error
+}
+
+// IdempotencyInterceptor выполняет изменяющие вызовы с заголовком "idempotency-key" не более одного раза.
+// Повтор вызова с тем же ключом получает сохраненный ответ, ключ, использованный с другим запросом,
+// отклоняется с кодом InvalidArgument. Должен подключаться после аутентификации.
+type IdempotencyInterceptor struct {
+	idempotency idempotencyService
+}
+
+func NewIdempotencyInterceptor(idempotency idempotencyService) *IdempotencyInterceptor {
+	return &IdempotencyInterceptor{
+		idempotency: idempotency,
+	}
+}
+
+// UnaryInterceptor обрабатывает унарные RPC-вызовы
+func (i *IdempotencyInterceptor) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	newResponse, ok := idempotentMethods[info.FullMethod]
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	keys := md.Get(idempotencyKeyMetadata)
+	if len(keys) == 0 || keys[0] == "" {
+		return handler(ctx, req)
+	}
+	key := keys[0]
+
+	message, ok := req.(proto.Message)
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "ошибка сериализации запроса: %v", err)
+	}
+
+	username, _ := ctx.Value(usernameKey).(string)
+	record, err := i.idempotency.Begin(ctx, username, key, append([]byte(info.FullMethod+"\n"), body...))
+	if err != nil {
+		return nil, idempotencyStatusError(err)
+	}
+
+	if record != nil {
+		resp := newResponse()
+		if err = proto.Unmarshal(record.Response, resp); err != nil {
+			return nil, status.Errorf(codes.Internal, "ошибка восстановления сохраненного ответа: %v", err)
+		}
+
+		_ = grpc.SetHeader(ctx, metadata.Pairs(idempotentReplayedMetadata, "true"))
+		return resp, nil
+	}
+
+	resp, err := handler(ctx, req)
+	if err != nil {
+		_ = i.idempotency.Release(ctx, username, key)
+		return nil, err
+	}
+
+	respMessage, ok := resp.(proto.Message)
+	if !ok {
+		_ = i.idempotency.Release(ctx, username, key)
+		return resp, nil
+	}
+
+	response, err := proto.Marshal(respMessage)
+	if err != nil {
+		_ = i.idempotency.Release(ctx, username, key)
+		return resp, nil
+	}
+
+	// Код 0 означает незавершенный запрос, поэтому успешный вызов сохраняется с HTTP-кодом 200
+	_ = i.idempotency.Complete(ctx, username, key, http.StatusOK, response)
+
+	return resp, nil
+}
+
+// idempotencyStatusError преобразует ошибку сервиса ключей идемпотентности в gRPC статус
+func idempotencyStatusError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidIdempotencyKey):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrIdempotencyKeyReused):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrIdempotencyKeyInProgress):
+		return status.Error(codes.Aborted, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "ошибка при проверке ключа идемпотентности: %v", err)
+	}
+}
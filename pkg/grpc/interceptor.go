@@ -3,98 +3,237 @@ package grpc
 import (
 	"context"
 	"encoding/base64"
+	"errors"
+	"net"
 	"strings"
 
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/rbac"
+	"gitlab.ozon.dev/gojhw1/pkg/service"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
 type ctxKey string
 
 const (
-	usernameKey ctxKey = "username"
+	userIDKey       ctxKey = "user_id"
+	usernameKey     ctxKey = "username"
+	apiKeyScopesKey ctxKey = "api_key_scopes"
 )
 
-type BasicAuthInterceptor struct {
-	userRepository userRepository
+// authenticator проверяет access-токены и пароли с защитой от перебора
+type authenticator interface {
+	ParseAccessToken(accessToken string) (int64, error)
+	Authenticate(ctx context.Context, username, password, ip string) error
 }
 
-func NewBasicAuthInterceptor(userRepository userRepository) *BasicAuthInterceptor {
-	return &BasicAuthInterceptor{
-		userRepository: userRepository,
+// AuthInterceptor аутентифицирует вызовы по access-токену из заголовка "authorization: Bearer <token>"
+// или по API-ключу из заголовка "x-api-key". Если basicAuthFallback включен, дополнительно принимается Basic Auth.
+type AuthInterceptor struct {
+	auth              authenticator
+	apiKeys           apiKeyService
+	basicAuthFallback bool
+}
+
+func NewAuthInterceptor(auth authenticator, apiKeys apiKeyService, basicAuthFallback bool) *AuthInterceptor {
+	return &AuthInterceptor{
+		auth:              auth,
+		apiKeys:           apiKeys,
+		basicAuthFallback: basicAuthFallback,
 	}
 }
 
 // UnaryInterceptor обрабатывает унарные RPC-вызовы
-func (i *BasicAuthInterceptor) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-	// Пропускаем аутентификацию для метода CreateUser, аналогично register в HTTP версии
-	if info.FullMethod == "/proto.UserRPCHandler/CreateUser" {
+func (i *AuthInterceptor) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	// Пропускаем аутентификацию для публичных методов, аналогично register в HTTP версии
+	if rule, ok := rbac.RuleForRPC(info.FullMethod); ok && rule.Public {
 		return handler(ctx, req)
 	}
 
+	// Добавляем пользователя в контекст (по аналогии с c.Locals("user_id") в fiber)
+	newCtx, err := i.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Вызываем обработчик с обновленным контекстом
+	return handler(newCtx, req)
+}
+
+// StreamInterceptor обрабатывает потоковые RPC-вызовы
+func (i *AuthInterceptor) StreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := ss.Context()
+
+	newCtx, err := i.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Создаем обертку для ServerStream с добавленным пользователем в контексте
+	wrappedStream := NewWrappedServerStream(ss, newCtx)
+
+	// Вызываем обработчик с оберткой потока
+	return handler(srv, wrappedStream)
+}
+
+// authenticate проверяет API-ключ или заголовок авторизации из метаданных и возвращает контекст
+// с ID пользователя (access-токен и API-ключ) или его именем (Basic Auth) и, для API-ключа, его областями действия
+func (i *AuthInterceptor) authenticate(ctx context.Context) (context.Context, error) {
 	// Получаем метаданные из контекста
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, status.Errorf(codes.Unauthenticated, "метаданные не найдены")
 	}
 
+	if apiKey := md.Get("x-api-key"); len(apiKey) > 0 && apiKey[0] != "" {
+		user, key, err := i.apiKeys.AuthenticateAPIKey(ctx, apiKey[0])
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, service.ErrInvalidAPIKey.Error())
+		}
+
+		ctx = context.WithValue(ctx, userIDKey, user.ID)
+		return context.WithValue(ctx, apiKeyScopesKey, key.Scopes), nil
+	}
+
+	return i.authenticateHeader(ctx, md)
+}
+
+// authenticateHeader проверяет заголовок авторизации и возвращает контекст с ID или именем пользователя
+func (i *AuthInterceptor) authenticateHeader(ctx context.Context, md metadata.MD) (context.Context, error) {
 	// Ищем заголовок авторизации
 	authHeader, ok := md["authorization"]
 	if !ok || len(authHeader) == 0 {
 		return nil, status.Errorf(codes.Unauthenticated, "требуется авторизация")
 	}
 
-	// Извлекаем и проверяем учетные данные
-	username, password, ok := parseBasicAuth(authHeader[0])
-	if !ok {
+	switch {
+	case strings.HasPrefix(authHeader[0], "Bearer "):
+		userID, err := i.auth.ParseAccessToken(strings.TrimPrefix(authHeader[0], "Bearer "))
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "недействительный или просроченный токен")
+		}
+
+		return context.WithValue(ctx, userIDKey, userID), nil
+
+	case i.basicAuthFallback && strings.HasPrefix(authHeader[0], "Basic "):
+		// Извлекаем и проверяем учетные данные
+		username, password, ok := parseBasicAuth(authHeader[0])
+		if !ok {
+			return nil, status.Errorf(codes.Unauthenticated, "некорректный формат заголовка авторизации")
+		}
+
+		if err := i.auth.Authenticate(ctx, username, password, peerIP(ctx)); err != nil {
+			if errors.Is(err, service.ErrAccountLocked) {
+				return nil, status.Error(codes.ResourceExhausted, err.Error())
+			}
+			return nil, status.Errorf(codes.Unauthenticated, "неверные учетные данные")
+		}
+
+		return context.WithValue(ctx, usernameKey, username), nil
+
+	default:
 		return nil, status.Errorf(codes.Unauthenticated, "некорректный формат заголовка авторизации")
 	}
+}
+
+// peerIP возвращает IP-адрес клиента без порта или пустую строку, если адрес неизвестен
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
 
-	// Проверяем учетные данные
-	if !i.userRepository.CheckPassword(ctx, username, password) {
-		return nil, status.Errorf(codes.Unauthenticated, "неверные учетные данные")
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
 	}
 
-	// Добавляем имя пользователя в контекст (по аналогии с ContextUsername в fiber)
-	newCtx := context.WithValue(ctx, usernameKey, username)
+	return host
+}
+
+// PermissionInterceptor проверяет права пользователя по таблице прав rbac.
+// Должен вызываться после AuthInterceptor, который кладет в контекст ID или имя пользователя.
+type PermissionInterceptor struct {
+	userRepository userRepository
+}
+
+func NewPermissionInterceptor(userRepository userRepository) *PermissionInterceptor {
+	return &PermissionInterceptor{
+		userRepository: userRepository,
+	}
+}
+
+// UnaryInterceptor обрабатывает унарные RPC-вызовы
+func (i *PermissionInterceptor) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	newCtx, err := i.authorize(ctx, info.FullMethod, req)
+	if err != nil {
+		return nil, err
+	}
 
-	// Вызываем обработчик с обновленным контекстом
 	return handler(newCtx, req)
 }
 
 // StreamInterceptor обрабатывает потоковые RPC-вызовы
-func (i *BasicAuthInterceptor) StreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-	// Получаем метаданные из контекста
-	ctx := ss.Context()
-	md, ok := metadata.FromIncomingContext(ctx)
+func (i *PermissionInterceptor) StreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	newCtx, err := i.authorize(ss.Context(), info.FullMethod, nil)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, NewWrappedServerStream(ss, newCtx))
+}
+
+// targetIDRequest - запрос к операции над учетной записью с указанным ID
+type targetIDRequest interface {
+	GetId() int64
+}
+
+// authorize находит правило для метода и проверяет роль пользователя из контекста.
+// ID учетной записи, над которой выполняется операция, берется из поля id запроса req.
+// Возвращает контекст с сохраненным пользователем.
+func (i *PermissionInterceptor) authorize(ctx context.Context, fullMethod string, req any) (context.Context, error) {
+	rule, ok := rbac.RuleForRPC(fullMethod)
 	if !ok {
-		return status.Errorf(codes.Unauthenticated, "метаданные не найдены")
+		return nil, status.Error(codes.PermissionDenied, rbac.ErrUnknownOperation.Error())
 	}
 
-	// Ищем заголовок авторизации
-	authHeader, ok := md["authorization"]
-	if !ok || len(authHeader) == 0 {
-		return status.Errorf(codes.Unauthenticated, "требуется авторизация")
+	if rule.Public {
+		return ctx, nil
 	}
 
-	// Извлекаем и проверяем учетные данные
-	username, password, ok := parseBasicAuth(authHeader[0])
-	if !ok {
-		return status.Errorf(codes.Unauthenticated, "некорректный формат заголовка авторизации")
+	var user model.User
+	var err error
+	if userID, ok := ctx.Value(userIDKey).(int64); ok {
+		user, err = i.userRepository.GetByID(ctx, userID)
+	} else {
+		username, _ := ctx.Value(usernameKey).(string)
+		user, err = i.userRepository.GetByUsername(ctx, username)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "требуется авторизация")
 	}
 
-	// Проверяем учетные данные
-	if !i.userRepository.CheckPassword(ctx, username, password) {
-		return status.Errorf(codes.Unauthenticated, "неверные учетные данные")
+	var targetID int64
+	if r, ok := req.(targetIDRequest); ok {
+		targetID = r.GetId()
 	}
 
-	// Создаем обертку для ServerStream с добавленным username в контекст
-	wrappedStream := NewWrappedServerStream(ss, context.WithValue(ctx, usernameKey, username))
+	if err = rbac.AuthorizeTarget(user, rule, targetID); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
 
-	// Вызываем обработчик с оберткой потока
-	return handler(srv, wrappedStream)
+	if scopes, ok := ctx.Value(apiKeyScopesKey).([]string); ok {
+		if err = rbac.AuthorizeScopes(scopes, rule); err != nil {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+	}
+
+	ctx = context.WithValue(ctx, usernameKey, user.Username)
+	return rbac.ContextWithUser(ctx, user), nil
 }
 
 // parseBasicAuth извлекает учетные данные из заголовка Basic Auth
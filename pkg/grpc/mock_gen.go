@@ -0,0 +1,3 @@
+package grpc
+
+//go:generate mockgen -typed -source=order.go -destination=mock_order_test.go -package=grpc
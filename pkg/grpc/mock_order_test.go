@@ -0,0 +1,920 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: order.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -source=order.go -destination=mock_order_test.go -package=grpc
+//
+
+// Package grpc is a generated GoMock package.
+package grpc
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	importer "gitlab.ozon.dev/gojhw1/pkg/importer"
+	model "gitlab.ozon.dev/gojhw1/pkg/model"
+	service "gitlab.ozon.dev/gojhw1/pkg/service"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockorderServiceInterface is a mock of orderServiceInterface interface.
+type MockorderServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockorderServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockorderServiceInterfaceMockRecorder is the mock recorder for MockorderServiceInterface.
+type MockorderServiceInterfaceMockRecorder struct {
+	mock *MockorderServiceInterface
+}
+
+// NewMockorderServiceInterface creates a new mock instance.
+func NewMockorderServiceInterface(ctrl *gomock.Controller) *MockorderServiceInterface {
+	mock := &MockorderServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockorderServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockorderServiceInterface) EXPECT() *MockorderServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AcceptOrder mocks base method.
+func (m *MockorderServiceInterface) AcceptOrder(ctx context.Context, id, customerID, pickupPointID int64, deadline time.Time, weight, cost float64, packageType *model.PackageType, wrapper *model.WrapperType, paymentMode model.PaymentMode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptOrder", ctx, id, customerID, pickupPointID, deadline, weight, cost, packageType, wrapper, paymentMode)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptOrder indicates an expected call of AcceptOrder.
+func (mr *MockorderServiceInterfaceMockRecorder) AcceptOrder(ctx, id, customerID, pickupPointID, deadline, weight, cost, packageType, wrapper, paymentMode any) *MockorderServiceInterfaceAcceptOrderCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOrder", reflect.TypeOf((*MockorderServiceInterface)(nil).AcceptOrder), ctx, id, customerID, pickupPointID, deadline, weight, cost, packageType, wrapper, paymentMode)
+	return &MockorderServiceInterfaceAcceptOrderCall{Call: call}
+}
+
+// MockorderServiceInterfaceAcceptOrderCall wrap *gomock.Call
+type MockorderServiceInterfaceAcceptOrderCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceAcceptOrderCall) Return(arg0 error) *MockorderServiceInterfaceAcceptOrderCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceAcceptOrderCall) Do(f func(context.Context, int64, int64, int64, time.Time, float64, float64, *model.PackageType, *model.WrapperType, model.PaymentMode) error) *MockorderServiceInterfaceAcceptOrderCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceAcceptOrderCall) DoAndReturn(f func(context.Context, int64, int64, int64, time.Time, float64, float64, *model.PackageType, *model.WrapperType, model.PaymentMode) error) *MockorderServiceInterfaceAcceptOrderCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AcceptOrderWithItems mocks base method.
+func (m *MockorderServiceInterface) AcceptOrderWithItems(ctx context.Context, id, customerID, pickupPointID int64, deadline time.Time, items []model.OrderItem, packageType *model.PackageType, wrapper *model.WrapperType, paymentMode model.PaymentMode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptOrderWithItems", ctx, id, customerID, pickupPointID, deadline, items, packageType, wrapper, paymentMode)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptOrderWithItems indicates an expected call of AcceptOrderWithItems.
+func (mr *MockorderServiceInterfaceMockRecorder) AcceptOrderWithItems(ctx, id, customerID, pickupPointID, deadline, items, packageType, wrapper, paymentMode any) *MockorderServiceInterfaceAcceptOrderWithItemsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOrderWithItems", reflect.TypeOf((*MockorderServiceInterface)(nil).AcceptOrderWithItems), ctx, id, customerID, pickupPointID, deadline, items, packageType, wrapper, paymentMode)
+	return &MockorderServiceInterfaceAcceptOrderWithItemsCall{Call: call}
+}
+
+// MockorderServiceInterfaceAcceptOrderWithItemsCall wrap *gomock.Call
+type MockorderServiceInterfaceAcceptOrderWithItemsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceAcceptOrderWithItemsCall) Return(arg0 error) *MockorderServiceInterfaceAcceptOrderWithItemsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceAcceptOrderWithItemsCall) Do(f func(context.Context, int64, int64, int64, time.Time, []model.OrderItem, *model.PackageType, *model.WrapperType, model.PaymentMode) error) *MockorderServiceInterfaceAcceptOrderWithItemsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceAcceptOrderWithItemsCall) DoAndReturn(f func(context.Context, int64, int64, int64, time.Time, []model.OrderItem, *model.PackageType, *model.WrapperType, model.PaymentMode) error) *MockorderServiceInterfaceAcceptOrderWithItemsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ClearDatabase mocks base method.
+func (m *MockorderServiceInterface) ClearDatabase(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearDatabase", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearDatabase indicates an expected call of ClearDatabase.
+func (mr *MockorderServiceInterfaceMockRecorder) ClearDatabase(ctx any) *MockorderServiceInterfaceClearDatabaseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearDatabase", reflect.TypeOf((*MockorderServiceInterface)(nil).ClearDatabase), ctx)
+	return &MockorderServiceInterfaceClearDatabaseCall{Call: call}
+}
+
+// MockorderServiceInterfaceClearDatabaseCall wrap *gomock.Call
+type MockorderServiceInterfaceClearDatabaseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceClearDatabaseCall) Return(arg0 error) *MockorderServiceInterfaceClearDatabaseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceClearDatabaseCall) Do(f func(context.Context) error) *MockorderServiceInterfaceClearDatabaseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceClearDatabaseCall) DoAndReturn(f func(context.Context) error) *MockorderServiceInterfaceClearDatabaseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeliverOrder mocks base method.
+func (m *MockorderServiceInterface) DeliverOrder(ctx context.Context, id, version, customerID int64, itemIDs []int64, payment *model.PaymentDetails, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverOrder", ctx, id, version, customerID, itemIDs, payment, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeliverOrder indicates an expected call of DeliverOrder.
+func (mr *MockorderServiceInterfaceMockRecorder) DeliverOrder(ctx, id, version, customerID, itemIDs, payment, now any) *MockorderServiceInterfaceDeliverOrderCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverOrder", reflect.TypeOf((*MockorderServiceInterface)(nil).DeliverOrder), ctx, id, version, customerID, itemIDs, payment, now)
+	return &MockorderServiceInterfaceDeliverOrderCall{Call: call}
+}
+
+// MockorderServiceInterfaceDeliverOrderCall wrap *gomock.Call
+type MockorderServiceInterfaceDeliverOrderCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceDeliverOrderCall) Return(arg0 error) *MockorderServiceInterfaceDeliverOrderCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceDeliverOrderCall) Do(f func(context.Context, int64, int64, int64, []int64, *model.PaymentDetails, time.Time) error) *MockorderServiceInterfaceDeliverOrderCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceDeliverOrderCall) DoAndReturn(f func(context.Context, int64, int64, int64, []int64, *model.PaymentDetails, time.Time) error) *MockorderServiceInterfaceDeliverOrderCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeliverOrders mocks base method.
+func (m *MockorderServiceInterface) DeliverOrders(ctx context.Context, ids []int64, customerID int64, itemIDs []int64, payment *model.PaymentDetails, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverOrders", ctx, ids, customerID, itemIDs, payment, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeliverOrders indicates an expected call of DeliverOrders.
+func (mr *MockorderServiceInterfaceMockRecorder) DeliverOrders(ctx, ids, customerID, itemIDs, payment, now any) *MockorderServiceInterfaceDeliverOrdersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverOrders", reflect.TypeOf((*MockorderServiceInterface)(nil).DeliverOrders), ctx, ids, customerID, itemIDs, payment, now)
+	return &MockorderServiceInterfaceDeliverOrdersCall{Call: call}
+}
+
+// MockorderServiceInterfaceDeliverOrdersCall wrap *gomock.Call
+type MockorderServiceInterfaceDeliverOrdersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceDeliverOrdersCall) Return(arg0 error) *MockorderServiceInterfaceDeliverOrdersCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceDeliverOrdersCall) Do(f func(context.Context, []int64, int64, []int64, *model.PaymentDetails, time.Time) error) *MockorderServiceInterfaceDeliverOrdersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceDeliverOrdersCall) DoAndReturn(f func(context.Context, []int64, int64, []int64, *model.PaymentDetails, time.Time) error) *MockorderServiceInterfaceDeliverOrdersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ExportOrders mocks base method.
+func (m *MockorderServiceInterface) ExportOrders(ctx context.Context, filter model.OrderFilter) (service.OrderExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportOrders", ctx, filter)
+	ret0, _ := ret[0].(service.OrderExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportOrders indicates an expected call of ExportOrders.
+func (mr *MockorderServiceInterfaceMockRecorder) ExportOrders(ctx, filter any) *MockorderServiceInterfaceExportOrdersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportOrders", reflect.TypeOf((*MockorderServiceInterface)(nil).ExportOrders), ctx, filter)
+	return &MockorderServiceInterfaceExportOrdersCall{Call: call}
+}
+
+// MockorderServiceInterfaceExportOrdersCall wrap *gomock.Call
+type MockorderServiceInterfaceExportOrdersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceExportOrdersCall) Return(arg0 service.OrderExport, arg1 error) *MockorderServiceInterfaceExportOrdersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceExportOrdersCall) Do(f func(context.Context, model.OrderFilter) (service.OrderExport, error)) *MockorderServiceInterfaceExportOrdersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceExportOrdersCall) DoAndReturn(f func(context.Context, model.OrderFilter) (service.OrderExport, error)) *MockorderServiceInterfaceExportOrdersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ExtendStorage mocks base method.
+func (m *MockorderServiceInterface) ExtendStorage(ctx context.Context, id, version int64, days int) (model.Order, model.OrderExtension, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendStorage", ctx, id, version, days)
+	ret0, _ := ret[0].(model.Order)
+	ret1, _ := ret[1].(model.OrderExtension)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ExtendStorage indicates an expected call of ExtendStorage.
+func (mr *MockorderServiceInterfaceMockRecorder) ExtendStorage(ctx, id, version, days any) *MockorderServiceInterfaceExtendStorageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendStorage", reflect.TypeOf((*MockorderServiceInterface)(nil).ExtendStorage), ctx, id, version, days)
+	return &MockorderServiceInterfaceExtendStorageCall{Call: call}
+}
+
+// MockorderServiceInterfaceExtendStorageCall wrap *gomock.Call
+type MockorderServiceInterfaceExtendStorageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceExtendStorageCall) Return(arg0 model.Order, arg1 model.OrderExtension, arg2 error) *MockorderServiceInterfaceExtendStorageCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceExtendStorageCall) Do(f func(context.Context, int64, int64, int) (model.Order, model.OrderExtension, error)) *MockorderServiceInterfaceExtendStorageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceExtendStorageCall) DoAndReturn(f func(context.Context, int64, int64, int) (model.Order, model.OrderExtension, error)) *MockorderServiceInterfaceExtendStorageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetOrderByID mocks base method.
+func (m *MockorderServiceInterface) GetOrderByID(ctx context.Context, id int64) (model.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderByID", ctx, id)
+	ret0, _ := ret[0].(model.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderByID indicates an expected call of GetOrderByID.
+func (mr *MockorderServiceInterfaceMockRecorder) GetOrderByID(ctx, id any) *MockorderServiceInterfaceGetOrderByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderByID", reflect.TypeOf((*MockorderServiceInterface)(nil).GetOrderByID), ctx, id)
+	return &MockorderServiceInterfaceGetOrderByIDCall{Call: call}
+}
+
+// MockorderServiceInterfaceGetOrderByIDCall wrap *gomock.Call
+type MockorderServiceInterfaceGetOrderByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceGetOrderByIDCall) Return(arg0 model.Order, arg1 error) *MockorderServiceInterfaceGetOrderByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceGetOrderByIDCall) Do(f func(context.Context, int64) (model.Order, error)) *MockorderServiceInterfaceGetOrderByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceGetOrderByIDCall) DoAndReturn(f func(context.Context, int64) (model.Order, error)) *MockorderServiceInterfaceGetOrderByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetOrderPayments mocks base method.
+func (m *MockorderServiceInterface) GetOrderPayments(ctx context.Context, id int64) (model.OrderPayments, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderPayments", ctx, id)
+	ret0, _ := ret[0].(model.OrderPayments)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderPayments indicates an expected call of GetOrderPayments.
+func (mr *MockorderServiceInterfaceMockRecorder) GetOrderPayments(ctx, id any) *MockorderServiceInterfaceGetOrderPaymentsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderPayments", reflect.TypeOf((*MockorderServiceInterface)(nil).GetOrderPayments), ctx, id)
+	return &MockorderServiceInterfaceGetOrderPaymentsCall{Call: call}
+}
+
+// MockorderServiceInterfaceGetOrderPaymentsCall wrap *gomock.Call
+type MockorderServiceInterfaceGetOrderPaymentsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceGetOrderPaymentsCall) Return(arg0 model.OrderPayments, arg1 error) *MockorderServiceInterfaceGetOrderPaymentsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceGetOrderPaymentsCall) Do(f func(context.Context, int64) (model.OrderPayments, error)) *MockorderServiceInterfaceGetOrderPaymentsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceGetOrderPaymentsCall) DoAndReturn(f func(context.Context, int64) (model.OrderPayments, error)) *MockorderServiceInterfaceGetOrderPaymentsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ImportOrders mocks base method.
+func (m *MockorderServiceInterface) ImportOrders(ctx context.Context, file model.ImportFile, options model.ImportOptions) (model.ImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportOrders", ctx, file, options)
+	ret0, _ := ret[0].(model.ImportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportOrders indicates an expected call of ImportOrders.
+func (mr *MockorderServiceInterfaceMockRecorder) ImportOrders(ctx, file, options any) *MockorderServiceInterfaceImportOrdersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportOrders", reflect.TypeOf((*MockorderServiceInterface)(nil).ImportOrders), ctx, file, options)
+	return &MockorderServiceInterfaceImportOrdersCall{Call: call}
+}
+
+// MockorderServiceInterfaceImportOrdersCall wrap *gomock.Call
+type MockorderServiceInterfaceImportOrdersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceImportOrdersCall) Return(arg0 model.ImportResult, arg1 error) *MockorderServiceInterfaceImportOrdersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceImportOrdersCall) Do(f func(context.Context, model.ImportFile, model.ImportOptions) (model.ImportResult, error)) *MockorderServiceInterfaceImportOrdersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceImportOrdersCall) DoAndReturn(f func(context.Context, model.ImportFile, model.ImportOptions) (model.ImportResult, error)) *MockorderServiceInterfaceImportOrdersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ImportRecords mocks base method.
+func (m *MockorderServiceInterface) ImportRecords(ctx context.Context, orders []importer.Record, options model.ImportOptions) (model.ImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportRecords", ctx, orders, options)
+	ret0, _ := ret[0].(model.ImportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportRecords indicates an expected call of ImportRecords.
+func (mr *MockorderServiceInterfaceMockRecorder) ImportRecords(ctx, orders, options any) *MockorderServiceInterfaceImportRecordsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportRecords", reflect.TypeOf((*MockorderServiceInterface)(nil).ImportRecords), ctx, orders, options)
+	return &MockorderServiceInterfaceImportRecordsCall{Call: call}
+}
+
+// MockorderServiceInterfaceImportRecordsCall wrap *gomock.Call
+type MockorderServiceInterfaceImportRecordsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceImportRecordsCall) Return(arg0 model.ImportResult, arg1 error) *MockorderServiceInterfaceImportRecordsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceImportRecordsCall) Do(f func(context.Context, []importer.Record, model.ImportOptions) (model.ImportResult, error)) *MockorderServiceInterfaceImportRecordsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceImportRecordsCall) DoAndReturn(f func(context.Context, []importer.Record, model.ImportOptions) (model.ImportResult, error)) *MockorderServiceInterfaceImportRecordsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListCourierReturnsWithCursor mocks base method.
+func (m *MockorderServiceInterface) ListCourierReturnsWithCursor(ctx context.Context, cursorID int64, limit int) ([]model.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCourierReturnsWithCursor", ctx, cursorID, limit)
+	ret0, _ := ret[0].([]model.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCourierReturnsWithCursor indicates an expected call of ListCourierReturnsWithCursor.
+func (mr *MockorderServiceInterfaceMockRecorder) ListCourierReturnsWithCursor(ctx, cursorID, limit any) *MockorderServiceInterfaceListCourierReturnsWithCursorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCourierReturnsWithCursor", reflect.TypeOf((*MockorderServiceInterface)(nil).ListCourierReturnsWithCursor), ctx, cursorID, limit)
+	return &MockorderServiceInterfaceListCourierReturnsWithCursorCall{Call: call}
+}
+
+// MockorderServiceInterfaceListCourierReturnsWithCursorCall wrap *gomock.Call
+type MockorderServiceInterfaceListCourierReturnsWithCursorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceListCourierReturnsWithCursorCall) Return(arg0 []model.Order, arg1 error) *MockorderServiceInterfaceListCourierReturnsWithCursorCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceListCourierReturnsWithCursorCall) Do(f func(context.Context, int64, int) ([]model.Order, error)) *MockorderServiceInterfaceListCourierReturnsWithCursorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceListCourierReturnsWithCursorCall) DoAndReturn(f func(context.Context, int64, int) ([]model.Order, error)) *MockorderServiceInterfaceListCourierReturnsWithCursorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListOrdersWithCursor mocks base method.
+func (m *MockorderServiceInterface) ListOrdersWithCursor(ctx context.Context, cursorID int64, limit int, customerID int64, filterPVZ bool, searchTerm string) ([]model.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrdersWithCursor", ctx, cursorID, limit, customerID, filterPVZ, searchTerm)
+	ret0, _ := ret[0].([]model.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrdersWithCursor indicates an expected call of ListOrdersWithCursor.
+func (mr *MockorderServiceInterfaceMockRecorder) ListOrdersWithCursor(ctx, cursorID, limit, customerID, filterPVZ, searchTerm any) *MockorderServiceInterfaceListOrdersWithCursorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrdersWithCursor", reflect.TypeOf((*MockorderServiceInterface)(nil).ListOrdersWithCursor), ctx, cursorID, limit, customerID, filterPVZ, searchTerm)
+	return &MockorderServiceInterfaceListOrdersWithCursorCall{Call: call}
+}
+
+// MockorderServiceInterfaceListOrdersWithCursorCall wrap *gomock.Call
+type MockorderServiceInterfaceListOrdersWithCursorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceListOrdersWithCursorCall) Return(arg0 []model.Order, arg1 error) *MockorderServiceInterfaceListOrdersWithCursorCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceListOrdersWithCursorCall) Do(f func(context.Context, int64, int, int64, bool, string) ([]model.Order, error)) *MockorderServiceInterfaceListOrdersWithCursorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceListOrdersWithCursorCall) DoAndReturn(f func(context.Context, int64, int, int64, bool, string) ([]model.Order, error)) *MockorderServiceInterfaceListOrdersWithCursorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListReturnsWithCursor mocks base method.
+func (m *MockorderServiceInterface) ListReturnsWithCursor(ctx context.Context, cursorID int64, limit int, searchTerm string, filter model.ReturnFilter) ([]model.ReturnedOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReturnsWithCursor", ctx, cursorID, limit, searchTerm, filter)
+	ret0, _ := ret[0].([]model.ReturnedOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReturnsWithCursor indicates an expected call of ListReturnsWithCursor.
+func (mr *MockorderServiceInterfaceMockRecorder) ListReturnsWithCursor(ctx, cursorID, limit, searchTerm, filter any) *MockorderServiceInterfaceListReturnsWithCursorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReturnsWithCursor", reflect.TypeOf((*MockorderServiceInterface)(nil).ListReturnsWithCursor), ctx, cursorID, limit, searchTerm, filter)
+	return &MockorderServiceInterfaceListReturnsWithCursorCall{Call: call}
+}
+
+// MockorderServiceInterfaceListReturnsWithCursorCall wrap *gomock.Call
+type MockorderServiceInterfaceListReturnsWithCursorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceListReturnsWithCursorCall) Return(arg0 []model.ReturnedOrder, arg1 error) *MockorderServiceInterfaceListReturnsWithCursorCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceListReturnsWithCursorCall) Do(f func(context.Context, int64, int, string, model.ReturnFilter) ([]model.ReturnedOrder, error)) *MockorderServiceInterfaceListReturnsWithCursorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceListReturnsWithCursorCall) DoAndReturn(f func(context.Context, int64, int, string, model.ReturnFilter) ([]model.ReturnedOrder, error)) *MockorderServiceInterfaceListReturnsWithCursorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LocateOrder mocks base method.
+func (m *MockorderServiceInterface) LocateOrder(ctx context.Context, id int64) (model.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LocateOrder", ctx, id)
+	ret0, _ := ret[0].(model.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LocateOrder indicates an expected call of LocateOrder.
+func (mr *MockorderServiceInterfaceMockRecorder) LocateOrder(ctx, id any) *MockorderServiceInterfaceLocateOrderCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LocateOrder", reflect.TypeOf((*MockorderServiceInterface)(nil).LocateOrder), ctx, id)
+	return &MockorderServiceInterfaceLocateOrderCall{Call: call}
+}
+
+// MockorderServiceInterfaceLocateOrderCall wrap *gomock.Call
+type MockorderServiceInterfaceLocateOrderCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceLocateOrderCall) Return(arg0 model.StorageCell, arg1 error) *MockorderServiceInterfaceLocateOrderCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceLocateOrderCall) Do(f func(context.Context, int64) (model.StorageCell, error)) *MockorderServiceInterfaceLocateOrderCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceLocateOrderCall) DoAndReturn(f func(context.Context, int64) (model.StorageCell, error)) *MockorderServiceInterfaceLocateOrderCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// OrderHistory mocks base method.
+func (m *MockorderServiceInterface) OrderHistory(ctx context.Context, searchTerm string) ([]model.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderHistory", ctx, searchTerm)
+	ret0, _ := ret[0].([]model.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrderHistory indicates an expected call of OrderHistory.
+func (mr *MockorderServiceInterfaceMockRecorder) OrderHistory(ctx, searchTerm any) *MockorderServiceInterfaceOrderHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderHistory", reflect.TypeOf((*MockorderServiceInterface)(nil).OrderHistory), ctx, searchTerm)
+	return &MockorderServiceInterfaceOrderHistoryCall{Call: call}
+}
+
+// MockorderServiceInterfaceOrderHistoryCall wrap *gomock.Call
+type MockorderServiceInterfaceOrderHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceOrderHistoryCall) Return(arg0 []model.Order, arg1 error) *MockorderServiceInterfaceOrderHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceOrderHistoryCall) Do(f func(context.Context, string) ([]model.Order, error)) *MockorderServiceInterfaceOrderHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceOrderHistoryCall) DoAndReturn(f func(context.Context, string) ([]model.Order, error)) *MockorderServiceInterfaceOrderHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// OrderTimeline mocks base method.
+func (m *MockorderServiceInterface) OrderTimeline(ctx context.Context, id int64) ([]model.OrderStateTransition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderTimeline", ctx, id)
+	ret0, _ := ret[0].([]model.OrderStateTransition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrderTimeline indicates an expected call of OrderTimeline.
+func (mr *MockorderServiceInterfaceMockRecorder) OrderTimeline(ctx, id any) *MockorderServiceInterfaceOrderTimelineCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderTimeline", reflect.TypeOf((*MockorderServiceInterface)(nil).OrderTimeline), ctx, id)
+	return &MockorderServiceInterfaceOrderTimelineCall{Call: call}
+}
+
+// MockorderServiceInterfaceOrderTimelineCall wrap *gomock.Call
+type MockorderServiceInterfaceOrderTimelineCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceOrderTimelineCall) Return(arg0 []model.OrderStateTransition, arg1 error) *MockorderServiceInterfaceOrderTimelineCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceOrderTimelineCall) Do(f func(context.Context, int64) ([]model.OrderStateTransition, error)) *MockorderServiceInterfaceOrderTimelineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceOrderTimelineCall) DoAndReturn(f func(context.Context, int64) ([]model.OrderStateTransition, error)) *MockorderServiceInterfaceOrderTimelineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ProcessReturnOrder mocks base method.
+func (m *MockorderServiceInterface) ProcessReturnOrder(ctx context.Context, id, version, customerID int64, itemIDs []int64, details model.ReturnDetails, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessReturnOrder", ctx, id, version, customerID, itemIDs, details, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessReturnOrder indicates an expected call of ProcessReturnOrder.
+func (mr *MockorderServiceInterfaceMockRecorder) ProcessReturnOrder(ctx, id, version, customerID, itemIDs, details, now any) *MockorderServiceInterfaceProcessReturnOrderCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessReturnOrder", reflect.TypeOf((*MockorderServiceInterface)(nil).ProcessReturnOrder), ctx, id, version, customerID, itemIDs, details, now)
+	return &MockorderServiceInterfaceProcessReturnOrderCall{Call: call}
+}
+
+// MockorderServiceInterfaceProcessReturnOrderCall wrap *gomock.Call
+type MockorderServiceInterfaceProcessReturnOrderCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceProcessReturnOrderCall) Return(arg0 error) *MockorderServiceInterfaceProcessReturnOrderCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceProcessReturnOrderCall) Do(f func(context.Context, int64, int64, int64, []int64, model.ReturnDetails, time.Time) error) *MockorderServiceInterfaceProcessReturnOrderCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceProcessReturnOrderCall) DoAndReturn(f func(context.Context, int64, int64, int64, []int64, model.ReturnDetails, time.Time) error) *MockorderServiceInterfaceProcessReturnOrderCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ProcessReturnOrders mocks base method.
+func (m *MockorderServiceInterface) ProcessReturnOrders(ctx context.Context, ids []int64, customerID int64, itemIDs []int64, details model.ReturnDetails, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessReturnOrders", ctx, ids, customerID, itemIDs, details, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessReturnOrders indicates an expected call of ProcessReturnOrders.
+func (mr *MockorderServiceInterfaceMockRecorder) ProcessReturnOrders(ctx, ids, customerID, itemIDs, details, now any) *MockorderServiceInterfaceProcessReturnOrdersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessReturnOrders", reflect.TypeOf((*MockorderServiceInterface)(nil).ProcessReturnOrders), ctx, ids, customerID, itemIDs, details, now)
+	return &MockorderServiceInterfaceProcessReturnOrdersCall{Call: call}
+}
+
+// MockorderServiceInterfaceProcessReturnOrdersCall wrap *gomock.Call
+type MockorderServiceInterfaceProcessReturnOrdersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceProcessReturnOrdersCall) Return(arg0 error) *MockorderServiceInterfaceProcessReturnOrdersCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceProcessReturnOrdersCall) Do(f func(context.Context, []int64, int64, []int64, model.ReturnDetails, time.Time) error) *MockorderServiceInterfaceProcessReturnOrdersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceProcessReturnOrdersCall) DoAndReturn(f func(context.Context, []int64, int64, []int64, model.ReturnDetails, time.Time) error) *MockorderServiceInterfaceProcessReturnOrdersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnOrderToCourier mocks base method.
+func (m *MockorderServiceInterface) ReturnOrderToCourier(ctx context.Context, id, version int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReturnOrderToCourier", ctx, id, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReturnOrderToCourier indicates an expected call of ReturnOrderToCourier.
+func (mr *MockorderServiceInterfaceMockRecorder) ReturnOrderToCourier(ctx, id, version any) *MockorderServiceInterfaceReturnOrderToCourierCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnOrderToCourier", reflect.TypeOf((*MockorderServiceInterface)(nil).ReturnOrderToCourier), ctx, id, version)
+	return &MockorderServiceInterfaceReturnOrderToCourierCall{Call: call}
+}
+
+// MockorderServiceInterfaceReturnOrderToCourierCall wrap *gomock.Call
+type MockorderServiceInterfaceReturnOrderToCourierCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceReturnOrderToCourierCall) Return(arg0 error) *MockorderServiceInterfaceReturnOrderToCourierCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceReturnOrderToCourierCall) Do(f func(context.Context, int64, int64) error) *MockorderServiceInterfaceReturnOrderToCourierCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceReturnOrderToCourierCall) DoAndReturn(f func(context.Context, int64, int64) error) *MockorderServiceInterfaceReturnOrderToCourierCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockimportJobService is a mock of importJobService interface.
+type MockimportJobService struct {
+	ctrl     *gomock.Controller
+	recorder *MockimportJobServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockimportJobServiceMockRecorder is the mock recorder for MockimportJobService.
+type MockimportJobServiceMockRecorder struct {
+	mock *MockimportJobService
+}
+
+// NewMockimportJobService creates a new mock instance.
+func NewMockimportJobService(ctrl *gomock.Controller) *MockimportJobService {
+	mock := &MockimportJobService{ctrl: ctrl}
+	mock.recorder = &MockimportJobServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimportJobService) EXPECT() *MockimportJobServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockimportJobService) Get(ctx context.Context, id int64) (model.ImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(model.ImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockimportJobServiceMockRecorder) Get(ctx, id any) *MockimportJobServiceGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockimportJobService)(nil).Get), ctx, id)
+	return &MockimportJobServiceGetCall{Call: call}
+}
+
+// MockimportJobServiceGetCall wrap *gomock.Call
+type MockimportJobServiceGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockimportJobServiceGetCall) Return(arg0 model.ImportJob, arg1 error) *MockimportJobServiceGetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockimportJobServiceGetCall) Do(f func(context.Context, int64) (model.ImportJob, error)) *MockimportJobServiceGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockimportJobServiceGetCall) DoAndReturn(f func(context.Context, int64) (model.ImportJob, error)) *MockimportJobServiceGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Submit mocks base method.
+func (m *MockimportJobService) Submit(ctx context.Context, file model.ImportFile, options model.ImportOptions) (model.ImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Submit", ctx, file, options)
+	ret0, _ := ret[0].(model.ImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Submit indicates an expected call of Submit.
+func (mr *MockimportJobServiceMockRecorder) Submit(ctx, file, options any) *MockimportJobServiceSubmitCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit", reflect.TypeOf((*MockimportJobService)(nil).Submit), ctx, file, options)
+	return &MockimportJobServiceSubmitCall{Call: call}
+}
+
+// MockimportJobServiceSubmitCall wrap *gomock.Call
+type MockimportJobServiceSubmitCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockimportJobServiceSubmitCall) Return(arg0 model.ImportJob, arg1 error) *MockimportJobServiceSubmitCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockimportJobServiceSubmitCall) Do(f func(context.Context, model.ImportFile, model.ImportOptions) (model.ImportJob, error)) *MockimportJobServiceSubmitCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockimportJobServiceSubmitCall) DoAndReturn(f func(context.Context, model.ImportFile, model.ImportOptions) (model.ImportJob, error)) *MockimportJobServiceSubmitCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -1,55 +1,90 @@
 package grpc
 
 import (
+	"bufio"
 	"context"
+	"errors"
 	"fmt"
-	"os"
-	"path/filepath"
+	"io"
 	"time"
 
+	"gitlab.ozon.dev/gojhw1/pkg/exporter"
 	pb "gitlab.ozon.dev/gojhw1/pkg/gen/proto"
-	"gitlab.ozon.dev/gojhw1/pkg/logger"
+	"gitlab.ozon.dev/gojhw1/pkg/importer"
 	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/service"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+const (
+	// importJobWatchInterval - как часто проверяется ход отслеживаемой задачи импорта
+	importJobWatchInterval = time.Second
+	// maxImportStreamSize - максимальный размер файла, переданного частями в ImportOrders
+	maxImportStreamSize = 64 << 20
+	// maxImportStreamOrders - максимальное количество заказов, переданных потоком в ImportOrders
+	maxImportStreamOrders = 100000
+	// exportChunkSize - размер части файла выгрузки в одном сообщении ExportOrders
+	exportChunkSize = 64 << 10
+)
+
 // OrderRPCHandler реализует gRPC сервис для работы с заказами
 type OrderRPCHandler struct {
 	pb.UnimplementedOrderRPCHandlerServer
 	orderRPCHandler orderServiceInterface
+	importJobs      importJobService
 }
 
 // orderServiceInterface описывает интерфейс сервиса для работы с заказами
 type orderServiceInterface interface {
-	AcceptOrder(ctx context.Context, id, customerID int64, deadline time.Time, weight, cost float64, packageType *model.PackageType, wrapper *model.WrapperType) error
-	ReturnOrderToCourier(ctx context.Context, id int64) error
-	DeliverOrder(ctx context.Context, id, customerID int64, now time.Time) error
-	ProcessReturnOrder(ctx context.Context, id, customerID int64, now time.Time) error
+	AcceptOrder(ctx context.Context, id, customerID, pickupPointID int64, deadline time.Time, weight, cost float64, packageType *model.PackageType, wrapper *model.WrapperType, paymentMode model.PaymentMode) error
+	AcceptOrderWithItems(ctx context.Context, id, customerID, pickupPointID int64, deadline time.Time, items []model.OrderItem, packageType *model.PackageType, wrapper *model.WrapperType, paymentMode model.PaymentMode) error
+	ReturnOrderToCourier(ctx context.Context, id, version int64) error
+	ExtendStorage(ctx context.Context, id, version int64, days int) (model.Order, model.OrderExtension, error)
+	DeliverOrder(ctx context.Context, id, version, customerID int64, itemIDs []int64, payment *model.PaymentDetails, now time.Time) error
+	ProcessReturnOrder(ctx context.Context, id, version, customerID int64, itemIDs []int64, details model.ReturnDetails, now time.Time) error
+	DeliverOrders(ctx context.Context, ids []int64, customerID int64, itemIDs []int64, payment *model.PaymentDetails, now time.Time) error
+	ProcessReturnOrders(ctx context.Context, ids []int64, customerID int64, itemIDs []int64, details model.ReturnDetails, now time.Time) error
 	OrderHistory(ctx context.Context, searchTerm string) ([]model.Order, error)
-	AcceptOrdersFromFile(ctx context.Context, filename string) error
+	ImportOrders(ctx context.Context, file model.ImportFile, options model.ImportOptions) (model.ImportResult, error)
+	ImportRecords(ctx context.Context, orders []importer.Record, options model.ImportOptions) (model.ImportResult, error)
 	GetOrderByID(ctx context.Context, id int64) (model.Order, error)
+	LocateOrder(ctx context.Context, id int64) (model.StorageCell, error)
+	OrderTimeline(ctx context.Context, id int64) ([]model.OrderStateTransition, error)
+	GetOrderPayments(ctx context.Context, id int64) (model.OrderPayments, error)
 	ClearDatabase(ctx context.Context) error
 	ListOrdersWithCursor(ctx context.Context, cursorID int64, limit int, customerID int64, filterPVZ bool, searchTerm string) ([]model.Order, error)
-	ListReturnsWithCursor(ctx context.Context, cursorID int64, limit int, searchTerm string) ([]model.Order, error)
+	ListReturnsWithCursor(ctx context.Context, cursorID int64, limit int, searchTerm string, filter model.ReturnFilter) ([]model.ReturnedOrder, error)
+	ListCourierReturnsWithCursor(ctx context.Context, cursorID int64, limit int) ([]model.Order, error)
+	ExportOrders(ctx context.Context, filter model.OrderFilter) (service.OrderExport, error)
+}
+
+// importJobService описывает интерфейс сервиса фоновых задач импорта заказов
+type importJobService interface {
+	Submit(ctx context.Context, file model.ImportFile, options model.ImportOptions) (model.ImportJob, error)
+	Get(ctx context.Context, id int64) (model.ImportJob, error)
 }
 
 // NewOrderRPCHandler создает новый экземпляр OrderRPCHandler
-func NewOrderRPCHandler(orderRPCHandler orderServiceInterface) *OrderRPCHandler {
+func NewOrderRPCHandler(orderRPCHandler orderServiceInterface, importJobs importJobService) *OrderRPCHandler {
 	return &OrderRPCHandler{
 		orderRPCHandler: orderRPCHandler,
+		importJobs:      importJobs,
 	}
 }
 
 // CreateOrder создает новый заказ
 func (s *OrderRPCHandler) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*pb.Order, error) {
-	if req.GetWeight() <= 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "вес должен быть больше 0")
-	}
+	// Вес и стоимость заказа с товарами считаются по товарам и проверяются в сервисе
+	if len(req.GetItems()) == 0 {
+		if req.GetWeight() <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "вес должен быть больше 0")
+		}
 
-	if req.GetCost() <= 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "стоимость должна быть больше 0")
+		if req.GetCost() <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "стоимость должна быть больше 0")
+		}
 	}
 
 	deadline, err := parseDeadline(req.GetDeadlineAt())
@@ -60,16 +95,32 @@ func (s *OrderRPCHandler) CreateOrder(ctx context.Context, req *pb.CreateOrderRe
 	packageType := packageTypeFromProto(req.GetPackageType())
 	wrapper := wrapperTypeFromProto(req.GetWrapper())
 
-	err = s.orderRPCHandler.AcceptOrder(
-		ctx,
-		req.GetId(),
-		req.GetCustomerId(),
-		deadline,
-		req.GetWeight(),
-		req.GetCost(),
-		packageType,
-		wrapper,
-	)
+	if len(req.GetItems()) > 0 {
+		err = s.orderRPCHandler.AcceptOrderWithItems(
+			ctx,
+			req.GetId(),
+			req.GetCustomerId(),
+			req.GetPickupPointId(),
+			deadline,
+			orderItemsFromProto(req.GetItems()),
+			packageType,
+			wrapper,
+			model.PaymentMode(req.GetPaymentMode()),
+		)
+	} else {
+		err = s.orderRPCHandler.AcceptOrder(
+			ctx,
+			req.GetId(),
+			req.GetCustomerId(),
+			req.GetPickupPointId(),
+			deadline,
+			req.GetWeight(),
+			req.GetCost(),
+			packageType,
+			wrapper,
+			model.PaymentMode(req.GetPaymentMode()),
+		)
+	}
 
 	if err != nil {
 		return nil, parseGRPCError(err)
@@ -97,13 +148,49 @@ func (s *OrderRPCHandler) GetOrder(ctx context.Context, req *pb.GetOrderRequest)
 	return convertModelOrderToProto(order), nil
 }
 
+// OrderTimeline возвращает историю смены статусов заказа
+func (s *OrderRPCHandler) OrderTimeline(ctx context.Context, req *pb.OrderTimelineRequest) (*pb.OrderTimelineResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID заказа должен быть положительным числом")
+	}
+
+	transitions, err := s.orderRPCHandler.OrderTimeline(ctx, req.GetId())
+	if err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	pbTransitions := make([]*pb.OrderStateTransition, 0, len(transitions))
+	for _, transition := range transitions {
+		pbTransitions = append(pbTransitions, convertModelTransitionToProto(transition))
+	}
+
+	return &pb.OrderTimelineResponse{
+		OrderId:     req.GetId(),
+		Transitions: pbTransitions,
+	}, nil
+}
+
+// GetOrderPayments возвращает оплату заказа и возвраты денег по нему
+func (s *OrderRPCHandler) GetOrderPayments(ctx context.Context, req *pb.GetOrderPaymentsRequest) (*pb.OrderPayments, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID заказа должен быть положительным числом")
+	}
+
+	payments, err := s.orderRPCHandler.GetOrderPayments(ctx, req.GetId())
+	if err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	return convertModelOrderPaymentsToProto(payments), nil
+}
+
 // ReturnToCourier обрабатывает возврат заказа курьеру
 func (s *OrderRPCHandler) ReturnToCourier(ctx context.Context, req *pb.ReturnToCourierRequest) (*pb.ReturnToCourierResponse, error) {
 	if req.GetId() <= 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "ID заказа должен быть положительным числом")
 	}
 
-	err := s.orderRPCHandler.ReturnOrderToCourier(ctx, req.GetId())
+	err := s.orderRPCHandler.ReturnOrderToCourier(ctx, req.GetId(), req.GetVersion())
 	if err != nil {
 		return nil, parseGRPCError(err)
 	}
@@ -113,7 +200,26 @@ func (s *OrderRPCHandler) ReturnToCourier(ctx context.Context, req *pb.ReturnToC
 	}, nil
 }
 
-// ProcessCustomer обрабатывает действия с заказами для указанного клиента
+// ExtendStorage продлевает срок хранения заказа по просьбе клиента
+func (s *OrderRPCHandler) ExtendStorage(ctx context.Context, req *pb.ExtendStorageRequest) (*pb.ExtendStorageResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID заказа должен быть положительным числом")
+	}
+
+	order, extension, err := s.orderRPCHandler.ExtendStorage(ctx, req.GetId(), req.GetVersion(), int(req.GetDays()))
+	if err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	return &pb.ExtendStorageResponse{
+		Order:     convertModelOrderToProto(order),
+		Extension: convertModelExtensionToProto(extension),
+	}, nil
+}
+
+// ProcessCustomer обрабатывает действия с заказами для указанного клиента.
+// При atomic все заказы обрабатываются в одной транзакции, и ошибка любого из них возвращается как ошибка вызова.
+// Если указана версия, заказ в запросе должен быть один, и расхождение версии тоже возвращается как ошибка вызова.
 func (s *OrderRPCHandler) ProcessCustomer(ctx context.Context, req *pb.ProcessCustomerRequest) (*pb.ProcessCustomerResponse, error) {
 	if req.GetCustomerId() <= 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "ID клиента должен быть положительным числом")
@@ -123,17 +229,66 @@ func (s *OrderRPCHandler) ProcessCustomer(ctx context.Context, req *pb.ProcessCu
 		return nil, status.Errorf(codes.InvalidArgument, "неизвестное действие")
 	}
 
+	// Без atomic заказы выдаются по одному, и оплата целиком досталась бы каждому из них
+	if req.GetAction() == "handout" && req.GetPayment() != nil && !req.GetAtomic() && len(req.GetOrderIds()) > 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "оплату выдачи нескольких заказов можно провести только с atomic")
+	}
+
+	if req.GetVersion() > 0 && len(req.GetOrderIds()) != 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "версию заказа можно указать только при обработке одного заказа")
+	}
+
 	now := time.Now()
+	details := model.ReturnDetails{
+		Reason:    model.ReturnReason(req.GetReason()),
+		Comment:   req.GetComment(),
+		Condition: model.ReturnCondition(req.GetCondition()),
+		Photos:    req.GetPhotos(),
+	}
+	payment := paymentDetailsFromProto(req.GetPayment())
 	results := make([]*pb.ProcessingResult, 0, len(req.GetOrderIds()))
 
+	if req.GetAtomic() || req.GetVersion() > 0 {
+		var err error
+
+		switch {
+		case req.GetVersion() > 0 && req.GetAction() == "handout":
+			err = s.orderRPCHandler.DeliverOrder(ctx, req.GetOrderIds()[0], req.GetVersion(), req.GetCustomerId(), req.GetItemIds(), payment, now)
+		case req.GetVersion() > 0 && req.GetAction() == "return":
+			err = s.orderRPCHandler.ProcessReturnOrder(ctx, req.GetOrderIds()[0], req.GetVersion(), req.GetCustomerId(), req.GetItemIds(), details, now)
+		case req.GetAction() == "handout":
+			err = s.orderRPCHandler.DeliverOrders(ctx, req.GetOrderIds(), req.GetCustomerId(), req.GetItemIds(), payment, now)
+		case req.GetAction() == "return":
+			err = s.orderRPCHandler.ProcessReturnOrders(ctx, req.GetOrderIds(), req.GetCustomerId(), req.GetItemIds(), details, now)
+		}
+
+		if err != nil {
+			return nil, parseGRPCError(err)
+		}
+
+		for _, orderID := range req.GetOrderIds() {
+			results = append(results, &pb.ProcessingResult{
+				OrderId: orderID,
+				Status:  int32(codes.OK),
+				Result: &pb.ProcessingResult_Message{
+					Message: fmt.Sprintf("Заказ ID %d успешно %s клиенту %d", orderID, req.GetAction(), req.GetCustomerId()),
+				},
+			})
+		}
+
+		return &pb.ProcessCustomerResponse{
+			Results: results,
+		}, nil
+	}
+
 	for _, orderID := range req.GetOrderIds() {
 		var err error
 
 		switch req.GetAction() {
 		case "handout":
-			err = s.orderRPCHandler.DeliverOrder(ctx, orderID, req.GetCustomerId(), now)
+			err = s.orderRPCHandler.DeliverOrder(ctx, orderID, 0, req.GetCustomerId(), req.GetItemIds(), payment, now)
 		case "return":
-			err = s.orderRPCHandler.ProcessReturnOrder(ctx, orderID, req.GetCustomerId(), now)
+			err = s.orderRPCHandler.ProcessReturnOrder(ctx, orderID, 0, req.GetCustomerId(), req.GetItemIds(), details, now)
 		}
 
 		result := &pb.ProcessingResult{
@@ -224,6 +379,10 @@ func (s *OrderRPCHandler) ListReturns(ctx context.Context, req *pb.ListReturnsRe
 		req.GetCursorId(),
 		limit+1,
 		req.GetSearchTerm(),
+		model.ReturnFilter{
+			Reason:    model.ReturnReason(req.GetReason()),
+			Condition: model.ReturnCondition(req.GetCondition()),
+		},
 	)
 	if err != nil {
 		return nil, parseGRPCError(err)
@@ -241,12 +400,55 @@ func (s *OrderRPCHandler) ListReturns(ctx context.Context, req *pb.ListReturnsRe
 	}
 
 	protoReturns := make([]*pb.Order, len(returns))
+	details := make([]*pb.OrderReturn, 0, len(returns))
 	for i, ret := range returns {
-		protoReturns[i] = convertModelOrderToProto(ret)
+		protoReturns[i] = convertModelOrderToProto(ret.Order)
+		if ret.Return != nil {
+			details = append(details, convertModelOrderReturnToProto(*ret.Return))
+		}
 	}
 
 	return &pb.ListReturnsResponse{
 		Returns:    protoReturns,
+		Details:    details,
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// ListCourierReturns получает список заказов, которые нужно вернуть курьеру сегодня, с курсорной пагинацией
+func (s *OrderRPCHandler) ListCourierReturns(ctx context.Context, req *pb.ListCourierReturnsRequest) (*pb.ListCourierReturnsResponse, error) {
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	orders, err := s.orderRPCHandler.ListCourierReturnsWithCursor(ctx, req.GetCursorId(), limit+1)
+	if err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	hasMore := len(orders) > limit
+	var nextCursor int64
+
+	if hasMore {
+		orders = orders[:limit]
+	}
+
+	if len(orders) > 0 {
+		nextCursor = orders[len(orders)-1].ID
+	}
+
+	protoOrders := make([]*pb.Order, len(orders))
+	for i, order := range orders {
+		protoOrders[i] = convertModelOrderToProto(order)
+	}
+
+	return &pb.ListCourierReturnsResponse{
+		Orders:     protoOrders,
 		HasMore:    hasMore,
 		NextCursor: nextCursor,
 	}, nil
@@ -271,51 +473,222 @@ func (s *OrderRPCHandler) OrderHistory(ctx context.Context, req *pb.OrderHistory
 	}, nil
 }
 
-// AcceptOrdersFromFile загружает заказы из файла
+// ExportOrders выгружает заказы в файл и передает его частями. Первое сообщение содержит
+// тип содержимого и имя файла, остальные - части файла не больше exportChunkSize.
+// Заказы читаются из БД по мере отправки и не загружаются в память целиком.
+func (s *OrderRPCHandler) ExportOrders(req *pb.ExportOrdersRequest, stream pb.OrderRPCHandler_ExportOrdersServer) error {
+	format, err := exporter.Resolve(req.GetFormat())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	filter, err := convertProtoExportRequestToFilter(req)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	export, err := s.orderRPCHandler.ExportOrders(stream.Context(), filter)
+	if err != nil {
+		return parseGRPCError(err)
+	}
+
+	err = stream.Send(&pb.ExportOrdersChunk{
+		ContentType: exporter.ContentType(format),
+		Filename:    exporter.Filename(format),
+	})
+	if err != nil {
+		return err
+	}
+
+	chunks := bufio.NewWriterSize(exportChunkWriter{stream: stream}, exportChunkSize)
+	writer, err := exporter.NewWriter(format, chunks)
+	if err != nil {
+		return parseGRPCError(err)
+	}
+
+	if err = export(writer.Write); err != nil {
+		if stream.Context().Err() != nil {
+			return status.FromContextError(stream.Context().Err()).Err()
+		}
+		return parseGRPCError(err)
+	}
+
+	if err = writer.Close(); err != nil {
+		return parseGRPCError(err)
+	}
+
+	return chunks.Flush()
+}
+
+// exportChunkWriter отправляет записанные данные сообщениями ExportOrdersChunk
+type exportChunkWriter struct {
+	stream pb.OrderRPCHandler_ExportOrdersServer
+}
+
+// Write отправляет данные одним сообщением. Сообщение сериализуется при отправке, поэтому p можно переиспользовать.
+func (w exportChunkWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&pb.ExportOrdersChunk{Data: p}); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// AcceptOrdersFromFile загружает заказы из файла и возвращает отчет по каждой записи.
+// При dry_run записи только проверяются, заказы не создаются,
+// при bulk заказы принимаются одной транзакцией или не принимается ни один.
+// Формат файла берется из format, а если он не указан - из расширения имени файла или content_type.
+// Для больших файлов следует использовать SubmitImportJob.
 func (s *OrderRPCHandler) AcceptOrdersFromFile(ctx context.Context, req *pb.AcceptOrdersFromFileRequest) (*pb.AcceptOrdersFromFileResponse, error) {
 	if len(req.GetFileContent()) == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "файл не может быть пустым")
 	}
 
-	tempDir := "temp"
-	if _, err := os.Stat(tempDir); os.IsNotExist(err) {
-		if err := os.Mkdir(tempDir, 0755); err != nil {
-			return nil, status.Errorf(codes.Internal, "ошибка при создании временной директории: %v", err)
+	file, options := convertProtoImportRequestToModel(req)
+	result, err := s.orderRPCHandler.ImportOrders(ctx, file, options)
+	if err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	return convertModelImportResultToProto(importResultMessage(result), result), nil
+}
+
+// ImportOrders загружает заказы, переданные потоком, и возвращает отчет по каждой записи.
+// Первым сообщением можно передать параметры импорта, затем - заказы по одному или файл по частям.
+// Заказы и части файла в одном потоке смешивать нельзя. Файл собирается в памяти, без временных файлов.
+func (s *OrderRPCHandler) ImportOrders(stream pb.OrderRPCHandler_ImportOrdersServer) error {
+	var (
+		file     model.ImportFile
+		options  model.ImportOptions
+		orders   []importer.Record
+		received int
+	)
+
+	for ; ; received++ {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		switch payload := req.GetPayload().(type) {
+		case *pb.ImportOrdersRequest_Options:
+			if received > 0 {
+				return status.Errorf(codes.InvalidArgument, "параметры импорта передаются только в первом сообщении")
+			}
+			file.Name = payload.Options.GetFilename()
+			file.ContentType = payload.Options.GetContentType()
+			options = model.ImportOptions{
+				DryRun: payload.Options.GetDryRun(),
+				Bulk:   payload.Options.GetBulk(),
+				Format: payload.Options.GetFormat(),
+			}
+		case *pb.ImportOrdersRequest_Order:
+			if len(file.Content) > 0 {
+				return status.Errorf(codes.InvalidArgument, "заказы и части файла нельзя передавать в одном потоке")
+			}
+			if len(orders) >= maxImportStreamOrders {
+				return status.Errorf(codes.ResourceExhausted, "в потоке больше %d заказов", maxImportStreamOrders)
+			}
+			orders = append(orders, convertProtoOrderToImportRecord(payload.Order))
+		case *pb.ImportOrdersRequest_Chunk:
+			if len(orders) > 0 {
+				return status.Errorf(codes.InvalidArgument, "заказы и части файла нельзя передавать в одном потоке")
+			}
+			if len(file.Content)+len(payload.Chunk) > maxImportStreamSize {
+				return status.Errorf(codes.ResourceExhausted, "размер файла больше %d байт", maxImportStreamSize)
+			}
+			file.Content = append(file.Content, payload.Chunk...)
+		default:
+			return status.Errorf(codes.InvalidArgument, "сообщение не содержит ни параметров, ни заказа, ни части файла")
 		}
 	}
 
-	filename := req.GetFilename()
-	if filename == "" {
-		filename = fmt.Sprintf("order_upload_%d.json", time.Now().Unix())
+	var (
+		result model.ImportResult
+		err    error
+	)
+	switch {
+	case len(file.Content) > 0:
+		result, err = s.orderRPCHandler.ImportOrders(stream.Context(), file, options)
+	case len(orders) > 0:
+		result, err = s.orderRPCHandler.ImportRecords(stream.Context(), orders, options)
+	default:
+		return status.Errorf(codes.InvalidArgument, "поток не содержит заказов")
+	}
+	if err != nil {
+		return parseGRPCError(err)
 	}
 
-	tempFilePath := filepath.Join(tempDir, filename)
+	return stream.SendAndClose(convertModelImportResultToProto(importResultMessage(result), result))
+}
 
-	if err := os.WriteFile(tempFilePath, req.GetFileContent(), 0644); err != nil {
-		return nil, status.Errorf(codes.Internal, "ошибка при записи во временный файл: %v", err)
+// SubmitImportJob ставит загрузку заказов из файла в очередь и возвращает созданную задачу
+func (s *OrderRPCHandler) SubmitImportJob(ctx context.Context, req *pb.AcceptOrdersFromFileRequest) (*pb.ImportJob, error) {
+	if len(req.GetFileContent()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "файл не может быть пустым")
 	}
 
-	defer func() {
-		if removeErr := os.Remove(tempFilePath); removeErr != nil {
-			logger.Warnf("Ошибка при удалении временного файла %s: %v\n", tempFilePath, removeErr)
+	file, options := convertProtoImportRequestToModel(req)
+	job, err := s.importJobs.Submit(ctx, file, options)
+	if err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	return convertModelImportJobToProto(job), nil
+}
+
+// GetImportJob возвращает ход и результат задачи импорта
+func (s *OrderRPCHandler) GetImportJob(ctx context.Context, req *pb.GetImportJobRequest) (*pb.ImportJob, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID задачи импорта должен быть положительным числом")
+	}
+
+	job, err := s.importJobs.Get(ctx, req.GetId())
+	if err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	return convertModelImportJobToProto(job), nil
+}
+
+// WatchImportJob отправляет состояние задачи импорта при каждом его изменении,
+// поток завершается после завершения задачи
+func (s *OrderRPCHandler) WatchImportJob(req *pb.GetImportJobRequest, stream pb.OrderRPCHandler_WatchImportJobServer) error {
+	if req.GetId() <= 0 {
+		return status.Errorf(codes.InvalidArgument, "ID задачи импорта должен быть положительным числом")
+	}
+
+	ctx := stream.Context()
+	ticker := time.NewTicker(importJobWatchInterval)
+	defer ticker.Stop()
+
+	var lastUpdate time.Time
+	for {
+		job, err := s.importJobs.Get(ctx, req.GetId())
+		if err != nil {
+			return parseGRPCError(err)
 		}
 
-		if _, err := os.Stat(tempDir); !os.IsNotExist(err) {
-			if files, err := os.ReadDir(tempDir); err == nil && len(files) == 0 {
-				if removeErr := os.Remove(tempDir); removeErr != nil {
-					logger.Warnf("Ошибка при удалении временной директории %s: %v\n", tempDir, removeErr)
-				}
+		if !job.UpdatedAt.Equal(lastUpdate) {
+			lastUpdate = job.UpdatedAt
+			if err = stream.Send(convertModelImportJobToProto(job)); err != nil {
+				return err
 			}
 		}
-	}()
 
-	if err := s.orderRPCHandler.AcceptOrdersFromFile(ctx, tempFilePath); err != nil {
-		return nil, parseGRPCError(err)
-	}
+		if job.Finished() {
+			return nil
+		}
 
-	return &pb.AcceptOrdersFromFileResponse{
-		Message: "Заказы успешно загружены из файла",
-	}, nil
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
 }
 
 // ClearDatabase очищает базу данных
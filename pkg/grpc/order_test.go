@@ -0,0 +1,173 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "gitlab.ozon.dev/gojhw1/pkg/gen/proto"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/repository"
+	"gitlab.ozon.dev/gojhw1/pkg/service"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestOrderRPCHandler_ProcessCustomer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		request         *pb.ProcessCustomerRequest
+		mockSetup       func(mockService *MockorderServiceInterface)
+		expectedCode    codes.Code
+		expectedResults []codes.Code
+	}{
+		{
+			name: "error non-atomic handout of several on-pickup orders with payment",
+			request: &pb.ProcessCustomerRequest{
+				CustomerId: 456,
+				Action:     "handout",
+				OrderIds:   []int64{123, 124},
+				Payment:    &pb.PaymentDetails{Method: "card", Amount: 3000},
+			},
+			mockSetup:    func(mockService *MockorderServiceInterface) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "error non-atomic handout of prepaid and on-pickup orders with payment",
+			request: &pb.ProcessCustomerRequest{
+				CustomerId: 456,
+				Action:     "handout",
+				OrderIds:   []int64{125, 123},
+				Payment:    &pb.PaymentDetails{Method: "cash", Amount: 1000},
+			},
+			mockSetup:    func(mockService *MockorderServiceInterface) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "non-atomic handout of prepaid and on-pickup orders without payment",
+			request: &pb.ProcessCustomerRequest{
+				CustomerId: 456,
+				Action:     "handout",
+				OrderIds:   []int64{125, 123},
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					DeliverOrder(gomock.Any(), int64(125), int64(0), int64(456), []int64(nil), nil, gomock.Any()).
+					Return(nil)
+
+				mockService.EXPECT().
+					DeliverOrder(gomock.Any(), int64(123), int64(0), int64(456), []int64(nil), nil, gomock.Any()).
+					Return(fmt.Errorf("%w: к оплате 1000.00", service.ErrPaymentRequired))
+			},
+			expectedCode:    codes.OK,
+			expectedResults: []codes.Code{codes.OK, codes.InvalidArgument},
+		},
+		{
+			name: "success non-atomic handout of one order with payment",
+			request: &pb.ProcessCustomerRequest{
+				CustomerId: 456,
+				Action:     "handout",
+				OrderIds:   []int64{123},
+				Payment:    &pb.PaymentDetails{Method: "cash", Amount: 1000},
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					DeliverOrder(gomock.Any(), int64(123), int64(0), int64(456), []int64(nil),
+						&model.PaymentDetails{Method: model.PaymentMethodCash, Amount: 1000}, gomock.Any()).
+					Return(nil)
+			},
+			expectedCode:    codes.OK,
+			expectedResults: []codes.Code{codes.OK},
+		},
+		{
+			name: "success handout with matching version",
+			request: &pb.ProcessCustomerRequest{
+				CustomerId: 456,
+				Action:     "handout",
+				OrderIds:   []int64{123},
+				Version:    3,
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					DeliverOrder(gomock.Any(), int64(123), int64(3), int64(456), []int64(nil), nil, gomock.Any()).
+					Return(nil)
+			},
+			expectedCode:    codes.OK,
+			expectedResults: []codes.Code{codes.OK},
+		},
+		{
+			name: "error return with outdated version",
+			request: &pb.ProcessCustomerRequest{
+				CustomerId: 456,
+				Action:     "return",
+				OrderIds:   []int64{123},
+				Version:    3,
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					ProcessReturnOrder(gomock.Any(), int64(123), int64(3), int64(456), []int64(nil), gomock.Any(), gomock.Any()).
+					Return(fmt.Errorf("%w: %w: заказ 123, версия 4, ожидалась 3", service.ErrVersionMismatch, repository.ErrConcurrentModification))
+			},
+			expectedCode: codes.Aborted,
+		},
+		{
+			name: "error version for several orders",
+			request: &pb.ProcessCustomerRequest{
+				CustomerId: 456,
+				Action:     "handout",
+				OrderIds:   []int64{123, 124},
+				Version:    3,
+			},
+			mockSetup:    func(mockService *MockorderServiceInterface) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "success atomic handout of several orders with payment",
+			request: &pb.ProcessCustomerRequest{
+				CustomerId: 456,
+				Action:     "handout",
+				OrderIds:   []int64{125, 123, 124},
+				Atomic:     true,
+				Payment:    &pb.PaymentDetails{Method: "mixed", Amount: 3000, CashAmount: 1000},
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					DeliverOrders(gomock.Any(), []int64{125, 123, 124}, int64(456), []int64(nil),
+						&model.PaymentDetails{Method: model.PaymentMethodMixed, Amount: 3000, CashAmount: 1000}, gomock.Any()).
+					Return(nil)
+			},
+			expectedCode:    codes.OK,
+			expectedResults: []codes.Code{codes.OK, codes.OK, codes.OK},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockService := NewMockorderServiceInterface(ctrl)
+			tt.mockSetup(mockService)
+
+			handler := NewOrderRPCHandler(mockService, nil)
+
+			resp, err := handler.ProcessCustomer(context.Background(), tt.request)
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode != codes.OK {
+				return
+			}
+			require.NoError(t, err)
+
+			results := make([]codes.Code, 0, len(resp.GetResults()))
+			for _, result := range resp.GetResults() {
+				results = append(results, codes.Code(result.GetStatus()))
+			}
+			assert.Equal(t, tt.expectedResults, results)
+		})
+	}
+}
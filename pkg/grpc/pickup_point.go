@@ -0,0 +1,139 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	pb "gitlab.ozon.dev/gojhw1/pkg/gen/proto"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pickupPointRepository определяет методы для работы с ПВЗ в репозитории
+type pickupPointRepository interface {
+	Create(ctx context.Context, point model.PickupPoint) (model.PickupPoint, error)
+	Update(ctx context.Context, point model.PickupPoint) error
+	Delete(ctx context.Context, id int64) error
+	GetByID(ctx context.Context, id int64) (model.PickupPoint, error)
+	List(ctx context.Context) ([]model.PickupPoint, error)
+}
+
+// PickupPointRPCHandler реализует gRPC-сервис для управления ПВЗ
+type PickupPointRPCHandler struct {
+	pb.UnimplementedPickupPointRPCHandlerServer
+	repo pickupPointRepository
+}
+
+// NewPickupPointRPCHandler создает новый экземпляр PickupPointRPCHandler
+func NewPickupPointRPCHandler(repo pickupPointRepository) *PickupPointRPCHandler {
+	return &PickupPointRPCHandler{repo: repo}
+}
+
+// CreatePickupPoint создает новый ПВЗ
+func (s *PickupPointRPCHandler) CreatePickupPoint(ctx context.Context, req *pb.CreatePickupPointRequest) (*pb.PickupPoint, error) {
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "название ПВЗ не может быть пустым")
+	}
+
+	point, err := s.repo.Create(ctx, model.PickupPoint{
+		Name:    name,
+		Address: strings.TrimSpace(req.GetAddress()),
+	})
+	if err != nil {
+		return nil, pickupPointStatusError(err)
+	}
+
+	return convertModelPickupPointToProto(point), nil
+}
+
+// GetPickupPoint возвращает ПВЗ по ID
+func (s *PickupPointRPCHandler) GetPickupPoint(ctx context.Context, req *pb.GetPickupPointRequest) (*pb.PickupPoint, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID ПВЗ должен быть положительным числом")
+	}
+
+	point, err := s.repo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, pickupPointStatusError(err)
+	}
+
+	return convertModelPickupPointToProto(point), nil
+}
+
+// ListPickupPoints возвращает список ПВЗ
+func (s *PickupPointRPCHandler) ListPickupPoints(ctx context.Context, _ *pb.ListPickupPointsRequest) (*pb.ListPickupPointsResponse, error) {
+	points, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, pickupPointStatusError(err)
+	}
+
+	pbPoints := make([]*pb.PickupPoint, 0, len(points))
+	for _, point := range points {
+		pbPoints = append(pbPoints, convertModelPickupPointToProto(point))
+	}
+
+	return &pb.ListPickupPointsResponse{
+		PickupPoints: pbPoints,
+		Total:        int32(len(pbPoints)),
+	}, nil
+}
+
+// UpdatePickupPoint изменяет название и адрес ПВЗ
+func (s *PickupPointRPCHandler) UpdatePickupPoint(ctx context.Context, req *pb.UpdatePickupPointRequest) (*pb.UpdatePickupPointResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID ПВЗ должен быть положительным числом")
+	}
+
+	point, err := s.repo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, pickupPointStatusError(err)
+	}
+
+	if name := strings.TrimSpace(req.GetName()); name != "" {
+		point.Name = name
+	}
+	if address := strings.TrimSpace(req.GetAddress()); address != "" {
+		point.Address = address
+	}
+
+	if err := s.repo.Update(ctx, point); err != nil {
+		return nil, pickupPointStatusError(err)
+	}
+
+	return &pb.UpdatePickupPointResponse{
+		Message: "ПВЗ успешно обновлен",
+	}, nil
+}
+
+// DeletePickupPoint удаляет ПВЗ, в котором нет заказов
+func (s *PickupPointRPCHandler) DeletePickupPoint(ctx context.Context, req *pb.DeletePickupPointRequest) (*pb.DeletePickupPointResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID ПВЗ должен быть положительным числом")
+	}
+
+	if err := s.repo.Delete(ctx, req.GetId()); err != nil {
+		return nil, pickupPointStatusError(err)
+	}
+
+	return &pb.DeletePickupPointResponse{
+		Message: "ПВЗ успешно удален",
+	}, nil
+}
+
+// pickupPointStatusError преобразует ошибку репозитория ПВЗ в gRPC статус
+func pickupPointStatusError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrPickupPointNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, repository.ErrPickupPointAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, repository.ErrPickupPointInUse):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "ошибка при работе с ПВЗ: %v", err)
+	}
+}
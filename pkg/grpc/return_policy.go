@@ -0,0 +1,124 @@
+package grpc
+
+import (
+	"context"
+
+	pb "gitlab.ozon.dev/gojhw1/pkg/gen/proto"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// returnPolicyService определяет методы для настройки политик возврата и сегментов клиентов
+type returnPolicyService interface {
+	CreatePolicy(ctx context.Context, policy model.ReturnPolicy) (model.ReturnPolicy, error)
+	UpdatePolicy(ctx context.Context, policy model.ReturnPolicy) (model.ReturnPolicy, error)
+	DeletePolicy(ctx context.Context, id int64) error
+	GetPolicy(ctx context.Context, id int64) (model.ReturnPolicy, error)
+	ListPolicies(ctx context.Context) ([]model.ReturnPolicy, error)
+	SetCustomerSegment(ctx context.Context, customerID int64, segment string) (model.CustomerSegment, error)
+}
+
+// ReturnPolicyRPCHandler реализует gRPC-сервис для работы с политиками возврата
+type ReturnPolicyRPCHandler struct {
+	pb.UnimplementedReturnPolicyRPCHandlerServer
+	policies returnPolicyService
+}
+
+// NewReturnPolicyRPCHandler создает новый экземпляр ReturnPolicyRPCHandler
+func NewReturnPolicyRPCHandler(policies returnPolicyService) *ReturnPolicyRPCHandler {
+	return &ReturnPolicyRPCHandler{policies: policies}
+}
+
+// CreateReturnPolicy создает политику возврата
+func (s *ReturnPolicyRPCHandler) CreateReturnPolicy(ctx context.Context, req *pb.ReturnPolicyRequest) (*pb.ReturnPolicy, error) {
+	created, err := s.policies.CreatePolicy(ctx, returnPolicyFromProto(req))
+	if err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	return convertModelReturnPolicyToProto(created), nil
+}
+
+// GetReturnPolicy возвращает политику возврата по ID
+func (s *ReturnPolicyRPCHandler) GetReturnPolicy(ctx context.Context, req *pb.GetReturnPolicyRequest) (*pb.ReturnPolicy, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID политики возврата должен быть положительным числом")
+	}
+
+	policy, err := s.policies.GetPolicy(ctx, req.GetId())
+	if err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	return convertModelReturnPolicyToProto(policy), nil
+}
+
+// ListReturnPolicies возвращает список политик возврата
+func (s *ReturnPolicyRPCHandler) ListReturnPolicies(ctx context.Context, _ *pb.ListReturnPoliciesRequest) (*pb.ListReturnPoliciesResponse, error) {
+	policies, err := s.policies.ListPolicies(ctx)
+	if err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	pbPolicies := make([]*pb.ReturnPolicy, 0, len(policies))
+	for _, policy := range policies {
+		pbPolicies = append(pbPolicies, convertModelReturnPolicyToProto(policy))
+	}
+
+	return &pb.ListReturnPoliciesResponse{
+		ReturnPolicies: pbPolicies,
+		Total:          int32(len(pbPolicies)),
+	}, nil
+}
+
+// UpdateReturnPolicy заменяет все параметры политики возврата
+func (s *ReturnPolicyRPCHandler) UpdateReturnPolicy(ctx context.Context, req *pb.UpdateReturnPolicyRequest) (*pb.ReturnPolicy, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID политики возврата должен быть положительным числом")
+	}
+
+	policy := returnPolicyFromProto(req.GetPolicy())
+	policy.ID = req.GetId()
+
+	updated, err := s.policies.UpdatePolicy(ctx, policy)
+	if err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	return convertModelReturnPolicyToProto(updated), nil
+}
+
+// DeleteReturnPolicy удаляет политику возврата
+func (s *ReturnPolicyRPCHandler) DeleteReturnPolicy(ctx context.Context, req *pb.DeleteReturnPolicyRequest) (*pb.DeleteReturnPolicyResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID политики возврата должен быть положительным числом")
+	}
+
+	if err := s.policies.DeletePolicy(ctx, req.GetId()); err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	return &pb.DeleteReturnPolicyResponse{
+		Message: "Политика возврата успешно удалена",
+	}, nil
+}
+
+// SetCustomerSegment относит клиента к сегменту, пустой сегмент снимает клиента с сегмента
+func (s *ReturnPolicyRPCHandler) SetCustomerSegment(ctx context.Context, req *pb.SetCustomerSegmentRequest) (*pb.CustomerSegment, error) {
+	if req.GetCustomerId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID клиента должен быть положительным числом")
+	}
+
+	segment, err := s.policies.SetCustomerSegment(ctx, req.GetCustomerId(), req.GetSegment())
+	if err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	return &pb.CustomerSegment{
+		CustomerId: segment.CustomerID,
+		Segment:    segment.Segment,
+		UpdatedAt:  timestamppb.New(segment.UpdatedAt),
+	}, nil
+}
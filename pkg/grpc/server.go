@@ -12,41 +12,63 @@ import (
 
 // Server представляет gRPC сервер
 type Server struct {
-	grpcServer   *grpc.Server
-	host         string
-	port         string
-	userService  *UserRPCHandler
-	orderService *OrderRPCHandler
+	grpcServer          *grpc.Server
+	host                string
+	port                string
+	userService         *UserRPCHandler
+	orderService        *OrderRPCHandler
+	pickupPointService  *PickupPointRPCHandler
+	storageService      *StorageRPCHandler
+	returnPolicyService *ReturnPolicyRPCHandler
+	shiftService        *ShiftRPCHandler
 }
 
-// NewServer создает новый экземпляр gRPC сервера
-func NewServer(host, port string, userRepo userRepository, orderService orderServiceInterface) *Server {
-	authInterceptor := NewBasicAuthInterceptor(userRepo)
+// NewServer создает новый экземпляр gRPC сервера.
+// basicAuthFallback разрешает аутентификацию по Basic Auth наряду с access-токенами.
+func NewServer(host, port string, userRepo userRepository, pickupPointRepo pickupPointRepository, storage storageService, returnPolicies returnPolicyService, shifts shiftService, auth authenticator, apiKeys apiKeyService, idempotency idempotencyService, orderService orderServiceInterface, importJobs importJobService, basicAuthFallback bool) *Server {
+	authInterceptor := NewAuthInterceptor(auth, apiKeys, basicAuthFallback)
+	permissionInterceptor := NewPermissionInterceptor(userRepo)
+	idempotencyInterceptor := NewIdempotencyInterceptor(idempotency)
 
 	grpcServer := grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		grpc.ChainUnaryInterceptor(
 			authInterceptor.UnaryInterceptor,
+			permissionInterceptor.UnaryInterceptor,
+			idempotencyInterceptor.UnaryInterceptor,
 		),
 		grpc.ChainStreamInterceptor(
 			authInterceptor.StreamInterceptor,
+			permissionInterceptor.StreamInterceptor,
 		),
 	)
 
-	userService := NewUserRPCHandler(userRepo)
-	orderRpcService := NewOrderRPCHandler(orderService)
+	userService := NewUserRPCHandler(userRepo, apiKeys)
+	orderRpcService := NewOrderRPCHandler(orderService, importJobs)
+	pickupPointService := NewPickupPointRPCHandler(pickupPointRepo)
+	storageRpcService := NewStorageRPCHandler(storage, orderService)
+	returnPolicyRpcService := NewReturnPolicyRPCHandler(returnPolicies)
+	shiftRpcService := NewShiftRPCHandler(shifts)
 
 	pb.RegisterUserRPCHandlerServer(grpcServer, userService)
 	pb.RegisterOrderRPCHandlerServer(grpcServer, orderRpcService)
+	pb.RegisterPickupPointRPCHandlerServer(grpcServer, pickupPointService)
+	pb.RegisterStorageRPCHandlerServer(grpcServer, storageRpcService)
+	pb.RegisterReturnPolicyRPCHandlerServer(grpcServer, returnPolicyRpcService)
+	pb.RegisterShiftRPCHandlerServer(grpcServer, shiftRpcService)
 
 	reflection.Register(grpcServer)
 
 	return &Server{
-		grpcServer:   grpcServer,
-		host:         host,
-		port:         port,
-		userService:  userService,
-		orderService: orderRpcService,
+		grpcServer:          grpcServer,
+		host:                host,
+		port:                port,
+		userService:         userService,
+		orderService:        orderRpcService,
+		pickupPointService:  pickupPointService,
+		storageService:      storageRpcService,
+		returnPolicyService: returnPolicyRpcService,
+		shiftService:        shiftRpcService,
 	}
 }
 
@@ -0,0 +1,229 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+
+	"gitlab.ozon.dev/gojhw1/pkg/exporter"
+	pb "gitlab.ozon.dev/gojhw1/pkg/gen/proto"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// shiftService определяет методы для работы со сменами ПВЗ и сверки кассы
+type shiftService interface {
+	OpenShift(ctx context.Context, pickupPointID int64, openingCash float64) (model.Shift, error)
+	CurrentShift(ctx context.Context, pickupPointID int64) (model.Shift, error)
+	GetShift(ctx context.Context, id int64) (model.Shift, error)
+	ListShiftsWithCursor(ctx context.Context, cursorID int64, limit int) ([]model.Shift, error)
+	CloseShift(ctx context.Context, id int64, countedCash float64) (model.Shift, error)
+	ShiftReport(ctx context.Context, id int64) (model.ShiftReport, error)
+}
+
+// ShiftRPCHandler реализует gRPC-сервис для работы со сменами ПВЗ
+type ShiftRPCHandler struct {
+	pb.UnimplementedShiftRPCHandlerServer
+	shifts shiftService
+}
+
+// NewShiftRPCHandler создает новый экземпляр ShiftRPCHandler
+func NewShiftRPCHandler(shifts shiftService) *ShiftRPCHandler {
+	return &ShiftRPCHandler{shifts: shifts}
+}
+
+// OpenShift открывает смену в ПВЗ
+func (s *ShiftRPCHandler) OpenShift(ctx context.Context, req *pb.OpenShiftRequest) (*pb.Shift, error) {
+	shift, err := s.shifts.OpenShift(ctx, req.GetPickupPointId(), req.GetOpeningCash())
+	if err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	return convertModelShiftToProto(shift), nil
+}
+
+// GetCurrentShift возвращает открытую смену ПВЗ с предварительным отчетом сверки
+func (s *ShiftRPCHandler) GetCurrentShift(ctx context.Context, req *pb.GetCurrentShiftRequest) (*pb.Shift, error) {
+	shift, err := s.shifts.CurrentShift(ctx, req.GetPickupPointId())
+	if err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	return convertModelShiftToProto(shift), nil
+}
+
+// GetShift возвращает смену по ID с отчетом сверки
+func (s *ShiftRPCHandler) GetShift(ctx context.Context, req *pb.GetShiftRequest) (*pb.Shift, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID смены должен быть положительным числом")
+	}
+
+	shift, err := s.shifts.GetShift(ctx, req.GetId())
+	if err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	return convertModelShiftToProto(shift), nil
+}
+
+// ListShifts возвращает смены ПВЗ с курсорной пагинацией
+func (s *ShiftRPCHandler) ListShifts(ctx context.Context, req *pb.ListShiftsRequest) (*pb.ListShiftsResponse, error) {
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	shifts, err := s.shifts.ListShiftsWithCursor(ctx, req.GetCursorId(), limit+1)
+	if err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	hasMore := len(shifts) > limit
+	var nextCursor int64
+
+	if hasMore {
+		shifts = shifts[:limit]
+	}
+
+	if len(shifts) > 0 {
+		nextCursor = shifts[len(shifts)-1].ID
+	}
+
+	protoShifts := make([]*pb.Shift, len(shifts))
+	for i, shift := range shifts {
+		protoShifts[i] = convertModelShiftToProto(shift)
+	}
+
+	return &pb.ListShiftsResponse{
+		Shifts:     protoShifts,
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// CloseShift закрывает смену со сверкой кассы
+func (s *ShiftRPCHandler) CloseShift(ctx context.Context, req *pb.CloseShiftRequest) (*pb.Shift, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID смены должен быть положительным числом")
+	}
+
+	shift, err := s.shifts.CloseShift(ctx, req.GetId(), req.GetCountedCash())
+	if err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	return convertModelShiftToProto(shift), nil
+}
+
+// ExportShiftReport выгружает отчет сверки смены в файл JSON или CSV
+func (s *ShiftRPCHandler) ExportShiftReport(ctx context.Context, req *pb.ExportShiftReportRequest) (*pb.ShiftReportFile, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID смены должен быть положительным числом")
+	}
+
+	format, err := exporter.ResolveReportFormat(req.GetFormat())
+	if err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	report, err := s.shifts.ShiftReport(ctx, req.GetId())
+	if err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	var buf bytes.Buffer
+	if err = exporter.WriteShiftReport(&buf, format, report); err != nil {
+		return nil, status.Errorf(codes.Internal, "ошибка формирования отчета сверки смены: %v", err)
+	}
+
+	return &pb.ShiftReportFile{
+		Filename:    exporter.ReportFilename(report.ShiftID, format),
+		ContentType: exporter.ReportContentType(format),
+		Content:     buf.Bytes(),
+	}, nil
+}
+
+// convertModelShiftToProto преобразует модель смены в protobuf формат
+func convertModelShiftToProto(shift model.Shift) *pb.Shift {
+	protoShift := &pb.Shift{
+		Id:            shift.ID,
+		PickupPointId: shift.PickupPointID,
+		State:         string(shift.State),
+		OpeningCash:   shift.OpeningCash,
+		OpenedAt:      timestamppb.New(shift.OpenedAt),
+	}
+
+	if shift.CountedCash != nil {
+		protoShift.CountedCash = *shift.CountedCash
+	}
+	if shift.OpenedBy != nil {
+		protoShift.OpenedBy = *shift.OpenedBy
+	}
+	if shift.ClosedBy != nil {
+		protoShift.ClosedBy = *shift.ClosedBy
+	}
+	if shift.ClosedAt != nil {
+		protoShift.ClosedAt = timestamppb.New(*shift.ClosedAt)
+	}
+	if shift.Report != nil {
+		protoShift.Report = convertModelShiftReportToProto(*shift.Report)
+	}
+
+	return protoShift
+}
+
+// convertModelShiftReportToProto преобразует отчет сверки смены в protobuf формат
+func convertModelShiftReportToProto(report model.ShiftReport) *pb.ShiftReport {
+	protoReport := &pb.ShiftReport{
+		ShiftId:       report.ShiftID,
+		PickupPointId: report.PickupPointID,
+		From:          timestamppb.New(report.From),
+		To:            timestamppb.New(report.To),
+		Final:         report.Final,
+		Operators:     make([]*pb.ShiftOperatorTotals, 0, len(report.Operators)),
+		Totals:        convertModelShiftTotalsToProto(report.Totals),
+		OpeningCash:   report.OpeningCash,
+		ExpectedCash:  report.ExpectedCash,
+	}
+
+	for _, operator := range report.Operators {
+		protoOperator := &pb.ShiftOperatorTotals{
+			Username: operator.Username,
+			Totals:   convertModelShiftTotalsToProto(operator.ShiftTotals),
+		}
+		if operator.OperatorID != nil {
+			protoOperator.OperatorId = *operator.OperatorID
+		}
+		protoReport.Operators = append(protoReport.Operators, protoOperator)
+	}
+
+	if report.CountedCash != nil {
+		protoReport.CountedCash = *report.CountedCash
+	}
+	if report.CashDifference != nil {
+		protoReport.CashDifference = *report.CashDifference
+	}
+
+	return protoReport
+}
+
+// convertModelShiftTotalsToProto преобразует итоги смены в protobuf формат
+func convertModelShiftTotalsToProto(totals model.ShiftTotals) *pb.ShiftTotals {
+	return &pb.ShiftTotals{
+		Handouts:       int32(totals.Handouts),
+		HandoutAmount:  totals.HandoutAmount,
+		Payments:       int32(totals.Payments),
+		CashReceived:   totals.CashReceived,
+		CardReceived:   totals.CardReceived,
+		Returns:        int32(totals.Returns),
+		ReturnAmount:   totals.ReturnAmount,
+		CashRefunded:   totals.CashRefunded,
+		CardRefunded:   totals.CardRefunded,
+		OnlineRefunded: totals.OnlineRefunded,
+		CourierReturns: int32(totals.CourierReturns),
+	}
+}
@@ -0,0 +1,105 @@
+package grpc
+
+import (
+	"context"
+
+	pb "gitlab.ozon.dev/gojhw1/pkg/gen/proto"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// storageService определяет методы для настройки ячеек хранения и просмотра их заполненности
+type storageService interface {
+	CreateCell(ctx context.Context, cell model.StorageCell) (model.StorageCell, error)
+	DeleteCell(ctx context.Context, id int64) error
+	Occupancy(ctx context.Context) ([]model.CellOccupancy, error)
+}
+
+// StorageRPCHandler реализует gRPC-сервис для работы с ячейками хранения
+type StorageRPCHandler struct {
+	pb.UnimplementedStorageRPCHandlerServer
+	storage storageService
+	orders  orderServiceInterface
+}
+
+// NewStorageRPCHandler создает новый экземпляр StorageRPCHandler
+func NewStorageRPCHandler(storage storageService, orders orderServiceInterface) *StorageRPCHandler {
+	return &StorageRPCHandler{
+		storage: storage,
+		orders:  orders,
+	}
+}
+
+// CreateStorageCell создает ячейку хранения в ПВЗ
+func (s *StorageRPCHandler) CreateStorageCell(ctx context.Context, req *pb.CreateStorageCellRequest) (*pb.StorageCell, error) {
+	cell := model.StorageCell{
+		PickupPointID: req.GetPickupPointId(),
+		Rack:          req.GetRack(),
+		Code:          req.GetCode(),
+		Capacity:      int(req.GetCapacity()),
+		MaxWeight:     req.GetMaxWeight(),
+	}
+	if req.GetPackageType() != "" {
+		packageType := model.PackageType(req.GetPackageType())
+		cell.PackageType = &packageType
+	}
+
+	created, err := s.storage.CreateCell(ctx, cell)
+	if err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	return convertModelStorageCellToProto(created), nil
+}
+
+// DeleteStorageCell удаляет пустую ячейку хранения
+func (s *StorageRPCHandler) DeleteStorageCell(ctx context.Context, req *pb.DeleteStorageCellRequest) (*pb.DeleteStorageCellResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID ячейки должен быть положительным числом")
+	}
+
+	if err := s.storage.DeleteCell(ctx, req.GetId()); err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	return &pb.DeleteStorageCellResponse{
+		Message: "Ячейка успешно удалена",
+	}, nil
+}
+
+// ListStorageCells возвращает заполненность ячеек ПВЗ
+func (s *StorageRPCHandler) ListStorageCells(ctx context.Context, _ *pb.ListStorageCellsRequest) (*pb.ListStorageCellsResponse, error) {
+	cells, err := s.storage.Occupancy(ctx)
+	if err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	pbCells := make([]*pb.StorageCellOccupancy, 0, len(cells))
+	for _, cell := range cells {
+		pbCells = append(pbCells, &pb.StorageCellOccupancy{
+			Cell:        convertModelStorageCellToProto(cell.StorageCell),
+			OrdersCount: int32(cell.OrdersCount),
+			Weight:      cell.Weight,
+		})
+	}
+
+	return &pb.ListStorageCellsResponse{
+		Cells: pbCells,
+		Total: int32(len(pbCells)),
+	}, nil
+}
+
+// LocateOrder возвращает ячейку, в которой лежит заказ
+func (s *StorageRPCHandler) LocateOrder(ctx context.Context, req *pb.LocateOrderRequest) (*pb.StorageCell, error) {
+	if req.GetOrderId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID заказа должен быть положительным числом")
+	}
+
+	cell, err := s.orders.LocateOrder(ctx, req.GetOrderId())
+	if err != nil {
+		return nil, parseGRPCError(err)
+	}
+
+	return convertModelStorageCellToProto(cell), nil
+}
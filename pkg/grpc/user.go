@@ -2,11 +2,14 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	pb "gitlab.ozon.dev/gojhw1/pkg/gen/proto"
 	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/rbac"
 	"gitlab.ozon.dev/gojhw1/pkg/repository"
+	"gitlab.ozon.dev/gojhw1/pkg/service"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -21,18 +24,30 @@ type userRepository interface {
 	GetByUsername(ctx context.Context, username string) (model.User, error)
 	List(ctx context.Context, searchTerm string) ([]model.User, error)
 	CheckPassword(ctx context.Context, username, password string) bool
+	Approve(ctx context.Context, userID int64, role string) error
+	AssignPickupPoint(ctx context.Context, userID int64, pickupPointID *int64) error
+}
+
+// apiKeyService определяет методы для управления и проверки API-ключей
+type apiKeyService interface {
+	Create(ctx context.Context, userID int64, name string, scopes []string, expiresAt *time.Time) (model.APIKey, string, error)
+	List(ctx context.Context, userID int64) ([]model.APIKey, error)
+	Revoke(ctx context.Context, userID, keyID int64) error
+	AuthenticateAPIKey(ctx context.Context, rawKey string) (model.User, model.APIKey, error)
 }
 
 // UserRPCHandler реализует gRPC-сервис для управления пользователями
 type UserRPCHandler struct {
 	pb.UnimplementedUserRPCHandlerServer
 	userRepository userRepository
+	apiKeys        apiKeyService
 }
 
 // NewUserRPCHandler создает новый экземпляр UserRPCHandler
-func NewUserRPCHandler(userRepo userRepository) *UserRPCHandler {
+func NewUserRPCHandler(userRepo userRepository, apiKeys apiKeyService) *UserRPCHandler {
 	return &UserRPCHandler{
 		userRepository: userRepo,
+		apiKeys:        apiKeys,
 	}
 }
 
@@ -46,14 +61,12 @@ func (s *UserRPCHandler) CreateUser(ctx context.Context, req *pb.CreateUserReque
 		return nil, status.Errorf(codes.InvalidArgument, "пароль не может быть пустым")
 	}
 
-	role := req.GetRole()
-	if role == "" {
-		role = "user"
-	}
-
+	// Роль из запроса не используется: публичная регистрация создает неподтвержденную учетную запись
+	// с минимальными правами, роль назначает администратор через ApproveUser или PromoteUser
 	user := model.User{
 		Username: req.GetUsername(),
-		Role:     role,
+		Role:     model.RoleCourier,
+		Status:   model.UserStatusPending,
 	}
 
 	if err := s.userRepository.Create(ctx, user, req.GetPassword()); err != nil {
@@ -64,7 +77,7 @@ func (s *UserRPCHandler) CreateUser(ctx context.Context, req *pb.CreateUserReque
 	}
 
 	return &pb.CreateUserResponse{
-		Message: "Пользователь успешно создан",
+		Message: "Пользователь успешно создан и ожидает подтверждения администратором",
 	}, nil
 }
 
@@ -109,6 +122,10 @@ func (s *UserRPCHandler) UpdateUser(ctx context.Context, req *pb.UpdateUserReque
 		return nil, status.Errorf(codes.InvalidArgument, "ID пользователя должен быть положительным числом")
 	}
 
+	if req.GetRole() != "" && !rbac.IsKnownRole(req.GetRole()) {
+		return nil, status.Errorf(codes.InvalidArgument, "неизвестная роль пользователя")
+	}
+
 	existingUser, err := s.userRepository.GetByID(ctx, req.GetId())
 	if err != nil {
 		if err == repository.ErrUserNotFound {
@@ -181,3 +198,165 @@ func (s *UserRPCHandler) DeleteUser(ctx context.Context, req *pb.DeleteUserReque
 		Message: "Пользователь успешно удален",
 	}, nil
 }
+
+// ApproveUser подтверждает учетную запись зарегистрированного пользователя
+func (s *UserRPCHandler) ApproveUser(ctx context.Context, req *pb.ApproveUserRequest) (*pb.ApproveUserResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID пользователя должен быть положительным числом")
+	}
+
+	if req.GetRole() != "" && !rbac.IsKnownRole(req.GetRole()) {
+		return nil, status.Errorf(codes.InvalidArgument, "неизвестная роль пользователя")
+	}
+
+	if err := s.userRepository.Approve(ctx, req.GetId(), req.GetRole()); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrUserNotFound):
+			return nil, status.Errorf(codes.NotFound, "пользователь не найден")
+		case errors.Is(err, repository.ErrUserAlreadyActive):
+			return nil, status.Errorf(codes.FailedPrecondition, "пользователь уже подтвержден")
+		}
+		return nil, status.Errorf(codes.Internal, "ошибка при подтверждении пользователя: %v", err)
+	}
+
+	return &pb.ApproveUserResponse{
+		Message: "Пользователь успешно подтвержден",
+	}, nil
+}
+
+// PromoteUser назначает пользователю новую роль
+func (s *UserRPCHandler) PromoteUser(ctx context.Context, req *pb.PromoteUserRequest) (*pb.PromoteUserResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID пользователя должен быть положительным числом")
+	}
+
+	if !rbac.IsKnownRole(req.GetRole()) {
+		return nil, status.Errorf(codes.InvalidArgument, "неизвестная роль пользователя")
+	}
+
+	existingUser, err := s.userRepository.GetByID(ctx, req.GetId())
+	if err != nil {
+		if err == repository.ErrUserNotFound {
+			return nil, status.Errorf(codes.NotFound, "пользователь не найден")
+		}
+		return nil, status.Errorf(codes.Internal, "ошибка при получении пользователя: %v", err)
+	}
+
+	existingUser.Role = req.GetRole()
+	existingUser.UpdatedAt = time.Now()
+
+	if err := s.userRepository.Update(ctx, existingUser); err != nil {
+		return nil, status.Errorf(codes.Internal, "ошибка при назначении роли: %v", err)
+	}
+
+	return &pb.PromoteUserResponse{
+		Message: "Роль пользователя успешно изменена",
+	}, nil
+}
+
+// AssignPickupPoint привязывает пользователя к ПВЗ или снимает привязку
+func (s *UserRPCHandler) AssignPickupPoint(ctx context.Context, req *pb.AssignPickupPointRequest) (*pb.AssignPickupPointResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID пользователя должен быть положительным числом")
+	}
+
+	if req.GetPickupPointId() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID ПВЗ не может быть отрицательным")
+	}
+
+	var pickupPointID *int64
+	if req.GetPickupPointId() > 0 {
+		id := req.GetPickupPointId()
+		pickupPointID = &id
+	}
+
+	if err := s.userRepository.AssignPickupPoint(ctx, req.GetUserId(), pickupPointID); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrUserNotFound):
+			return nil, status.Errorf(codes.NotFound, "пользователь не найден")
+		case errors.Is(err, repository.ErrPickupPointNotFound):
+			return nil, status.Errorf(codes.NotFound, "ПВЗ не найден")
+		}
+		return nil, status.Errorf(codes.Internal, "ошибка при привязке пользователя к ПВЗ: %v", err)
+	}
+
+	return &pb.AssignPickupPointResponse{
+		Message: "Привязка пользователя к ПВЗ успешно изменена",
+	}, nil
+}
+
+// CreateAPIKey выпускает API-ключ для пользователя
+func (s *UserRPCHandler) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID пользователя должен быть положительным числом")
+	}
+
+	var expiresAt *time.Time
+	if req.GetExpiresAt() != nil {
+		t := req.GetExpiresAt().AsTime()
+		expiresAt = &t
+	}
+
+	key, rawKey, err := s.apiKeys.Create(ctx, req.GetUserId(), req.GetName(), req.GetScopes(), expiresAt)
+	if err != nil {
+		return nil, apiKeyStatusError(err)
+	}
+
+	return &pb.CreateAPIKeyResponse{
+		ApiKey: convertModelAPIKeyToProto(key),
+		Key:    rawKey,
+	}, nil
+}
+
+// ListAPIKeys возвращает API-ключи пользователя
+func (s *UserRPCHandler) ListAPIKeys(ctx context.Context, req *pb.ListAPIKeysRequest) (*pb.ListAPIKeysResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID пользователя должен быть положительным числом")
+	}
+
+	keys, err := s.apiKeys.List(ctx, req.GetUserId())
+	if err != nil {
+		return nil, apiKeyStatusError(err)
+	}
+
+	pbKeys := make([]*pb.APIKey, 0, len(keys))
+	for _, key := range keys {
+		pbKeys = append(pbKeys, convertModelAPIKeyToProto(key))
+	}
+
+	return &pb.ListAPIKeysResponse{
+		ApiKeys: pbKeys,
+	}, nil
+}
+
+// RevokeAPIKey отзывает API-ключ пользователя
+func (s *UserRPCHandler) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (*pb.RevokeAPIKeyResponse, error) {
+	if req.GetUserId() <= 0 || req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID пользователя и API-ключа должны быть положительными числами")
+	}
+
+	if err := s.apiKeys.Revoke(ctx, req.GetUserId(), req.GetId()); err != nil {
+		return nil, apiKeyStatusError(err)
+	}
+
+	return &pb.RevokeAPIKeyResponse{
+		Message: "API-ключ успешно отозван",
+	}, nil
+}
+
+// apiKeyStatusError преобразует ошибку сервиса API-ключей в gRPC статус
+func apiKeyStatusError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrEmptyAPIKeyName),
+		errors.Is(err, service.ErrEmptyAPIKeyScopes),
+		errors.Is(err, service.ErrInvalidAPIKeyScope),
+		errors.Is(err, service.ErrInvalidAPIKeyExpiry):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, repository.ErrUserNotFound):
+		return status.Errorf(codes.NotFound, "пользователь не найден")
+	case errors.Is(err, repository.ErrAPIKeyNotFound):
+		return status.Error(codes.NotFound, repository.ErrAPIKeyNotFound.Error())
+	default:
+		return status.Errorf(codes.Internal, "ошибка при работе с API-ключами: %v", err)
+	}
+}
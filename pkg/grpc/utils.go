@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"time"
 
+	"gitlab.ozon.dev/gojhw1/pkg/exporter"
 	pb "gitlab.ozon.dev/gojhw1/pkg/gen/proto"
+	"gitlab.ozon.dev/gojhw1/pkg/importer"
 	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/repository"
 	"gitlab.ozon.dev/gojhw1/pkg/service"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -38,25 +41,274 @@ func parseDeadline(deadlineStr string) (time.Time, error) {
 	return deadline, nil
 }
 
+// convertModelOrderStateToProto преобразует статус заказа в protobuf формат
+func convertModelOrderStateToProto(state model.OrderState) pb.OrderState {
+	switch state {
+	case model.StateAccepted:
+		return pb.OrderState_ORDER_STATE_ACCEPTED
+	case model.StateDelivered:
+		return pb.OrderState_ORDER_STATE_DELIVERED
+	case model.StateReturned:
+		return pb.OrderState_ORDER_STATE_RETURNED
+	case model.StateReturnedToCourier:
+		return pb.OrderState_ORDER_STATE_RETURNED_TO_COURIER
+	case model.StateExpired:
+		return pb.OrderState_ORDER_STATE_EXPIRED
+	case model.StateLost:
+		return pb.OrderState_ORDER_STATE_LOST
+	default:
+		return pb.OrderState_ORDER_STATE_UNSPECIFIED
+	}
+}
+
+// convertModelTransitionToProto преобразует запись о смене статуса заказа в protobuf формат
+func convertModelTransitionToProto(transition model.OrderStateTransition) *pb.OrderStateTransition {
+	protoTransition := &pb.OrderStateTransition{
+		Id:        transition.ID,
+		OrderId:   transition.OrderID,
+		ToState:   convertModelOrderStateToProto(transition.ToState),
+		ChangedAt: timestamppb.New(transition.ChangedAt),
+	}
+
+	if transition.FromState != nil {
+		protoTransition.FromState = convertModelOrderStateToProto(*transition.FromState)
+	}
+	if transition.ChangedBy != nil {
+		protoTransition.ChangedBy = *transition.ChangedBy
+	}
+
+	return protoTransition
+}
+
+// convertModelExtensionToProto преобразует продление срока хранения заказа в protobuf формат
+func convertModelExtensionToProto(extension model.OrderExtension) *pb.OrderExtension {
+	protoExtension := &pb.OrderExtension{
+		OrderId:            extension.OrderID,
+		Days:               int32(extension.Days),
+		Fee:                extension.Fee,
+		PreviousDeadlineAt: timestamppb.New(extension.PreviousDeadlineAt),
+		DeadlineAt:         timestamppb.New(extension.DeadlineAt),
+		ExtendedAt:         timestamppb.New(extension.ExtendedAt),
+	}
+
+	if extension.ExtendedBy != nil {
+		protoExtension.ExtendedBy = *extension.ExtendedBy
+	}
+
+	return protoExtension
+}
+
+// convertModelOrderReturnToProto преобразует сведения о возврате заказа в protobuf формат
+func convertModelOrderReturnToProto(ret model.OrderReturn) *pb.OrderReturn {
+	protoReturn := &pb.OrderReturn{
+		OrderId:            ret.OrderID,
+		CustomerId:         ret.CustomerID,
+		Reason:             string(ret.Reason),
+		Comment:            ret.Comment,
+		Condition:          string(ret.Condition),
+		Photos:             ret.Photos,
+		ItemIds:            ret.ItemIDs,
+		Policy:             ret.Policy,
+		Fee:                ret.Fee,
+		Refund:             ret.Refund,
+		RequiresInspection: ret.RequiresInspection,
+		ReturnedAt:         timestamppb.New(ret.ReturnedAt),
+	}
+
+	if ret.PolicyID != nil {
+		protoReturn.PolicyId = *ret.PolicyID
+	}
+	if ret.ReturnedBy != nil {
+		protoReturn.ReturnedBy = *ret.ReturnedBy
+	}
+
+	return protoReturn
+}
+
+// orderStateFromProto преобразует protobuf статус заказа в модель
+func orderStateFromProto(state pb.OrderState) (model.OrderState, error) {
+	switch state {
+	case pb.OrderState_ORDER_STATE_ACCEPTED:
+		return model.StateAccepted, nil
+	case pb.OrderState_ORDER_STATE_DELIVERED:
+		return model.StateDelivered, nil
+	case pb.OrderState_ORDER_STATE_RETURNED:
+		return model.StateReturned, nil
+	case pb.OrderState_ORDER_STATE_RETURNED_TO_COURIER:
+		return model.StateReturnedToCourier, nil
+	case pb.OrderState_ORDER_STATE_EXPIRED:
+		return model.StateExpired, nil
+	case pb.OrderState_ORDER_STATE_LOST:
+		return model.StateLost, nil
+	default:
+		return "", fmt.Errorf("неизвестный статус заказа: %s", state)
+	}
+}
+
+// convertProtoExportRequestToFilter преобразует запрос выгрузки заказов в фильтр
+func convertProtoExportRequestToFilter(req *pb.ExportOrdersRequest) (model.OrderFilter, error) {
+	if req.GetCustomerId() < 0 {
+		return model.OrderFilter{}, fmt.Errorf("ID клиента не может быть отрицательным")
+	}
+	if req.GetPickupPointId() < 0 {
+		return model.OrderFilter{}, fmt.Errorf("ID ПВЗ не может быть отрицательным")
+	}
+
+	filter := model.OrderFilter{
+		CustomerID:    req.GetCustomerId(),
+		PickupPointID: req.GetPickupPointId(),
+		InPickupPoint: req.GetInPickupPoint(),
+		Search:        req.GetSearch(),
+	}
+
+	for _, protoState := range req.GetStates() {
+		state, err := orderStateFromProto(protoState)
+		if err != nil {
+			return model.OrderFilter{}, err
+		}
+		filter.States = append(filter.States, state)
+	}
+
+	if req.GetUpdatedFrom() != nil {
+		from := req.GetUpdatedFrom().AsTime()
+		filter.UpdatedFrom = &from
+	}
+	if req.GetUpdatedTo() != nil {
+		to := req.GetUpdatedTo().AsTime()
+		filter.UpdatedTo = &to
+	}
+	if filter.UpdatedFrom != nil && filter.UpdatedTo != nil && !filter.UpdatedFrom.Before(*filter.UpdatedTo) {
+		return model.OrderFilter{}, fmt.Errorf("начало периода должно быть раньше его конца")
+	}
+
+	return filter, nil
+}
+
+// convertProtoImportRequestToModel преобразует запрос загрузки заказов из файла в файл и параметры импорта
+func convertProtoImportRequestToModel(req *pb.AcceptOrdersFromFileRequest) (model.ImportFile, model.ImportOptions) {
+	file := model.ImportFile{
+		Name:        req.GetFilename(),
+		ContentType: req.GetContentType(),
+		Content:     req.GetFileContent(),
+	}
+	options := model.ImportOptions{
+		DryRun: req.GetDryRun(),
+		Bulk:   req.GetBulk(),
+		Format: req.GetFormat(),
+	}
+
+	return file, options
+}
+
+// convertProtoOrderToImportRecord преобразует заказ из потока загрузки в запись импорта
+func convertProtoOrderToImportRecord(req *pb.CreateOrderRequest) importer.Record {
+	record := importer.Record{
+		ID:            req.GetId(),
+		CustomerID:    req.GetCustomerId(),
+		PickupPointID: req.GetPickupPointId(),
+		DeadlineAt:    req.GetDeadlineAt(),
+		Weight:        req.GetWeight(),
+		Cost:          req.GetCost(),
+	}
+	if packageType := packageTypeFromProto(req.GetPackageType()); packageType != nil {
+		record.PackageType = string(*packageType)
+	}
+	if wrapper := wrapperTypeFromProto(req.GetWrapper()); wrapper != nil {
+		record.Wrapper = string(*wrapper)
+	}
+
+	return record
+}
+
+// importResultMessage возвращает сообщение для отчета об импорте заказов
+func importResultMessage(result model.ImportResult) string {
+	switch {
+	case result.DryRun:
+		return "Файл проверен, заказы не создавались"
+	case result.Bulk && result.Failed > 0:
+		return "Файл содержит ошибки, заказы не создавались"
+	case result.Failed > 0:
+		return "Заказы загружены, часть записей содержит ошибки"
+	default:
+		return "Заказы успешно загружены из файла"
+	}
+}
+
+// convertModelImportResultToProto преобразует отчет об импорте заказов в protobuf формат
+func convertModelImportResultToProto(message string, result model.ImportResult) *pb.AcceptOrdersFromFileResponse {
+	return &pb.AcceptOrdersFromFileResponse{
+		Message:   message,
+		DryRun:    result.DryRun,
+		Total:     int32(result.Total),
+		Succeeded: int32(result.Succeeded),
+		Failed:    int32(result.Failed),
+		Rows:      convertModelImportRowsToProto(result.Rows),
+		Bulk:      result.Bulk,
+		Format:    result.Format,
+	}
+}
+
+// convertModelImportJobToProto преобразует задачу импорта заказов в protobuf формат
+func convertModelImportJobToProto(job model.ImportJob) *pb.ImportJob {
+	protoJob := &pb.ImportJob{
+		Id:        job.ID,
+		UserId:    job.UserID,
+		Filename:  job.Filename,
+		Format:    job.Format,
+		Status:    string(job.Status),
+		DryRun:    job.DryRun,
+		Bulk:      job.Bulk,
+		Total:     int32(job.Total),
+		Processed: int32(job.Processed),
+		Succeeded: int32(job.Succeeded),
+		Failed:    int32(job.Failed),
+		Errors:    convertModelImportRowsToProto(job.Errors),
+		Error:     job.Error,
+		CreatedAt: timestamppb.New(job.CreatedAt),
+		UpdatedAt: timestamppb.New(job.UpdatedAt),
+	}
+
+	if job.StartedAt != nil {
+		protoJob.StartedAt = timestamppb.New(*job.StartedAt)
+	}
+	if job.FinishedAt != nil {
+		protoJob.FinishedAt = timestamppb.New(*job.FinishedAt)
+	}
+
+	return protoJob
+}
+
+// convertModelImportRowsToProto преобразует результаты обработки записей файла импорта в protobuf формат
+func convertModelImportRowsToProto(rows []model.ImportRowResult) []*pb.ImportRowResult {
+	protoRows := make([]*pb.ImportRowResult, 0, len(rows))
+	for _, row := range rows {
+		protoRows = append(protoRows, &pb.ImportRowResult{
+			Row:     int32(row.Row),
+			OrderId: row.OrderID,
+			Status:  string(row.Status),
+			Code:    row.Code,
+			Error:   row.Error,
+		})
+	}
+
+	return protoRows
+}
+
 // ConvertModelOrderToProto преобразует модель заказа в protobuf формат
 func convertModelOrderToProto(order model.Order) *pb.Order {
 	protoOrder := &pb.Order{
-		Id:         order.ID,
-		CustomerId: order.CustomerID,
-		Weight:     order.Weight,
-		Cost:       order.Cost,
-		UpdatedAt:  timestamppb.New(order.UpdatedAt),
+		Id:            order.ID,
+		CustomerId:    order.CustomerID,
+		PickupPointId: order.PickupPointID,
+		Version:       order.Version,
+		Weight:        order.Weight,
+		Cost:          order.Cost,
+		UpdatedAt:     timestamppb.New(order.UpdatedAt),
+		PaymentMode:   string(order.PaymentMode),
 	}
 
 	// Установка состояния заказа
-	switch order.State {
-	case model.StateAccepted:
-		protoOrder.State = pb.OrderState_ORDER_STATE_ACCEPTED
-	case model.StateDelivered:
-		protoOrder.State = pb.OrderState_ORDER_STATE_DELIVERED
-	case model.StateReturned:
-		protoOrder.State = pb.OrderState_ORDER_STATE_RETURNED
-	}
+	protoOrder.State = convertModelOrderStateToProto(order.State)
 
 	// Дедлайн
 	if !order.DeadlineAt.IsZero() {
@@ -68,6 +320,11 @@ func convertModelOrderToProto(order model.Order) *pb.Order {
 		protoOrder.DeliveredAt = timestamppb.New(*order.DeliveredAt)
 	}
 
+	// Ячейка хранения
+	if order.StorageCellID != nil {
+		protoOrder.StorageCellId = *order.StorageCellID
+	}
+
 	// Время возврата
 	if order.ReturnedAt != nil && !order.ReturnedAt.IsZero() {
 		protoOrder.ReturnedAt = timestamppb.New(*order.ReturnedAt)
@@ -93,18 +350,213 @@ func convertModelOrderToProto(order model.Order) *pb.Order {
 		}
 	}
 
+	// Товары заказа
+	for _, item := range order.Items {
+		protoOrder.Items = append(protoOrder.Items, &pb.OrderItem{
+			Id:     item.ID,
+			Name:   item.Name,
+			Weight: item.Weight,
+			Cost:   item.Cost,
+			State:  string(item.State),
+		})
+	}
+
 	return protoOrder
 }
 
+// orderItemsFromProto преобразует товары из protobuf запроса в товары заказа
+func orderItemsFromProto(items []*pb.OrderItem) []model.OrderItem {
+	result := make([]model.OrderItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, model.OrderItem{
+			Name:   item.GetName(),
+			Weight: item.GetWeight(),
+			Cost:   item.GetCost(),
+		})
+	}
+
+	return result
+}
+
+// paymentDetailsFromProto преобразует оплату из protobuf запроса в модель, пустая оплата означает ее отсутствие
+func paymentDetailsFromProto(payment *pb.PaymentDetails) *model.PaymentDetails {
+	if payment == nil {
+		return nil
+	}
+
+	return &model.PaymentDetails{
+		Method:     model.PaymentMethod(payment.GetMethod()),
+		Amount:     payment.GetAmount(),
+		CashAmount: payment.GetCashAmount(),
+	}
+}
+
+// convertModelOrderPaymentsToProto преобразует оплату заказа и возвраты денег по нему в protobuf формат
+func convertModelOrderPaymentsToProto(payments model.OrderPayments) *pb.OrderPayments {
+	protoPayments := &pb.OrderPayments{
+		OrderId:     payments.OrderID,
+		PaymentMode: string(payments.PaymentMode),
+		Refunds:     make([]*pb.Refund, 0, len(payments.Refunds)),
+	}
+
+	if payment := payments.Payment; payment != nil {
+		protoPayments.Payment = &pb.Payment{
+			Id:         payment.ID,
+			OrderId:    payment.OrderID,
+			Method:     string(payment.Method),
+			Amount:     payment.Amount,
+			CashAmount: payment.CashAmount,
+			CardAmount: payment.CardAmount,
+			PaidAt:     timestamppb.New(payment.PaidAt),
+		}
+		if payment.TransactionID != nil {
+			protoPayments.Payment.TransactionId = *payment.TransactionID
+		}
+		if payment.OperatorID != nil {
+			protoPayments.Payment.OperatorId = *payment.OperatorID
+		}
+	}
+
+	for _, refund := range payments.Refunds {
+		protoRefund := &pb.Refund{
+			Id:         refund.ID,
+			OrderId:    refund.OrderID,
+			ReturnId:   refund.ReturnID,
+			Method:     string(refund.Method),
+			Amount:     refund.Amount,
+			CashAmount: refund.CashAmount,
+			CardAmount: refund.CardAmount,
+			RefundedAt: timestamppb.New(refund.RefundedAt),
+		}
+		if refund.PaymentID != nil {
+			protoRefund.PaymentId = *refund.PaymentID
+		}
+		if refund.TransactionID != nil {
+			protoRefund.TransactionId = *refund.TransactionID
+		}
+		if refund.OperatorID != nil {
+			protoRefund.OperatorId = *refund.OperatorID
+		}
+
+		protoPayments.Refunds = append(protoPayments.Refunds, protoRefund)
+	}
+
+	return protoPayments
+}
+
 // ConvertModelsUserToProto преобразует модель пользователя в protobuf формат
 func convertModelsUserToProto(user model.User) *pb.User {
-	return &pb.User{
+	protoUser := &pb.User{
 		Id:        user.ID,
 		Username:  user.Username,
 		Role:      user.Role,
+		Status:    user.Status,
 		CreatedAt: timestamppb.New(user.CreatedAt),
 		UpdatedAt: timestamppb.New(user.UpdatedAt),
 	}
+
+	if user.PickupPointID != nil {
+		protoUser.PickupPointId = *user.PickupPointID
+	}
+
+	return protoUser
+}
+
+// convertModelPickupPointToProto преобразует модель ПВЗ в protobuf формат
+func convertModelPickupPointToProto(point model.PickupPoint) *pb.PickupPoint {
+	return &pb.PickupPoint{
+		Id:        point.ID,
+		Name:      point.Name,
+		Address:   point.Address,
+		CreatedAt: timestamppb.New(point.CreatedAt),
+		UpdatedAt: timestamppb.New(point.UpdatedAt),
+	}
+}
+
+// convertModelAPIKeyToProto преобразует модель API-ключа в protobuf формат
+func convertModelAPIKeyToProto(key model.APIKey) *pb.APIKey {
+	protoKey := &pb.APIKey{
+		Id:        key.ID,
+		UserId:    key.UserID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Scopes:    key.Scopes,
+		CreatedAt: timestamppb.New(key.CreatedAt),
+	}
+
+	if key.ExpiresAt != nil {
+		protoKey.ExpiresAt = timestamppb.New(*key.ExpiresAt)
+	}
+
+	if key.LastUsedAt != nil {
+		protoKey.LastUsedAt = timestamppb.New(*key.LastUsedAt)
+	}
+
+	return protoKey
+}
+
+// convertModelStorageCellToProto преобразует модель ячейки хранения в protobuf формат
+func convertModelStorageCellToProto(cell model.StorageCell) *pb.StorageCell {
+	protoCell := &pb.StorageCell{
+		Id:            cell.ID,
+		PickupPointId: cell.PickupPointID,
+		Rack:          cell.Rack,
+		Code:          cell.Code,
+		Capacity:      int32(cell.Capacity),
+		MaxWeight:     cell.MaxWeight,
+		CreatedAt:     timestamppb.New(cell.CreatedAt),
+	}
+
+	if cell.PackageType != nil {
+		protoCell.PackageType = string(*cell.PackageType)
+	}
+
+	return protoCell
+}
+
+// convertModelReturnPolicyToProto преобразует модель политики возврата в protobuf формат
+func convertModelReturnPolicyToProto(policy model.ReturnPolicy) *pb.ReturnPolicy {
+	protoPolicy := &pb.ReturnPolicy{
+		Id:                 policy.ID,
+		Name:               policy.Name,
+		ReturnWindowHours:  int32(policy.ReturnWindowHours),
+		NonReturnable:      policy.NonReturnable,
+		FeePercent:         policy.FeePercent,
+		RequiresInspection: policy.RequiresInspection,
+		CreatedAt:          timestamppb.New(policy.CreatedAt),
+		UpdatedAt:          timestamppb.New(policy.UpdatedAt),
+	}
+
+	if policy.PackageType != nil {
+		protoPolicy.PackageType = string(*policy.PackageType)
+	}
+	if policy.CustomerSegment != nil {
+		protoPolicy.CustomerSegment = *policy.CustomerSegment
+	}
+
+	return protoPolicy
+}
+
+// returnPolicyFromProto преобразует параметры политики возврата из protobuf формата в модель.
+// Пустые упаковка и сегмент означают, что политика действует для любых заказов и клиентов.
+func returnPolicyFromProto(req *pb.ReturnPolicyRequest) model.ReturnPolicy {
+	policy := model.ReturnPolicy{
+		Name:               req.GetName(),
+		ReturnWindowHours:  int(req.GetReturnWindowHours()),
+		NonReturnable:      req.GetNonReturnable(),
+		FeePercent:         req.GetFeePercent(),
+		RequiresInspection: req.GetRequiresInspection(),
+	}
+	if req.GetPackageType() != "" {
+		packageType := model.PackageType(req.GetPackageType())
+		policy.PackageType = &packageType
+	}
+	if req.GetCustomerSegment() != "" {
+		segment := req.GetCustomerSegment()
+		policy.CustomerSegment = &segment
+	}
+
+	return policy
 }
 
 // PackageTypeFromProto преобразует protobuf тип упаковки в модель
@@ -147,33 +599,90 @@ func parseGRPCError(err error) error {
 
 	// Bad Request errors
 	case errors.Is(err, service.ErrStorageDeadlinePassed),
+		errors.Is(err, service.ErrPickupPointRequired),
+		errors.Is(err, service.ErrEmptyCellCode),
+		errors.Is(err, service.ErrInvalidCellCapacity),
+		errors.Is(err, service.ErrInvalidCellMaxWeight),
 		errors.Is(err, service.ErrDeadlineNotExpired),
 		errors.Is(err, service.ErrNotDelivered),
 		errors.Is(err, service.ErrOpenFile),
 		errors.Is(err, service.ErrReadFile),
 		errors.Is(err, service.ErrParseFile),
+		errors.Is(err, importer.ErrUnsupportedFormat),
 		errors.Is(err, service.ErrInvalidDateFormat),
 		errors.Is(err, service.ErrNegativeWeight),
 		errors.Is(err, service.ErrInvalidOrderID),
+		errors.Is(err, service.ErrDuplicateOrderID),
 		errors.Is(err, service.ErrPackageWeightExceeded),
 		errors.Is(err, service.ErrUnknownPackageType),
 		errors.Is(err, service.ErrUnknownWrapperType),
+		errors.Is(err, service.ErrInvalidExtensionDays),
+		errors.Is(err, service.ErrEmptyReturnPolicyName),
+		errors.Is(err, service.ErrInvalidReturnWindow),
+		errors.Is(err, service.ErrInvalidReturnFee),
+		errors.Is(err, service.ErrInvalidReturnReason),
+		errors.Is(err, service.ErrInvalidReturnCondition),
+		errors.Is(err, service.ErrInvalidReturnPhotos),
+		errors.Is(err, service.ErrEmptyItemName),
+		errors.Is(err, service.ErrNoOrderItemsSelected),
+		errors.Is(err, service.ErrInvalidPaymentMode),
+		errors.Is(err, service.ErrInvalidPaymentMethod),
+		errors.Is(err, service.ErrPaymentRequired),
+		errors.Is(err, service.ErrPaymentNotRequired),
+		errors.Is(err, service.ErrPaymentAmountMismatch),
+		errors.Is(err, service.ErrInvalidCashAmount),
+		errors.Is(err, service.ErrEmptyCustomerSegment),
+		errors.Is(err, service.ErrInvalidShiftCash),
+		errors.Is(err, exporter.ErrUnsupportedReportFormat),
+		errors.Is(err, repository.ErrInvalidCustomerID),
 		errors.Is(err, service.ErrNegativeCost):
 		return status.Errorf(codes.InvalidArgument, err.Error())
 
 	// Conflict errors
 	case errors.Is(err, service.ErrOrderExists),
+		errors.Is(err, repository.ErrOrderAlreadyExists),
 		errors.Is(err, service.ErrOrderAlreadyDelivered),
-		errors.Is(err, service.ErrWrongState):
+		errors.Is(err, service.ErrWrongState),
+		errors.Is(err, repository.ErrStorageCellAlreadyExists),
+		errors.Is(err, repository.ErrReturnPolicyAlreadyExists),
+		errors.Is(err, repository.ErrShiftAlreadyOpen):
 		return status.Errorf(codes.AlreadyExists, err.Error())
 
+	// Failed precondition errors
+	case errors.Is(err, service.ErrInvalidTransition),
+		errors.Is(err, service.ErrExtensionNotAllowed),
+		errors.Is(err, service.ErrExtensionLimitExceeded),
+		errors.Is(err, service.ErrExtensionDaysExceeded),
+		errors.Is(err, service.ErrStorageExpired),
+		errors.Is(err, service.ErrOrderNotReturnable),
+		errors.Is(err, service.ErrOrderItemUnavailable),
+		errors.Is(err, service.ErrPaymentDeclined),
+		errors.Is(err, repository.ErrShiftClosed),
+		errors.Is(err, repository.ErrNoFreeStorageCell),
+		errors.Is(err, repository.ErrStorageCellOccupied):
+		return status.Errorf(codes.FailedPrecondition, err.Error())
+
+	// Aborted errors
+	case errors.Is(err, repository.ErrConcurrentModification):
+		return status.Errorf(codes.Aborted, err.Error())
+
 	// Forbidden errors
-	case errors.Is(err, service.ErrWrongCustomer):
+	case errors.Is(err, service.ErrWrongCustomer),
+		errors.Is(err, service.ErrForeignPickupPoint),
+		errors.Is(err, service.ErrPickupPointNotAssigned),
+		errors.Is(err, service.ErrImportJobUserRequired):
 		return status.Errorf(codes.PermissionDenied, err.Error())
 
 	// Not Found errors
 	case errors.Is(err, errors.New("пользователь не найден")),
-		errors.Is(err, errors.New("заказ не найден")):
+		errors.Is(err, errors.New("заказ не найден")),
+		errors.Is(err, repository.ErrPickupPointNotFound),
+		errors.Is(err, repository.ErrStorageCellNotFound),
+		errors.Is(err, repository.ErrImportJobNotFound),
+		errors.Is(err, repository.ErrReturnPolicyNotFound),
+		errors.Is(err, repository.ErrOrderItemNotFound),
+		errors.Is(err, repository.ErrShiftNotFound),
+		errors.Is(err, service.ErrOrderNotInCell):
 		return status.Errorf(codes.NotFound, err.Error())
 
 	// Default case for unhandled errors
@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gitlab.ozon.dev/gojhw1/pkg/logger"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/repository"
+	"gitlab.ozon.dev/gojhw1/pkg/service"
+)
+
+// apiKeyServiceInterface описывает интерфейс сервиса API-ключей
+type apiKeyServiceInterface interface {
+	Create(ctx context.Context, userID int64, name string, scopes []string, expiresAt *time.Time) (model.APIKey, string, error)
+	List(ctx context.Context, userID int64) ([]model.APIKey, error)
+	Revoke(ctx context.Context, userID, keyID int64) error
+}
+
+// createAPIKeyRequest описывает структуру запроса на выпуск API-ключа
+type createAPIKeyRequest struct {
+	Name      string     `json:"name"`
+	Scopes    []string   `json:"scopes"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// APIKeyHandler обработчик запросов для управления API-ключами пользователей
+type APIKeyHandler struct {
+	service apiKeyServiceInterface
+}
+
+// NewAPIKeyHandler создает новый обработчик API-ключей
+func NewAPIKeyHandler(service apiKeyServiceInterface) *APIKeyHandler {
+	return &APIKeyHandler{service: service}
+}
+
+// CreateAPIKey обрабатывает запрос на выпуск API-ключа для пользователя.
+// Ключ возвращается в ответе один раз и больше не может быть получен.
+func (h *APIKeyHandler) CreateAPIKey(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, err := parseUserIDFromParams(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	var req createAPIKeyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Ошибка при разборе запроса",
+		})
+	}
+
+	key, rawKey, err := h.service.Create(ctx, userID, req.Name, req.Scopes, req.ExpiresAt)
+	if err != nil {
+		return apiKeyError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"api_key": key,
+		"key":     rawKey,
+	})
+}
+
+// ListAPIKeys обрабатывает запрос на получение API-ключей пользователя
+func (h *APIKeyHandler) ListAPIKeys(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, err := parseUserIDFromParams(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	keys, err := h.service.List(ctx, userID)
+	if err != nil {
+		return apiKeyError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"api_keys": keys,
+		"total":    len(keys),
+	})
+}
+
+// RevokeAPIKey обрабатывает запрос на отзыв API-ключа пользователя
+func (h *APIKeyHandler) RevokeAPIKey(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userID, err := parseUserIDFromParams(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	keyID, err := strconv.ParseInt(c.Params("keyId"), 10, 64)
+	if err != nil || keyID <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrInvalidAPIKeyID.Error(),
+		})
+	}
+
+	if err := h.service.Revoke(ctx, userID, keyID); err != nil {
+		return apiKeyError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "API-ключ успешно отозван",
+	})
+}
+
+// apiKeyError преобразует ошибку сервиса API-ключей в HTTP-ответ
+func apiKeyError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, service.ErrEmptyAPIKeyName),
+		errors.Is(err, service.ErrEmptyAPIKeyScopes),
+		errors.Is(err, service.ErrInvalidAPIKeyScope),
+		errors.Is(err, service.ErrInvalidAPIKeyExpiry):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	case errors.Is(err, repository.ErrUserNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Пользователь не найден",
+		})
+	case errors.Is(err, repository.ErrAPIKeyNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": repository.ErrAPIKeyNotFound.Error(),
+		})
+	default:
+		logger.Errorf("Ошибка работы с API-ключами: %v", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Ошибка при работе с API-ключами",
+		})
+	}
+}
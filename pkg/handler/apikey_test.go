@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/repository"
+	"gitlab.ozon.dev/gojhw1/pkg/service"
+	"go.uber.org/mock/gomock"
+)
+
+// setupAPIKeyTest создает тестовое окружение и возвращает app, mockService и функцию для очистки ресурсов
+func setupAPIKeyTest(t *testing.T) (*fiber.App, *MockapiKeyServiceInterface, func()) {
+	ctrl := gomock.NewController(t)
+	mockService := NewMockapiKeyServiceInterface(ctrl)
+
+	app := fiber.New()
+	handler := NewAPIKeyHandler(mockService)
+
+	app.Post("/users/:id/api-keys", handler.CreateAPIKey)
+	app.Get("/users/:id/api-keys", handler.ListAPIKeys)
+	app.Delete("/users/:id/api-keys/:keyId", handler.RevokeAPIKey)
+
+	cleanup := func() {
+		ctrl.Finish()
+	}
+
+	return app, mockService, cleanup
+}
+
+func TestAPIKeyHandler(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2025, 4, 20, 10, 0, 0, 0, time.UTC)
+	key := model.APIKey{
+		ID:        7,
+		UserID:    1,
+		Name:      "courier-sync",
+		Prefix:    "a1b2c3d4e5f6",
+		Scopes:    []string{"orders:read", "orders:accept"},
+		CreatedAt: createdAt,
+	}
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		requestBody    any
+		mockSetup      func(mockService *MockapiKeyServiceInterface)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "create key",
+			method:      http.MethodPost,
+			path:        "/users/1/api-keys",
+			requestBody: createAPIKeyRequest{Name: "courier-sync", Scopes: []string{"orders:read", "orders:accept"}},
+			mockSetup: func(mockService *MockapiKeyServiceInterface) {
+				mockService.EXPECT().
+					Create(gomock.Any(), int64(1), "courier-sync", []string{"orders:read", "orders:accept"}, nil).
+					Return(key, "pvz_a1b2c3d4e5f6_secret", nil)
+			},
+			expectedStatus: fiber.StatusCreated,
+			expectedBody:   `"key":"pvz_a1b2c3d4e5f6_secret"`,
+		},
+		{
+			name:        "create key with scope outside role",
+			method:      http.MethodPost,
+			path:        "/users/2/api-keys",
+			requestBody: createAPIKeyRequest{Name: "cleanup", Scopes: []string{"db:clear"}},
+			mockSetup: func(mockService *MockapiKeyServiceInterface) {
+				mockService.EXPECT().
+					Create(gomock.Any(), int64(2), "cleanup", []string{"db:clear"}, nil).
+					Return(model.APIKey{}, "", fmt.Errorf("%w: db:clear", service.ErrInvalidAPIKeyScope))
+			},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `{"error":"недопустимая область действия API-ключа: db:clear"}`,
+		},
+		{
+			name:        "create key for unknown user",
+			method:      http.MethodPost,
+			path:        "/users/99/api-keys",
+			requestBody: createAPIKeyRequest{Name: "sync", Scopes: []string{"orders:read"}},
+			mockSetup: func(mockService *MockapiKeyServiceInterface) {
+				mockService.EXPECT().
+					Create(gomock.Any(), int64(99), "sync", []string{"orders:read"}, nil).
+					Return(model.APIKey{}, "", repository.ErrUserNotFound)
+			},
+			expectedStatus: fiber.StatusNotFound,
+			expectedBody:   `{"error":"Пользователь не найден"}`,
+		},
+		{
+			name:   "list keys",
+			method: http.MethodGet,
+			path:   "/users/1/api-keys",
+			mockSetup: func(mockService *MockapiKeyServiceInterface) {
+				mockService.EXPECT().List(gomock.Any(), int64(1)).Return([]model.APIKey{key}, nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `"prefix":"a1b2c3d4e5f6"`,
+		},
+		{
+			name:   "list keys with storage error",
+			method: http.MethodGet,
+			path:   "/users/1/api-keys",
+			mockSetup: func(mockService *MockapiKeyServiceInterface) {
+				mockService.EXPECT().List(gomock.Any(), int64(1)).Return(nil, errors.New("db is down"))
+			},
+			expectedStatus: fiber.StatusInternalServerError,
+			expectedBody:   `{"error":"Ошибка при работе с API-ключами"}`,
+		},
+		{
+			name:   "revoke key",
+			method: http.MethodDelete,
+			path:   "/users/1/api-keys/7",
+			mockSetup: func(mockService *MockapiKeyServiceInterface) {
+				mockService.EXPECT().Revoke(gomock.Any(), int64(1), int64(7)).Return(nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `{"message":"API-ключ успешно отозван"}`,
+		},
+		{
+			name:   "revoke unknown key",
+			method: http.MethodDelete,
+			path:   "/users/1/api-keys/8",
+			mockSetup: func(mockService *MockapiKeyServiceInterface) {
+				mockService.EXPECT().Revoke(gomock.Any(), int64(1), int64(8)).Return(repository.ErrAPIKeyNotFound)
+			},
+			expectedStatus: fiber.StatusNotFound,
+			expectedBody:   `{"error":"API-ключ не найден"}`,
+		},
+		{
+			name:           "revoke with invalid key id",
+			method:         http.MethodDelete,
+			path:           "/users/1/api-keys/abc",
+			mockSetup:      func(mockService *MockapiKeyServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `{"error":"неверный формат ID API-ключа"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app, mockService, cleanup := setupAPIKeyTest(t)
+			defer cleanup()
+
+			tt.mockSetup(mockService)
+
+			var body io.Reader
+			if tt.requestBody != nil {
+				reqBody, err := json.Marshal(tt.requestBody)
+				require.NoError(t, err)
+				body = bytes.NewReader(reqBody)
+			}
+
+			req := httptest.NewRequest(tt.method, tt.path, body)
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Contains(t, string(respBody), tt.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"gitlab.ozon.dev/gojhw1/pkg/logger"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/service"
+)
+
+var (
+	// ErrEmptyRefreshToken возникает, когда в запросе не передан refresh-токен
+	ErrEmptyRefreshToken = errors.New("refresh-токен не может быть пустым")
+	// ErrEmptyUnlockTarget возникает, когда для снятия блокировки не указаны ни пользователь, ни IP-адрес
+	ErrEmptyUnlockTarget = errors.New("необходимо указать имя пользователя или IP-адрес")
+)
+
+// authServiceInterface описывает интерфейс сервиса аутентификации
+type authServiceInterface interface {
+	Login(ctx context.Context, username, password, ip string) (model.TokenPair, error)
+	Refresh(ctx context.Context, refreshToken string) (model.TokenPair, error)
+	Logout(ctx context.Context, refreshToken string) error
+	Unlock(ctx context.Context, username, ip string) error
+}
+
+// loginRequest описывает структуру запроса на вход
+type loginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// refreshRequest описывает структуру запроса на обновление токенов и выход
+type refreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// unlockRequest описывает структуру запроса на снятие блокировки входа
+type unlockRequest struct {
+	Username string `json:"username"`
+	IP       string `json:"ip"`
+}
+
+// AuthHandler обработчик запросов для аутентификации
+type AuthHandler struct {
+	service authServiceInterface
+}
+
+// NewAuthHandler создает новый обработчик аутентификации
+func NewAuthHandler(service authServiceInterface) *AuthHandler {
+	return &AuthHandler{service: service}
+}
+
+// Login обрабатывает запрос на вход по имени пользователя и паролю.
+// Возвращает access-токен и refresh-токен.
+func (h *AuthHandler) Login(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req loginRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Ошибка при разборе запроса",
+		})
+	}
+
+	if req.Username == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrEmptyUsername.Error(),
+		})
+	}
+
+	if req.Password == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrEmptyPassword.Error(),
+		})
+	}
+
+	tokens, err := h.service.Login(ctx, req.Username, req.Password, c.IP())
+	if err != nil {
+		return authError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(tokens)
+}
+
+// Refresh обрабатывает запрос на обмен refresh-токена на новую пару токенов
+func (h *AuthHandler) Refresh(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req refreshRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Ошибка при разборе запроса",
+		})
+	}
+
+	if req.RefreshToken == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrEmptyRefreshToken.Error(),
+		})
+	}
+
+	tokens, err := h.service.Refresh(ctx, req.RefreshToken)
+	if err != nil {
+		return authError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(tokens)
+}
+
+// Logout обрабатывает запрос на выход: отзывает refresh-токен
+func (h *AuthHandler) Logout(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req refreshRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Ошибка при разборе запроса",
+		})
+	}
+
+	if req.RefreshToken == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrEmptyRefreshToken.Error(),
+		})
+	}
+
+	if err := h.service.Logout(ctx, req.RefreshToken); err != nil {
+		return authError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Сессия завершена",
+	})
+}
+
+// Unlock обрабатывает запрос администратора на снятие блокировки входа
+// для пользователя и/или IP-адреса
+func (h *AuthHandler) Unlock(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req unlockRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Ошибка при разборе запроса",
+		})
+	}
+
+	if req.Username == "" && req.IP == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrEmptyUnlockTarget.Error(),
+		})
+	}
+
+	if err := h.service.Unlock(ctx, req.Username, req.IP); err != nil {
+		logger.Errorf("Ошибка снятия блокировки входа: %v", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Ошибка при снятии блокировки входа",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Блокировка входа снята",
+	})
+}
+
+// authError преобразует ошибку сервиса аутентификации в HTTP-ответ
+func authError(c *fiber.Ctx, err error) error {
+	switch {
+	// Подробности не возвращаем клиенту, чтобы не раскрывать причину отказа
+	case errors.Is(err, service.ErrInvalidCredentials):
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": service.ErrInvalidCredentials.Error(),
+		})
+	case errors.Is(err, service.ErrInvalidToken):
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": service.ErrInvalidToken.Error(),
+		})
+	case errors.Is(err, service.ErrAccountLocked):
+		return c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	case errors.Is(err, service.ErrUserNotActive):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	default:
+		logger.Errorf("Ошибка аутентификации: %v", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Ошибка при аутентификации",
+		})
+	}
+}
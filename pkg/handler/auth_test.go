@@ -0,0 +1,305 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/repository"
+	"gitlab.ozon.dev/gojhw1/pkg/service"
+	"go.uber.org/mock/gomock"
+)
+
+// setupAuthTest создает тестовое окружение и возвращает app, mockService и функцию для очистки ресурсов
+func setupAuthTest(t *testing.T) (*fiber.App, *MockauthServiceInterface, func()) {
+	ctrl := gomock.NewController(t)
+	mockService := NewMockauthServiceInterface(ctrl)
+
+	app := fiber.New()
+	handler := NewAuthHandler(mockService)
+
+	app.Post("/auth/login", handler.Login)
+	app.Post("/auth/refresh", handler.Refresh)
+	app.Post("/auth/logout", handler.Logout)
+	app.Post("/auth/unlock", handler.Unlock)
+
+	cleanup := func() {
+		ctrl.Finish()
+	}
+
+	return app, mockService, cleanup
+}
+
+func TestAuthHandler_Login(t *testing.T) {
+	t.Parallel()
+
+	tokens := model.TokenPair{
+		AccessToken:  "access",
+		RefreshToken: "refresh",
+		TokenType:    "Bearer",
+		ExpiresIn:    900,
+	}
+
+	tests := []struct {
+		name           string
+		requestBody    any
+		mockSetup      func(mockService *MockauthServiceInterface)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "success login",
+			requestBody: loginRequest{Username: "admin", Password: "admin"},
+			mockSetup: func(mockService *MockauthServiceInterface) {
+				mockService.EXPECT().Login(gomock.Any(), "admin", "admin", gomock.Any()).Return(tokens, nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `{"access_token":"access","refresh_token":"refresh","token_type":"Bearer","expires_in":900}`,
+		},
+		{
+			name:        "invalid credentials",
+			requestBody: loginRequest{Username: "admin", Password: "wrong"},
+			mockSetup: func(mockService *MockauthServiceInterface) {
+				mockService.EXPECT().Login(gomock.Any(), "admin", "wrong", gomock.Any()).Return(model.TokenPair{}, service.ErrInvalidCredentials)
+			},
+			expectedStatus: fiber.StatusUnauthorized,
+			expectedBody:   `{"error":"неверные учетные данные"}`,
+		},
+		{
+			name:        "pending user",
+			requestBody: loginRequest{Username: "newuser", Password: "pass"},
+			mockSetup: func(mockService *MockauthServiceInterface) {
+				mockService.EXPECT().Login(gomock.Any(), "newuser", "pass", gomock.Any()).Return(model.TokenPair{}, service.ErrUserNotActive)
+			},
+			expectedStatus: fiber.StatusForbidden,
+			expectedBody:   `{"error":"учетная запись ожидает подтверждения администратором"}`,
+		},
+		{
+			name:        "account locked",
+			requestBody: loginRequest{Username: "admin", Password: "wrong"},
+			mockSetup: func(mockService *MockauthServiceInterface) {
+				mockService.EXPECT().Login(gomock.Any(), "admin", "wrong", gomock.Any()).
+					Return(model.TokenPair{}, fmt.Errorf("%w: повторите через 15m0s", service.ErrAccountLocked))
+			},
+			expectedStatus: fiber.StatusTooManyRequests,
+			expectedBody:   `{"error":"слишком много неудачных попыток входа, вход временно заблокирован: повторите через 15m0s"}`,
+		},
+		{
+			name:        "internal error",
+			requestBody: loginRequest{Username: "admin", Password: "admin"},
+			mockSetup: func(mockService *MockauthServiceInterface) {
+				mockService.EXPECT().Login(gomock.Any(), "admin", "admin", gomock.Any()).Return(model.TokenPair{}, errors.New("db is down"))
+			},
+			expectedStatus: fiber.StatusInternalServerError,
+			expectedBody:   `{"error":"Ошибка при аутентификации"}`,
+		},
+		{
+			name:           "empty password",
+			requestBody:    loginRequest{Username: "admin"},
+			mockSetup:      func(mockService *MockauthServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `{"error":"пароль не может быть пустым"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app, mockService, cleanup := setupAuthTest(t)
+			defer cleanup()
+
+			tt.mockSetup(mockService)
+
+			reqBody, err := json.Marshal(tt.requestBody)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/login", bytes.NewReader(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Contains(t, string(body), tt.expectedBody)
+		})
+	}
+}
+
+func TestAuthHandler_RefreshAndLogout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		path           string
+		requestBody    any
+		mockSetup      func(mockService *MockauthServiceInterface)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "success refresh",
+			path:        "/auth/refresh",
+			requestBody: refreshRequest{RefreshToken: "refresh"},
+			mockSetup: func(mockService *MockauthServiceInterface) {
+				mockService.EXPECT().Refresh(gomock.Any(), "refresh").Return(model.TokenPair{AccessToken: "new-access"}, nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `"access_token":"new-access"`,
+		},
+		{
+			name:        "revoked refresh token",
+			path:        "/auth/refresh",
+			requestBody: refreshRequest{RefreshToken: "revoked"},
+			mockSetup: func(mockService *MockauthServiceInterface) {
+				mockService.EXPECT().Refresh(gomock.Any(), "revoked").
+					Return(model.TokenPair{}, fmt.Errorf("%w: %w", service.ErrInvalidToken, repository.ErrRefreshTokenNotFound))
+			},
+			expectedStatus: fiber.StatusUnauthorized,
+			expectedBody:   `{"error":"недействительный или просроченный токен"}`,
+		},
+		{
+			name:           "empty refresh token",
+			path:           "/auth/refresh",
+			requestBody:    refreshRequest{},
+			mockSetup:      func(mockService *MockauthServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `{"error":"refresh-токен не может быть пустым"}`,
+		},
+		{
+			name:        "success logout",
+			path:        "/auth/logout",
+			requestBody: refreshRequest{RefreshToken: "refresh"},
+			mockSetup: func(mockService *MockauthServiceInterface) {
+				mockService.EXPECT().Logout(gomock.Any(), "refresh").Return(nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `{"message":"Сессия завершена"}`,
+		},
+		{
+			name:        "logout with unknown token",
+			path:        "/auth/logout",
+			requestBody: refreshRequest{RefreshToken: "unknown"},
+			mockSetup: func(mockService *MockauthServiceInterface) {
+				mockService.EXPECT().Logout(gomock.Any(), "unknown").
+					Return(fmt.Errorf("%w: %w", service.ErrInvalidToken, repository.ErrRefreshTokenNotFound))
+			},
+			expectedStatus: fiber.StatusUnauthorized,
+			expectedBody:   `{"error":"недействительный или просроченный токен"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app, mockService, cleanup := setupAuthTest(t)
+			defer cleanup()
+
+			tt.mockSetup(mockService)
+
+			reqBody, err := json.Marshal(tt.requestBody)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, bytes.NewReader(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Contains(t, string(body), tt.expectedBody)
+		})
+	}
+}
+
+func TestAuthHandler_Unlock(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		requestBody    any
+		mockSetup      func(mockService *MockauthServiceInterface)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "unlock user",
+			requestBody: unlockRequest{Username: "admin"},
+			mockSetup: func(mockService *MockauthServiceInterface) {
+				mockService.EXPECT().Unlock(gomock.Any(), "admin", "").Return(nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `{"message":"Блокировка входа снята"}`,
+		},
+		{
+			name:        "unlock ip",
+			requestBody: unlockRequest{IP: "10.0.0.1"},
+			mockSetup: func(mockService *MockauthServiceInterface) {
+				mockService.EXPECT().Unlock(gomock.Any(), "", "10.0.0.1").Return(nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `{"message":"Блокировка входа снята"}`,
+		},
+		{
+			name:           "empty target",
+			requestBody:    unlockRequest{},
+			mockSetup:      func(mockService *MockauthServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `{"error":"необходимо указать имя пользователя или IP-адрес"}`,
+		},
+		{
+			name:        "store error",
+			requestBody: unlockRequest{Username: "admin"},
+			mockSetup: func(mockService *MockauthServiceInterface) {
+				mockService.EXPECT().Unlock(gomock.Any(), "admin", "").Return(errors.New("redis is down"))
+			},
+			expectedStatus: fiber.StatusInternalServerError,
+			expectedBody:   `{"error":"Ошибка при снятии блокировки входа"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app, mockService, cleanup := setupAuthTest(t)
+			defer cleanup()
+
+			tt.mockSetup(mockService)
+
+			reqBody, err := json.Marshal(tt.requestBody)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/unlock", bytes.NewReader(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Contains(t, string(body), tt.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gitlab.ozon.dev/gojhw1/pkg/exporter"
+	"gitlab.ozon.dev/gojhw1/pkg/logger"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+// ExportOrders обрабатывает запрос на выгрузку заказов в файл CSV, NDJSON или XLSX.
+// Фильтры совпадают с фильтрами списка заказов, дополнительно можно указать статусы и период изменения.
+// Файл записывается в ответ по мере чтения заказов из БД. Ошибка после начала записи
+// только прерывает ответ, поэтому права и параметры проверяются до нее.
+func (h *OrderHandler) ExportOrders(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	format, err := exporter.Resolve(c.Query("format"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	filter, err := parseOrderFilter(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	export, err := h.service.ExportOrders(ctx, filter)
+	if err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при выгрузке заказов: %v", msg),
+		})
+	}
+
+	c.Set(fiber.HeaderContentType, exporter.ContentType(format))
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, exporter.Filename(format)))
+	c.Status(fiber.StatusOK).Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		writer, err := exporter.NewWriter(format, w)
+		if err != nil {
+			logger.Errorf("Ошибка начала выгрузки заказов: %v", err)
+			return
+		}
+
+		if err = export(writer.Write); err != nil {
+			logger.Errorf("Выгрузка заказов прервана: %v", err)
+			return
+		}
+
+		if err = writer.Close(); err != nil {
+			logger.Errorf("Ошибка завершения выгрузки заказов: %v", err)
+			return
+		}
+
+		if err = w.Flush(); err != nil {
+			logger.Errorf("Ошибка отправки выгрузки заказов: %v", err)
+		}
+	})
+
+	return nil
+}
+
+// parseOrderFilter извлекает фильтр выгрузки заказов из параметров запроса
+func parseOrderFilter(c *fiber.Ctx) (model.OrderFilter, error) {
+	filter := model.OrderFilter{
+		InPickupPoint: c.Query("pvz") == "true",
+		Search:        c.Query("search"),
+	}
+
+	var err error
+	if customerID := c.Query("customer_id"); customerID != "" {
+		if filter.CustomerID, err = parseCustomerIDFromString(customerID); err != nil {
+			return model.OrderFilter{}, err
+		}
+	}
+
+	if pickupPointID := c.Query("pickup_point_id"); pickupPointID != "" {
+		filter.PickupPointID, err = strconv.ParseInt(pickupPointID, 10, 64)
+		if err != nil || filter.PickupPointID <= 0 {
+			return model.OrderFilter{}, ErrInvalidPickupPointID
+		}
+	}
+
+	if states := c.Query("state"); states != "" {
+		for _, state := range strings.Split(states, ",") {
+			state := model.OrderState(strings.TrimSpace(state))
+			if !state.Valid() {
+				return model.OrderFilter{}, fmt.Errorf("%w: %s", ErrInvalidOrderState, state)
+			}
+			filter.States = append(filter.States, state)
+		}
+	}
+
+	if filter.UpdatedFrom, err = parseTimeFromString(c.Query("from")); err != nil {
+		return model.OrderFilter{}, err
+	}
+	if filter.UpdatedTo, err = parseTimeFromString(c.Query("to")); err != nil {
+		return model.OrderFilter{}, err
+	}
+	if filter.UpdatedFrom != nil && filter.UpdatedTo != nil && !filter.UpdatedFrom.Before(*filter.UpdatedTo) {
+		return model.OrderFilter{}, ErrInvalidTimeRange
+	}
+
+	return filter, nil
+}
+
+// parseTimeFromString разбирает время в формате RFC 3339 или 2006-01-02T15:04:05 (UTC), пустая строка - nil
+func parseTimeFromString(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		if t, err = time.Parse(timeLayout, value); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidTime, value)
+		}
+	}
+
+	return &t, nil
+}
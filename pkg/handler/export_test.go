@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/service"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOrderHandler_ExportOrders(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC)
+	orders := []model.Order{
+		{ID: 2, CustomerID: 20, State: model.StateDelivered, Weight: 2, Cost: 250.5, DeadlineAt: from, UpdatedAt: from, Version: 2},
+		{ID: 1, CustomerID: 10, State: model.StateAccepted, Weight: 1, Cost: 100, DeadlineAt: from, UpdatedAt: from, Version: 1},
+	}
+	export := func(orders []model.Order, err error) service.OrderExport {
+		return func(fn func(order model.Order) error) error {
+			for _, order := range orders {
+				if err := fn(order); err != nil {
+					return err
+				}
+			}
+			return err
+		}
+	}
+
+	tests := []struct {
+		name                string
+		query               string
+		mockSetup           func(mockService *MockorderServiceInterface)
+		expectedStatus      int
+		expectedContentType string
+		expectedBody        string
+	}{
+		{
+			name:  "csv with filters",
+			query: "?customer_id=20&state=delivered,accepted&from=2030-01-01T00:00:00&to=2030-02-01T00:00:00Z&search=2",
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().ExportOrders(gomock.Any(), model.OrderFilter{
+					CustomerID:  20,
+					States:      []model.OrderState{model.StateDelivered, model.StateAccepted},
+					UpdatedFrom: &from,
+					UpdatedTo:   &to,
+					Search:      "2",
+				}).Return(export(orders[:1], nil), nil)
+			},
+			expectedStatus:      fiber.StatusOK,
+			expectedContentType: "text/csv; charset=utf-8",
+			expectedBody: "id,customer_id,pickup_point_id,state,deadline_at,weight,cost,package_type,wrapper,updated_at,delivered_at,returned_at,storage_cell_id,version\n" +
+				"2,20,,delivered,2030-01-01T00:00:00,2,250.5,,,2030-01-01T00:00:00,,,,2\n",
+		},
+		{
+			name:  "ndjson",
+			query: "?format=ndjson&pvz=true",
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().ExportOrders(gomock.Any(), model.OrderFilter{InPickupPoint: true}).
+					Return(export(orders, nil), nil)
+			},
+			expectedStatus:      fiber.StatusOK,
+			expectedContentType: "application/x-ndjson",
+			expectedBody:        `"version":2}` + "\n" + `{"id":1,`,
+		},
+		{
+			name:           "unsupported format",
+			query:          "?format=pdf",
+			mockSetup:      func(mockService *MockorderServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `неподдерживаемый формат выгрузки заказов: pdf`,
+		},
+		{
+			name:           "unknown state",
+			query:          "?state=accepted,archived",
+			mockSetup:      func(mockService *MockorderServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `неизвестный статус заказа: archived`,
+		},
+		{
+			name:           "empty period",
+			query:          "?from=2030-02-01T00:00:00&to=2030-01-01T00:00:00",
+			mockSetup:      func(mockService *MockorderServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   ErrInvalidTimeRange.Error(),
+		},
+		{
+			name: "employee without pickup point",
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().ExportOrders(gomock.Any(), model.OrderFilter{}).
+					Return(nil, service.ErrPickupPointNotAssigned)
+			},
+			expectedStatus: fiber.StatusForbidden,
+			expectedBody:   `Ошибка при выгрузке заказов`,
+		},
+		{
+			name: "export interrupted",
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().ExportOrders(gomock.Any(), model.OrderFilter{}).
+					Return(export(orders[:1], errors.New("соединение с БД потеряно")), nil)
+			},
+			expectedStatus:      fiber.StatusOK,
+			expectedContentType: "text/csv; charset=utf-8",
+			expectedBody:        "2,20,,delivered",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockService := NewMockorderServiceInterface(ctrl)
+			tt.mockSetup(mockService)
+
+			app := fiber.New()
+			app.Get("/orders/export", NewOrderHandler(mockService).ExportOrders)
+
+			req := httptest.NewRequest(http.MethodGet, "/orders/export"+tt.query, nil)
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			if tt.expectedContentType != "" {
+				assert.Equal(t, tt.expectedContentType, resp.Header.Get(fiber.HeaderContentType))
+			}
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Contains(t, string(body), tt.expectedBody)
+		})
+	}
+}
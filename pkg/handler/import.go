@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/service"
+)
+
+// importJobServiceInterface описывает интерфейс сервиса задач импорта заказов
+type importJobServiceInterface interface {
+	Submit(ctx context.Context, file model.ImportFile, options model.ImportOptions) (model.ImportJob, error)
+	Get(ctx context.Context, id int64) (model.ImportJob, error)
+}
+
+// ImportHandler обработчик запросов для импорта заказов из файла
+type ImportHandler struct {
+	service importJobServiceInterface
+}
+
+// NewImportHandler создает новый обработчик импорта заказов
+func NewImportHandler(service importJobServiceInterface) *ImportHandler {
+	return &ImportHandler{service: service}
+}
+
+// AcceptOrdersFromFile обрабатывает запрос на загрузку заказов из файла.
+// Ставит импорт файла в очередь и сразу возвращает задачу, ход которой можно узнать по ее ID.
+// При dry_run=true записи только проверяются, при mode=bulk заказы принимаются одной транзакцией
+// или не принимается ни один. Формат файла задается параметром format (json, ndjson, csv, xlsx),
+// а если он не указан - определяется по расширению и типу содержимого файла.
+func (h *ImportHandler) AcceptOrdersFromFile(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при получении файла: %v", err),
+		})
+	}
+
+	content, err := readFormFile(file)
+	if err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при обработке файла: %v", msg),
+		})
+	}
+
+	options := model.ImportOptions{
+		DryRun: c.Query("dry_run") == "true",
+		Bulk:   c.Query("mode") == "bulk",
+		Format: c.Query("format"),
+	}
+
+	job, err := h.service.Submit(ctx, model.ImportFile{
+		Name:        file.Filename,
+		ContentType: file.Header.Get(fiber.HeaderContentType),
+		Content:     content,
+	}, options)
+	if err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при обработке файла: %v", msg),
+		})
+	}
+
+	c.Location(fmt.Sprintf("/api/v1/imports/%d", job.ID))
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"message": "Файл принят, заказы будут загружены в фоне",
+		"job":     job,
+	})
+}
+
+// GetImportJob обрабатывает запрос на получение хода и результата задачи импорта
+func (h *ImportHandler) GetImportJob(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil || id <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrInvalidImportJobID.Error(),
+		})
+	}
+
+	job, err := h.service.Get(ctx, id)
+	if err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при получении задачи импорта: %v", msg),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(job)
+}
+
+// readFormFile читает содержимое загруженного файла
+func readFormFile(header *multipart.FileHeader) ([]byte, error) {
+	file, err := header.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", service.ErrOpenFile, err)
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", service.ErrReadFile, err)
+	}
+
+	return content, nil
+}
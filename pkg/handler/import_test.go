@@ -0,0 +1,216 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/gojhw1/pkg/importer"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/repository"
+	"gitlab.ozon.dev/gojhw1/pkg/service"
+	"go.uber.org/mock/gomock"
+)
+
+// setupImportTest создает тестовое окружение и возвращает app, mockService и функцию для очистки ресурсов
+func setupImportTest(t *testing.T) (*fiber.App, *MockimportJobServiceInterface, func()) {
+	ctrl := gomock.NewController(t)
+	mockService := NewMockimportJobServiceInterface(ctrl)
+
+	app := fiber.New()
+	handler := NewImportHandler(mockService)
+
+	app.Post("/upload", handler.AcceptOrdersFromFile)
+	app.Get("/imports/:id", handler.GetImportJob)
+
+	cleanup := func() {
+		ctrl.Finish()
+	}
+
+	return app, mockService, cleanup
+}
+
+func TestImportHandler_AcceptOrdersFromFile(t *testing.T) {
+	t.Parallel()
+
+	content := []byte(`[{"id":123,"customer_id":456,"deadline_at":"24h","weight":1,"cost":100}]`)
+	file := model.ImportFile{Name: "orders.json", ContentType: "application/octet-stream", Content: content}
+	job := model.ImportJob{ID: 7, UserID: 1, Filename: "orders.json", Format: "json", Status: model.ImportJobPending, Total: 1}
+
+	tests := []struct {
+		name             string
+		query            string
+		mockSetup        func(mockService *MockimportJobServiceInterface)
+		expectedStatus   int
+		expectedBody     string
+		expectedLocation string
+	}{
+		{
+			name: "job submitted",
+			mockSetup: func(mockService *MockimportJobServiceInterface) {
+				mockService.EXPECT().
+					Submit(gomock.Any(), file, model.ImportOptions{}).
+					Return(job, nil)
+			},
+			expectedStatus:   fiber.StatusAccepted,
+			expectedBody:     `"job":{"id":7,"user_id":1,"filename":"orders.json","format":"json","status":"pending"`,
+			expectedLocation: "/api/v1/imports/7",
+		},
+		{
+			name:  "bulk dry run",
+			query: "?dry_run=true&mode=bulk",
+			mockSetup: func(mockService *MockimportJobServiceInterface) {
+				mockService.EXPECT().
+					Submit(gomock.Any(), file, model.ImportOptions{DryRun: true, Bulk: true}).
+					Return(model.ImportJob{ID: 8, Status: model.ImportJobPending, DryRun: true, Bulk: true}, nil)
+			},
+			expectedStatus:   fiber.StatusAccepted,
+			expectedBody:     `"dry_run":true,"bulk":true`,
+			expectedLocation: "/api/v1/imports/8",
+		},
+		{
+			name:  "explicit format",
+			query: "?format=ndjson",
+			mockSetup: func(mockService *MockimportJobServiceInterface) {
+				mockService.EXPECT().
+					Submit(gomock.Any(), file, model.ImportOptions{Format: "ndjson"}).
+					Return(model.ImportJob{ID: 9, Format: "ndjson", Status: model.ImportJobPending}, nil)
+			},
+			expectedStatus:   fiber.StatusAccepted,
+			expectedBody:     `"format":"ndjson"`,
+			expectedLocation: "/api/v1/imports/9",
+		},
+		{
+			name:  "unsupported format",
+			query: "?format=xml",
+			mockSetup: func(mockService *MockimportJobServiceInterface) {
+				mockService.EXPECT().
+					Submit(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(model.ImportJob{}, fmt.Errorf("%w: xml", importer.ErrUnsupportedFormat))
+			},
+			expectedStatus: fiber.StatusUnsupportedMediaType,
+			expectedBody:   `неподдерживаемый формат файла с заказами: xml`,
+		},
+		{
+			name: "unreadable file",
+			mockSetup: func(mockService *MockimportJobServiceInterface) {
+				mockService.EXPECT().
+					Submit(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(model.ImportJob{}, service.ErrParseFile)
+			},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `ошибка при разборе файла принятия заказов`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app, mockService, cleanup := setupImportTest(t)
+			defer cleanup()
+
+			tt.mockSetup(mockService)
+
+			var buf bytes.Buffer
+			writer := multipart.NewWriter(&buf)
+			part, err := writer.CreateFormFile("file", "orders.json")
+			require.NoError(t, err)
+			_, err = part.Write(content)
+			require.NoError(t, err)
+			require.NoError(t, writer.Close())
+
+			req := httptest.NewRequest(http.MethodPost, "/upload"+tt.query, &buf)
+			req.Header.Set("Content-Type", writer.FormDataContentType())
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			assert.Equal(t, tt.expectedLocation, resp.Header.Get(fiber.HeaderLocation))
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Contains(t, string(body), tt.expectedBody)
+		})
+	}
+}
+
+func TestImportHandler_GetImportJob(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		path           string
+		mockSetup      func(mockService *MockimportJobServiceInterface)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "job in progress",
+			path: "/imports/7",
+			mockSetup: func(mockService *MockimportJobServiceInterface) {
+				mockService.EXPECT().Get(gomock.Any(), int64(7)).Return(model.ImportJob{
+					ID:        7,
+					Status:    model.ImportJobRunning,
+					Total:     3,
+					Processed: 2,
+					Succeeded: 1,
+					Failed:    1,
+					Errors: []model.ImportRowResult{
+						{Row: 2, OrderID: 124, Status: model.ImportRowFailed, Code: "order_exists", Error: "заказ уже существует"},
+					},
+				}, nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `"processed":2,"succeeded":1,"failed":1,"errors":[{"row":2,"order_id":124,"status":"failed","code":"order_exists"`,
+		},
+		{
+			name:           "invalid id",
+			path:           "/imports/abc",
+			mockSetup:      func(mockService *MockimportJobServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `{"error":"неверный формат ID задачи импорта"}`,
+		},
+		{
+			name: "job not found",
+			path: "/imports/99",
+			mockSetup: func(mockService *MockimportJobServiceInterface) {
+				mockService.EXPECT().Get(gomock.Any(), int64(99)).
+					Return(model.ImportJob{}, fmt.Errorf("%w: %d", repository.ErrImportJobNotFound, 99))
+			},
+			expectedStatus: fiber.StatusNotFound,
+			expectedBody:   `{"error":"Ошибка при получении задачи импорта: задача импорта не найдена: 99"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app, mockService, cleanup := setupImportTest(t)
+			defer cleanup()
+
+			tt.mockSetup(mockService)
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Contains(t, string(body), tt.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: apikey.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -source=apikey.go -destination=mock_apikey_test.go -package=handler
+//
+
+// Package handler is a generated GoMock package.
+package handler
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "gitlab.ozon.dev/gojhw1/pkg/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockapiKeyServiceInterface is a mock of apiKeyServiceInterface interface.
+type MockapiKeyServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiKeyServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockapiKeyServiceInterfaceMockRecorder is the mock recorder for MockapiKeyServiceInterface.
+type MockapiKeyServiceInterfaceMockRecorder struct {
+	mock *MockapiKeyServiceInterface
+}
+
+// NewMockapiKeyServiceInterface creates a new mock instance.
+func NewMockapiKeyServiceInterface(ctrl *gomock.Controller) *MockapiKeyServiceInterface {
+	mock := &MockapiKeyServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockapiKeyServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockapiKeyServiceInterface) EXPECT() *MockapiKeyServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockapiKeyServiceInterface) Create(ctx context.Context, userID int64, name string, scopes []string, expiresAt *time.Time) (model.APIKey, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, userID, name, scopes, expiresAt)
+	ret0, _ := ret[0].(model.APIKey)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockapiKeyServiceInterfaceMockRecorder) Create(ctx, userID, name, scopes, expiresAt any) *MockapiKeyServiceInterfaceCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockapiKeyServiceInterface)(nil).Create), ctx, userID, name, scopes, expiresAt)
+	return &MockapiKeyServiceInterfaceCreateCall{Call: call}
+}
+
+// MockapiKeyServiceInterfaceCreateCall wrap *gomock.Call
+type MockapiKeyServiceInterfaceCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyServiceInterfaceCreateCall) Return(arg0 model.APIKey, arg1 string, arg2 error) *MockapiKeyServiceInterfaceCreateCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyServiceInterfaceCreateCall) Do(f func(context.Context, int64, string, []string, *time.Time) (model.APIKey, string, error)) *MockapiKeyServiceInterfaceCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyServiceInterfaceCreateCall) DoAndReturn(f func(context.Context, int64, string, []string, *time.Time) (model.APIKey, string, error)) *MockapiKeyServiceInterfaceCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockapiKeyServiceInterface) List(ctx context.Context, userID int64) ([]model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userID)
+	ret0, _ := ret[0].([]model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockapiKeyServiceInterfaceMockRecorder) List(ctx, userID any) *MockapiKeyServiceInterfaceListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockapiKeyServiceInterface)(nil).List), ctx, userID)
+	return &MockapiKeyServiceInterfaceListCall{Call: call}
+}
+
+// MockapiKeyServiceInterfaceListCall wrap *gomock.Call
+type MockapiKeyServiceInterfaceListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyServiceInterfaceListCall) Return(arg0 []model.APIKey, arg1 error) *MockapiKeyServiceInterfaceListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyServiceInterfaceListCall) Do(f func(context.Context, int64) ([]model.APIKey, error)) *MockapiKeyServiceInterfaceListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyServiceInterfaceListCall) DoAndReturn(f func(context.Context, int64) ([]model.APIKey, error)) *MockapiKeyServiceInterfaceListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Revoke mocks base method.
+func (m *MockapiKeyServiceInterface) Revoke(ctx context.Context, userID, keyID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, userID, keyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockapiKeyServiceInterfaceMockRecorder) Revoke(ctx, userID, keyID any) *MockapiKeyServiceInterfaceRevokeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockapiKeyServiceInterface)(nil).Revoke), ctx, userID, keyID)
+	return &MockapiKeyServiceInterfaceRevokeCall{Call: call}
+}
+
+// MockapiKeyServiceInterfaceRevokeCall wrap *gomock.Call
+type MockapiKeyServiceInterfaceRevokeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyServiceInterfaceRevokeCall) Return(arg0 error) *MockapiKeyServiceInterfaceRevokeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyServiceInterfaceRevokeCall) Do(f func(context.Context, int64, int64) error) *MockapiKeyServiceInterfaceRevokeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyServiceInterfaceRevokeCall) DoAndReturn(f func(context.Context, int64, int64) error) *MockapiKeyServiceInterfaceRevokeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,196 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: auth.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -source=auth.go -destination=mock_auth_test.go -package=handler
+//
+
+// Package handler is a generated GoMock package.
+package handler
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "gitlab.ozon.dev/gojhw1/pkg/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockauthServiceInterface is a mock of authServiceInterface interface.
+type MockauthServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockauthServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockauthServiceInterfaceMockRecorder is the mock recorder for MockauthServiceInterface.
+type MockauthServiceInterfaceMockRecorder struct {
+	mock *MockauthServiceInterface
+}
+
+// NewMockauthServiceInterface creates a new mock instance.
+func NewMockauthServiceInterface(ctrl *gomock.Controller) *MockauthServiceInterface {
+	mock := &MockauthServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockauthServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauthServiceInterface) EXPECT() *MockauthServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Login mocks base method.
+func (m *MockauthServiceInterface) Login(ctx context.Context, username, password, ip string) (model.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", ctx, username, password, ip)
+	ret0, _ := ret[0].(model.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockauthServiceInterfaceMockRecorder) Login(ctx, username, password, ip any) *MockauthServiceInterfaceLoginCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockauthServiceInterface)(nil).Login), ctx, username, password, ip)
+	return &MockauthServiceInterfaceLoginCall{Call: call}
+}
+
+// MockauthServiceInterfaceLoginCall wrap *gomock.Call
+type MockauthServiceInterfaceLoginCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockauthServiceInterfaceLoginCall) Return(arg0 model.TokenPair, arg1 error) *MockauthServiceInterfaceLoginCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockauthServiceInterfaceLoginCall) Do(f func(context.Context, string, string, string) (model.TokenPair, error)) *MockauthServiceInterfaceLoginCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockauthServiceInterfaceLoginCall) DoAndReturn(f func(context.Context, string, string, string) (model.TokenPair, error)) *MockauthServiceInterfaceLoginCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Logout mocks base method.
+func (m *MockauthServiceInterface) Logout(ctx context.Context, refreshToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, refreshToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockauthServiceInterfaceMockRecorder) Logout(ctx, refreshToken any) *MockauthServiceInterfaceLogoutCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockauthServiceInterface)(nil).Logout), ctx, refreshToken)
+	return &MockauthServiceInterfaceLogoutCall{Call: call}
+}
+
+// MockauthServiceInterfaceLogoutCall wrap *gomock.Call
+type MockauthServiceInterfaceLogoutCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockauthServiceInterfaceLogoutCall) Return(arg0 error) *MockauthServiceInterfaceLogoutCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockauthServiceInterfaceLogoutCall) Do(f func(context.Context, string) error) *MockauthServiceInterfaceLogoutCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockauthServiceInterfaceLogoutCall) DoAndReturn(f func(context.Context, string) error) *MockauthServiceInterfaceLogoutCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Refresh mocks base method.
+func (m *MockauthServiceInterface) Refresh(ctx context.Context, refreshToken string) (model.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, refreshToken)
+	ret0, _ := ret[0].(model.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockauthServiceInterfaceMockRecorder) Refresh(ctx, refreshToken any) *MockauthServiceInterfaceRefreshCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockauthServiceInterface)(nil).Refresh), ctx, refreshToken)
+	return &MockauthServiceInterfaceRefreshCall{Call: call}
+}
+
+// MockauthServiceInterfaceRefreshCall wrap *gomock.Call
+type MockauthServiceInterfaceRefreshCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockauthServiceInterfaceRefreshCall) Return(arg0 model.TokenPair, arg1 error) *MockauthServiceInterfaceRefreshCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockauthServiceInterfaceRefreshCall) Do(f func(context.Context, string) (model.TokenPair, error)) *MockauthServiceInterfaceRefreshCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockauthServiceInterfaceRefreshCall) DoAndReturn(f func(context.Context, string) (model.TokenPair, error)) *MockauthServiceInterfaceRefreshCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Unlock mocks base method.
+func (m *MockauthServiceInterface) Unlock(ctx context.Context, username, ip string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unlock", ctx, username, ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unlock indicates an expected call of Unlock.
+func (mr *MockauthServiceInterfaceMockRecorder) Unlock(ctx, username, ip any) *MockauthServiceInterfaceUnlockCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockauthServiceInterface)(nil).Unlock), ctx, username, ip)
+	return &MockauthServiceInterfaceUnlockCall{Call: call}
+}
+
+// MockauthServiceInterfaceUnlockCall wrap *gomock.Call
+type MockauthServiceInterfaceUnlockCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockauthServiceInterfaceUnlockCall) Return(arg0 error) *MockauthServiceInterfaceUnlockCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockauthServiceInterfaceUnlockCall) Do(f func(context.Context, string, string) error) *MockauthServiceInterfaceUnlockCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockauthServiceInterfaceUnlockCall) DoAndReturn(f func(context.Context, string, string) error) *MockauthServiceInterfaceUnlockCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -1,4 +1,11 @@
 package handler
 
 //go:generate mockgen -typed -source=order.go -destination=mock_order_test.go -package=handler
+//go:generate mockgen -typed -source=import.go -destination=mock_import_test.go -package=handler
 //go:generate mockgen -typed -source=user.go -destination=mock_user_test.go -package=handler
+//go:generate mockgen -typed -source=auth.go -destination=mock_auth_test.go -package=handler
+//go:generate mockgen -typed -source=apikey.go -destination=mock_apikey_test.go -package=handler
+//go:generate mockgen -typed -source=pickup_point.go -destination=mock_pickup_point_test.go -package=handler
+//go:generate mockgen -typed -source=storage.go -destination=mock_storage_test.go -package=handler
+//go:generate mockgen -typed -source=return_policy.go -destination=mock_return_policy_test.go -package=handler
+//go:generate mockgen -typed -source=shift.go -destination=mock_shift_test.go -package=handler
@@ -0,0 +1,120 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: import.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -source=import.go -destination=mock_import_test.go -package=handler
+//
+
+// Package handler is a generated GoMock package.
+package handler
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "gitlab.ozon.dev/gojhw1/pkg/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockimportJobServiceInterface is a mock of importJobServiceInterface interface.
+type MockimportJobServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockimportJobServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockimportJobServiceInterfaceMockRecorder is the mock recorder for MockimportJobServiceInterface.
+type MockimportJobServiceInterfaceMockRecorder struct {
+	mock *MockimportJobServiceInterface
+}
+
+// NewMockimportJobServiceInterface creates a new mock instance.
+func NewMockimportJobServiceInterface(ctrl *gomock.Controller) *MockimportJobServiceInterface {
+	mock := &MockimportJobServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockimportJobServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimportJobServiceInterface) EXPECT() *MockimportJobServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockimportJobServiceInterface) Get(ctx context.Context, id int64) (model.ImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(model.ImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockimportJobServiceInterfaceMockRecorder) Get(ctx, id any) *MockimportJobServiceInterfaceGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockimportJobServiceInterface)(nil).Get), ctx, id)
+	return &MockimportJobServiceInterfaceGetCall{Call: call}
+}
+
+// MockimportJobServiceInterfaceGetCall wrap *gomock.Call
+type MockimportJobServiceInterfaceGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockimportJobServiceInterfaceGetCall) Return(arg0 model.ImportJob, arg1 error) *MockimportJobServiceInterfaceGetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockimportJobServiceInterfaceGetCall) Do(f func(context.Context, int64) (model.ImportJob, error)) *MockimportJobServiceInterfaceGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockimportJobServiceInterfaceGetCall) DoAndReturn(f func(context.Context, int64) (model.ImportJob, error)) *MockimportJobServiceInterfaceGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Submit mocks base method.
+func (m *MockimportJobServiceInterface) Submit(ctx context.Context, file model.ImportFile, options model.ImportOptions) (model.ImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Submit", ctx, file, options)
+	ret0, _ := ret[0].(model.ImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Submit indicates an expected call of Submit.
+func (mr *MockimportJobServiceInterfaceMockRecorder) Submit(ctx, file, options any) *MockimportJobServiceInterfaceSubmitCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit", reflect.TypeOf((*MockimportJobServiceInterface)(nil).Submit), ctx, file, options)
+	return &MockimportJobServiceInterfaceSubmitCall{Call: call}
+}
+
+// MockimportJobServiceInterfaceSubmitCall wrap *gomock.Call
+type MockimportJobServiceInterfaceSubmitCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockimportJobServiceInterfaceSubmitCall) Return(arg0 model.ImportJob, arg1 error) *MockimportJobServiceInterfaceSubmitCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockimportJobServiceInterfaceSubmitCall) Do(f func(context.Context, model.ImportFile, model.ImportOptions) (model.ImportJob, error)) *MockimportJobServiceInterfaceSubmitCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockimportJobServiceInterfaceSubmitCall) DoAndReturn(f func(context.Context, model.ImportFile, model.ImportOptions) (model.ImportJob, error)) *MockimportJobServiceInterfaceSubmitCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -15,6 +15,7 @@ import (
 	time "time"
 
 	model "gitlab.ozon.dev/gojhw1/pkg/model"
+	service "gitlab.ozon.dev/gojhw1/pkg/service"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -43,17 +44,17 @@ func (m *MockorderServiceInterface) EXPECT() *MockorderServiceInterfaceMockRecor
 }
 
 // AcceptOrder mocks base method.
-func (m *MockorderServiceInterface) AcceptOrder(ctx context.Context, id, customerID int64, deadline time.Time, weight, cost float64, packageType *model.PackageType, wrapper *model.WrapperType) error {
+func (m *MockorderServiceInterface) AcceptOrder(ctx context.Context, id, customerID, pickupPointID int64, deadline time.Time, weight, cost float64, packageType *model.PackageType, wrapper *model.WrapperType, paymentMode model.PaymentMode) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AcceptOrder", ctx, id, customerID, deadline, weight, cost, packageType, wrapper)
+	ret := m.ctrl.Call(m, "AcceptOrder", ctx, id, customerID, pickupPointID, deadline, weight, cost, packageType, wrapper, paymentMode)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AcceptOrder indicates an expected call of AcceptOrder.
-func (mr *MockorderServiceInterfaceMockRecorder) AcceptOrder(ctx, id, customerID, deadline, weight, cost, packageType, wrapper any) *MockorderServiceInterfaceAcceptOrderCall {
+func (mr *MockorderServiceInterfaceMockRecorder) AcceptOrder(ctx, id, customerID, pickupPointID, deadline, weight, cost, packageType, wrapper, paymentMode any) *MockorderServiceInterfaceAcceptOrderCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOrder", reflect.TypeOf((*MockorderServiceInterface)(nil).AcceptOrder), ctx, id, customerID, deadline, weight, cost, packageType, wrapper)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOrder", reflect.TypeOf((*MockorderServiceInterface)(nil).AcceptOrder), ctx, id, customerID, pickupPointID, deadline, weight, cost, packageType, wrapper, paymentMode)
 	return &MockorderServiceInterfaceAcceptOrderCall{Call: call}
 }
 
@@ -69,51 +70,51 @@ func (c *MockorderServiceInterfaceAcceptOrderCall) Return(arg0 error) *Mockorder
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockorderServiceInterfaceAcceptOrderCall) Do(f func(context.Context, int64, int64, time.Time, float64, float64, *model.PackageType, *model.WrapperType) error) *MockorderServiceInterfaceAcceptOrderCall {
+func (c *MockorderServiceInterfaceAcceptOrderCall) Do(f func(context.Context, int64, int64, int64, time.Time, float64, float64, *model.PackageType, *model.WrapperType, model.PaymentMode) error) *MockorderServiceInterfaceAcceptOrderCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockorderServiceInterfaceAcceptOrderCall) DoAndReturn(f func(context.Context, int64, int64, time.Time, float64, float64, *model.PackageType, *model.WrapperType) error) *MockorderServiceInterfaceAcceptOrderCall {
+func (c *MockorderServiceInterfaceAcceptOrderCall) DoAndReturn(f func(context.Context, int64, int64, int64, time.Time, float64, float64, *model.PackageType, *model.WrapperType, model.PaymentMode) error) *MockorderServiceInterfaceAcceptOrderCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// AcceptOrdersFromFile mocks base method.
-func (m *MockorderServiceInterface) AcceptOrdersFromFile(ctx context.Context, filename string) error {
+// AcceptOrderWithItems mocks base method.
+func (m *MockorderServiceInterface) AcceptOrderWithItems(ctx context.Context, id, customerID, pickupPointID int64, deadline time.Time, items []model.OrderItem, packageType *model.PackageType, wrapper *model.WrapperType, paymentMode model.PaymentMode) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AcceptOrdersFromFile", ctx, filename)
+	ret := m.ctrl.Call(m, "AcceptOrderWithItems", ctx, id, customerID, pickupPointID, deadline, items, packageType, wrapper, paymentMode)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// AcceptOrdersFromFile indicates an expected call of AcceptOrdersFromFile.
-func (mr *MockorderServiceInterfaceMockRecorder) AcceptOrdersFromFile(ctx, filename any) *MockorderServiceInterfaceAcceptOrdersFromFileCall {
+// AcceptOrderWithItems indicates an expected call of AcceptOrderWithItems.
+func (mr *MockorderServiceInterfaceMockRecorder) AcceptOrderWithItems(ctx, id, customerID, pickupPointID, deadline, items, packageType, wrapper, paymentMode any) *MockorderServiceInterfaceAcceptOrderWithItemsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOrdersFromFile", reflect.TypeOf((*MockorderServiceInterface)(nil).AcceptOrdersFromFile), ctx, filename)
-	return &MockorderServiceInterfaceAcceptOrdersFromFileCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOrderWithItems", reflect.TypeOf((*MockorderServiceInterface)(nil).AcceptOrderWithItems), ctx, id, customerID, pickupPointID, deadline, items, packageType, wrapper, paymentMode)
+	return &MockorderServiceInterfaceAcceptOrderWithItemsCall{Call: call}
 }
 
-// MockorderServiceInterfaceAcceptOrdersFromFileCall wrap *gomock.Call
-type MockorderServiceInterfaceAcceptOrdersFromFileCall struct {
+// MockorderServiceInterfaceAcceptOrderWithItemsCall wrap *gomock.Call
+type MockorderServiceInterfaceAcceptOrderWithItemsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockorderServiceInterfaceAcceptOrdersFromFileCall) Return(arg0 error) *MockorderServiceInterfaceAcceptOrdersFromFileCall {
+func (c *MockorderServiceInterfaceAcceptOrderWithItemsCall) Return(arg0 error) *MockorderServiceInterfaceAcceptOrderWithItemsCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockorderServiceInterfaceAcceptOrdersFromFileCall) Do(f func(context.Context, string) error) *MockorderServiceInterfaceAcceptOrdersFromFileCall {
+func (c *MockorderServiceInterfaceAcceptOrderWithItemsCall) Do(f func(context.Context, int64, int64, int64, time.Time, []model.OrderItem, *model.PackageType, *model.WrapperType, model.PaymentMode) error) *MockorderServiceInterfaceAcceptOrderWithItemsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockorderServiceInterfaceAcceptOrdersFromFileCall) DoAndReturn(f func(context.Context, string) error) *MockorderServiceInterfaceAcceptOrdersFromFileCall {
+func (c *MockorderServiceInterfaceAcceptOrderWithItemsCall) DoAndReturn(f func(context.Context, int64, int64, int64, time.Time, []model.OrderItem, *model.PackageType, *model.WrapperType, model.PaymentMode) error) *MockorderServiceInterfaceAcceptOrderWithItemsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -157,17 +158,17 @@ func (c *MockorderServiceInterfaceClearDatabaseCall) DoAndReturn(f func(context.
 }
 
 // DeliverOrder mocks base method.
-func (m *MockorderServiceInterface) DeliverOrder(ctx context.Context, id, customerID int64, now time.Time) error {
+func (m *MockorderServiceInterface) DeliverOrder(ctx context.Context, id, version, customerID int64, itemIDs []int64, payment *model.PaymentDetails, now time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeliverOrder", ctx, id, customerID, now)
+	ret := m.ctrl.Call(m, "DeliverOrder", ctx, id, version, customerID, itemIDs, payment, now)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeliverOrder indicates an expected call of DeliverOrder.
-func (mr *MockorderServiceInterfaceMockRecorder) DeliverOrder(ctx, id, customerID, now any) *MockorderServiceInterfaceDeliverOrderCall {
+func (mr *MockorderServiceInterfaceMockRecorder) DeliverOrder(ctx, id, version, customerID, itemIDs, payment, now any) *MockorderServiceInterfaceDeliverOrderCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverOrder", reflect.TypeOf((*MockorderServiceInterface)(nil).DeliverOrder), ctx, id, customerID, now)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverOrder", reflect.TypeOf((*MockorderServiceInterface)(nil).DeliverOrder), ctx, id, version, customerID, itemIDs, payment, now)
 	return &MockorderServiceInterfaceDeliverOrderCall{Call: call}
 }
 
@@ -183,13 +184,130 @@ func (c *MockorderServiceInterfaceDeliverOrderCall) Return(arg0 error) *Mockorde
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockorderServiceInterfaceDeliverOrderCall) Do(f func(context.Context, int64, int64, time.Time) error) *MockorderServiceInterfaceDeliverOrderCall {
+func (c *MockorderServiceInterfaceDeliverOrderCall) Do(f func(context.Context, int64, int64, int64, []int64, *model.PaymentDetails, time.Time) error) *MockorderServiceInterfaceDeliverOrderCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockorderServiceInterfaceDeliverOrderCall) DoAndReturn(f func(context.Context, int64, int64, time.Time) error) *MockorderServiceInterfaceDeliverOrderCall {
+func (c *MockorderServiceInterfaceDeliverOrderCall) DoAndReturn(f func(context.Context, int64, int64, int64, []int64, *model.PaymentDetails, time.Time) error) *MockorderServiceInterfaceDeliverOrderCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeliverOrders mocks base method.
+func (m *MockorderServiceInterface) DeliverOrders(ctx context.Context, ids []int64, customerID int64, itemIDs []int64, payment *model.PaymentDetails, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverOrders", ctx, ids, customerID, itemIDs, payment, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeliverOrders indicates an expected call of DeliverOrders.
+func (mr *MockorderServiceInterfaceMockRecorder) DeliverOrders(ctx, ids, customerID, itemIDs, payment, now any) *MockorderServiceInterfaceDeliverOrdersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverOrders", reflect.TypeOf((*MockorderServiceInterface)(nil).DeliverOrders), ctx, ids, customerID, itemIDs, payment, now)
+	return &MockorderServiceInterfaceDeliverOrdersCall{Call: call}
+}
+
+// MockorderServiceInterfaceDeliverOrdersCall wrap *gomock.Call
+type MockorderServiceInterfaceDeliverOrdersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceDeliverOrdersCall) Return(arg0 error) *MockorderServiceInterfaceDeliverOrdersCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceDeliverOrdersCall) Do(f func(context.Context, []int64, int64, []int64, *model.PaymentDetails, time.Time) error) *MockorderServiceInterfaceDeliverOrdersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceDeliverOrdersCall) DoAndReturn(f func(context.Context, []int64, int64, []int64, *model.PaymentDetails, time.Time) error) *MockorderServiceInterfaceDeliverOrdersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ExportOrders mocks base method.
+func (m *MockorderServiceInterface) ExportOrders(ctx context.Context, filter model.OrderFilter) (service.OrderExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportOrders", ctx, filter)
+	ret0, _ := ret[0].(service.OrderExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportOrders indicates an expected call of ExportOrders.
+func (mr *MockorderServiceInterfaceMockRecorder) ExportOrders(ctx, filter any) *MockorderServiceInterfaceExportOrdersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportOrders", reflect.TypeOf((*MockorderServiceInterface)(nil).ExportOrders), ctx, filter)
+	return &MockorderServiceInterfaceExportOrdersCall{Call: call}
+}
+
+// MockorderServiceInterfaceExportOrdersCall wrap *gomock.Call
+type MockorderServiceInterfaceExportOrdersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceExportOrdersCall) Return(arg0 service.OrderExport, arg1 error) *MockorderServiceInterfaceExportOrdersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceExportOrdersCall) Do(f func(context.Context, model.OrderFilter) (service.OrderExport, error)) *MockorderServiceInterfaceExportOrdersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceExportOrdersCall) DoAndReturn(f func(context.Context, model.OrderFilter) (service.OrderExport, error)) *MockorderServiceInterfaceExportOrdersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ExtendStorage mocks base method.
+func (m *MockorderServiceInterface) ExtendStorage(ctx context.Context, id, version int64, days int) (model.Order, model.OrderExtension, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendStorage", ctx, id, version, days)
+	ret0, _ := ret[0].(model.Order)
+	ret1, _ := ret[1].(model.OrderExtension)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ExtendStorage indicates an expected call of ExtendStorage.
+func (mr *MockorderServiceInterfaceMockRecorder) ExtendStorage(ctx, id, version, days any) *MockorderServiceInterfaceExtendStorageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendStorage", reflect.TypeOf((*MockorderServiceInterface)(nil).ExtendStorage), ctx, id, version, days)
+	return &MockorderServiceInterfaceExtendStorageCall{Call: call}
+}
+
+// MockorderServiceInterfaceExtendStorageCall wrap *gomock.Call
+type MockorderServiceInterfaceExtendStorageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceExtendStorageCall) Return(arg0 model.Order, arg1 model.OrderExtension, arg2 error) *MockorderServiceInterfaceExtendStorageCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceExtendStorageCall) Do(f func(context.Context, int64, int64, int) (model.Order, model.OrderExtension, error)) *MockorderServiceInterfaceExtendStorageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceExtendStorageCall) DoAndReturn(f func(context.Context, int64, int64, int) (model.Order, model.OrderExtension, error)) *MockorderServiceInterfaceExtendStorageCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -233,6 +351,84 @@ func (c *MockorderServiceInterfaceGetOrderByIDCall) DoAndReturn(f func(context.C
 	return c
 }
 
+// GetOrderPayments mocks base method.
+func (m *MockorderServiceInterface) GetOrderPayments(ctx context.Context, id int64) (model.OrderPayments, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderPayments", ctx, id)
+	ret0, _ := ret[0].(model.OrderPayments)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderPayments indicates an expected call of GetOrderPayments.
+func (mr *MockorderServiceInterfaceMockRecorder) GetOrderPayments(ctx, id any) *MockorderServiceInterfaceGetOrderPaymentsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderPayments", reflect.TypeOf((*MockorderServiceInterface)(nil).GetOrderPayments), ctx, id)
+	return &MockorderServiceInterfaceGetOrderPaymentsCall{Call: call}
+}
+
+// MockorderServiceInterfaceGetOrderPaymentsCall wrap *gomock.Call
+type MockorderServiceInterfaceGetOrderPaymentsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceGetOrderPaymentsCall) Return(arg0 model.OrderPayments, arg1 error) *MockorderServiceInterfaceGetOrderPaymentsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceGetOrderPaymentsCall) Do(f func(context.Context, int64) (model.OrderPayments, error)) *MockorderServiceInterfaceGetOrderPaymentsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceGetOrderPaymentsCall) DoAndReturn(f func(context.Context, int64) (model.OrderPayments, error)) *MockorderServiceInterfaceGetOrderPaymentsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListCourierReturnsWithCursor mocks base method.
+func (m *MockorderServiceInterface) ListCourierReturnsWithCursor(ctx context.Context, cursorID int64, limit int) ([]model.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCourierReturnsWithCursor", ctx, cursorID, limit)
+	ret0, _ := ret[0].([]model.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCourierReturnsWithCursor indicates an expected call of ListCourierReturnsWithCursor.
+func (mr *MockorderServiceInterfaceMockRecorder) ListCourierReturnsWithCursor(ctx, cursorID, limit any) *MockorderServiceInterfaceListCourierReturnsWithCursorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCourierReturnsWithCursor", reflect.TypeOf((*MockorderServiceInterface)(nil).ListCourierReturnsWithCursor), ctx, cursorID, limit)
+	return &MockorderServiceInterfaceListCourierReturnsWithCursorCall{Call: call}
+}
+
+// MockorderServiceInterfaceListCourierReturnsWithCursorCall wrap *gomock.Call
+type MockorderServiceInterfaceListCourierReturnsWithCursorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceListCourierReturnsWithCursorCall) Return(arg0 []model.Order, arg1 error) *MockorderServiceInterfaceListCourierReturnsWithCursorCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceListCourierReturnsWithCursorCall) Do(f func(context.Context, int64, int) ([]model.Order, error)) *MockorderServiceInterfaceListCourierReturnsWithCursorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceListCourierReturnsWithCursorCall) DoAndReturn(f func(context.Context, int64, int) ([]model.Order, error)) *MockorderServiceInterfaceListCourierReturnsWithCursorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListOrdersWithCursor mocks base method.
 func (m *MockorderServiceInterface) ListOrdersWithCursor(ctx context.Context, cursorID int64, limit int, customerID int64, filterPVZ bool, searchTerm string) ([]model.Order, error) {
 	m.ctrl.T.Helper()
@@ -273,18 +469,18 @@ func (c *MockorderServiceInterfaceListOrdersWithCursorCall) DoAndReturn(f func(c
 }
 
 // ListReturnsWithCursor mocks base method.
-func (m *MockorderServiceInterface) ListReturnsWithCursor(ctx context.Context, cursorID int64, limit int, searchTerm string) ([]model.Order, error) {
+func (m *MockorderServiceInterface) ListReturnsWithCursor(ctx context.Context, cursorID int64, limit int, searchTerm string, filter model.ReturnFilter) ([]model.ReturnedOrder, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListReturnsWithCursor", ctx, cursorID, limit, searchTerm)
-	ret0, _ := ret[0].([]model.Order)
+	ret := m.ctrl.Call(m, "ListReturnsWithCursor", ctx, cursorID, limit, searchTerm, filter)
+	ret0, _ := ret[0].([]model.ReturnedOrder)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListReturnsWithCursor indicates an expected call of ListReturnsWithCursor.
-func (mr *MockorderServiceInterfaceMockRecorder) ListReturnsWithCursor(ctx, cursorID, limit, searchTerm any) *MockorderServiceInterfaceListReturnsWithCursorCall {
+func (mr *MockorderServiceInterfaceMockRecorder) ListReturnsWithCursor(ctx, cursorID, limit, searchTerm, filter any) *MockorderServiceInterfaceListReturnsWithCursorCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReturnsWithCursor", reflect.TypeOf((*MockorderServiceInterface)(nil).ListReturnsWithCursor), ctx, cursorID, limit, searchTerm)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReturnsWithCursor", reflect.TypeOf((*MockorderServiceInterface)(nil).ListReturnsWithCursor), ctx, cursorID, limit, searchTerm, filter)
 	return &MockorderServiceInterfaceListReturnsWithCursorCall{Call: call}
 }
 
@@ -294,19 +490,58 @@ type MockorderServiceInterfaceListReturnsWithCursorCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockorderServiceInterfaceListReturnsWithCursorCall) Return(arg0 []model.Order, arg1 error) *MockorderServiceInterfaceListReturnsWithCursorCall {
+func (c *MockorderServiceInterfaceListReturnsWithCursorCall) Return(arg0 []model.ReturnedOrder, arg1 error) *MockorderServiceInterfaceListReturnsWithCursorCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceListReturnsWithCursorCall) Do(f func(context.Context, int64, int, string, model.ReturnFilter) ([]model.ReturnedOrder, error)) *MockorderServiceInterfaceListReturnsWithCursorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceListReturnsWithCursorCall) DoAndReturn(f func(context.Context, int64, int, string, model.ReturnFilter) ([]model.ReturnedOrder, error)) *MockorderServiceInterfaceListReturnsWithCursorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LocateOrder mocks base method.
+func (m *MockorderServiceInterface) LocateOrder(ctx context.Context, id int64) (model.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LocateOrder", ctx, id)
+	ret0, _ := ret[0].(model.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LocateOrder indicates an expected call of LocateOrder.
+func (mr *MockorderServiceInterfaceMockRecorder) LocateOrder(ctx, id any) *MockorderServiceInterfaceLocateOrderCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LocateOrder", reflect.TypeOf((*MockorderServiceInterface)(nil).LocateOrder), ctx, id)
+	return &MockorderServiceInterfaceLocateOrderCall{Call: call}
+}
+
+// MockorderServiceInterfaceLocateOrderCall wrap *gomock.Call
+type MockorderServiceInterfaceLocateOrderCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceLocateOrderCall) Return(arg0 model.StorageCell, arg1 error) *MockorderServiceInterfaceLocateOrderCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockorderServiceInterfaceListReturnsWithCursorCall) Do(f func(context.Context, int64, int, string) ([]model.Order, error)) *MockorderServiceInterfaceListReturnsWithCursorCall {
+func (c *MockorderServiceInterfaceLocateOrderCall) Do(f func(context.Context, int64) (model.StorageCell, error)) *MockorderServiceInterfaceLocateOrderCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockorderServiceInterfaceListReturnsWithCursorCall) DoAndReturn(f func(context.Context, int64, int, string) ([]model.Order, error)) *MockorderServiceInterfaceListReturnsWithCursorCall {
+func (c *MockorderServiceInterfaceLocateOrderCall) DoAndReturn(f func(context.Context, int64) (model.StorageCell, error)) *MockorderServiceInterfaceLocateOrderCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -350,18 +585,57 @@ func (c *MockorderServiceInterfaceOrderHistoryCall) DoAndReturn(f func(context.C
 	return c
 }
 
+// OrderTimeline mocks base method.
+func (m *MockorderServiceInterface) OrderTimeline(ctx context.Context, id int64) ([]model.OrderStateTransition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderTimeline", ctx, id)
+	ret0, _ := ret[0].([]model.OrderStateTransition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrderTimeline indicates an expected call of OrderTimeline.
+func (mr *MockorderServiceInterfaceMockRecorder) OrderTimeline(ctx, id any) *MockorderServiceInterfaceOrderTimelineCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderTimeline", reflect.TypeOf((*MockorderServiceInterface)(nil).OrderTimeline), ctx, id)
+	return &MockorderServiceInterfaceOrderTimelineCall{Call: call}
+}
+
+// MockorderServiceInterfaceOrderTimelineCall wrap *gomock.Call
+type MockorderServiceInterfaceOrderTimelineCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceOrderTimelineCall) Return(arg0 []model.OrderStateTransition, arg1 error) *MockorderServiceInterfaceOrderTimelineCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceOrderTimelineCall) Do(f func(context.Context, int64) ([]model.OrderStateTransition, error)) *MockorderServiceInterfaceOrderTimelineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceOrderTimelineCall) DoAndReturn(f func(context.Context, int64) ([]model.OrderStateTransition, error)) *MockorderServiceInterfaceOrderTimelineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ProcessReturnOrder mocks base method.
-func (m *MockorderServiceInterface) ProcessReturnOrder(ctx context.Context, id, customerID int64, now time.Time) error {
+func (m *MockorderServiceInterface) ProcessReturnOrder(ctx context.Context, id, version, customerID int64, itemIDs []int64, details model.ReturnDetails, now time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ProcessReturnOrder", ctx, id, customerID, now)
+	ret := m.ctrl.Call(m, "ProcessReturnOrder", ctx, id, version, customerID, itemIDs, details, now)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ProcessReturnOrder indicates an expected call of ProcessReturnOrder.
-func (mr *MockorderServiceInterfaceMockRecorder) ProcessReturnOrder(ctx, id, customerID, now any) *MockorderServiceInterfaceProcessReturnOrderCall {
+func (mr *MockorderServiceInterfaceMockRecorder) ProcessReturnOrder(ctx, id, version, customerID, itemIDs, details, now any) *MockorderServiceInterfaceProcessReturnOrderCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessReturnOrder", reflect.TypeOf((*MockorderServiceInterface)(nil).ProcessReturnOrder), ctx, id, customerID, now)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessReturnOrder", reflect.TypeOf((*MockorderServiceInterface)(nil).ProcessReturnOrder), ctx, id, version, customerID, itemIDs, details, now)
 	return &MockorderServiceInterfaceProcessReturnOrderCall{Call: call}
 }
 
@@ -377,29 +651,67 @@ func (c *MockorderServiceInterfaceProcessReturnOrderCall) Return(arg0 error) *Mo
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockorderServiceInterfaceProcessReturnOrderCall) Do(f func(context.Context, int64, int64, time.Time) error) *MockorderServiceInterfaceProcessReturnOrderCall {
+func (c *MockorderServiceInterfaceProcessReturnOrderCall) Do(f func(context.Context, int64, int64, int64, []int64, model.ReturnDetails, time.Time) error) *MockorderServiceInterfaceProcessReturnOrderCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceProcessReturnOrderCall) DoAndReturn(f func(context.Context, int64, int64, int64, []int64, model.ReturnDetails, time.Time) error) *MockorderServiceInterfaceProcessReturnOrderCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ProcessReturnOrders mocks base method.
+func (m *MockorderServiceInterface) ProcessReturnOrders(ctx context.Context, ids []int64, customerID int64, itemIDs []int64, details model.ReturnDetails, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessReturnOrders", ctx, ids, customerID, itemIDs, details, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessReturnOrders indicates an expected call of ProcessReturnOrders.
+func (mr *MockorderServiceInterfaceMockRecorder) ProcessReturnOrders(ctx, ids, customerID, itemIDs, details, now any) *MockorderServiceInterfaceProcessReturnOrdersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessReturnOrders", reflect.TypeOf((*MockorderServiceInterface)(nil).ProcessReturnOrders), ctx, ids, customerID, itemIDs, details, now)
+	return &MockorderServiceInterfaceProcessReturnOrdersCall{Call: call}
+}
+
+// MockorderServiceInterfaceProcessReturnOrdersCall wrap *gomock.Call
+type MockorderServiceInterfaceProcessReturnOrdersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceProcessReturnOrdersCall) Return(arg0 error) *MockorderServiceInterfaceProcessReturnOrdersCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceProcessReturnOrdersCall) Do(f func(context.Context, []int64, int64, []int64, model.ReturnDetails, time.Time) error) *MockorderServiceInterfaceProcessReturnOrdersCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockorderServiceInterfaceProcessReturnOrderCall) DoAndReturn(f func(context.Context, int64, int64, time.Time) error) *MockorderServiceInterfaceProcessReturnOrderCall {
+func (c *MockorderServiceInterfaceProcessReturnOrdersCall) DoAndReturn(f func(context.Context, []int64, int64, []int64, model.ReturnDetails, time.Time) error) *MockorderServiceInterfaceProcessReturnOrdersCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ReturnOrderToCourier mocks base method.
-func (m *MockorderServiceInterface) ReturnOrderToCourier(ctx context.Context, id int64) error {
+func (m *MockorderServiceInterface) ReturnOrderToCourier(ctx context.Context, id, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReturnOrderToCourier", ctx, id)
+	ret := m.ctrl.Call(m, "ReturnOrderToCourier", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ReturnOrderToCourier indicates an expected call of ReturnOrderToCourier.
-func (mr *MockorderServiceInterfaceMockRecorder) ReturnOrderToCourier(ctx, id any) *MockorderServiceInterfaceReturnOrderToCourierCall {
+func (mr *MockorderServiceInterfaceMockRecorder) ReturnOrderToCourier(ctx, id, version any) *MockorderServiceInterfaceReturnOrderToCourierCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnOrderToCourier", reflect.TypeOf((*MockorderServiceInterface)(nil).ReturnOrderToCourier), ctx, id)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnOrderToCourier", reflect.TypeOf((*MockorderServiceInterface)(nil).ReturnOrderToCourier), ctx, id, version)
 	return &MockorderServiceInterfaceReturnOrderToCourierCall{Call: call}
 }
 
@@ -415,13 +727,13 @@ func (c *MockorderServiceInterfaceReturnOrderToCourierCall) Return(arg0 error) *
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockorderServiceInterfaceReturnOrderToCourierCall) Do(f func(context.Context, int64) error) *MockorderServiceInterfaceReturnOrderToCourierCall {
+func (c *MockorderServiceInterfaceReturnOrderToCourierCall) Do(f func(context.Context, int64, int64) error) *MockorderServiceInterfaceReturnOrderToCourierCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockorderServiceInterfaceReturnOrderToCourierCall) DoAndReturn(f func(context.Context, int64) error) *MockorderServiceInterfaceReturnOrderToCourierCall {
+func (c *MockorderServiceInterfaceReturnOrderToCourierCall) DoAndReturn(f func(context.Context, int64, int64) error) *MockorderServiceInterfaceReturnOrderToCourierCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -0,0 +1,235 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pickup_point.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -source=pickup_point.go -destination=mock_pickup_point_test.go -package=handler
+//
+
+// Package handler is a generated GoMock package.
+package handler
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "gitlab.ozon.dev/gojhw1/pkg/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockpickupPointRepository is a mock of pickupPointRepository interface.
+type MockpickupPointRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockpickupPointRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockpickupPointRepositoryMockRecorder is the mock recorder for MockpickupPointRepository.
+type MockpickupPointRepositoryMockRecorder struct {
+	mock *MockpickupPointRepository
+}
+
+// NewMockpickupPointRepository creates a new mock instance.
+func NewMockpickupPointRepository(ctrl *gomock.Controller) *MockpickupPointRepository {
+	mock := &MockpickupPointRepository{ctrl: ctrl}
+	mock.recorder = &MockpickupPointRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpickupPointRepository) EXPECT() *MockpickupPointRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockpickupPointRepository) Create(ctx context.Context, point model.PickupPoint) (model.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, point)
+	ret0, _ := ret[0].(model.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockpickupPointRepositoryMockRecorder) Create(ctx, point any) *MockpickupPointRepositoryCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockpickupPointRepository)(nil).Create), ctx, point)
+	return &MockpickupPointRepositoryCreateCall{Call: call}
+}
+
+// MockpickupPointRepositoryCreateCall wrap *gomock.Call
+type MockpickupPointRepositoryCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpickupPointRepositoryCreateCall) Return(arg0 model.PickupPoint, arg1 error) *MockpickupPointRepositoryCreateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpickupPointRepositoryCreateCall) Do(f func(context.Context, model.PickupPoint) (model.PickupPoint, error)) *MockpickupPointRepositoryCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpickupPointRepositoryCreateCall) DoAndReturn(f func(context.Context, model.PickupPoint) (model.PickupPoint, error)) *MockpickupPointRepositoryCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MockpickupPointRepository) Delete(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockpickupPointRepositoryMockRecorder) Delete(ctx, id any) *MockpickupPointRepositoryDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockpickupPointRepository)(nil).Delete), ctx, id)
+	return &MockpickupPointRepositoryDeleteCall{Call: call}
+}
+
+// MockpickupPointRepositoryDeleteCall wrap *gomock.Call
+type MockpickupPointRepositoryDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpickupPointRepositoryDeleteCall) Return(arg0 error) *MockpickupPointRepositoryDeleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpickupPointRepositoryDeleteCall) Do(f func(context.Context, int64) error) *MockpickupPointRepositoryDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpickupPointRepositoryDeleteCall) DoAndReturn(f func(context.Context, int64) error) *MockpickupPointRepositoryDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockpickupPointRepository) GetByID(ctx context.Context, id int64) (model.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(model.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockpickupPointRepositoryMockRecorder) GetByID(ctx, id any) *MockpickupPointRepositoryGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockpickupPointRepository)(nil).GetByID), ctx, id)
+	return &MockpickupPointRepositoryGetByIDCall{Call: call}
+}
+
+// MockpickupPointRepositoryGetByIDCall wrap *gomock.Call
+type MockpickupPointRepositoryGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpickupPointRepositoryGetByIDCall) Return(arg0 model.PickupPoint, arg1 error) *MockpickupPointRepositoryGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpickupPointRepositoryGetByIDCall) Do(f func(context.Context, int64) (model.PickupPoint, error)) *MockpickupPointRepositoryGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpickupPointRepositoryGetByIDCall) DoAndReturn(f func(context.Context, int64) (model.PickupPoint, error)) *MockpickupPointRepositoryGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockpickupPointRepository) List(ctx context.Context) ([]model.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]model.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockpickupPointRepositoryMockRecorder) List(ctx any) *MockpickupPointRepositoryListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockpickupPointRepository)(nil).List), ctx)
+	return &MockpickupPointRepositoryListCall{Call: call}
+}
+
+// MockpickupPointRepositoryListCall wrap *gomock.Call
+type MockpickupPointRepositoryListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpickupPointRepositoryListCall) Return(arg0 []model.PickupPoint, arg1 error) *MockpickupPointRepositoryListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpickupPointRepositoryListCall) Do(f func(context.Context) ([]model.PickupPoint, error)) *MockpickupPointRepositoryListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpickupPointRepositoryListCall) DoAndReturn(f func(context.Context) ([]model.PickupPoint, error)) *MockpickupPointRepositoryListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m *MockpickupPointRepository) Update(ctx context.Context, point model.PickupPoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, point)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockpickupPointRepositoryMockRecorder) Update(ctx, point any) *MockpickupPointRepositoryUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockpickupPointRepository)(nil).Update), ctx, point)
+	return &MockpickupPointRepositoryUpdateCall{Call: call}
+}
+
+// MockpickupPointRepositoryUpdateCall wrap *gomock.Call
+type MockpickupPointRepositoryUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpickupPointRepositoryUpdateCall) Return(arg0 error) *MockpickupPointRepositoryUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpickupPointRepositoryUpdateCall) Do(f func(context.Context, model.PickupPoint) error) *MockpickupPointRepositoryUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpickupPointRepositoryUpdateCall) DoAndReturn(f func(context.Context, model.PickupPoint) error) *MockpickupPointRepositoryUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,275 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: return_policy.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -source=return_policy.go -destination=mock_return_policy_test.go -package=handler
+//
+
+// Package handler is a generated GoMock package.
+package handler
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "gitlab.ozon.dev/gojhw1/pkg/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockreturnPolicyServiceInterface is a mock of returnPolicyServiceInterface interface.
+type MockreturnPolicyServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockreturnPolicyServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockreturnPolicyServiceInterfaceMockRecorder is the mock recorder for MockreturnPolicyServiceInterface.
+type MockreturnPolicyServiceInterfaceMockRecorder struct {
+	mock *MockreturnPolicyServiceInterface
+}
+
+// NewMockreturnPolicyServiceInterface creates a new mock instance.
+func NewMockreturnPolicyServiceInterface(ctrl *gomock.Controller) *MockreturnPolicyServiceInterface {
+	mock := &MockreturnPolicyServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockreturnPolicyServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreturnPolicyServiceInterface) EXPECT() *MockreturnPolicyServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreatePolicy mocks base method.
+func (m *MockreturnPolicyServiceInterface) CreatePolicy(ctx context.Context, policy model.ReturnPolicy) (model.ReturnPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePolicy", ctx, policy)
+	ret0, _ := ret[0].(model.ReturnPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePolicy indicates an expected call of CreatePolicy.
+func (mr *MockreturnPolicyServiceInterfaceMockRecorder) CreatePolicy(ctx, policy any) *MockreturnPolicyServiceInterfaceCreatePolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePolicy", reflect.TypeOf((*MockreturnPolicyServiceInterface)(nil).CreatePolicy), ctx, policy)
+	return &MockreturnPolicyServiceInterfaceCreatePolicyCall{Call: call}
+}
+
+// MockreturnPolicyServiceInterfaceCreatePolicyCall wrap *gomock.Call
+type MockreturnPolicyServiceInterfaceCreatePolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockreturnPolicyServiceInterfaceCreatePolicyCall) Return(arg0 model.ReturnPolicy, arg1 error) *MockreturnPolicyServiceInterfaceCreatePolicyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockreturnPolicyServiceInterfaceCreatePolicyCall) Do(f func(context.Context, model.ReturnPolicy) (model.ReturnPolicy, error)) *MockreturnPolicyServiceInterfaceCreatePolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockreturnPolicyServiceInterfaceCreatePolicyCall) DoAndReturn(f func(context.Context, model.ReturnPolicy) (model.ReturnPolicy, error)) *MockreturnPolicyServiceInterfaceCreatePolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeletePolicy mocks base method.
+func (m *MockreturnPolicyServiceInterface) DeletePolicy(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePolicy", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePolicy indicates an expected call of DeletePolicy.
+func (mr *MockreturnPolicyServiceInterfaceMockRecorder) DeletePolicy(ctx, id any) *MockreturnPolicyServiceInterfaceDeletePolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePolicy", reflect.TypeOf((*MockreturnPolicyServiceInterface)(nil).DeletePolicy), ctx, id)
+	return &MockreturnPolicyServiceInterfaceDeletePolicyCall{Call: call}
+}
+
+// MockreturnPolicyServiceInterfaceDeletePolicyCall wrap *gomock.Call
+type MockreturnPolicyServiceInterfaceDeletePolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockreturnPolicyServiceInterfaceDeletePolicyCall) Return(arg0 error) *MockreturnPolicyServiceInterfaceDeletePolicyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockreturnPolicyServiceInterfaceDeletePolicyCall) Do(f func(context.Context, int64) error) *MockreturnPolicyServiceInterfaceDeletePolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockreturnPolicyServiceInterfaceDeletePolicyCall) DoAndReturn(f func(context.Context, int64) error) *MockreturnPolicyServiceInterfaceDeletePolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetPolicy mocks base method.
+func (m *MockreturnPolicyServiceInterface) GetPolicy(ctx context.Context, id int64) (model.ReturnPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicy", ctx, id)
+	ret0, _ := ret[0].(model.ReturnPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicy indicates an expected call of GetPolicy.
+func (mr *MockreturnPolicyServiceInterfaceMockRecorder) GetPolicy(ctx, id any) *MockreturnPolicyServiceInterfaceGetPolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicy", reflect.TypeOf((*MockreturnPolicyServiceInterface)(nil).GetPolicy), ctx, id)
+	return &MockreturnPolicyServiceInterfaceGetPolicyCall{Call: call}
+}
+
+// MockreturnPolicyServiceInterfaceGetPolicyCall wrap *gomock.Call
+type MockreturnPolicyServiceInterfaceGetPolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockreturnPolicyServiceInterfaceGetPolicyCall) Return(arg0 model.ReturnPolicy, arg1 error) *MockreturnPolicyServiceInterfaceGetPolicyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockreturnPolicyServiceInterfaceGetPolicyCall) Do(f func(context.Context, int64) (model.ReturnPolicy, error)) *MockreturnPolicyServiceInterfaceGetPolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockreturnPolicyServiceInterfaceGetPolicyCall) DoAndReturn(f func(context.Context, int64) (model.ReturnPolicy, error)) *MockreturnPolicyServiceInterfaceGetPolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListPolicies mocks base method.
+func (m *MockreturnPolicyServiceInterface) ListPolicies(ctx context.Context) ([]model.ReturnPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPolicies", ctx)
+	ret0, _ := ret[0].([]model.ReturnPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPolicies indicates an expected call of ListPolicies.
+func (mr *MockreturnPolicyServiceInterfaceMockRecorder) ListPolicies(ctx any) *MockreturnPolicyServiceInterfaceListPoliciesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicies", reflect.TypeOf((*MockreturnPolicyServiceInterface)(nil).ListPolicies), ctx)
+	return &MockreturnPolicyServiceInterfaceListPoliciesCall{Call: call}
+}
+
+// MockreturnPolicyServiceInterfaceListPoliciesCall wrap *gomock.Call
+type MockreturnPolicyServiceInterfaceListPoliciesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockreturnPolicyServiceInterfaceListPoliciesCall) Return(arg0 []model.ReturnPolicy, arg1 error) *MockreturnPolicyServiceInterfaceListPoliciesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockreturnPolicyServiceInterfaceListPoliciesCall) Do(f func(context.Context) ([]model.ReturnPolicy, error)) *MockreturnPolicyServiceInterfaceListPoliciesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockreturnPolicyServiceInterfaceListPoliciesCall) DoAndReturn(f func(context.Context) ([]model.ReturnPolicy, error)) *MockreturnPolicyServiceInterfaceListPoliciesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetCustomerSegment mocks base method.
+func (m *MockreturnPolicyServiceInterface) SetCustomerSegment(ctx context.Context, customerID int64, segment string) (model.CustomerSegment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCustomerSegment", ctx, customerID, segment)
+	ret0, _ := ret[0].(model.CustomerSegment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCustomerSegment indicates an expected call of SetCustomerSegment.
+func (mr *MockreturnPolicyServiceInterfaceMockRecorder) SetCustomerSegment(ctx, customerID, segment any) *MockreturnPolicyServiceInterfaceSetCustomerSegmentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCustomerSegment", reflect.TypeOf((*MockreturnPolicyServiceInterface)(nil).SetCustomerSegment), ctx, customerID, segment)
+	return &MockreturnPolicyServiceInterfaceSetCustomerSegmentCall{Call: call}
+}
+
+// MockreturnPolicyServiceInterfaceSetCustomerSegmentCall wrap *gomock.Call
+type MockreturnPolicyServiceInterfaceSetCustomerSegmentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockreturnPolicyServiceInterfaceSetCustomerSegmentCall) Return(arg0 model.CustomerSegment, arg1 error) *MockreturnPolicyServiceInterfaceSetCustomerSegmentCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockreturnPolicyServiceInterfaceSetCustomerSegmentCall) Do(f func(context.Context, int64, string) (model.CustomerSegment, error)) *MockreturnPolicyServiceInterfaceSetCustomerSegmentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockreturnPolicyServiceInterfaceSetCustomerSegmentCall) DoAndReturn(f func(context.Context, int64, string) (model.CustomerSegment, error)) *MockreturnPolicyServiceInterfaceSetCustomerSegmentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdatePolicy mocks base method.
+func (m *MockreturnPolicyServiceInterface) UpdatePolicy(ctx context.Context, policy model.ReturnPolicy) (model.ReturnPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePolicy", ctx, policy)
+	ret0, _ := ret[0].(model.ReturnPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePolicy indicates an expected call of UpdatePolicy.
+func (mr *MockreturnPolicyServiceInterfaceMockRecorder) UpdatePolicy(ctx, policy any) *MockreturnPolicyServiceInterfaceUpdatePolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePolicy", reflect.TypeOf((*MockreturnPolicyServiceInterface)(nil).UpdatePolicy), ctx, policy)
+	return &MockreturnPolicyServiceInterfaceUpdatePolicyCall{Call: call}
+}
+
+// MockreturnPolicyServiceInterfaceUpdatePolicyCall wrap *gomock.Call
+type MockreturnPolicyServiceInterfaceUpdatePolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockreturnPolicyServiceInterfaceUpdatePolicyCall) Return(arg0 model.ReturnPolicy, arg1 error) *MockreturnPolicyServiceInterfaceUpdatePolicyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockreturnPolicyServiceInterfaceUpdatePolicyCall) Do(f func(context.Context, model.ReturnPolicy) (model.ReturnPolicy, error)) *MockreturnPolicyServiceInterfaceUpdatePolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockreturnPolicyServiceInterfaceUpdatePolicyCall) DoAndReturn(f func(context.Context, model.ReturnPolicy) (model.ReturnPolicy, error)) *MockreturnPolicyServiceInterfaceUpdatePolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
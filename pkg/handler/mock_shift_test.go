@@ -0,0 +1,276 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: shift.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -source=shift.go -destination=mock_shift_test.go -package=handler
+//
+
+// Package handler is a generated GoMock package.
+package handler
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "gitlab.ozon.dev/gojhw1/pkg/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockshiftServiceInterface is a mock of shiftServiceInterface interface.
+type MockshiftServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockshiftServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockshiftServiceInterfaceMockRecorder is the mock recorder for MockshiftServiceInterface.
+type MockshiftServiceInterfaceMockRecorder struct {
+	mock *MockshiftServiceInterface
+}
+
+// NewMockshiftServiceInterface creates a new mock instance.
+func NewMockshiftServiceInterface(ctrl *gomock.Controller) *MockshiftServiceInterface {
+	mock := &MockshiftServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockshiftServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockshiftServiceInterface) EXPECT() *MockshiftServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CloseShift mocks base method.
+func (m *MockshiftServiceInterface) CloseShift(ctx context.Context, id int64, countedCash float64) (model.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseShift", ctx, id, countedCash)
+	ret0, _ := ret[0].(model.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseShift indicates an expected call of CloseShift.
+func (mr *MockshiftServiceInterfaceMockRecorder) CloseShift(ctx, id, countedCash any) *MockshiftServiceInterfaceCloseShiftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShift", reflect.TypeOf((*MockshiftServiceInterface)(nil).CloseShift), ctx, id, countedCash)
+	return &MockshiftServiceInterfaceCloseShiftCall{Call: call}
+}
+
+// MockshiftServiceInterfaceCloseShiftCall wrap *gomock.Call
+type MockshiftServiceInterfaceCloseShiftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockshiftServiceInterfaceCloseShiftCall) Return(arg0 model.Shift, arg1 error) *MockshiftServiceInterfaceCloseShiftCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockshiftServiceInterfaceCloseShiftCall) Do(f func(context.Context, int64, float64) (model.Shift, error)) *MockshiftServiceInterfaceCloseShiftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockshiftServiceInterfaceCloseShiftCall) DoAndReturn(f func(context.Context, int64, float64) (model.Shift, error)) *MockshiftServiceInterfaceCloseShiftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CurrentShift mocks base method.
+func (m *MockshiftServiceInterface) CurrentShift(ctx context.Context, pickupPointID int64) (model.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CurrentShift", ctx, pickupPointID)
+	ret0, _ := ret[0].(model.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CurrentShift indicates an expected call of CurrentShift.
+func (mr *MockshiftServiceInterfaceMockRecorder) CurrentShift(ctx, pickupPointID any) *MockshiftServiceInterfaceCurrentShiftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentShift", reflect.TypeOf((*MockshiftServiceInterface)(nil).CurrentShift), ctx, pickupPointID)
+	return &MockshiftServiceInterfaceCurrentShiftCall{Call: call}
+}
+
+// MockshiftServiceInterfaceCurrentShiftCall wrap *gomock.Call
+type MockshiftServiceInterfaceCurrentShiftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockshiftServiceInterfaceCurrentShiftCall) Return(arg0 model.Shift, arg1 error) *MockshiftServiceInterfaceCurrentShiftCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockshiftServiceInterfaceCurrentShiftCall) Do(f func(context.Context, int64) (model.Shift, error)) *MockshiftServiceInterfaceCurrentShiftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockshiftServiceInterfaceCurrentShiftCall) DoAndReturn(f func(context.Context, int64) (model.Shift, error)) *MockshiftServiceInterfaceCurrentShiftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetShift mocks base method.
+func (m *MockshiftServiceInterface) GetShift(ctx context.Context, id int64) (model.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShift", ctx, id)
+	ret0, _ := ret[0].(model.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShift indicates an expected call of GetShift.
+func (mr *MockshiftServiceInterfaceMockRecorder) GetShift(ctx, id any) *MockshiftServiceInterfaceGetShiftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShift", reflect.TypeOf((*MockshiftServiceInterface)(nil).GetShift), ctx, id)
+	return &MockshiftServiceInterfaceGetShiftCall{Call: call}
+}
+
+// MockshiftServiceInterfaceGetShiftCall wrap *gomock.Call
+type MockshiftServiceInterfaceGetShiftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockshiftServiceInterfaceGetShiftCall) Return(arg0 model.Shift, arg1 error) *MockshiftServiceInterfaceGetShiftCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockshiftServiceInterfaceGetShiftCall) Do(f func(context.Context, int64) (model.Shift, error)) *MockshiftServiceInterfaceGetShiftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockshiftServiceInterfaceGetShiftCall) DoAndReturn(f func(context.Context, int64) (model.Shift, error)) *MockshiftServiceInterfaceGetShiftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListShiftsWithCursor mocks base method.
+func (m *MockshiftServiceInterface) ListShiftsWithCursor(ctx context.Context, cursorID int64, limit int) ([]model.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListShiftsWithCursor", ctx, cursorID, limit)
+	ret0, _ := ret[0].([]model.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShiftsWithCursor indicates an expected call of ListShiftsWithCursor.
+func (mr *MockshiftServiceInterfaceMockRecorder) ListShiftsWithCursor(ctx, cursorID, limit any) *MockshiftServiceInterfaceListShiftsWithCursorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShiftsWithCursor", reflect.TypeOf((*MockshiftServiceInterface)(nil).ListShiftsWithCursor), ctx, cursorID, limit)
+	return &MockshiftServiceInterfaceListShiftsWithCursorCall{Call: call}
+}
+
+// MockshiftServiceInterfaceListShiftsWithCursorCall wrap *gomock.Call
+type MockshiftServiceInterfaceListShiftsWithCursorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockshiftServiceInterfaceListShiftsWithCursorCall) Return(arg0 []model.Shift, arg1 error) *MockshiftServiceInterfaceListShiftsWithCursorCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockshiftServiceInterfaceListShiftsWithCursorCall) Do(f func(context.Context, int64, int) ([]model.Shift, error)) *MockshiftServiceInterfaceListShiftsWithCursorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockshiftServiceInterfaceListShiftsWithCursorCall) DoAndReturn(f func(context.Context, int64, int) ([]model.Shift, error)) *MockshiftServiceInterfaceListShiftsWithCursorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// OpenShift mocks base method.
+func (m *MockshiftServiceInterface) OpenShift(ctx context.Context, pickupPointID int64, openingCash float64) (model.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenShift", ctx, pickupPointID, openingCash)
+	ret0, _ := ret[0].(model.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenShift indicates an expected call of OpenShift.
+func (mr *MockshiftServiceInterfaceMockRecorder) OpenShift(ctx, pickupPointID, openingCash any) *MockshiftServiceInterfaceOpenShiftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenShift", reflect.TypeOf((*MockshiftServiceInterface)(nil).OpenShift), ctx, pickupPointID, openingCash)
+	return &MockshiftServiceInterfaceOpenShiftCall{Call: call}
+}
+
+// MockshiftServiceInterfaceOpenShiftCall wrap *gomock.Call
+type MockshiftServiceInterfaceOpenShiftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockshiftServiceInterfaceOpenShiftCall) Return(arg0 model.Shift, arg1 error) *MockshiftServiceInterfaceOpenShiftCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockshiftServiceInterfaceOpenShiftCall) Do(f func(context.Context, int64, float64) (model.Shift, error)) *MockshiftServiceInterfaceOpenShiftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockshiftServiceInterfaceOpenShiftCall) DoAndReturn(f func(context.Context, int64, float64) (model.Shift, error)) *MockshiftServiceInterfaceOpenShiftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ShiftReport mocks base method.
+func (m *MockshiftServiceInterface) ShiftReport(ctx context.Context, id int64) (model.ShiftReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShiftReport", ctx, id)
+	ret0, _ := ret[0].(model.ShiftReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShiftReport indicates an expected call of ShiftReport.
+func (mr *MockshiftServiceInterfaceMockRecorder) ShiftReport(ctx, id any) *MockshiftServiceInterfaceShiftReportCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShiftReport", reflect.TypeOf((*MockshiftServiceInterface)(nil).ShiftReport), ctx, id)
+	return &MockshiftServiceInterfaceShiftReportCall{Call: call}
+}
+
+// MockshiftServiceInterfaceShiftReportCall wrap *gomock.Call
+type MockshiftServiceInterfaceShiftReportCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockshiftServiceInterfaceShiftReportCall) Return(arg0 model.ShiftReport, arg1 error) *MockshiftServiceInterfaceShiftReportCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockshiftServiceInterfaceShiftReportCall) Do(f func(context.Context, int64) (model.ShiftReport, error)) *MockshiftServiceInterfaceShiftReportCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockshiftServiceInterfaceShiftReportCall) DoAndReturn(f func(context.Context, int64) (model.ShiftReport, error)) *MockshiftServiceInterfaceShiftReportCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
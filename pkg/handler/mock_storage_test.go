@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: storage.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -source=storage.go -destination=mock_storage_test.go -package=handler
+//
+
+// Package handler is a generated GoMock package.
+package handler
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "gitlab.ozon.dev/gojhw1/pkg/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockstorageServiceInterface is a mock of storageServiceInterface interface.
+type MockstorageServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockstorageServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockstorageServiceInterfaceMockRecorder is the mock recorder for MockstorageServiceInterface.
+type MockstorageServiceInterfaceMockRecorder struct {
+	mock *MockstorageServiceInterface
+}
+
+// NewMockstorageServiceInterface creates a new mock instance.
+func NewMockstorageServiceInterface(ctrl *gomock.Controller) *MockstorageServiceInterface {
+	mock := &MockstorageServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockstorageServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstorageServiceInterface) EXPECT() *MockstorageServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateCell mocks base method.
+func (m *MockstorageServiceInterface) CreateCell(ctx context.Context, cell model.StorageCell) (model.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCell", ctx, cell)
+	ret0, _ := ret[0].(model.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCell indicates an expected call of CreateCell.
+func (mr *MockstorageServiceInterfaceMockRecorder) CreateCell(ctx, cell any) *MockstorageServiceInterfaceCreateCellCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCell", reflect.TypeOf((*MockstorageServiceInterface)(nil).CreateCell), ctx, cell)
+	return &MockstorageServiceInterfaceCreateCellCall{Call: call}
+}
+
+// MockstorageServiceInterfaceCreateCellCall wrap *gomock.Call
+type MockstorageServiceInterfaceCreateCellCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockstorageServiceInterfaceCreateCellCall) Return(arg0 model.StorageCell, arg1 error) *MockstorageServiceInterfaceCreateCellCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockstorageServiceInterfaceCreateCellCall) Do(f func(context.Context, model.StorageCell) (model.StorageCell, error)) *MockstorageServiceInterfaceCreateCellCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockstorageServiceInterfaceCreateCellCall) DoAndReturn(f func(context.Context, model.StorageCell) (model.StorageCell, error)) *MockstorageServiceInterfaceCreateCellCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteCell mocks base method.
+func (m *MockstorageServiceInterface) DeleteCell(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCell", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCell indicates an expected call of DeleteCell.
+func (mr *MockstorageServiceInterfaceMockRecorder) DeleteCell(ctx, id any) *MockstorageServiceInterfaceDeleteCellCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCell", reflect.TypeOf((*MockstorageServiceInterface)(nil).DeleteCell), ctx, id)
+	return &MockstorageServiceInterfaceDeleteCellCall{Call: call}
+}
+
+// MockstorageServiceInterfaceDeleteCellCall wrap *gomock.Call
+type MockstorageServiceInterfaceDeleteCellCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockstorageServiceInterfaceDeleteCellCall) Return(arg0 error) *MockstorageServiceInterfaceDeleteCellCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockstorageServiceInterfaceDeleteCellCall) Do(f func(context.Context, int64) error) *MockstorageServiceInterfaceDeleteCellCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockstorageServiceInterfaceDeleteCellCall) DoAndReturn(f func(context.Context, int64) error) *MockstorageServiceInterfaceDeleteCellCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Occupancy mocks base method.
+func (m *MockstorageServiceInterface) Occupancy(ctx context.Context) ([]model.CellOccupancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Occupancy", ctx)
+	ret0, _ := ret[0].([]model.CellOccupancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Occupancy indicates an expected call of Occupancy.
+func (mr *MockstorageServiceInterfaceMockRecorder) Occupancy(ctx any) *MockstorageServiceInterfaceOccupancyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Occupancy", reflect.TypeOf((*MockstorageServiceInterface)(nil).Occupancy), ctx)
+	return &MockstorageServiceInterfaceOccupancyCall{Call: call}
+}
+
+// MockstorageServiceInterfaceOccupancyCall wrap *gomock.Call
+type MockstorageServiceInterfaceOccupancyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockstorageServiceInterfaceOccupancyCall) Return(arg0 []model.CellOccupancy, arg1 error) *MockstorageServiceInterfaceOccupancyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockstorageServiceInterfaceOccupancyCall) Do(f func(context.Context) ([]model.CellOccupancy, error)) *MockstorageServiceInterfaceOccupancyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockstorageServiceInterfaceOccupancyCall) DoAndReturn(f func(context.Context) ([]model.CellOccupancy, error)) *MockstorageServiceInterfaceOccupancyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -41,6 +41,82 @@ func (m *MockuserRepository) EXPECT() *MockuserRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Approve mocks base method.
+func (m *MockuserRepository) Approve(ctx context.Context, userID int64, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockuserRepositoryMockRecorder) Approve(ctx, userID, role any) *MockuserRepositoryApproveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockuserRepository)(nil).Approve), ctx, userID, role)
+	return &MockuserRepositoryApproveCall{Call: call}
+}
+
+// MockuserRepositoryApproveCall wrap *gomock.Call
+type MockuserRepositoryApproveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserRepositoryApproveCall) Return(arg0 error) *MockuserRepositoryApproveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserRepositoryApproveCall) Do(f func(context.Context, int64, string) error) *MockuserRepositoryApproveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserRepositoryApproveCall) DoAndReturn(f func(context.Context, int64, string) error) *MockuserRepositoryApproveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AssignPickupPoint mocks base method.
+func (m *MockuserRepository) AssignPickupPoint(ctx context.Context, userID int64, pickupPointID *int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignPickupPoint", ctx, userID, pickupPointID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignPickupPoint indicates an expected call of AssignPickupPoint.
+func (mr *MockuserRepositoryMockRecorder) AssignPickupPoint(ctx, userID, pickupPointID any) *MockuserRepositoryAssignPickupPointCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignPickupPoint", reflect.TypeOf((*MockuserRepository)(nil).AssignPickupPoint), ctx, userID, pickupPointID)
+	return &MockuserRepositoryAssignPickupPointCall{Call: call}
+}
+
+// MockuserRepositoryAssignPickupPointCall wrap *gomock.Call
+type MockuserRepositoryAssignPickupPointCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserRepositoryAssignPickupPointCall) Return(arg0 error) *MockuserRepositoryAssignPickupPointCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserRepositoryAssignPickupPointCall) Do(f func(context.Context, int64, *int64) error) *MockuserRepositoryAssignPickupPointCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserRepositoryAssignPickupPointCall) DoAndReturn(f func(context.Context, int64, *int64) error) *MockuserRepositoryAssignPickupPointCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CheckPassword mocks base method.
 func (m *MockuserRepository) CheckPassword(ctx context.Context, username, password string) bool {
 	m.ctrl.T.Helper()
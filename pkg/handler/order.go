@@ -3,45 +3,84 @@ package handler
 import (
 	"context"
 	"fmt"
-	"os"
 	"strconv"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
-	"gitlab.ozon.dev/gojhw1/pkg/logger"
 	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/service"
 )
 
 // orderRequest описывает структуру запроса для создания нового заказа
 type orderRequest struct {
-	ID          int64   `json:"id"`
-	CustomerID  int64   `json:"customer_id"`
-	DeadlineAt  string  `json:"deadline_at"`
-	Weight      float64 `json:"weight"`
-	Cost        float64 `json:"cost"`
-	PackageType string  `json:"package_type,omitempty"`
-	Wrapper     string  `json:"wrapper,omitempty"`
+	ID            int64   `json:"id"`
+	CustomerID    int64   `json:"customer_id"`
+	PickupPointID int64   `json:"pickup_point_id,omitempty"`
+	DeadlineAt    string  `json:"deadline_at"`
+	Weight        float64 `json:"weight"`
+	Cost          float64 `json:"cost"`
+	PackageType   string  `json:"package_type,omitempty"`
+	Wrapper       string  `json:"wrapper,omitempty"`
+	PaymentMode   string  `json:"payment_mode,omitempty"` // prepaid или on_pickup, по умолчанию prepaid
+	// Items - товары заказа. Если они указаны, вес и стоимость заказа считаются по товарам
+	Items []orderItemRequest `json:"items,omitempty"`
 }
 
-// processRequest описывает структуру запроса для обработки заказов
+// orderItemRequest описывает товар в запросе на прием заказа
+type orderItemRequest struct {
+	Name   string  `json:"name"`
+	Weight float64 `json:"weight"`
+	Cost   float64 `json:"cost"`
+}
+
+// processRequest описывает структуру запроса для обработки заказов.
+// При Atomic заказы обрабатываются все вместе или не обрабатывается ни один.
 type processRequest struct {
-	CustomerID int64   `json:"customer_id"`
-	Action     string  `json:"action"`
-	OrderIDs   []int64 `json:"order_ids"`
+	CustomerID int64    `json:"customer_id"`
+	Action     string   `json:"action"`
+	OrderIDs   []int64  `json:"order_ids"`
+	Atomic     bool     `json:"atomic"`
+	ItemIDs    []int64  `json:"item_ids"`  // выдаваемые или возвращаемые товары, по умолчанию все товары
+	Reason     string   `json:"reason"`    // причина возврата, обязательна для action=return
+	Comment    string   `json:"comment"`   // комментарий клиента к возврату
+	Condition  string   `json:"condition"` // состояние возвращаемого заказа, обязательно для action=return
+	Photos     []string `json:"photos"`    // ссылки на фотографии возвращаемого заказа
+	// Payment - оплата при выдаче заказов с оплатой при получении
+	Payment *paymentRequest `json:"payment,omitempty"`
+}
+
+// paymentRequest описывает оплату, которую сотрудник ПВЗ принимает при выдаче заказов
+type paymentRequest struct {
+	Method     string  `json:"method"`                // cash, card или mixed
+	Amount     float64 `json:"amount"`                // сумма оплаты, должна совпадать с суммой к оплате
+	CashAmount float64 `json:"cash_amount,omitempty"` // часть суммы наличными при смешанной оплате
+}
+
+// extendRequest описывает структуру запроса на продление срока хранения заказа
+type extendRequest struct {
+	Days int `json:"days"`
 }
 
 // orderServiceInterface описывает интерфейс сервиса для работы с заказами
 type orderServiceInterface interface {
-	AcceptOrder(ctx context.Context, id, customerID int64, deadline time.Time, weight, cost float64, packageType *model.PackageType, wrapper *model.WrapperType) error
-	ReturnOrderToCourier(ctx context.Context, id int64) error
-	DeliverOrder(ctx context.Context, id, customerID int64, now time.Time) error
-	ProcessReturnOrder(ctx context.Context, id, customerID int64, now time.Time) error
+	AcceptOrder(ctx context.Context, id, customerID, pickupPointID int64, deadline time.Time, weight, cost float64, packageType *model.PackageType, wrapper *model.WrapperType, paymentMode model.PaymentMode) error
+	AcceptOrderWithItems(ctx context.Context, id, customerID, pickupPointID int64, deadline time.Time, items []model.OrderItem, packageType *model.PackageType, wrapper *model.WrapperType, paymentMode model.PaymentMode) error
+	ReturnOrderToCourier(ctx context.Context, id, version int64) error
+	ExtendStorage(ctx context.Context, id, version int64, days int) (model.Order, model.OrderExtension, error)
+	DeliverOrder(ctx context.Context, id, version, customerID int64, itemIDs []int64, payment *model.PaymentDetails, now time.Time) error
+	ProcessReturnOrder(ctx context.Context, id, version, customerID int64, itemIDs []int64, details model.ReturnDetails, now time.Time) error
+	DeliverOrders(ctx context.Context, ids []int64, customerID int64, itemIDs []int64, payment *model.PaymentDetails, now time.Time) error
+	ProcessReturnOrders(ctx context.Context, ids []int64, customerID int64, itemIDs []int64, details model.ReturnDetails, now time.Time) error
 	OrderHistory(ctx context.Context, searchTerm string) ([]model.Order, error)
-	AcceptOrdersFromFile(ctx context.Context, filename string) error
 	GetOrderByID(ctx context.Context, id int64) (model.Order, error)
+	LocateOrder(ctx context.Context, id int64) (model.StorageCell, error)
+	OrderTimeline(ctx context.Context, id int64) ([]model.OrderStateTransition, error)
+	GetOrderPayments(ctx context.Context, id int64) (model.OrderPayments, error)
 	ClearDatabase(ctx context.Context) error
 	ListOrdersWithCursor(ctx context.Context, cursorID int64, limit int, customerID int64, filterPVZ bool, searchTerm string) ([]model.Order, error)
-	ListReturnsWithCursor(ctx context.Context, cursorID int64, limit int, searchTerm string) ([]model.Order, error)
+	ListReturnsWithCursor(ctx context.Context, cursorID int64, limit int, searchTerm string, filter model.ReturnFilter) ([]model.ReturnedOrder, error)
+	ListCourierReturnsWithCursor(ctx context.Context, cursorID int64, limit int) ([]model.Order, error)
+	ExportOrders(ctx context.Context, filter model.OrderFilter) (service.OrderExport, error)
 }
 
 // OrderHandler - Обработчик запросов для заказов
@@ -76,16 +115,33 @@ func (h *OrderHandler) CreateOrder(c *fiber.Ctx) error {
 		})
 	}
 
-	if err = h.service.AcceptOrder(
-		ctx,
-		req.ID,
-		req.CustomerID,
-		deadline,
-		req.Weight,
-		req.Cost,
-		packageType,
-		wrapper,
-	); err != nil {
+	if len(req.Items) > 0 {
+		err = h.service.AcceptOrderWithItems(
+			ctx,
+			req.ID,
+			req.CustomerID,
+			req.PickupPointID,
+			deadline,
+			req.orderItems(),
+			packageType,
+			wrapper,
+			model.PaymentMode(req.PaymentMode),
+		)
+	} else {
+		err = h.service.AcceptOrder(
+			ctx,
+			req.ID,
+			req.CustomerID,
+			req.PickupPointID,
+			deadline,
+			req.Weight,
+			req.Cost,
+			packageType,
+			wrapper,
+			model.PaymentMode(req.PaymentMode),
+		)
+	}
+	if err != nil {
 		status, msg := processError(err)
 		return c.Status(status).JSON(fiber.Map{
 			"error": fmt.Sprintf("Ошибка при принятии заказа: %v", msg),
@@ -100,11 +156,13 @@ func (h *OrderHandler) CreateOrder(c *fiber.Ctx) error {
 		})
 	}
 
+	c.Set(fiber.HeaderETag, orderETag(order.Version))
 	return c.Status(fiber.StatusCreated).JSON(order)
 }
 
 // GetOrder обрабатывает запрос на получение информации о заказе по его ID.
 // Возвращает детальную информацию о заказе или ошибку, если заказ не найден.
+// Версия заказа передается в заголовке ETag для последующих запросов с If-Match.
 func (h *OrderHandler) GetOrder(c *fiber.Ctx) error {
 	ctx := c.UserContext()
 
@@ -123,11 +181,86 @@ func (h *OrderHandler) GetOrder(c *fiber.Ctx) error {
 		})
 	}
 
+	c.Set(fiber.HeaderETag, orderETag(order.Version))
 	return c.Status(fiber.StatusOK).JSON(order)
 }
 
+// LocateOrder обрабатывает запрос на поиск ячейки хранения, в которой лежит заказ
+func (h *OrderHandler) LocateOrder(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	orderID, err := parseOrderIDFromString(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	cell, err := h.service.LocateOrder(ctx, orderID)
+	if err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при поиске ячейки заказа: %v", msg),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"order_id": orderID,
+		"cell":     cell,
+	})
+}
+
+// OrderTimeline обрабатывает запрос на получение истории смены статусов заказа
+func (h *OrderHandler) OrderTimeline(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	orderID, err := parseOrderIDFromString(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	transitions, err := h.service.OrderTimeline(ctx, orderID)
+	if err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при получении истории статусов заказа: %v", msg),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"order_id":    orderID,
+		"transitions": transitions,
+		"total":       len(transitions),
+	})
+}
+
+// OrderPayments обрабатывает запрос на получение оплаты заказа и возвратов денег по нему
+func (h *OrderHandler) OrderPayments(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	orderID, err := parseOrderIDFromString(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	payments, err := h.service.GetOrderPayments(ctx, orderID)
+	if err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при получении оплаты заказа: %v", msg),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(payments)
+}
+
 // ReturnToCourier обрабатывает запрос на возврат заказа курьеру.
 // Изменяет статус заказа и регистрирует операцию возврата.
+// Если передан заголовок If-Match, заказ возвращается только при совпадении версии.
 func (h *OrderHandler) ReturnToCourier(c *fiber.Ctx) error {
 	ctx := c.UserContext()
 
@@ -138,7 +271,14 @@ func (h *OrderHandler) ReturnToCourier(c *fiber.Ctx) error {
 		})
 	}
 
-	if err = h.service.ReturnOrderToCourier(ctx, orderID); err != nil {
+	version, err := parseIfMatch(c.Get(fiber.HeaderIfMatch))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	if err = h.service.ReturnOrderToCourier(ctx, orderID, version); err != nil {
 		status, msg := processError(err)
 		return c.Status(status).JSON(fiber.Map{
 			"error": fmt.Sprintf("Ошибка при возврате заказа курьеру: %v", msg),
@@ -150,8 +290,53 @@ func (h *OrderHandler) ReturnToCourier(c *fiber.Ctx) error {
 	})
 }
 
+// ExtendStorage обрабатывает запрос на продление срока хранения заказа по просьбе клиента.
+// Возвращает заказ с новым сроком хранения и стоимостью, новая версия заказа передается в заголовке ETag.
+func (h *OrderHandler) ExtendStorage(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	orderID, err := parseOrderIDFromString(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	version, err := parseIfMatch(c.Get(fiber.HeaderIfMatch))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	var req extendRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при разборе запроса: %v", err),
+		})
+	}
+
+	order, extension, err := h.service.ExtendStorage(ctx, orderID, version, req.Days)
+	if err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при продлении срока хранения заказа: %v", msg),
+		})
+	}
+
+	c.Set(fiber.HeaderETag, orderETag(order.Version))
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"order":     order,
+		"extension": extension,
+	})
+}
+
 // ProcessCustomer обрабатывает запрос на выполнение действий с заказами для указанного клиента.
 // Поддерживает действия "handout" (выдача) и "return" (возврат).
+// В режиме atomic заказы обрабатываются в одной транзакции, и при ошибке хотя бы одного заказа
+// возвращается ее код без изменения остальных.
+// Если передан заголовок If-Match, заказ в запросе должен быть один, и он обрабатывается только
+// при совпадении версии, иначе возвращается код 412.
 func (h *OrderHandler) ProcessCustomer(c *fiber.Ctx) error {
 	ctx := c.UserContext()
 
@@ -168,17 +353,69 @@ func (h *OrderHandler) ProcessCustomer(c *fiber.Ctx) error {
 		})
 	}
 
+	// Без atomic заказы выдаются по одному, и оплата целиком досталась бы каждому из них
+	if req.Action == "handout" && req.Payment != nil && !req.Atomic && len(req.OrderIDs) > 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrPaymentRequiresAtomic.Error(),
+		})
+	}
+
+	version, err := parseIfMatch(c.Get(fiber.HeaderIfMatch))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	if version > 0 && len(req.OrderIDs) != 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrIfMatchSingleOrder.Error(),
+		})
+	}
+
 	now := time.Now()
+	details := req.returnDetails()
+	payment := req.paymentDetails()
 	results := make([]map[string]any, 0, len(req.OrderIDs))
 
-	for _, orderID := range req.OrderIDs {
-		var err error
+	// Расхождение версии заказа, как и ошибка в режиме atomic, возвращается кодом ответа
+	if req.Atomic || version > 0 {
+		switch {
+		case version > 0 && req.Action == "handout":
+			err = h.service.DeliverOrder(ctx, req.OrderIDs[0], version, req.CustomerID, req.ItemIDs, payment, now)
+		case version > 0 && req.Action == "return":
+			err = h.service.ProcessReturnOrder(ctx, req.OrderIDs[0], version, req.CustomerID, req.ItemIDs, details, now)
+		case req.Action == "handout":
+			err = h.service.DeliverOrders(ctx, req.OrderIDs, req.CustomerID, req.ItemIDs, payment, now)
+		case req.Action == "return":
+			err = h.service.ProcessReturnOrders(ctx, req.OrderIDs, req.CustomerID, req.ItemIDs, details, now)
+		}
 
+		if err != nil {
+			status, msg := processError(err)
+			return c.Status(status).JSON(fiber.Map{
+				"error": msg,
+			})
+		}
+
+		for _, orderID := range req.OrderIDs {
+			results = append(results, map[string]any{
+				"order_id": orderID,
+				"message":  fmt.Sprintf("Заказ ID %d %s клиенту %d", orderID, req.Action, req.CustomerID),
+				"status":   fiber.StatusOK,
+			})
+		}
+
+		return c.Status(fiber.StatusOK).JSON(fiber.Map{
+			"results": results,
+		})
+	}
+
+	for _, orderID := range req.OrderIDs {
 		switch req.Action {
 		case "handout":
-			err = h.service.DeliverOrder(ctx, orderID, req.CustomerID, now)
+			err = h.service.DeliverOrder(ctx, orderID, 0, req.CustomerID, req.ItemIDs, payment, now)
 		case "return":
-			err = h.service.ProcessReturnOrder(ctx, orderID, req.CustomerID, now)
+			err = h.service.ProcessReturnOrder(ctx, orderID, 0, req.CustomerID, req.ItemIDs, details, now)
 		}
 
 		if err != nil {
@@ -259,6 +496,7 @@ func (h *OrderHandler) ListOrders(c *fiber.Ctx) error {
 }
 
 // ListReturns обрабатывает запрос на получение списка возвращенных заказов с курсорной пагинацией по ID.
+// Параметры reason и condition оставляют только возвраты с указанными причиной и состоянием заказа.
 func (h *OrderHandler) ListReturns(c *fiber.Ctx) error {
 	ctx := c.UserContext()
 
@@ -278,12 +516,17 @@ func (h *OrderHandler) ListReturns(c *fiber.Ctx) error {
 	}
 
 	searchTerm := c.Query("search", "")
+	filter := model.ReturnFilter{
+		Reason:    model.ReturnReason(c.Query("reason")),
+		Condition: model.ReturnCondition(c.Query("condition")),
+	}
 
 	// Получаем возвраты с использованием курсорной пагинации
-	returns, err := h.service.ListReturnsWithCursor(ctx, cursorID, limit+1, searchTerm)
+	returns, err := h.service.ListReturnsWithCursor(ctx, cursorID, limit+1, searchTerm, filter)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": fmt.Sprintf("Ошибка при получении возвратов: %v", err),
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при получении возвратов: %v", msg),
 		})
 	}
 
@@ -308,73 +551,68 @@ func (h *OrderHandler) ListReturns(c *fiber.Ctx) error {
 	})
 }
 
-// OrderHistory обрабатывает запрос на получение истории всех заказов.
-// Возвращает полный список заказов с их текущими статусами.
-func (h *OrderHandler) OrderHistory(c *fiber.Ctx) error {
+// ListCourierReturns обрабатывает запрос на получение списка заказов, которые нужно вернуть курьеру сегодня:
+// заказов с истекшим сроком хранения и заказов, срок хранения которых истекает до конца дня.
+func (h *OrderHandler) ListCourierReturns(c *fiber.Ctx) error {
 	ctx := c.UserContext()
-	searchTerm := c.Query("search", "")
 
-	orders, err := h.service.OrderHistory(ctx, searchTerm)
+	cursorID, err := parseCursorFromString(c.Query("cursor"))
 	if err != nil {
-		status, msg := processError(err)
-		return c.Status(status).JSON(fiber.Map{
-			"error": fmt.Sprintf("Ошибка при получении истории заказов: %v", msg),
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
 		})
 	}
 
-	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"orders": orders,
-		"total":  len(orders),
-	})
-}
-
-// AcceptOrdersFromFile обрабатывает запрос на загрузку заказов из файла.
-// Принимает файл с данными заказов, обрабатывает его и создает заказы в системе.
-func (h *OrderHandler) AcceptOrdersFromFile(c *fiber.Ctx) error {
-	ctx := c.UserContext()
-
-	file, err := c.FormFile("file")
+	limit, err := parseLimitFromString(c.Query("limit"), defaultPageSize)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": fmt.Sprintf("Ошибка при получении файла: %v", err),
+			"error": err.Error(),
 		})
 	}
 
-	tempDir := "temp"
-	if _, err := os.Stat(tempDir); os.IsNotExist(err) {
-		if err := os.Mkdir(tempDir, 0755); err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-				"error": fmt.Sprintf("Ошибка при создании временной директории: %v", err),
-			})
-		}
+	orders, err := h.service.ListCourierReturnsWithCursor(ctx, cursorID, limit+1)
+	if err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при получении заказов для возврата курьеру: %v", msg),
+		})
 	}
 
-	tempFilePath := fmt.Sprintf("%s/%s", tempDir, file.Filename)
-	if err = c.SaveFile(file, tempFilePath); err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": fmt.Sprintf("Ошибка при сохранении файла: %v", err),
-		})
+	hasMore := len(orders) > limit
+	var nextCursor string
+
+	if hasMore {
+		orders = orders[:limit]
 	}
 
-	defer func() {
-		if removeErr := os.Remove(tempFilePath); removeErr != nil {
-			logger.Warnf("Ошибка при удалении временного файла %s: %v\n", tempFilePath, removeErr)
-		}
+	if len(orders) > 0 {
+		nextCursor = strconv.FormatInt(orders[len(orders)-1].ID, 10)
+	}
 
-		if removeErr := os.Remove(tempDir); removeErr != nil {
-			logger.Warnf("Ошибка при удалении временной директории %s: %v\n", tempDir, removeErr)
-		}
-	}()
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"orders":      orders,
+		"has_more":    hasMore,
+		"next_cursor": nextCursor,
+	})
+}
+
+// OrderHistory обрабатывает запрос на получение истории всех заказов.
+// Возвращает полный список заказов с их текущими статусами.
+func (h *OrderHandler) OrderHistory(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	searchTerm := c.Query("search", "")
 
-	if err = h.service.AcceptOrdersFromFile(ctx, tempFilePath); err != nil {
+	orders, err := h.service.OrderHistory(ctx, searchTerm)
+	if err != nil {
 		status, msg := processError(err)
 		return c.Status(status).JSON(fiber.Map{
-			"error": fmt.Sprintf("Ошибка при обработке файла: %v", msg),
+			"error": fmt.Sprintf("Ошибка при получении истории заказов: %v", msg),
 		})
 	}
 
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"message": "Заказы успешно обработаны",
+		"orders": orders,
+		"total":  len(orders),
 	})
 }
 
@@ -394,3 +632,40 @@ func (h *OrderHandler) ClearDatabase(c *fiber.Ctx) error {
 		"message": "База данных успешно очищена",
 	})
 }
+
+// returnDetails преобразует запрос в сведения о возврате заказа
+func (r processRequest) returnDetails() model.ReturnDetails {
+	return model.ReturnDetails{
+		Reason:    model.ReturnReason(r.Reason),
+		Comment:   r.Comment,
+		Condition: model.ReturnCondition(r.Condition),
+		Photos:    r.Photos,
+	}
+}
+
+// paymentDetails преобразует оплату из запроса в сведения об оплате, отсутствие оплаты возвращает nil
+func (r processRequest) paymentDetails() *model.PaymentDetails {
+	if r.Payment == nil {
+		return nil
+	}
+
+	return &model.PaymentDetails{
+		Method:     model.PaymentMethod(r.Payment.Method),
+		Amount:     r.Payment.Amount,
+		CashAmount: r.Payment.CashAmount,
+	}
+}
+
+// orderItems преобразует товары из запроса в товары заказа
+func (r orderRequest) orderItems() []model.OrderItem {
+	items := make([]model.OrderItem, 0, len(r.Items))
+	for _, item := range r.Items {
+		items = append(items, model.OrderItem{
+			Name:   item.Name,
+			Weight: item.Weight,
+			Cost:   item.Cost,
+		})
+	}
+
+	return items
+}
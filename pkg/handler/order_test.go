@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -30,12 +31,16 @@ func setupOrderTest(t *testing.T) (*fiber.App, *MockorderServiceInterface, func(
 	// Регистрация маршрутов для тестирования
 	app.Post("/orders", handler.CreateOrder)
 	app.Get("/orders/:id", handler.GetOrder)
+	app.Get("/orders/:id/location", handler.LocateOrder)
+	app.Get("/orders/:id/timeline", handler.OrderTimeline)
+	app.Get("/orders/:id/payments", handler.OrderPayments)
 	app.Post("/orders/:id/return", handler.ReturnToCourier)
+	app.Post("/orders/:id/extend", handler.ExtendStorage)
 	app.Post("/orders/process", handler.ProcessCustomer)
 	app.Get("/orders", handler.ListOrders)
 	app.Get("/returns", handler.ListReturns)
+	app.Get("/returns/courier", handler.ListCourierReturns)
 	app.Get("/history", handler.OrderHistory)
-	app.Post("/upload", handler.AcceptOrdersFromFile)
 	app.Delete("/clear", handler.ClearDatabase)
 
 	cleanup := func() {
@@ -68,8 +73,8 @@ func TestOrderHandler_CreateOrder(t *testing.T) {
 			},
 			mockSetup: func(mockService *MockorderServiceInterface) {
 				mockService.EXPECT().
-					AcceptOrder(gomock.Any(), int64(123), int64(456), gomock.Any(),
-						float64(1.5), float64(1000), gomock.Any(), gomock.Any()).
+					AcceptOrder(gomock.Any(), int64(123), int64(456), int64(0), gomock.Any(),
+						float64(1.5), float64(1000), gomock.Any(), gomock.Any(), model.PaymentMode("")).
 					Return(nil)
 
 				mockService.EXPECT().
@@ -79,11 +84,61 @@ func TestOrderHandler_CreateOrder(t *testing.T) {
 						CustomerID: 456,
 						Weight:     1.5,
 						Cost:       1000,
+						Version:    1,
 					}, nil)
 			},
 			expectedStatus: fiber.StatusCreated,
 			expectedBody:   `{"id":123,"customer_id":456}`,
 		},
+		{
+			name: "success creating order with payment on pickup",
+			requestBody: orderRequest{
+				ID:          123,
+				CustomerID:  456,
+				DeadlineAt:  time.Now().Add(24 * time.Hour).Format(timeLayout),
+				Weight:      1.5,
+				Cost:        1000,
+				PackageType: string(model.PackageBox),
+				PaymentMode: string(model.PaymentModeOnPickup),
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					AcceptOrder(gomock.Any(), int64(123), int64(456), int64(0), gomock.Any(),
+						float64(1.5), float64(1000), gomock.Any(), nil, model.PaymentModeOnPickup).
+					Return(nil)
+
+				mockService.EXPECT().
+					GetOrderByID(gomock.Any(), int64(123)).
+					Return(model.Order{
+						ID:          123,
+						CustomerID:  456,
+						Weight:      1.5,
+						Cost:        1000,
+						PaymentMode: model.PaymentModeOnPickup,
+						Version:     1,
+					}, nil)
+			},
+			expectedStatus: fiber.StatusCreated,
+			expectedBody:   `"payment_mode":"on_pickup"`,
+		},
+		{
+			name: "error creating order with unknown payment mode",
+			requestBody: orderRequest{
+				ID:          123,
+				CustomerID:  456,
+				DeadlineAt:  time.Now().Add(24 * time.Hour).Format(timeLayout),
+				Weight:      1.5,
+				Cost:        1000,
+				PaymentMode: "credit",
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					AcceptOrder(gomock.Any(), int64(123), int64(456), int64(0), gomock.Any(),
+						float64(1.5), float64(1000), nil, nil, model.PaymentMode("credit")).
+					Return(fmt.Errorf("%w: %q", service.ErrInvalidPaymentMode, "credit"))
+			},
+			expectedStatus: fiber.StatusBadRequest,
+		},
 		{
 			name: "validation error - invalid weight",
 			requestBody: orderRequest{
@@ -97,6 +152,60 @@ func TestOrderHandler_CreateOrder(t *testing.T) {
 			expectedStatus: fiber.StatusBadRequest,
 			expectedBody:   `{"error":"вес должен быть больше 0"}`,
 		},
+		{
+			name: "success creating order with items",
+			requestBody: orderRequest{
+				ID:          123,
+				CustomerID:  456,
+				DeadlineAt:  time.Now().Add(24 * time.Hour).Format(timeLayout),
+				PackageType: string(model.PackageBox),
+				Items: []orderItemRequest{
+					{Name: "Чайник", Weight: 1.2, Cost: 2500},
+					{Name: "Кружка", Weight: 0.3, Cost: 400},
+				},
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					AcceptOrderWithItems(gomock.Any(), int64(123), int64(456), int64(0), gomock.Any(),
+						[]model.OrderItem{
+							{Name: "Чайник", Weight: 1.2, Cost: 2500},
+							{Name: "Кружка", Weight: 0.3, Cost: 400},
+						}, gomock.Any(), nil, model.PaymentMode("")).
+					Return(nil)
+
+				mockService.EXPECT().
+					GetOrderByID(gomock.Any(), int64(123)).
+					Return(model.Order{
+						ID:         123,
+						CustomerID: 456,
+						Weight:     1.5,
+						Cost:       2920,
+						Version:    1,
+						Items: []model.OrderItem{
+							{ID: 1, OrderID: 123, Name: "Чайник", Weight: 1.2, Cost: 2500, State: model.ItemStateAccepted},
+							{ID: 2, OrderID: 123, Name: "Кружка", Weight: 0.3, Cost: 400, State: model.ItemStateAccepted},
+						},
+					}, nil)
+			},
+			expectedStatus: fiber.StatusCreated,
+		},
+		{
+			name: "error creating order with unnamed item",
+			requestBody: orderRequest{
+				ID:         123,
+				CustomerID: 456,
+				DeadlineAt: time.Now().Add(24 * time.Hour).Format(timeLayout),
+				Items:      []orderItemRequest{{Weight: 1.2, Cost: 2500}},
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					AcceptOrderWithItems(gomock.Any(), int64(123), int64(456), int64(0), gomock.Any(),
+						gomock.Any(), nil, nil, model.PaymentMode("")).
+					Return(fmt.Errorf("%w: товар 1", service.ErrEmptyItemName))
+			},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `{"error":"Ошибка при принятии заказа: название товара не может быть пустым: товар 1"}`,
+		},
 		{
 			name: "error creating order",
 			requestBody: orderRequest{
@@ -108,8 +217,8 @@ func TestOrderHandler_CreateOrder(t *testing.T) {
 			},
 			mockSetup: func(mockService *MockorderServiceInterface) {
 				mockService.EXPECT().
-					AcceptOrder(gomock.Any(), int64(123), int64(456), gomock.Any(),
-						float64(1.5), float64(1000), nil, nil).
+					AcceptOrder(gomock.Any(), int64(123), int64(456), int64(0), gomock.Any(),
+						float64(1.5), float64(1000), nil, nil, model.PaymentMode("")).
 					Return(service.ErrOrderExists)
 			},
 			expectedStatus: fiber.StatusConflict,
@@ -126,8 +235,8 @@ func TestOrderHandler_CreateOrder(t *testing.T) {
 			},
 			mockSetup: func(mockService *MockorderServiceInterface) {
 				mockService.EXPECT().
-					AcceptOrder(gomock.Any(), int64(123), int64(456), gomock.Any(),
-						float64(1.5), float64(1000), gomock.Any(), gomock.Any()).
+					AcceptOrder(gomock.Any(), int64(123), int64(456), int64(0), gomock.Any(),
+						float64(1.5), float64(1000), gomock.Any(), gomock.Any(), model.PaymentMode("")).
 					Return(nil)
 
 				mockService.EXPECT().
@@ -189,6 +298,7 @@ func TestOrderHandler_ReturnToCourier(t *testing.T) {
 	tests := []struct {
 		name           string
 		orderID        string
+		ifMatch        string
 		mockSetup      func(mockService *MockorderServiceInterface)
 		expectedStatus int
 		expectedBody   string
@@ -198,7 +308,7 @@ func TestOrderHandler_ReturnToCourier(t *testing.T) {
 			orderID: "123",
 			mockSetup: func(mockService *MockorderServiceInterface) {
 				mockService.EXPECT().
-					ReturnOrderToCourier(gomock.Any(), int64(123)).
+					ReturnOrderToCourier(gomock.Any(), int64(123), int64(0)).
 					Return(nil)
 			},
 			expectedStatus: fiber.StatusOK,
@@ -209,12 +319,44 @@ func TestOrderHandler_ReturnToCourier(t *testing.T) {
 			orderID: "123",
 			mockSetup: func(mockService *MockorderServiceInterface) {
 				mockService.EXPECT().
-					ReturnOrderToCourier(gomock.Any(), int64(123)).
+					ReturnOrderToCourier(gomock.Any(), int64(123), int64(0)).
 					Return(service.ErrOrderAlreadyDelivered)
 			},
 			expectedStatus: fiber.StatusConflict,
 			expectedBody:   `{"error":"Ошибка при возврате заказа курьеру: заказ уже доставлен клиенту, возврат невозможен"}`,
 		},
+		{
+			name:    "success returning expected version",
+			orderID: "123",
+			ifMatch: `"3"`,
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					ReturnOrderToCourier(gomock.Any(), int64(123), int64(3)).
+					Return(nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `{"message":"Заказ возвращен курьеру 123"}`,
+		},
+		{
+			name:    "order modified concurrently",
+			orderID: "123",
+			ifMatch: `"3"`,
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					ReturnOrderToCourier(gomock.Any(), int64(123), int64(3)).
+					Return(repository.ErrConcurrentModification)
+			},
+			expectedStatus: fiber.StatusConflict,
+			expectedBody:   `{"error":"Ошибка при возврате заказа курьеру: заказ уже изменен другим запросом, получите актуальную версию"}`,
+		},
+		{
+			name:           "validation error - invalid If-Match",
+			orderID:        "123",
+			ifMatch:        `"abc"`,
+			mockSetup:      func(mockService *MockorderServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `{"error":"неверный формат заголовка If-Match, ожидается ETag заказа"}`,
+		},
 		{
 			name:           "validation error - invalid order ID",
 			orderID:        "abc",
@@ -233,6 +375,9 @@ func TestOrderHandler_ReturnToCourier(t *testing.T) {
 			tt.mockSetup(mockService)
 
 			req := httptest.NewRequest(http.MethodPost, "/orders/"+tt.orderID+"/return", nil)
+			if tt.ifMatch != "" {
+				req.Header.Set(fiber.HeaderIfMatch, tt.ifMatch)
+			}
 
 			resp, err := app.Test(req)
 			require.NoError(t, err)
@@ -247,78 +392,489 @@ func TestOrderHandler_ReturnToCourier(t *testing.T) {
 	}
 }
 
+func TestOrderHandler_ExtendStorage(t *testing.T) {
+	t.Parallel()
+
+	deadline := time.Date(2030, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		orderID        string
+		ifMatch        string
+		body           string
+		mockSetup      func(mockService *MockorderServiceInterface)
+		expectedStatus int
+		expectedETag   string
+		expectedBody   string
+	}{
+		{
+			name:    "success extending with fee",
+			orderID: "123",
+			ifMatch: `"3"`,
+			body:    `{"days": 2}`,
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					ExtendStorage(gomock.Any(), int64(123), int64(3), 2).
+					Return(
+						model.Order{ID: 123, State: model.StateAccepted, Cost: 150, DeadlineAt: deadline, Version: 4},
+						model.OrderExtension{OrderID: 123, Days: 2, Fee: 50, PreviousDeadlineAt: deadline.AddDate(0, 0, -2), DeadlineAt: deadline},
+						nil,
+					)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedETag:   `"4"`,
+			expectedBody:   `"extension":{"id":0,"order_id":123,"days":2,"fee":50,"previous_deadline_at":"2030-01-08T12:00:00Z","deadline_at":"2030-01-10T12:00:00Z"`,
+		},
+		{
+			name:    "extension limit exceeded",
+			orderID: "123",
+			body:    `{"days": 1}`,
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					ExtendStorage(gomock.Any(), int64(123), int64(0), 1).
+					Return(model.Order{}, model.OrderExtension{}, service.ErrExtensionLimitExceeded)
+			},
+			expectedStatus: fiber.StatusConflict,
+			expectedBody:   `{"error":"Ошибка при продлении срока хранения заказа: превышено допустимое количество продлений срока хранения"}`,
+		},
+		{
+			name:    "invalid days",
+			orderID: "123",
+			body:    `{"days": 0}`,
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					ExtendStorage(gomock.Any(), int64(123), int64(0), 0).
+					Return(model.Order{}, model.OrderExtension{}, service.ErrInvalidExtensionDays)
+			},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `срок продления должен быть положительным числом дней`,
+		},
+		{
+			name:    "storage already expired",
+			orderID: "123",
+			body:    `{"days": 1}`,
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					ExtendStorage(gomock.Any(), int64(123), int64(0), 1).
+					Return(model.Order{}, model.OrderExtension{}, service.ErrStorageExpired)
+			},
+			expectedStatus: fiber.StatusGone,
+			expectedBody:   `срок хранения заказа истек`,
+		},
+		{
+			name:           "validation error - invalid body",
+			orderID:        "123",
+			body:           `{"days": "two"}`,
+			mockSetup:      func(mockService *MockorderServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `Ошибка при разборе запроса`,
+		},
+		{
+			name:           "validation error - invalid order ID",
+			orderID:        "abc",
+			body:           `{"days": 1}`,
+			mockSetup:      func(mockService *MockorderServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `{"error":"неверный формат ID заказа"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			app, mockService, cleanup := setupOrderTest(t)
+			defer cleanup()
+
+			tt.mockSetup(mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/orders/"+tt.orderID+"/extend", bytes.NewBufferString(tt.body))
+			req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+			if tt.ifMatch != "" {
+				req.Header.Set(fiber.HeaderIfMatch, tt.ifMatch)
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			assert.Equal(t, tt.expectedETag, resp.Header.Get(fiber.HeaderETag))
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Contains(t, string(body), tt.expectedBody)
+		})
+	}
+}
+
 func TestOrderHandler_ProcessCustomer(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
 		name           string
 		requestBody    any
+		ifMatch        string
 		mockSetup      func(mockService *MockorderServiceInterface)
 		expectedStatus int
 	}{
 		{
-			name: "success handout customer",
+			name: "success handout customer",
+			requestBody: processRequest{
+				CustomerID: 456,
+				Action:     "handout",
+				OrderIDs:   []int64{123, 124},
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					DeliverOrder(gomock.Any(), int64(123), int64(0), int64(456), []int64(nil), nil, gomock.Any()).
+					Return(nil)
+
+				mockService.EXPECT().
+					DeliverOrder(gomock.Any(), int64(124), int64(0), int64(456), []int64(nil), nil, gomock.Any()).
+					Return(nil)
+			},
+			expectedStatus: fiber.StatusOK,
+		},
+		{
+			name: "success handout customer with payment",
+			requestBody: processRequest{
+				CustomerID: 456,
+				Action:     "handout",
+				OrderIDs:   []int64{123, 124},
+				Atomic:     true,
+				Payment:    &paymentRequest{Method: "mixed", Amount: 3000, CashAmount: 1000},
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					DeliverOrders(gomock.Any(), []int64{123, 124}, int64(456), []int64(nil),
+						&model.PaymentDetails{Method: model.PaymentMethodMixed, Amount: 3000, CashAmount: 1000}, gomock.Any()).
+					Return(nil)
+			},
+			expectedStatus: fiber.StatusOK,
+		},
+		{
+			name: "error non-atomic handout of several on-pickup orders with payment",
+			requestBody: processRequest{
+				CustomerID: 456,
+				Action:     "handout",
+				OrderIDs:   []int64{123, 124},
+				Payment:    &paymentRequest{Method: "card", Amount: 3000},
+			},
+			mockSetup:      func(mockService *MockorderServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+		},
+		{
+			name: "error non-atomic handout of prepaid and on-pickup orders with payment",
+			requestBody: processRequest{
+				CustomerID: 456,
+				Action:     "handout",
+				OrderIDs:   []int64{125, 123},
+				Payment:    &paymentRequest{Method: "cash", Amount: 1000},
+			},
+			mockSetup:      func(mockService *MockorderServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+		},
+		{
+			name: "non-atomic handout of prepaid and on-pickup orders without payment",
+			requestBody: processRequest{
+				CustomerID: 456,
+				Action:     "handout",
+				OrderIDs:   []int64{125, 123},
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					DeliverOrder(gomock.Any(), int64(125), int64(0), int64(456), []int64(nil), nil, gomock.Any()).
+					Return(nil)
+
+				mockService.EXPECT().
+					DeliverOrder(gomock.Any(), int64(123), int64(0), int64(456), []int64(nil), nil, gomock.Any()).
+					Return(fmt.Errorf("%w: к оплате 1000.00", service.ErrPaymentRequired))
+			},
+			expectedStatus: fiber.StatusOK,
+		},
+		{
+			name: "success non-atomic handout of one order with payment",
+			requestBody: processRequest{
+				CustomerID: 456,
+				Action:     "handout",
+				OrderIDs:   []int64{123},
+				Payment:    &paymentRequest{Method: "cash", Amount: 1000},
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					DeliverOrder(gomock.Any(), int64(123), int64(0), int64(456), []int64(nil),
+						&model.PaymentDetails{Method: model.PaymentMethodCash, Amount: 1000}, gomock.Any()).
+					Return(nil)
+			},
+			expectedStatus: fiber.StatusOK,
+		},
+		{
+			name: "error handout customer without payment",
+			requestBody: processRequest{
+				CustomerID: 456,
+				Action:     "handout",
+				OrderIDs:   []int64{123},
+				Atomic:     true,
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					DeliverOrders(gomock.Any(), []int64{123}, int64(456), []int64(nil), nil, gomock.Any()).
+					Return(fmt.Errorf("%w: к оплате 1000.00", service.ErrPaymentRequired))
+			},
+			expectedStatus: fiber.StatusBadRequest,
+		},
+		{
+			name: "error handout customer with declined card",
+			requestBody: processRequest{
+				CustomerID: 456,
+				Action:     "handout",
+				OrderIDs:   []int64{123},
+				Atomic:     true,
+				Payment:    &paymentRequest{Method: "card", Amount: 1000},
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					DeliverOrders(gomock.Any(), []int64{123}, int64(456), []int64(nil),
+						&model.PaymentDetails{Method: model.PaymentMethodCard, Amount: 1000}, gomock.Any()).
+					Return(fmt.Errorf("%w: недостаточно средств", service.ErrPaymentDeclined))
+			},
+			expectedStatus: fiber.StatusPaymentRequired,
+		},
+		{
+			name: "success return customer",
+			requestBody: processRequest{
+				CustomerID: 456,
+				Action:     "return",
+				OrderIDs:   []int64{123, 124},
+				Reason:     "defective",
+				Comment:    "не включается",
+				Condition:  "opened",
+				Photos:     []string{"https://cdn.example.com/returns/123.jpg"},
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				details := model.ReturnDetails{
+					Reason:    model.ReturnReasonDefective,
+					Comment:   "не включается",
+					Condition: model.ReturnConditionOpened,
+					Photos:    []string{"https://cdn.example.com/returns/123.jpg"},
+				}
+
+				mockService.EXPECT().
+					ProcessReturnOrder(gomock.Any(), int64(123), int64(0), int64(456), []int64(nil), details, gomock.Any()).
+					Return(nil)
+
+				mockService.EXPECT().
+					ProcessReturnOrder(gomock.Any(), int64(124), int64(0), int64(456), []int64(nil), details, gomock.Any()).
+					Return(nil)
+			},
+			expectedStatus: fiber.StatusOK,
+		},
+		{
+			name: "validation error - invalid action",
+			requestBody: processRequest{
+				CustomerID: 1,
+				Action:     "invalid",
+				OrderIDs:   []int64{123, 124},
+			},
+			mockSetup:      func(mockService *MockorderServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+		},
+		{
+			name: "error handout customer",
+			requestBody: processRequest{
+				CustomerID: 456,
+				Action:     "handout",
+				OrderIDs:   []int64{123, 124},
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					DeliverOrder(gomock.Any(), int64(123), int64(0), int64(456), []int64(nil), nil, gomock.Any()).
+					Return(nil)
+
+				mockService.EXPECT().
+					DeliverOrder(gomock.Any(), int64(124), int64(0), int64(456), []int64(nil), nil, gomock.Any()).
+					Return(service.ErrWrongCustomer)
+			},
+			expectedStatus: fiber.StatusOK,
+		},
+		{
+			name: "success partial handout customer",
+			requestBody: processRequest{
+				CustomerID: 456,
+				Action:     "handout",
+				OrderIDs:   []int64{123},
+				ItemIDs:    []int64{1, 3},
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					DeliverOrder(gomock.Any(), int64(123), int64(0), int64(456), []int64{1, 3}, nil, gomock.Any()).
+					Return(nil)
+			},
+			expectedStatus: fiber.StatusOK,
+		},
+		{
+			name: "error atomic handout with unknown item",
+			requestBody: processRequest{
+				CustomerID: 456,
+				Action:     "handout",
+				OrderIDs:   []int64{123, 124},
+				Atomic:     true,
+				ItemIDs:    []int64{99},
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					DeliverOrders(gomock.Any(), []int64{123, 124}, int64(456), []int64{99}, nil, gomock.Any()).
+					Return(fmt.Errorf("%w: товар 99", repository.ErrOrderItemNotFound))
+			},
+			expectedStatus: fiber.StatusNotFound,
+		},
+		{
+			name: "error partial return of refused item",
+			requestBody: processRequest{
+				CustomerID: 456,
+				Action:     "return",
+				OrderIDs:   []int64{123, 124},
+				Atomic:     true,
+				ItemIDs:    []int64{2},
+				Reason:     "wrong_item",
+				Condition:  "intact",
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					ProcessReturnOrders(gomock.Any(), []int64{123, 124}, int64(456), []int64{2}, gomock.Any(), gomock.Any()).
+					Return(fmt.Errorf("заказ 123: %w: товар 2 в статусе refused", service.ErrOrderItemUnavailable))
+			},
+			expectedStatus: fiber.StatusConflict,
+		},
+		{
+			name: "success atomic handout customer",
+			requestBody: processRequest{
+				CustomerID: 456,
+				Action:     "handout",
+				OrderIDs:   []int64{123, 124},
+				Atomic:     true,
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					DeliverOrders(gomock.Any(), []int64{123, 124}, int64(456), []int64(nil), nil, gomock.Any()).
+					Return(nil)
+			},
+			expectedStatus: fiber.StatusOK,
+		},
+		{
+			name: "error atomic return customer",
+			requestBody: processRequest{
+				CustomerID: 456,
+				Action:     "return",
+				OrderIDs:   []int64{123, 124},
+				Atomic:     true,
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					ProcessReturnOrders(gomock.Any(), []int64{123, 124}, int64(456), []int64(nil), gomock.Any(), gomock.Any()).
+					Return(fmt.Errorf("заказ 124: %w", service.ErrReturnExpired))
+			},
+			expectedStatus: fiber.StatusGone,
+		},
+		{
+			name: "error atomic return of non-returnable order",
+			requestBody: processRequest{
+				CustomerID: 456,
+				Action:     "return",
+				OrderIDs:   []int64{123, 124},
+				Atomic:     true,
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					ProcessReturnOrders(gomock.Any(), []int64{123, 124}, int64(456), []int64(nil), gomock.Any(), gomock.Any()).
+					Return(fmt.Errorf("заказ 123: %w: политика %q", service.ErrOrderNotReturnable, "Пленка"))
+			},
+			expectedStatus: fiber.StatusConflict,
+		},
+		{
+			name: "error atomic return without reason",
+			requestBody: processRequest{
+				CustomerID: 456,
+				Action:     "return",
+				OrderIDs:   []int64{123, 124},
+				Atomic:     true,
+				Condition:  "intact",
+			},
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					ProcessReturnOrders(gomock.Any(), []int64{123, 124}, int64(456), []int64(nil), gomock.Any(), gomock.Any()).
+					Return(fmt.Errorf("%w: %q", service.ErrInvalidReturnReason, ""))
+			},
+			expectedStatus: fiber.StatusBadRequest,
+		},
+		{
+			name: "success handout with matching version",
 			requestBody: processRequest{
 				CustomerID: 456,
 				Action:     "handout",
-				OrderIDs:   []int64{123, 124},
+				OrderIDs:   []int64{123},
 			},
+			ifMatch: `"3"`,
 			mockSetup: func(mockService *MockorderServiceInterface) {
 				mockService.EXPECT().
-					DeliverOrder(gomock.Any(), int64(123), int64(456), gomock.Any()).
-					Return(nil)
-
-				mockService.EXPECT().
-					DeliverOrder(gomock.Any(), int64(124), int64(456), gomock.Any()).
+					DeliverOrder(gomock.Any(), int64(123), int64(3), int64(456), []int64(nil), nil, gomock.Any()).
 					Return(nil)
 			},
 			expectedStatus: fiber.StatusOK,
 		},
 		{
-			name: "success return customer",
+			name: "error return with outdated version",
 			requestBody: processRequest{
 				CustomerID: 456,
 				Action:     "return",
-				OrderIDs:   []int64{123, 124},
+				OrderIDs:   []int64{123},
+				Reason:     "changed_mind",
+				Condition:  "intact",
 			},
+			ifMatch: `"3"`,
 			mockSetup: func(mockService *MockorderServiceInterface) {
 				mockService.EXPECT().
-					ProcessReturnOrder(gomock.Any(), int64(123), int64(456), gomock.Any()).
-					Return(nil)
-
-				mockService.EXPECT().
-					ProcessReturnOrder(gomock.Any(), int64(124), int64(456), gomock.Any()).
-					Return(nil)
+					ProcessReturnOrder(gomock.Any(), int64(123), int64(3), int64(456), []int64(nil), gomock.Any(), gomock.Any()).
+					Return(fmt.Errorf("%w: %w: заказ 123, версия 4, ожидалась 3", service.ErrVersionMismatch, repository.ErrConcurrentModification))
 			},
-			expectedStatus: fiber.StatusOK,
+			expectedStatus: fiber.StatusPreconditionFailed,
 		},
 		{
-			name: "validation error - invalid action",
+			name: "error version for several orders",
 			requestBody: processRequest{
-				CustomerID: 1,
-				Action:     "invalid",
+				CustomerID: 456,
+				Action:     "handout",
 				OrderIDs:   []int64{123, 124},
 			},
+			ifMatch:        `"3"`,
 			mockSetup:      func(mockService *MockorderServiceInterface) {},
 			expectedStatus: fiber.StatusBadRequest,
 		},
 		{
-			name: "error handout customer",
+			name: "error invalid If-Match",
 			requestBody: processRequest{
 				CustomerID: 456,
 				Action:     "handout",
-				OrderIDs:   []int64{123, 124},
+				OrderIDs:   []int64{123},
+			},
+			ifMatch:        "version",
+			mockSetup:      func(mockService *MockorderServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+		},
+		{
+			name: "error atomic handout with duplicate order",
+			requestBody: processRequest{
+				CustomerID: 456,
+				Action:     "handout",
+				OrderIDs:   []int64{123, 123},
+				Atomic:     true,
 			},
 			mockSetup: func(mockService *MockorderServiceInterface) {
 				mockService.EXPECT().
-					DeliverOrder(gomock.Any(), int64(123), int64(456), gomock.Any()).
-					Return(nil)
-
-				mockService.EXPECT().
-					DeliverOrder(gomock.Any(), int64(124), int64(456), gomock.Any()).
-					Return(service.ErrWrongCustomer)
+					DeliverOrders(gomock.Any(), []int64{123, 123}, int64(456), []int64(nil), nil, gomock.Any()).
+					Return(fmt.Errorf("%w: %d", service.ErrDuplicateOrderID, 123))
 			},
-			expectedStatus: fiber.StatusOK,
+			expectedStatus: fiber.StatusBadRequest,
 		},
 	}
 
@@ -336,6 +892,9 @@ func TestOrderHandler_ProcessCustomer(t *testing.T) {
 
 			req := httptest.NewRequest(http.MethodPost, "/orders/process", bytes.NewReader(reqBody))
 			req.Header.Set("Content-Type", "application/json")
+			if tt.ifMatch != "" {
+				req.Header.Set(fiber.HeaderIfMatch, tt.ifMatch)
+			}
 
 			resp, err := app.Test(req)
 			require.NoError(t, err)
@@ -431,10 +990,10 @@ func TestOrderHandler_ListReturns(t *testing.T) {
 			queryParams: "cursor=0&limit=2",
 			mockSetup: func(mockService *MockorderServiceInterface) {
 				mockService.EXPECT().
-					ListReturnsWithCursor(gomock.Any(), int64(0), 3, "").
-					Return([]model.Order{
-						{ID: 1, CustomerID: 456, Cost: 100, State: model.StateReturned},
-						{ID: 2, CustomerID: 456, Cost: 200, State: model.StateReturned},
+					ListReturnsWithCursor(gomock.Any(), int64(0), 3, "", model.ReturnFilter{}).
+					Return([]model.ReturnedOrder{
+						{Order: model.Order{ID: 1, CustomerID: 456, Cost: 100, State: model.StateReturned}},
+						{Order: model.Order{ID: 2, CustomerID: 456, Cost: 200, State: model.StateReturned}},
 					}, nil)
 			},
 			expextedStatus: fiber.StatusOK,
@@ -444,19 +1003,55 @@ func TestOrderHandler_ListReturns(t *testing.T) {
 			queryParams: "cursor=0&limit=2&search=456",
 			mockSetup: func(mockService *MockorderServiceInterface) {
 				mockService.EXPECT().
-					ListReturnsWithCursor(gomock.Any(), int64(0), 3, "456").
-					Return([]model.Order{
-						{ID: 1, CustomerID: 456, Cost: 100, State: model.StateReturned},
+					ListReturnsWithCursor(gomock.Any(), int64(0), 3, "456", model.ReturnFilter{}).
+					Return([]model.ReturnedOrder{
+						{Order: model.Order{ID: 1, CustomerID: 456, Cost: 100, State: model.StateReturned}},
+					}, nil)
+			},
+			expextedStatus: fiber.StatusOK,
+		},
+		{
+			name:        "success list returns with reason and condition",
+			queryParams: "cursor=0&limit=2&reason=defective&condition=damaged",
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					ListReturnsWithCursor(gomock.Any(), int64(0), 3, "", model.ReturnFilter{
+						Reason:    model.ReturnReasonDefective,
+						Condition: model.ReturnConditionDamaged,
+					}).
+					Return([]model.ReturnedOrder{
+						{
+							Order: model.Order{ID: 1, CustomerID: 456, Cost: 100, State: model.StateReturned},
+							Return: &model.OrderReturn{
+								OrderID:    1,
+								CustomerID: 456,
+								ReturnDetails: model.ReturnDetails{
+									Reason:    model.ReturnReasonDefective,
+									Condition: model.ReturnConditionDamaged,
+								},
+								Refund: 100,
+							},
+						},
 					}, nil)
 			},
 			expextedStatus: fiber.StatusOK,
 		},
+		{
+			name:        "validation error - invalid reason",
+			queryParams: "reason=broken",
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					ListReturnsWithCursor(gomock.Any(), int64(0), defaultPageSize+1, "", model.ReturnFilter{Reason: "broken"}).
+					Return(nil, fmt.Errorf("%w: %q", service.ErrInvalidReturnReason, "broken"))
+			},
+			expextedStatus: fiber.StatusBadRequest,
+		},
 		{
 			name:        "error list returns",
 			queryParams: "cursor=0&limit=2",
 			mockSetup: func(mockService *MockorderServiceInterface) {
 				mockService.EXPECT().
-					ListReturnsWithCursor(gomock.Any(), int64(0), 3, "").
+					ListReturnsWithCursor(gomock.Any(), int64(0), 3, "", model.ReturnFilter{}).
 					Return(nil, errors.New("error"))
 			},
 			expextedStatus: fiber.StatusInternalServerError,
@@ -478,11 +1073,11 @@ func TestOrderHandler_ListReturns(t *testing.T) {
 			queryParams: "cursor=0&limit=2",
 			mockSetup: func(mockService *MockorderServiceInterface) {
 				mockService.EXPECT().
-					ListReturnsWithCursor(gomock.Any(), int64(0), 3, "").
-					Return([]model.Order{
-						{ID: 1, CustomerID: 456, Cost: 100, State: model.StateReturned},
-						{ID: 2, CustomerID: 456, Cost: 200, State: model.StateReturned},
-						{ID: 3, CustomerID: 456, Cost: 300, State: model.StateReturned},
+					ListReturnsWithCursor(gomock.Any(), int64(0), 3, "", model.ReturnFilter{}).
+					Return([]model.ReturnedOrder{
+						{Order: model.Order{ID: 1, CustomerID: 456, Cost: 100, State: model.StateReturned}},
+						{Order: model.Order{ID: 2, CustomerID: 456, Cost: 200, State: model.StateReturned}},
+						{Order: model.Order{ID: 3, CustomerID: 456, Cost: 300, State: model.StateReturned}},
 					}, nil)
 			},
 			expextedStatus: fiber.StatusOK,
@@ -508,6 +1103,73 @@ func TestOrderHandler_ListReturns(t *testing.T) {
 	}
 }
 
+func TestOrderHandler_ListCourierReturns(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		queryParams    string
+		mockSetup      func(mockService *MockorderServiceInterface)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "success list with next page",
+			queryParams: "cursor=10&limit=2",
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					ListCourierReturnsWithCursor(gomock.Any(), int64(10), 3).
+					Return([]model.Order{
+						{ID: 9, CustomerID: 456, State: model.StateExpired},
+						{ID: 8, CustomerID: 456, State: model.StateExpired},
+						{ID: 7, CustomerID: 789, State: model.StateAccepted},
+					}, nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `"has_more":true,"next_cursor":"8"`,
+		},
+		{
+			name:        "employee without pickup point",
+			queryParams: "",
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					ListCourierReturnsWithCursor(gomock.Any(), int64(0), defaultPageSize+1).
+					Return(nil, service.ErrPickupPointNotAssigned)
+			},
+			expectedStatus: fiber.StatusForbidden,
+			expectedBody:   "Ошибка при получении заказов для возврата курьеру",
+		},
+		{
+			name:           "validation error - invalid cursor",
+			queryParams:    "cursor=invalid",
+			mockSetup:      func(mockService *MockorderServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app, mockService, cleanup := setupOrderTest(t)
+			defer cleanup()
+
+			tt.mockSetup(mockService)
+
+			req := httptest.NewRequest(http.MethodGet, "/returns/courier?"+tt.queryParams, nil)
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Contains(t, string(body), tt.expectedBody)
+		})
+	}
+}
+
 func TestOrderHandler_OrderHistory(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -583,6 +1245,7 @@ func TestOrderHandler_GetOrder(t *testing.T) {
 						CustomerID: 456,
 						Weight:     1.5,
 						Cost:       1000,
+						Version:    3,
 					}, nil)
 			},
 			expectedStatus: fiber.StatusOK,
@@ -633,6 +1296,7 @@ func TestOrderHandler_GetOrder(t *testing.T) {
 				assert.Equal(t, float64(456), result["customer_id"])
 				assert.Equal(t, 1.5, result["weight"])
 				assert.Equal(t, 1000.0, result["cost"])
+				assert.Equal(t, `"3"`, resp.Header.Get(fiber.HeaderETag))
 			} else {
 				assert.Contains(t, string(body), tt.expectedBody)
 			}
@@ -640,6 +1304,223 @@ func TestOrderHandler_GetOrder(t *testing.T) {
 	}
 }
 
+func TestOrderHandler_LocateOrder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		orderID        string
+		mockSetup      func(mockService *MockorderServiceInterface)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:    "order in cell",
+			orderID: "123",
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					LocateOrder(gomock.Any(), int64(123)).
+					Return(model.StorageCell{ID: 5, PickupPointID: 1, Rack: "A", Code: "A-03", Capacity: 4, MaxWeight: 30}, nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `"code":"A-03"`,
+		},
+		{
+			name:    "order without cell",
+			orderID: "124",
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					LocateOrder(gomock.Any(), int64(124)).
+					Return(model.StorageCell{}, service.ErrOrderNotInCell)
+			},
+			expectedStatus: fiber.StatusNotFound,
+			expectedBody:   `{"error":"Ошибка при поиске ячейки заказа: заказ не размещен в ячейке хранения"}`,
+		},
+		{
+			name:    "order in other pickup point",
+			orderID: "125",
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					LocateOrder(gomock.Any(), int64(125)).
+					Return(model.StorageCell{}, service.ErrForeignPickupPoint)
+			},
+			expectedStatus: fiber.StatusForbidden,
+			expectedBody:   `{"error":"Ошибка при поиске ячейки заказа: заказ относится к другому ПВЗ"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			app, mockService, cleanup := setupOrderTest(t)
+			defer cleanup()
+
+			tt.mockSetup(mockService)
+
+			req := httptest.NewRequest(http.MethodGet, "/orders/"+tt.orderID+"/location", nil)
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Contains(t, string(body), tt.expectedBody)
+		})
+	}
+}
+
+func TestOrderHandler_OrderTimeline(t *testing.T) {
+	t.Parallel()
+
+	accepted := model.StateAccepted
+	changedBy := int64(1)
+	changedAt := time.Date(2025, 4, 26, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		orderID        string
+		mockSetup      func(mockService *MockorderServiceInterface)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:    "delivered order",
+			orderID: "123",
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					OrderTimeline(gomock.Any(), int64(123)).
+					Return([]model.OrderStateTransition{
+						{ID: 1, OrderID: 123, ToState: model.StateAccepted, ChangedBy: &changedBy, ChangedAt: changedAt},
+						{ID: 2, OrderID: 123, FromState: &accepted, ToState: model.StateDelivered, ChangedBy: &changedBy, ChangedAt: changedAt.Add(time.Hour)},
+					}, nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `"from_state":"accepted","to_state":"delivered"`,
+		},
+		{
+			name:    "unknown order",
+			orderID: "124",
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					OrderTimeline(gomock.Any(), int64(124)).
+					Return(nil, repository.ErrOrderNotFound)
+			},
+			expectedStatus: fiber.StatusNotFound,
+			expectedBody:   `{"error":"Ошибка при получении истории статусов заказа: заказ не существует"}`,
+		},
+		{
+			name:           "invalid order id",
+			orderID:        "abc",
+			mockSetup:      func(mockService *MockorderServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `неверный формат ID заказа`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			app, mockService, cleanup := setupOrderTest(t)
+			defer cleanup()
+
+			tt.mockSetup(mockService)
+
+			req := httptest.NewRequest(http.MethodGet, "/orders/"+tt.orderID+"/timeline", nil)
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Contains(t, string(body), tt.expectedBody)
+		})
+	}
+}
+
+func TestOrderHandler_OrderPayments(t *testing.T) {
+	t.Parallel()
+
+	operatorID := int64(1)
+	paidAt := time.Date(2025, 5, 16, 10, 0, 0, 0, time.UTC)
+	transactionID := "fake-0001"
+
+	tests := []struct {
+		name           string
+		orderID        string
+		mockSetup      func(mockService *MockorderServiceInterface)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:    "order paid on pickup",
+			orderID: "123",
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					GetOrderPayments(gomock.Any(), int64(123)).
+					Return(model.OrderPayments{
+						OrderID:     123,
+						PaymentMode: model.PaymentModeOnPickup,
+						Payment: &model.Payment{
+							ID:            1,
+							OrderID:       123,
+							Method:        model.PaymentMethodCard,
+							Amount:        1000,
+							CardAmount:    1000,
+							TransactionID: &transactionID,
+							OperatorID:    &operatorID,
+							PaidAt:        paidAt,
+						},
+					}, nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `"method":"card","amount":1000`,
+		},
+		{
+			name:    "unknown order",
+			orderID: "124",
+			mockSetup: func(mockService *MockorderServiceInterface) {
+				mockService.EXPECT().
+					GetOrderPayments(gomock.Any(), int64(124)).
+					Return(model.OrderPayments{}, repository.ErrOrderNotFound)
+			},
+			expectedStatus: fiber.StatusNotFound,
+			expectedBody:   `{"error":"Ошибка при получении оплаты заказа: заказ не существует"}`,
+		},
+		{
+			name:           "invalid order id",
+			orderID:        "abc",
+			mockSetup:      func(mockService *MockorderServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `неверный формат ID заказа`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			app, mockService, cleanup := setupOrderTest(t)
+			defer cleanup()
+
+			tt.mockSetup(mockService)
+
+			req := httptest.NewRequest(http.MethodGet, "/orders/"+tt.orderID+"/payments", nil)
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Contains(t, string(body), tt.expectedBody)
+		})
+	}
+}
+
 func TestOrderHandler_ClearDatabase(t *testing.T) {
 	t.Parallel()
 
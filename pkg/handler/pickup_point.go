@@ -0,0 +1,186 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/repository"
+)
+
+type pickupPointRepository interface {
+	Create(ctx context.Context, point model.PickupPoint) (model.PickupPoint, error)
+	Update(ctx context.Context, point model.PickupPoint) error
+	Delete(ctx context.Context, id int64) error
+	GetByID(ctx context.Context, id int64) (model.PickupPoint, error)
+	List(ctx context.Context) ([]model.PickupPoint, error)
+}
+
+// pickupPointRequest описывает структуру запроса на создание и изменение ПВЗ
+type pickupPointRequest struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+}
+
+// PickupPointHandler обработчик запросов для управления пунктами выдачи заказов
+type PickupPointHandler struct {
+	repo pickupPointRepository
+}
+
+// NewPickupPointHandler создает новый обработчик ПВЗ
+func NewPickupPointHandler(repo pickupPointRepository) *PickupPointHandler {
+	return &PickupPointHandler{repo: repo}
+}
+
+// CreatePickupPoint обрабатывает запрос на создание нового ПВЗ
+func (h *PickupPointHandler) CreatePickupPoint(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req pickupPointRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Ошибка при разборе запроса",
+		})
+	}
+
+	if strings.TrimSpace(req.Name) == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrEmptyPickupPointName.Error(),
+		})
+	}
+
+	point, err := h.repo.Create(ctx, model.PickupPoint{
+		Name:    strings.TrimSpace(req.Name),
+		Address: strings.TrimSpace(req.Address),
+	})
+	if err != nil {
+		return pickupPointError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(point)
+}
+
+// GetPickupPoint обрабатывает запрос на получение ПВЗ по ID
+func (h *PickupPointHandler) GetPickupPoint(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := parsePickupPointIDFromParams(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	point, err := h.repo.GetByID(ctx, id)
+	if err != nil {
+		return pickupPointError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(point)
+}
+
+// ListPickupPoints обрабатывает запрос на получение списка ПВЗ
+func (h *PickupPointHandler) ListPickupPoints(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	points, err := h.repo.List(ctx)
+	if err != nil {
+		return pickupPointError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"pickup_points": points,
+		"total":         len(points),
+	})
+}
+
+// UpdatePickupPoint обрабатывает запрос на изменение названия и адреса ПВЗ
+func (h *PickupPointHandler) UpdatePickupPoint(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := parsePickupPointIDFromParams(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	var req pickupPointRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Ошибка при разборе запроса",
+		})
+	}
+
+	point, err := h.repo.GetByID(ctx, id)
+	if err != nil {
+		return pickupPointError(c, err)
+	}
+
+	if name := strings.TrimSpace(req.Name); name != "" {
+		point.Name = name
+	}
+	if address := strings.TrimSpace(req.Address); address != "" {
+		point.Address = address
+	}
+
+	if err := h.repo.Update(ctx, point); err != nil {
+		return pickupPointError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "ПВЗ успешно обновлен",
+	})
+}
+
+// DeletePickupPoint обрабатывает запрос на удаление ПВЗ
+func (h *PickupPointHandler) DeletePickupPoint(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := parsePickupPointIDFromParams(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	if err := h.repo.Delete(ctx, id); err != nil {
+		return pickupPointError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "ПВЗ успешно удален",
+	})
+}
+
+// pickupPointError преобразует ошибку репозитория ПВЗ в HTTP-ответ
+func pickupPointError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrPickupPointNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "ПВЗ не найден",
+		})
+	case errors.Is(err, repository.ErrPickupPointAlreadyExists),
+		errors.Is(err, repository.ErrPickupPointInUse):
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error": "Ошибка при работе с ПВЗ",
+	})
+}
+
+// parsePickupPointIDFromParams извлекает и валидирует ID ПВЗ из параметров запроса
+func parsePickupPointIDFromParams(idParam string) (int64, error) {
+	id, err := strconv.ParseInt(idParam, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, ErrInvalidPickupPointID
+	}
+
+	return id, nil
+}
@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/repository"
+	"go.uber.org/mock/gomock"
+)
+
+// setupPickupPointTest создает тестовое окружение и возвращает app, mockRepo и функцию для очистки ресурсов
+func setupPickupPointTest(t *testing.T) (*fiber.App, *MockpickupPointRepository, func()) {
+	ctrl := gomock.NewController(t)
+	mockRepo := NewMockpickupPointRepository(ctrl)
+
+	app := fiber.New()
+	handler := NewPickupPointHandler(mockRepo)
+
+	app.Get("/pickup-points", handler.ListPickupPoints)
+	app.Post("/pickup-points", handler.CreatePickupPoint)
+	app.Get("/pickup-points/:id", handler.GetPickupPoint)
+	app.Put("/pickup-points/:id", handler.UpdatePickupPoint)
+	app.Delete("/pickup-points/:id", handler.DeletePickupPoint)
+
+	cleanup := func() {
+		ctrl.Finish()
+	}
+
+	return app, mockRepo, cleanup
+}
+
+func TestPickupPointHandler(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2025, 4, 22, 10, 0, 0, 0, time.UTC)
+	point := model.PickupPoint{
+		ID:        2,
+		Name:      "ПВЗ на Ленина",
+		Address:   "ул. Ленина, 1",
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		requestBody    any
+		mockSetup      func(mockRepo *MockpickupPointRepository)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "create pickup point",
+			method:      http.MethodPost,
+			path:        "/pickup-points",
+			requestBody: pickupPointRequest{Name: " ПВЗ на Ленина ", Address: "ул. Ленина, 1"},
+			mockSetup: func(mockRepo *MockpickupPointRepository) {
+				mockRepo.EXPECT().
+					Create(gomock.Any(), model.PickupPoint{Name: "ПВЗ на Ленина", Address: "ул. Ленина, 1"}).
+					Return(point, nil)
+			},
+			expectedStatus: fiber.StatusCreated,
+			expectedBody:   `"id":2`,
+		},
+		{
+			name:           "create pickup point without name",
+			method:         http.MethodPost,
+			path:           "/pickup-points",
+			requestBody:    pickupPointRequest{Address: "ул. Ленина, 1"},
+			mockSetup:      func(mockRepo *MockpickupPointRepository) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `{"error":"название ПВЗ не может быть пустым"}`,
+		},
+		{
+			name:        "create duplicate pickup point",
+			method:      http.MethodPost,
+			path:        "/pickup-points",
+			requestBody: pickupPointRequest{Name: "ПВЗ на Ленина"},
+			mockSetup: func(mockRepo *MockpickupPointRepository) {
+				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(model.PickupPoint{}, repository.ErrPickupPointAlreadyExists)
+			},
+			expectedStatus: fiber.StatusConflict,
+			expectedBody:   `{"error":"ПВЗ с таким названием уже существует"}`,
+		},
+		{
+			name:   "list pickup points",
+			method: http.MethodGet,
+			path:   "/pickup-points",
+			mockSetup: func(mockRepo *MockpickupPointRepository) {
+				mockRepo.EXPECT().List(gomock.Any()).Return([]model.PickupPoint{point}, nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `"total":1`,
+		},
+		{
+			name:   "get unknown pickup point",
+			method: http.MethodGet,
+			path:   "/pickup-points/9",
+			mockSetup: func(mockRepo *MockpickupPointRepository) {
+				mockRepo.EXPECT().GetByID(gomock.Any(), int64(9)).Return(model.PickupPoint{}, repository.ErrPickupPointNotFound)
+			},
+			expectedStatus: fiber.StatusNotFound,
+			expectedBody:   `{"error":"ПВЗ не найден"}`,
+		},
+		{
+			name:           "get pickup point with invalid id",
+			method:         http.MethodGet,
+			path:           "/pickup-points/abc",
+			mockSetup:      func(mockRepo *MockpickupPointRepository) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `{"error":"неверный формат ID ПВЗ"}`,
+		},
+		{
+			name:        "update pickup point address",
+			method:      http.MethodPut,
+			path:        "/pickup-points/2",
+			requestBody: pickupPointRequest{Address: "ул. Ленина, 3"},
+			mockSetup: func(mockRepo *MockpickupPointRepository) {
+				updated := point
+				updated.Address = "ул. Ленина, 3"
+
+				mockRepo.EXPECT().GetByID(gomock.Any(), int64(2)).Return(point, nil)
+				mockRepo.EXPECT().Update(gomock.Any(), updated).Return(nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `{"message":"ПВЗ успешно обновлен"}`,
+		},
+		{
+			name:   "delete pickup point with orders",
+			method: http.MethodDelete,
+			path:   "/pickup-points/2",
+			mockSetup: func(mockRepo *MockpickupPointRepository) {
+				mockRepo.EXPECT().Delete(gomock.Any(), int64(2)).Return(repository.ErrPickupPointInUse)
+			},
+			expectedStatus: fiber.StatusConflict,
+			expectedBody:   `{"error":"в ПВЗ есть заказы, удаление невозможно"}`,
+		},
+		{
+			name:   "delete pickup point with storage error",
+			method: http.MethodDelete,
+			path:   "/pickup-points/2",
+			mockSetup: func(mockRepo *MockpickupPointRepository) {
+				mockRepo.EXPECT().Delete(gomock.Any(), int64(2)).Return(errors.New("db is down"))
+			},
+			expectedStatus: fiber.StatusInternalServerError,
+			expectedBody:   `{"error":"Ошибка при работе с ПВЗ"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app, mockRepo, cleanup := setupPickupPointTest(t)
+			defer cleanup()
+
+			tt.mockSetup(mockRepo)
+
+			var body io.Reader
+			if tt.requestBody != nil {
+				reqBody, err := json.Marshal(tt.requestBody)
+				require.NoError(t, err)
+				body = bytes.NewReader(reqBody)
+			}
+
+			req := httptest.NewRequest(tt.method, tt.path, body)
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Contains(t, string(respBody), tt.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+// returnPolicyServiceInterface описывает интерфейс сервиса политик возврата
+type returnPolicyServiceInterface interface {
+	CreatePolicy(ctx context.Context, policy model.ReturnPolicy) (model.ReturnPolicy, error)
+	UpdatePolicy(ctx context.Context, policy model.ReturnPolicy) (model.ReturnPolicy, error)
+	DeletePolicy(ctx context.Context, id int64) error
+	GetPolicy(ctx context.Context, id int64) (model.ReturnPolicy, error)
+	ListPolicies(ctx context.Context) ([]model.ReturnPolicy, error)
+	SetCustomerSegment(ctx context.Context, customerID int64, segment string) (model.CustomerSegment, error)
+}
+
+// returnPolicyRequest описывает структуру запроса на создание и изменение политики возврата
+type returnPolicyRequest struct {
+	Name               string  `json:"name"`
+	PackageType        string  `json:"package_type,omitempty"`
+	CustomerSegment    string  `json:"customer_segment,omitempty"`
+	ReturnWindowHours  int     `json:"return_window_hours"`
+	NonReturnable      bool    `json:"non_returnable"`
+	FeePercent         float64 `json:"fee_percent"`
+	RequiresInspection bool    `json:"requires_inspection"`
+}
+
+// customerSegmentRequest описывает структуру запроса на изменение сегмента клиента
+type customerSegmentRequest struct {
+	Segment string `json:"segment"`
+}
+
+// ReturnPolicyHandler обработчик запросов для управления политиками возврата
+type ReturnPolicyHandler struct {
+	service returnPolicyServiceInterface
+}
+
+// NewReturnPolicyHandler создает новый обработчик политик возврата
+func NewReturnPolicyHandler(service returnPolicyServiceInterface) *ReturnPolicyHandler {
+	return &ReturnPolicyHandler{service: service}
+}
+
+// CreateReturnPolicy обрабатывает запрос на создание политики возврата
+func (h *ReturnPolicyHandler) CreateReturnPolicy(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req returnPolicyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при разборе запроса: %v", err),
+		})
+	}
+
+	created, err := h.service.CreatePolicy(ctx, req.toModel())
+	if err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при создании политики возврата: %v", msg),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(created)
+}
+
+// GetReturnPolicy обрабатывает запрос на получение политики возврата по ID
+func (h *ReturnPolicyHandler) GetReturnPolicy(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := parseReturnPolicyIDFromParams(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	policy, err := h.service.GetPolicy(ctx, id)
+	if err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при получении политики возврата: %v", msg),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(policy)
+}
+
+// ListReturnPolicies обрабатывает запрос на получение списка политик возврата
+func (h *ReturnPolicyHandler) ListReturnPolicies(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	policies, err := h.service.ListPolicies(ctx)
+	if err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при получении списка политик возврата: %v", msg),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"return_policies": policies,
+		"total":           len(policies),
+	})
+}
+
+// UpdateReturnPolicy обрабатывает запрос на изменение политики возврата.
+// Все параметры политики заменяются переданными в запросе.
+func (h *ReturnPolicyHandler) UpdateReturnPolicy(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := parseReturnPolicyIDFromParams(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	var req returnPolicyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при разборе запроса: %v", err),
+		})
+	}
+
+	policy := req.toModel()
+	policy.ID = id
+
+	updated, err := h.service.UpdatePolicy(ctx, policy)
+	if err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при изменении политики возврата: %v", msg),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(updated)
+}
+
+// DeleteReturnPolicy обрабатывает запрос на удаление политики возврата
+func (h *ReturnPolicyHandler) DeleteReturnPolicy(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := parseReturnPolicyIDFromParams(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	if err := h.service.DeletePolicy(ctx, id); err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при удалении политики возврата: %v", msg),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Политика возврата успешно удалена",
+	})
+}
+
+// SetCustomerSegment обрабатывает запрос на изменение сегмента клиента.
+// Пустой сегмент снимает клиента с сегмента.
+func (h *ReturnPolicyHandler) SetCustomerSegment(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	customerID, err := parseCustomerIDFromString(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	var req customerSegmentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при разборе запроса: %v", err),
+		})
+	}
+
+	segment, err := h.service.SetCustomerSegment(ctx, customerID, req.Segment)
+	if err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при изменении сегмента клиента: %v", msg),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(segment)
+}
+
+// toModel преобразует запрос в политику возврата.
+// Пустые упаковка и сегмент означают, что политика действует для любых заказов и клиентов.
+func (r returnPolicyRequest) toModel() model.ReturnPolicy {
+	policy := model.ReturnPolicy{
+		Name:               r.Name,
+		ReturnWindowHours:  r.ReturnWindowHours,
+		NonReturnable:      r.NonReturnable,
+		FeePercent:         r.FeePercent,
+		RequiresInspection: r.RequiresInspection,
+	}
+	if r.PackageType != "" {
+		packageType := model.PackageType(r.PackageType)
+		policy.PackageType = &packageType
+	}
+	if r.CustomerSegment != "" {
+		segment := r.CustomerSegment
+		policy.CustomerSegment = &segment
+	}
+
+	return policy
+}
+
+// parseReturnPolicyIDFromParams извлекает и валидирует ID политики возврата из параметров запроса
+func parseReturnPolicyIDFromParams(idParam string) (int64, error) {
+	id, err := strconv.ParseInt(idParam, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, ErrInvalidReturnPolicyID
+	}
+
+	return id, nil
+}
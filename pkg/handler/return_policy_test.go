@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/repository"
+	"gitlab.ozon.dev/gojhw1/pkg/service"
+	"go.uber.org/mock/gomock"
+)
+
+// setupReturnPolicyTest создает тестовое окружение и возвращает app, mockService и функцию для очистки ресурсов
+func setupReturnPolicyTest(t *testing.T) (*fiber.App, *MockreturnPolicyServiceInterface, func()) {
+	ctrl := gomock.NewController(t)
+	mockService := NewMockreturnPolicyServiceInterface(ctrl)
+
+	app := fiber.New()
+	handler := NewReturnPolicyHandler(mockService)
+
+	app.Get("/return-policies", handler.ListReturnPolicies)
+	app.Post("/return-policies", handler.CreateReturnPolicy)
+	app.Get("/return-policies/:id", handler.GetReturnPolicy)
+	app.Put("/return-policies/:id", handler.UpdateReturnPolicy)
+	app.Delete("/return-policies/:id", handler.DeleteReturnPolicy)
+	app.Put("/customers/:id/segment", handler.SetCustomerSegment)
+
+	cleanup := func() {
+		ctrl.Finish()
+	}
+
+	return app, mockService, cleanup
+}
+
+func TestReturnPolicyHandler(t *testing.T) {
+	t.Parallel()
+
+	film := model.PackageFilm
+	vip := "vip"
+	policy := model.ReturnPolicy{ID: 2, Name: "Пленка", PackageType: &film, NonReturnable: true}
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		requestBody    any
+		mockSetup      func(mockService *MockreturnPolicyServiceInterface)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "create non-returnable policy for film",
+			method:      http.MethodPost,
+			path:        "/return-policies",
+			requestBody: returnPolicyRequest{Name: "Пленка", PackageType: "film", NonReturnable: true},
+			mockSetup: func(mockService *MockreturnPolicyServiceInterface) {
+				mockService.EXPECT().
+					CreatePolicy(gomock.Any(), model.ReturnPolicy{Name: "Пленка", PackageType: &film, NonReturnable: true}).
+					Return(policy, nil)
+			},
+			expectedStatus: fiber.StatusCreated,
+			expectedBody:   `"package_type":"film"`,
+		},
+		{
+			name:        "create policy with fee over 100 percent",
+			method:      http.MethodPost,
+			path:        "/return-policies",
+			requestBody: returnPolicyRequest{Name: "VIP", CustomerSegment: "vip", ReturnWindowHours: 72, FeePercent: 120},
+			mockSetup: func(mockService *MockreturnPolicyServiceInterface) {
+				mockService.EXPECT().
+					CreatePolicy(gomock.Any(), model.ReturnPolicy{Name: "VIP", CustomerSegment: &vip, ReturnWindowHours: 72, FeePercent: 120}).
+					Return(model.ReturnPolicy{}, service.ErrInvalidReturnFee)
+			},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `{"error":"Ошибка при создании политики возврата: удержание при возврате должно быть от 0 до 100 процентов"}`,
+		},
+		{
+			name:        "create duplicate policy",
+			method:      http.MethodPost,
+			path:        "/return-policies",
+			requestBody: returnPolicyRequest{Name: "Пленка 2", PackageType: "film", ReturnWindowHours: 24},
+			mockSetup: func(mockService *MockreturnPolicyServiceInterface) {
+				mockService.EXPECT().CreatePolicy(gomock.Any(), gomock.Any()).Return(model.ReturnPolicy{}, repository.ErrReturnPolicyAlreadyExists)
+			},
+			expectedStatus: fiber.StatusConflict,
+			expectedBody:   "политика возврата для этой упаковки и сегмента клиентов уже существует",
+		},
+		{
+			name:   "list policies",
+			method: http.MethodGet,
+			path:   "/return-policies",
+			mockSetup: func(mockService *MockreturnPolicyServiceInterface) {
+				mockService.EXPECT().ListPolicies(gomock.Any()).Return([]model.ReturnPolicy{policy}, nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `"total":1`,
+		},
+		{
+			name:   "get missing policy",
+			method: http.MethodGet,
+			path:   "/return-policies/9",
+			mockSetup: func(mockService *MockreturnPolicyServiceInterface) {
+				mockService.EXPECT().GetPolicy(gomock.Any(), int64(9)).Return(model.ReturnPolicy{}, repository.ErrReturnPolicyNotFound)
+			},
+			expectedStatus: fiber.StatusNotFound,
+			expectedBody:   `{"error":"Ошибка при получении политики возврата: политика возврата не найдена"}`,
+		},
+		{
+			name:        "update policy",
+			method:      http.MethodPut,
+			path:        "/return-policies/2",
+			requestBody: returnPolicyRequest{Name: "Пленка", PackageType: "film", ReturnWindowHours: 24, FeePercent: 10, RequiresInspection: true},
+			mockSetup: func(mockService *MockreturnPolicyServiceInterface) {
+				mockService.EXPECT().
+					UpdatePolicy(gomock.Any(), model.ReturnPolicy{ID: 2, Name: "Пленка", PackageType: &film, ReturnWindowHours: 24, FeePercent: 10, RequiresInspection: true}).
+					Return(model.ReturnPolicy{ID: 2, Name: "Пленка", PackageType: &film, ReturnWindowHours: 24, FeePercent: 10, RequiresInspection: true}, nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `"requires_inspection":true`,
+		},
+		{
+			name:           "delete with invalid id",
+			method:         http.MethodDelete,
+			path:           "/return-policies/abc",
+			mockSetup:      func(mockService *MockreturnPolicyServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `{"error":"неверный формат ID политики возврата"}`,
+		},
+		{
+			name:   "delete policy",
+			method: http.MethodDelete,
+			path:   "/return-policies/2",
+			mockSetup: func(mockService *MockreturnPolicyServiceInterface) {
+				mockService.EXPECT().DeletePolicy(gomock.Any(), int64(2)).Return(nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `{"message":"Политика возврата успешно удалена"}`,
+		},
+		{
+			name:        "set customer segment",
+			method:      http.MethodPut,
+			path:        "/customers/7/segment",
+			requestBody: customerSegmentRequest{Segment: "vip"},
+			mockSetup: func(mockService *MockreturnPolicyServiceInterface) {
+				mockService.EXPECT().SetCustomerSegment(gomock.Any(), int64(7), "vip").
+					Return(model.CustomerSegment{CustomerID: 7, Segment: "vip"}, nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `"customer_id":7,"segment":"vip"`,
+		},
+		{
+			name:           "set segment with invalid customer id",
+			method:         http.MethodPut,
+			path:           "/customers/0/segment",
+			requestBody:    customerSegmentRequest{Segment: "vip"},
+			mockSetup:      func(mockService *MockreturnPolicyServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `{"error":"ID клиента должен быть больше 0"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app, mockService, cleanup := setupReturnPolicyTest(t)
+			defer cleanup()
+
+			tt.mockSetup(mockService)
+
+			var body io.Reader
+			if tt.requestBody != nil {
+				reqBody, err := json.Marshal(tt.requestBody)
+				require.NoError(t, err)
+				body = bytes.NewReader(reqBody)
+			}
+
+			req := httptest.NewRequest(tt.method, tt.path, body)
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Contains(t, string(respBody), tt.expectedBody)
+		})
+	}
+}
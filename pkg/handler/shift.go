@@ -0,0 +1,244 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"gitlab.ozon.dev/gojhw1/pkg/exporter"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+// shiftServiceInterface описывает интерфейс сервиса смен ПВЗ и сверки кассы
+type shiftServiceInterface interface {
+	OpenShift(ctx context.Context, pickupPointID int64, openingCash float64) (model.Shift, error)
+	CurrentShift(ctx context.Context, pickupPointID int64) (model.Shift, error)
+	GetShift(ctx context.Context, id int64) (model.Shift, error)
+	ListShiftsWithCursor(ctx context.Context, cursorID int64, limit int) ([]model.Shift, error)
+	CloseShift(ctx context.Context, id int64, countedCash float64) (model.Shift, error)
+	ShiftReport(ctx context.Context, id int64) (model.ShiftReport, error)
+}
+
+// openShiftRequest описывает структуру запроса на открытие смены
+type openShiftRequest struct {
+	PickupPointID int64   `json:"pickup_point_id"`
+	OpeningCash   float64 `json:"opening_cash"`
+}
+
+// closeShiftRequest описывает структуру запроса на закрытие смены
+type closeShiftRequest struct {
+	CountedCash float64 `json:"counted_cash"`
+}
+
+// ShiftHandler обработчик запросов для смен ПВЗ и сверки кассы
+type ShiftHandler struct {
+	service shiftServiceInterface
+}
+
+// NewShiftHandler создает новый обработчик смен
+func NewShiftHandler(service shiftServiceInterface) *ShiftHandler {
+	return &ShiftHandler{service: service}
+}
+
+// OpenShift обрабатывает запрос на открытие смены в ПВЗ
+func (h *ShiftHandler) OpenShift(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req openShiftRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при разборе запроса: %v", err),
+		})
+	}
+
+	if req.PickupPointID < 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrInvalidPickupPointID.Error(),
+		})
+	}
+
+	shift, err := h.service.OpenShift(ctx, req.PickupPointID, req.OpeningCash)
+	if err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при открытии смены: %v", msg),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(shift)
+}
+
+// CurrentShift обрабатывает запрос на получение открытой смены ПВЗ с предварительным отчетом сверки.
+// Администратор указывает ПВЗ в параметре pickup_point_id, сотруднику возвращается смена его ПВЗ.
+func (h *ShiftHandler) CurrentShift(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var pickupPointID int64
+	if param := c.Query("pickup_point_id"); param != "" {
+		var err error
+		pickupPointID, err = strconv.ParseInt(param, 10, 64)
+		if err != nil || pickupPointID <= 0 {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": ErrInvalidPickupPointID.Error(),
+			})
+		}
+	}
+
+	shift, err := h.service.CurrentShift(ctx, pickupPointID)
+	if err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при получении открытой смены: %v", msg),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(shift)
+}
+
+// GetShift обрабатывает запрос на получение смены по ID вместе с отчетом сверки
+func (h *ShiftHandler) GetShift(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := parseShiftIDFromParams(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	shift, err := h.service.GetShift(ctx, id)
+	if err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при получении смены: %v", msg),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(shift)
+}
+
+// ListShifts обрабатывает запрос на получение списка смен с курсорной пагинацией, новые смены первыми
+func (h *ShiftHandler) ListShifts(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	cursorID, err := parseCursorFromString(c.Query("cursor"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	limit, err := parseLimitFromString(c.Query("limit"), defaultPageSize)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	shifts, err := h.service.ListShiftsWithCursor(ctx, cursorID, limit+1)
+	if err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при получении списка смен: %v", msg),
+		})
+	}
+
+	hasMore := len(shifts) > limit
+	var nextCursor string
+
+	if hasMore {
+		shifts = shifts[:limit]
+	}
+
+	if len(shifts) > 0 {
+		nextCursor = strconv.FormatInt(shifts[len(shifts)-1].ID, 10)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"shifts":      shifts,
+		"has_more":    hasMore,
+		"next_cursor": nextCursor,
+	})
+}
+
+// CloseShift обрабатывает запрос на закрытие смены со сверкой пересчитанных наличных.
+// После закрытия смена и ее отчет сверки не изменяются.
+func (h *ShiftHandler) CloseShift(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := parseShiftIDFromParams(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	var req closeShiftRequest
+	if err = c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при разборе запроса: %v", err),
+		})
+	}
+
+	shift, err := h.service.CloseShift(ctx, id, req.CountedCash)
+	if err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при закрытии смены: %v", msg),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(shift)
+}
+
+// ShiftReport обрабатывает запрос на выгрузку отчета сверки смены в файл.
+// Формат задается параметром format: json (по умолчанию) или csv.
+func (h *ShiftHandler) ShiftReport(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := parseShiftIDFromParams(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	format, err := exporter.ResolveReportFormat(c.Query("format"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	report, err := h.service.ShiftReport(ctx, id)
+	if err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при получении отчета сверки смены: %v", msg),
+		})
+	}
+
+	var buf bytes.Buffer
+	if err = exporter.WriteShiftReport(&buf, format, report); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при формировании отчета сверки смены: %v", err),
+		})
+	}
+
+	c.Set(fiber.HeaderContentType, exporter.ReportContentType(format))
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, exporter.ReportFilename(report.ShiftID, format)))
+
+	return c.Status(fiber.StatusOK).Send(buf.Bytes())
+}
+
+// parseShiftIDFromParams извлекает и валидирует ID смены из параметров запроса
+func parseShiftIDFromParams(idParam string) (int64, error) {
+	id, err := strconv.ParseInt(idParam, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, ErrInvalidShiftID
+	}
+
+	return id, nil
+}
@@ -0,0 +1,262 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/repository"
+	"gitlab.ozon.dev/gojhw1/pkg/service"
+	"go.uber.org/mock/gomock"
+)
+
+// setupShiftTest создает тестовое окружение и возвращает app, mockService и функцию для очистки ресурсов
+func setupShiftTest(t *testing.T) (*fiber.App, *MockshiftServiceInterface, func()) {
+	ctrl := gomock.NewController(t)
+	mockService := NewMockshiftServiceInterface(ctrl)
+
+	app := fiber.New()
+	handler := NewShiftHandler(mockService)
+
+	app.Get("/shifts", handler.ListShifts)
+	app.Post("/shifts", handler.OpenShift)
+	app.Get("/shifts/current", handler.CurrentShift)
+	app.Get("/shifts/:id", handler.GetShift)
+	app.Post("/shifts/:id/close", handler.CloseShift)
+	app.Get("/shifts/:id/report", handler.ShiftReport)
+
+	cleanup := func() {
+		ctrl.Finish()
+	}
+
+	return app, mockService, cleanup
+}
+
+func TestShiftHandler(t *testing.T) {
+	t.Parallel()
+
+	openedAt := time.Date(2030, 1, 3, 9, 0, 0, 0, time.UTC)
+	counted := 1450.0
+	difference := -50.0
+	report := model.ShiftReport{
+		ShiftID:        3,
+		PickupPointID:  1,
+		From:           openedAt,
+		To:             openedAt.Add(12 * time.Hour),
+		Final:          true,
+		Operators:      []model.ShiftOperatorTotals{},
+		Totals:         model.ShiftTotals{Payments: 1, CashReceived: 500},
+		OpeningCash:    1000,
+		ExpectedCash:   1500,
+		CountedCash:    &counted,
+		CashDifference: &difference,
+	}
+	openShift := model.Shift{ID: 3, PickupPointID: 1, State: model.ShiftStateOpen, OpeningCash: 1000, OpenedAt: openedAt}
+	closedShift := model.Shift{ID: 3, PickupPointID: 1, State: model.ShiftStateClosed, OpeningCash: 1000, CountedCash: &counted, OpenedAt: openedAt, Report: &report}
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		requestBody    any
+		mockSetup      func(mockService *MockshiftServiceInterface)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "open shift",
+			method:      http.MethodPost,
+			path:        "/shifts",
+			requestBody: openShiftRequest{PickupPointID: 1, OpeningCash: 1000},
+			mockSetup: func(mockService *MockshiftServiceInterface) {
+				mockService.EXPECT().OpenShift(gomock.Any(), int64(1), 1000.0).Return(openShift, nil)
+			},
+			expectedStatus: fiber.StatusCreated,
+			expectedBody:   `"state":"open"`,
+		},
+		{
+			name:        "open second shift in pickup point",
+			method:      http.MethodPost,
+			path:        "/shifts",
+			requestBody: openShiftRequest{PickupPointID: 1},
+			mockSetup: func(mockService *MockshiftServiceInterface) {
+				mockService.EXPECT().OpenShift(gomock.Any(), int64(1), 0.0).Return(model.Shift{}, repository.ErrShiftAlreadyOpen)
+			},
+			expectedStatus: fiber.StatusConflict,
+			expectedBody:   "уже открыта смена",
+		},
+		{
+			name:        "open shift with negative cash",
+			method:      http.MethodPost,
+			path:        "/shifts",
+			requestBody: openShiftRequest{PickupPointID: 1, OpeningCash: -1},
+			mockSetup: func(mockService *MockshiftServiceInterface) {
+				mockService.EXPECT().OpenShift(gomock.Any(), int64(1), -1.0).Return(model.Shift{}, service.ErrInvalidShiftCash)
+			},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   "не может быть отрицательной",
+		},
+		{
+			name:   "current shift of pickup point",
+			method: http.MethodGet,
+			path:   "/shifts/current?pickup_point_id=1",
+			mockSetup: func(mockService *MockshiftServiceInterface) {
+				mockService.EXPECT().CurrentShift(gomock.Any(), int64(1)).Return(openShift, nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `"id":3`,
+		},
+		{
+			name:           "current shift with invalid pickup point",
+			method:         http.MethodGet,
+			path:           "/shifts/current?pickup_point_id=abc",
+			mockSetup:      func(mockService *MockshiftServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   ErrInvalidPickupPointID.Error(),
+		},
+		{
+			name:   "no open shift",
+			method: http.MethodGet,
+			path:   "/shifts/current",
+			mockSetup: func(mockService *MockshiftServiceInterface) {
+				mockService.EXPECT().CurrentShift(gomock.Any(), int64(0)).Return(model.Shift{}, repository.ErrShiftNotFound)
+			},
+			expectedStatus: fiber.StatusNotFound,
+			expectedBody:   "смена не найдена",
+		},
+		{
+			name:   "get closed shift",
+			method: http.MethodGet,
+			path:   "/shifts/3",
+			mockSetup: func(mockService *MockshiftServiceInterface) {
+				mockService.EXPECT().GetShift(gomock.Any(), int64(3)).Return(closedShift, nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `"cash_difference":-50`,
+		},
+		{
+			name:           "get shift with invalid id",
+			method:         http.MethodGet,
+			path:           "/shifts/0",
+			mockSetup:      func(mockService *MockshiftServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   ErrInvalidShiftID.Error(),
+		},
+		{
+			name:   "list shifts with next page",
+			method: http.MethodGet,
+			path:   "/shifts?limit=1",
+			mockSetup: func(mockService *MockshiftServiceInterface) {
+				mockService.EXPECT().ListShiftsWithCursor(gomock.Any(), int64(0), 2).
+					Return([]model.Shift{openShift, {ID: 2, PickupPointID: 1, State: model.ShiftStateClosed}}, nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `"has_more":true,"next_cursor":"3"`,
+		},
+		{
+			name:        "close shift",
+			method:      http.MethodPost,
+			path:        "/shifts/3/close",
+			requestBody: closeShiftRequest{CountedCash: 1450},
+			mockSetup: func(mockService *MockshiftServiceInterface) {
+				mockService.EXPECT().CloseShift(gomock.Any(), int64(3), 1450.0).Return(closedShift, nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `"state":"closed"`,
+		},
+		{
+			name:        "close already closed shift",
+			method:      http.MethodPost,
+			path:        "/shifts/3/close",
+			requestBody: closeShiftRequest{CountedCash: 1450},
+			mockSetup: func(mockService *MockshiftServiceInterface) {
+				mockService.EXPECT().CloseShift(gomock.Any(), int64(3), 1450.0).Return(model.Shift{}, repository.ErrShiftClosed)
+			},
+			expectedStatus: fiber.StatusConflict,
+			expectedBody:   "смена закрыта",
+		},
+		{
+			name:        "close foreign shift",
+			method:      http.MethodPost,
+			path:        "/shifts/3/close",
+			requestBody: closeShiftRequest{CountedCash: 1450},
+			mockSetup: func(mockService *MockshiftServiceInterface) {
+				mockService.EXPECT().CloseShift(gomock.Any(), int64(3), 1450.0).Return(model.Shift{}, service.ErrForeignPickupPoint)
+			},
+			expectedStatus: fiber.StatusForbidden,
+		},
+		{
+			name:   "csv report",
+			method: http.MethodGet,
+			path:   "/shifts/3/report?format=csv",
+			mockSetup: func(mockService *MockshiftServiceInterface) {
+				mockService.EXPECT().ShiftReport(gomock.Any(), int64(3)).Return(report, nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   ",total,0,0.00,1,500.00,",
+		},
+		{
+			name:           "report in unsupported format",
+			method:         http.MethodGet,
+			path:           "/shifts/3/report?format=pdf",
+			mockSetup:      func(mockService *MockshiftServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   "неподдерживаемый формат",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app, mockService, cleanup := setupShiftTest(t)
+			defer cleanup()
+
+			tt.mockSetup(mockService)
+
+			var body io.Reader
+			if tt.requestBody != nil {
+				reqBody, err := json.Marshal(tt.requestBody)
+				require.NoError(t, err)
+				body = bytes.NewReader(reqBody)
+			}
+
+			req := httptest.NewRequest(tt.method, tt.path, body)
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Contains(t, string(respBody), tt.expectedBody)
+		})
+	}
+}
+
+func TestShiftHandler_ShiftReportHeaders(t *testing.T) {
+	t.Parallel()
+
+	app, mockService, cleanup := setupShiftTest(t)
+	defer cleanup()
+
+	mockService.EXPECT().ShiftReport(gomock.Any(), int64(3)).Return(model.ShiftReport{ShiftID: 3}, nil)
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/shifts/3/report", nil))
+	require.NoError(t, err)
+
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get(fiber.HeaderContentType))
+	assert.Equal(t, `attachment; filename="shift-3.json"`, resp.Header.Get(fiber.HeaderContentDisposition))
+}
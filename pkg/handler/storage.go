@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+// storageServiceInterface описывает интерфейс сервиса ячеек хранения
+type storageServiceInterface interface {
+	CreateCell(ctx context.Context, cell model.StorageCell) (model.StorageCell, error)
+	DeleteCell(ctx context.Context, id int64) error
+	Occupancy(ctx context.Context) ([]model.CellOccupancy, error)
+}
+
+// storageCellRequest описывает структуру запроса на создание ячейки хранения
+type storageCellRequest struct {
+	PickupPointID int64   `json:"pickup_point_id,omitempty"`
+	Rack          string  `json:"rack"`
+	Code          string  `json:"code"`
+	Capacity      int     `json:"capacity"`
+	MaxWeight     float64 `json:"max_weight"`
+	PackageType   string  `json:"package_type,omitempty"`
+}
+
+// StorageHandler обработчик запросов для ячеек хранения заказов
+type StorageHandler struct {
+	service storageServiceInterface
+}
+
+// NewStorageHandler создает новый обработчик ячеек хранения
+func NewStorageHandler(service storageServiceInterface) *StorageHandler {
+	return &StorageHandler{service: service}
+}
+
+// CreateCell обрабатывает запрос на создание ячейки хранения
+func (h *StorageHandler) CreateCell(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req storageCellRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при разборе запроса: %v", err),
+		})
+	}
+
+	cell := model.StorageCell{
+		PickupPointID: req.PickupPointID,
+		Rack:          req.Rack,
+		Code:          req.Code,
+		Capacity:      req.Capacity,
+		MaxWeight:     req.MaxWeight,
+	}
+	if req.PackageType != "" {
+		packageType := model.PackageType(req.PackageType)
+		cell.PackageType = &packageType
+	}
+
+	created, err := h.service.CreateCell(ctx, cell)
+	if err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при создании ячейки: %v", msg),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(created)
+}
+
+// DeleteCell обрабатывает запрос на удаление пустой ячейки хранения
+func (h *StorageHandler) DeleteCell(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil || id <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrInvalidStorageCellID.Error(),
+		})
+	}
+
+	if err := h.service.DeleteCell(ctx, id); err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при удалении ячейки: %v", msg),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Ячейка успешно удалена",
+	})
+}
+
+// Occupancy обрабатывает запрос на получение заполненности ячеек ПВЗ
+func (h *StorageHandler) Occupancy(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	cells, err := h.service.Occupancy(ctx)
+	if err != nil {
+		status, msg := processError(err)
+		return c.Status(status).JSON(fiber.Map{
+			"error": fmt.Sprintf("Ошибка при получении заполненности ячеек: %v", msg),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"cells": cells,
+		"total": len(cells),
+	})
+}
@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/repository"
+	"gitlab.ozon.dev/gojhw1/pkg/service"
+	"go.uber.org/mock/gomock"
+)
+
+// setupStorageTest создает тестовое окружение и возвращает app, mockService и функцию для очистки ресурсов
+func setupStorageTest(t *testing.T) (*fiber.App, *MockstorageServiceInterface, func()) {
+	ctrl := gomock.NewController(t)
+	mockService := NewMockstorageServiceInterface(ctrl)
+
+	app := fiber.New()
+	handler := NewStorageHandler(mockService)
+
+	app.Get("/storage-cells", handler.Occupancy)
+	app.Post("/storage-cells", handler.CreateCell)
+	app.Delete("/storage-cells/:id", handler.DeleteCell)
+
+	cleanup := func() {
+		ctrl.Finish()
+	}
+
+	return app, mockService, cleanup
+}
+
+func TestStorageHandler(t *testing.T) {
+	t.Parallel()
+
+	box := model.PackageBox
+	cell := model.StorageCell{ID: 5, PickupPointID: 1, Rack: "A", Code: "A-03", Capacity: 4, MaxWeight: 30, PackageType: &box}
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		requestBody    any
+		mockSetup      func(mockService *MockstorageServiceInterface)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "create box cell",
+			method:      http.MethodPost,
+			path:        "/storage-cells",
+			requestBody: storageCellRequest{Rack: "A", Code: "A-03", Capacity: 4, MaxWeight: 30, PackageType: "box"},
+			mockSetup: func(mockService *MockstorageServiceInterface) {
+				mockService.EXPECT().
+					CreateCell(gomock.Any(), model.StorageCell{Rack: "A", Code: "A-03", Capacity: 4, MaxWeight: 30, PackageType: &box}).
+					Return(cell, nil)
+			},
+			expectedStatus: fiber.StatusCreated,
+			expectedBody:   `"package_type":"box"`,
+		},
+		{
+			name:        "create cell with zero capacity",
+			method:      http.MethodPost,
+			path:        "/storage-cells",
+			requestBody: storageCellRequest{Rack: "A", Code: "A-04", MaxWeight: 30},
+			mockSetup: func(mockService *MockstorageServiceInterface) {
+				mockService.EXPECT().CreateCell(gomock.Any(), gomock.Any()).Return(model.StorageCell{}, service.ErrInvalidCellCapacity)
+			},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `{"error":"Ошибка при создании ячейки: вместимость ячейки должна быть положительным числом"}`,
+		},
+		{
+			name:   "occupancy",
+			method: http.MethodGet,
+			path:   "/storage-cells",
+			mockSetup: func(mockService *MockstorageServiceInterface) {
+				mockService.EXPECT().Occupancy(gomock.Any()).
+					Return([]model.CellOccupancy{{StorageCell: cell, OrdersCount: 2, Weight: 7.5}}, nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `"orders_count":2,"weight":7.5`,
+		},
+		{
+			name:   "delete occupied cell",
+			method: http.MethodDelete,
+			path:   "/storage-cells/5",
+			mockSetup: func(mockService *MockstorageServiceInterface) {
+				mockService.EXPECT().DeleteCell(gomock.Any(), int64(5)).Return(repository.ErrStorageCellOccupied)
+			},
+			expectedStatus: fiber.StatusConflict,
+			expectedBody:   `{"error":"Ошибка при удалении ячейки: в ячейке хранятся заказы, удаление невозможно"}`,
+		},
+		{
+			name:           "delete with invalid id",
+			method:         http.MethodDelete,
+			path:           "/storage-cells/abc",
+			mockSetup:      func(mockService *MockstorageServiceInterface) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `{"error":"неверный формат ID ячейки хранения"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app, mockService, cleanup := setupStorageTest(t)
+			defer cleanup()
+
+			tt.mockSetup(mockService)
+
+			var body io.Reader
+			if tt.requestBody != nil {
+				reqBody, err := json.Marshal(tt.requestBody)
+				require.NoError(t, err)
+				body = bytes.NewReader(reqBody)
+			}
+
+			req := httptest.NewRequest(tt.method, tt.path, body)
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Contains(t, string(respBody), tt.expectedBody)
+		})
+	}
+}
@@ -2,10 +2,12 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/rbac"
 	"gitlab.ozon.dev/gojhw1/pkg/repository"
 )
 
@@ -18,13 +20,26 @@ type userRepository interface {
 	GetByUsername(ctx context.Context, username string) (model.User, error)
 	List(ctx context.Context, searchTerm string) ([]model.User, error)
 	CheckPassword(ctx context.Context, username, password string) bool
+	Approve(ctx context.Context, userID int64, role string) error
+	AssignPickupPoint(ctx context.Context, userID int64, pickupPointID *int64) error
 }
 
-// createUserRequest представляет собой структуру запроса для создания нового пользователя
+// createUserRequest представляет собой структуру запроса для создания нового пользователя.
+// Роль при регистрации не передается: ее назначает администратор при подтверждении.
 type сreateUserRequest struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
-	Role     string `json:"role"`
+}
+
+// roleRequest представляет собой структуру запроса для подтверждения пользователя и назначения роли
+type roleRequest struct {
+	Role string `json:"role"`
+}
+
+// assignPickupPointRequest представляет собой структуру запроса на привязку пользователя к ПВЗ.
+// Пустое значение снимает привязку.
+type assignPickupPointRequest struct {
+	PickupPointID *int64 `json:"pickup_point_id"`
 }
 
 // UserHandler обработчик запросов для управления пользователями
@@ -57,14 +72,11 @@ func (h *UserHandler) CreateUser(c *fiber.Ctx) error {
 		})
 	}
 
-	// Если роль не указана, используем роль по умолчанию
-	if req.Role == "" {
-		req.Role = "user"
-	}
-
+	// Публичная регистрация создает неподтвержденную учетную запись с минимальными правами
 	user := model.User{
 		Username: req.Username,
-		Role:     req.Role,
+		Role:     model.RoleCourier,
+		Status:   model.UserStatusPending,
 	}
 
 	if err := h.userRepository.Create(ctx, user, req.Password); err != nil {
@@ -79,7 +91,7 @@ func (h *UserHandler) CreateUser(c *fiber.Ctx) error {
 	}
 
 	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
-		"message": "Пользователь успешно создан",
+		"message": "Пользователь успешно создан и ожидает подтверждения администратором",
 	})
 }
 
@@ -150,6 +162,12 @@ func (h *UserHandler) UpdateUser(c *fiber.Ctx) error {
 		})
 	}
 
+	if req.Role != "" && !rbac.IsKnownRole(req.Role) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrInvalidRole.Error(),
+		})
+	}
+
 	existingUser, err := h.userRepository.GetByID(ctx, id)
 	if err != nil {
 		if err.Error() == repository.ErrUserNotFound.Error() {
@@ -233,6 +251,149 @@ func (h *UserHandler) UpdatePassword(c *fiber.Ctx) error {
 	})
 }
 
+// ApproveUser обрабатывает запрос на подтверждение зарегистрированного пользователя.
+// Если в запросе указана роль, она назначается пользователю вместе с подтверждением.
+func (h *UserHandler) ApproveUser(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := parseUserIDFromParams(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	var req roleRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Ошибка при разборе запроса",
+			})
+		}
+	}
+
+	if req.Role != "" && !rbac.IsKnownRole(req.Role) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrInvalidRole.Error(),
+		})
+	}
+
+	if err := h.userRepository.Approve(ctx, id, req.Role); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrUserNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "Пользователь не найден",
+			})
+		case errors.Is(err, repository.ErrUserAlreadyActive):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"error": "Пользователь уже подтвержден",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Ошибка при подтверждении пользователя",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Пользователь успешно подтвержден",
+	})
+}
+
+// PromoteUser обрабатывает запрос на назначение пользователю новой роли
+func (h *UserHandler) PromoteUser(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := parseUserIDFromParams(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	var req roleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Ошибка при разборе запроса",
+		})
+	}
+
+	if !rbac.IsKnownRole(req.Role) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrInvalidRole.Error(),
+		})
+	}
+
+	existingUser, err := h.userRepository.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "Пользователь не найден",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Ошибка при получении пользователя",
+		})
+	}
+
+	existingUser.Role = req.Role
+	existingUser.UpdatedAt = time.Now()
+
+	if err := h.userRepository.Update(ctx, existingUser); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Ошибка при назначении роли",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Роль пользователя успешно изменена",
+	})
+}
+
+// AssignPickupPoint обрабатывает запрос на привязку пользователя к ПВЗ
+func (h *UserHandler) AssignPickupPoint(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := parseUserIDFromParams(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	var req assignPickupPointRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Ошибка при разборе запроса",
+		})
+	}
+
+	if req.PickupPointID != nil && *req.PickupPointID <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrInvalidPickupPointID.Error(),
+		})
+	}
+
+	if err := h.userRepository.AssignPickupPoint(ctx, id, req.PickupPointID); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrUserNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "Пользователь не найден",
+			})
+		case errors.Is(err, repository.ErrPickupPointNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "ПВЗ не найден",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Ошибка при привязке пользователя к ПВЗ",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Привязка пользователя к ПВЗ успешно изменена",
+	})
+}
+
 // DeleteUser обрабатывает запрос на удаление пользователя
 func (h *UserHandler) DeleteUser(c *fiber.Ctx) error {
 	ctx := c.UserContext()
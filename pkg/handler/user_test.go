@@ -33,6 +33,8 @@ func setupUserTest(t *testing.T) (*fiber.App, *MockuserRepository, func()) {
 	app.Put("/users/:id", handler.UpdateUser)
 	app.Delete("/users/:id", handler.DeleteUser)
 	app.Put("/users/:id/password", handler.UpdatePassword)
+	app.Post("/users/:id/approve", handler.ApproveUser)
+	app.Post("/users/:id/promote", handler.PromoteUser)
 
 	cleanup := func() {
 		ctrl.Finish()
@@ -56,28 +58,28 @@ func TestUserHandler_CreateUser(t *testing.T) {
 			requestBody: сreateUserRequest{
 				Username: "testuser",
 				Password: "testpass",
-				Role:     "user",
 			},
 			mockSetup: func(mockDB *MockuserRepository) {
 				mockDB.EXPECT().Create(gomock.Any(), model.User{
 					Username: "testuser",
-					Role:     "user",
+					Role:     "courier",
+					Status:   "pending",
 				}, "testpass").Return(nil)
 			},
 			expectedStatus: fiber.StatusCreated,
-			expectedBody:   `{"message":"Пользователь успешно создан"}`,
+			expectedBody:   `{"message":"Пользователь успешно создан и ожидает подтверждения администратором"}`,
 		},
 		{
 			name: "error create user - already exists",
 			requestBody: сreateUserRequest{
 				Username: "testuser",
 				Password: "testpass",
-				Role:     "user",
 			},
 			mockSetup: func(mockDB *MockuserRepository) {
 				mockDB.EXPECT().Create(gomock.Any(), model.User{
 					Username: "testuser",
-					Role:     "user",
+					Role:     "courier",
+					Status:   "pending",
 				}, "testpass").Return(repository.ErrUserAlreadyExists)
 			},
 			expectedStatus: fiber.StatusConflict,
@@ -88,12 +90,12 @@ func TestUserHandler_CreateUser(t *testing.T) {
 			requestBody: сreateUserRequest{
 				Username: "testuser",
 				Password: "testpass",
-				Role:     "user",
 			},
 			mockSetup: func(mockDB *MockuserRepository) {
 				mockDB.EXPECT().Create(gomock.Any(), model.User{
 					Username: "testuser",
-					Role:     "user",
+					Role:     "courier",
+					Status:   "pending",
 				}, "testpass").Return(errors.New("unexpected error"))
 			},
 			expectedStatus: fiber.StatusInternalServerError,
@@ -104,26 +106,27 @@ func TestUserHandler_CreateUser(t *testing.T) {
 			requestBody: сreateUserRequest{
 				Username: "",
 				Password: "testpass",
-				Role:     "user",
 			},
 			mockSetup:      func(mockDB *MockuserRepository) {},
 			expectedStatus: fiber.StatusBadRequest,
 			expectedBody:   `{"error":"имя пользователя не может быть пустым"}`,
 		},
 		{
-			name: "empty role",
-			requestBody: сreateUserRequest{
-				Username: "testuser",
-				Password: "testpass",
+			name: "role from request is ignored",
+			requestBody: map[string]string{
+				"username": "testuser",
+				"password": "testpass",
+				"role":     "admin",
 			},
 			mockSetup: func(mockDB *MockuserRepository) {
 				mockDB.EXPECT().Create(gomock.Any(), model.User{
 					Username: "testuser",
-					Role:     "user",
+					Role:     "courier",
+					Status:   "pending",
 				}, "testpass").Return(nil)
 			},
 			expectedStatus: fiber.StatusCreated,
-			expectedBody:   `{"message":"Пользователь успешно создан"}`,
+			expectedBody:   `{"message":"Пользователь успешно создан и ожидает подтверждения администратором"}`,
 		},
 	}
 
@@ -163,7 +166,8 @@ func TestUserHandler_GetUser(t *testing.T) {
 	testUser := model.User{
 		ID:        1,
 		Username:  "testuser",
-		Role:      "user",
+		Role:      "operator",
+		Status:    "active",
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
@@ -181,7 +185,7 @@ func TestUserHandler_GetUser(t *testing.T) {
 			mockSetup: func(mockDB *MockuserRepository) {
 				mockDB.EXPECT().GetByID(gomock.Any(), int64(1)).Return(testUser, nil)
 			},
-			expectedBody: fmt.Sprintf(`{"id":1,"username":"testuser","role":"user","created_at":"%s","updated_at":"%s"}`,
+			expectedBody: fmt.Sprintf(`{"id":1,"username":"testuser","role":"operator","status":"active","created_at":"%s","updated_at":"%s"}`,
 				testUser.CreatedAt.Format(time.RFC3339Nano),
 				testUser.UpdatedAt.Format(time.RFC3339Nano)),
 			expectedStatus: fiber.StatusOK,
@@ -244,7 +248,8 @@ func TestUserHandler_ListUsers(t *testing.T) {
 		{
 			ID:        1,
 			Username:  "testuser1",
-			Role:      "user",
+			Role:      "operator",
+			Status:    "active",
 			CreatedAt: now,
 			UpdatedAt: now,
 		},
@@ -252,6 +257,7 @@ func TestUserHandler_ListUsers(t *testing.T) {
 			ID:        2,
 			Username:  "testuser2",
 			Role:      "admin",
+			Status:    "active",
 			CreatedAt: now,
 			UpdatedAt: now,
 		},
@@ -270,10 +276,10 @@ func TestUserHandler_ListUsers(t *testing.T) {
 			},
 			expectedStatus: fiber.StatusOK,
 			expectedBody: fmt.Sprintf(`{"total":2,"users":[%s,%s]}`,
-				fmt.Sprintf(`{"id":1,"username":"testuser1","role":"user","created_at":"%s","updated_at":"%s"}`,
+				fmt.Sprintf(`{"id":1,"username":"testuser1","role":"operator","status":"active","created_at":"%s","updated_at":"%s"}`,
 					testUsers[0].CreatedAt.Format(time.RFC3339Nano),
 					testUsers[0].UpdatedAt.Format(time.RFC3339Nano)),
-				fmt.Sprintf(`{"id":2,"username":"testuser2","role":"admin","created_at":"%s","updated_at":"%s"}`,
+				fmt.Sprintf(`{"id":2,"username":"testuser2","role":"admin","status":"active","created_at":"%s","updated_at":"%s"}`,
 					testUsers[1].CreatedAt.Format(time.RFC3339Nano),
 					testUsers[1].UpdatedAt.Format(time.RFC3339Nano)),
 			),
@@ -333,7 +339,7 @@ func TestUserHandler_UpdateUser(t *testing.T) {
 				mockDB.EXPECT().GetByID(gomock.Any(), int64(1)).Return(model.User{
 					ID:       1,
 					Username: "old_username",
-					Role:     "user",
+					Role:     "operator",
 				}, nil)
 				mockDB.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
 					func(ctx context.Context, user model.User) error {
@@ -383,7 +389,7 @@ func TestUserHandler_UpdateUser(t *testing.T) {
 				mockDB.EXPECT().GetByID(gomock.Any(), int64(1)).Return(model.User{
 					ID:       1,
 					Username: "old_username",
-					Role:     "user",
+					Role:     "operator",
 				}, nil)
 				mockDB.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("внутренняя ошибка"))
 			},
@@ -449,7 +455,7 @@ func TestUserHandler_UpdatePassword(t *testing.T) {
 				mockDB.EXPECT().GetByID(gomock.Any(), int64(1)).Return(model.User{
 					ID:       1,
 					Username: "user",
-					Role:     "user",
+					Role:     "operator",
 				}, nil)
 				mockDB.EXPECT().UpdatePassword(gomock.Any(), int64(1), "newpassword").Return(nil)
 			},
@@ -478,7 +484,7 @@ func TestUserHandler_UpdatePassword(t *testing.T) {
 				mockDB.EXPECT().GetByID(gomock.Any(), int64(1)).Return(model.User{
 					ID:       1,
 					Username: "user",
-					Role:     "user",
+					Role:     "operator",
 				}, nil)
 				mockDB.EXPECT().UpdatePassword(gomock.Any(), int64(1), "newpassword").Return(errors.New("внутренняя ошибка"))
 			},
@@ -612,3 +618,183 @@ func TestUserHandler_DeleteUser(t *testing.T) {
 		})
 	}
 }
+
+func TestUserHandler_ApproveUser(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		userID         string
+		requestBody    any
+		mockSetup      func(mock *MockuserRepository)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "success approve user with role",
+			userID:      "1",
+			requestBody: roleRequest{Role: "operator"},
+			mockSetup: func(mockDB *MockuserRepository) {
+				mockDB.EXPECT().Approve(gomock.Any(), int64(1), "operator").Return(nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `{"message":"Пользователь успешно подтвержден"}`,
+		},
+		{
+			name:        "success approve user without role",
+			userID:      "1",
+			requestBody: roleRequest{},
+			mockSetup: func(mockDB *MockuserRepository) {
+				mockDB.EXPECT().Approve(gomock.Any(), int64(1), "").Return(nil)
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `{"message":"Пользователь успешно подтвержден"}`,
+		},
+		{
+			name:        "error approve user - already active",
+			userID:      "1",
+			requestBody: roleRequest{},
+			mockSetup: func(mockDB *MockuserRepository) {
+				mockDB.EXPECT().Approve(gomock.Any(), int64(1), "").Return(repository.ErrUserAlreadyActive)
+			},
+			expectedStatus: fiber.StatusConflict,
+			expectedBody:   `{"error":"Пользователь уже подтвержден"}`,
+		},
+		{
+			name:        "error approve user - not found",
+			userID:      "1",
+			requestBody: roleRequest{},
+			mockSetup: func(mockDB *MockuserRepository) {
+				mockDB.EXPECT().Approve(gomock.Any(), int64(1), "").Return(repository.ErrUserNotFound)
+			},
+			expectedStatus: fiber.StatusNotFound,
+			expectedBody:   `{"error":"Пользователь не найден"}`,
+		},
+		{
+			name:           "validation error - unknown role",
+			userID:         "1",
+			requestBody:    roleRequest{Role: "superuser"},
+			mockSetup:      func(mockDB *MockuserRepository) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `{"error":"неизвестная роль пользователя"}`,
+		},
+		{
+			name:           "validation error - invalid ID",
+			userID:         "invalid",
+			requestBody:    roleRequest{},
+			mockSetup:      func(mockDB *MockuserRepository) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `{"error":"неверный формат ID пользователя"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app, mockDB, cleanup := setupUserTest(t)
+			defer cleanup()
+
+			tt.mockSetup(mockDB)
+
+			reqBody, err := json.Marshal(tt.requestBody)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/users/%s/approve", tt.userID), bytes.NewReader(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Contains(t, string(body), tt.expectedBody)
+		})
+	}
+}
+
+func TestUserHandler_PromoteUser(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	tests := []struct {
+		name           string
+		userID         string
+		requestBody    any
+		mockSetup      func(mock *MockuserRepository)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "success promote user",
+			userID:      "1",
+			requestBody: roleRequest{Role: "admin"},
+			mockSetup: func(mockDB *MockuserRepository) {
+				mockDB.EXPECT().GetByID(gomock.Any(), int64(1)).Return(model.User{
+					ID:        1,
+					Username:  "testuser",
+					Role:      "operator",
+					Status:    "active",
+					CreatedAt: now,
+					UpdatedAt: now,
+				}, nil)
+				mockDB.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, user model.User) error {
+						assert.Equal(t, "admin", user.Role)
+						return nil
+					})
+			},
+			expectedStatus: fiber.StatusOK,
+			expectedBody:   `{"message":"Роль пользователя успешно изменена"}`,
+		},
+		{
+			name:        "error promote user - not found",
+			userID:      "1",
+			requestBody: roleRequest{Role: "admin"},
+			mockSetup: func(mockDB *MockuserRepository) {
+				mockDB.EXPECT().GetByID(gomock.Any(), int64(1)).Return(model.User{}, repository.ErrUserNotFound)
+			},
+			expectedStatus: fiber.StatusNotFound,
+			expectedBody:   `{"error":"Пользователь не найден"}`,
+		},
+		{
+			name:           "validation error - empty role",
+			userID:         "1",
+			requestBody:    roleRequest{},
+			mockSetup:      func(mockDB *MockuserRepository) {},
+			expectedStatus: fiber.StatusBadRequest,
+			expectedBody:   `{"error":"неизвестная роль пользователя"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app, mockDB, cleanup := setupUserTest(t)
+			defer cleanup()
+
+			tt.mockSetup(mockDB)
+
+			reqBody, err := json.Marshal(tt.requestBody)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/users/%s/promote", tt.userID), bytes.NewReader(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Contains(t, string(body), tt.expectedBody)
+		})
+	}
+}
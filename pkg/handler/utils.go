@@ -4,10 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"gitlab.ozon.dev/gojhw1/pkg/cache"
+	"gitlab.ozon.dev/gojhw1/pkg/exporter"
+	"gitlab.ozon.dev/gojhw1/pkg/importer"
 	"gitlab.ozon.dev/gojhw1/pkg/model"
 	"gitlab.ozon.dev/gojhw1/pkg/repository"
 	"gitlab.ozon.dev/gojhw1/pkg/service"
@@ -30,6 +33,35 @@ var (
 	ErrInvalidUserID = errors.New("неверный формат ID пользователя")
 	// ErrUserIDMustBePositive возникает, когда ID пользователя не является положительным числом
 	ErrUserIDMustBePositive = errors.New("ID пользователя должен быть положительным числом")
+	// ErrInvalidRole возникает при указании роли, не описанной в модели прав
+	ErrInvalidRole = errors.New("неизвестная роль пользователя")
+	// ErrInvalidAPIKeyID возникает при передаче некорректного идентификатора API-ключа
+	ErrInvalidAPIKeyID = errors.New("неверный формат ID API-ключа")
+	// ErrInvalidPickupPointID возникает при передаче некорректного идентификатора ПВЗ
+	ErrInvalidPickupPointID = errors.New("неверный формат ID ПВЗ")
+	// ErrEmptyPickupPointName возникает при попытке создать ПВЗ без названия
+	ErrEmptyPickupPointName = errors.New("название ПВЗ не может быть пустым")
+	// ErrInvalidStorageCellID возникает при передаче некорректного идентификатора ячейки хранения
+	ErrInvalidStorageCellID = errors.New("неверный формат ID ячейки хранения")
+	// ErrInvalidReturnPolicyID возникает при передаче некорректного идентификатора политики возврата
+	ErrInvalidReturnPolicyID = errors.New("неверный формат ID политики возврата")
+	// ErrInvalidShiftID возникает при передаче некорректного идентификатора смены
+	ErrInvalidShiftID = errors.New("неверный формат ID смены")
+	// ErrInvalidImportJobID возникает при передаче некорректного идентификатора задачи импорта
+	ErrInvalidImportJobID = errors.New("неверный формат ID задачи импорта")
+	// ErrInvalidOrderState возникает при указании неизвестного статуса заказа
+	ErrInvalidOrderState = errors.New("неизвестный статус заказа")
+	// ErrInvalidTime возникает при некорректном формате времени в параметрах запроса
+	ErrInvalidTime = errors.New("неправильный формат времени, используйте YYYY-MM-DDThh:mm:ss или RFC 3339")
+	// ErrInvalidTimeRange возникает, когда начало периода не раньше его конца
+	ErrInvalidTimeRange = errors.New("начало периода должно быть раньше его конца")
+	// ErrPaymentRequiresAtomic возникает при оплате выдачи нескольких заказов без atomic:
+	// одна оплата распределяется между заказами только при их выдаче в одной транзакции
+	ErrPaymentRequiresAtomic = errors.New("оплату выдачи нескольких заказов можно провести только с atomic")
+	// ErrInvalidIfMatch возникает, когда заголовок If-Match не содержит версию заказа
+	ErrInvalidIfMatch = errors.New("неверный формат заголовка If-Match, ожидается ETag заказа")
+	// ErrIfMatchSingleOrder возникает, когда заголовок If-Match передан при обработке нескольких заказов
+	ErrIfMatchSingleOrder = errors.New("заголовок If-Match можно передать только при обработке одного заказа")
 )
 
 const timeLayout = "2006-01-02T15:04:05"
@@ -53,28 +85,77 @@ func processError(err error) (int, string) {
 	switch {
 	// Bad Request errors
 	case errors.Is(err, service.ErrStorageDeadlinePassed),
+		errors.Is(err, service.ErrPickupPointRequired),
+		errors.Is(err, service.ErrEmptyCellCode),
+		errors.Is(err, service.ErrInvalidCellCapacity),
+		errors.Is(err, service.ErrInvalidCellMaxWeight),
 		errors.Is(err, service.ErrDeadlineNotExpired),
 		errors.Is(err, service.ErrNotDelivered),
 		errors.Is(err, service.ErrOpenFile),
 		errors.Is(err, service.ErrReadFile),
 		errors.Is(err, service.ErrParseFile),
+		errors.Is(err, service.ErrInvalidReturnReason),
+		errors.Is(err, service.ErrInvalidReturnCondition),
+		errors.Is(err, service.ErrInvalidReturnPhotos),
+		errors.Is(err, service.ErrEmptyItemName),
+		errors.Is(err, service.ErrNoOrderItemsSelected),
+		errors.Is(err, service.ErrInvalidPaymentMode),
+		errors.Is(err, service.ErrInvalidPaymentMethod),
+		errors.Is(err, service.ErrPaymentRequired),
+		errors.Is(err, service.ErrPaymentNotRequired),
+		errors.Is(err, service.ErrPaymentAmountMismatch),
+		errors.Is(err, service.ErrInvalidCashAmount),
 		errors.Is(err, service.ErrInvalidDateFormat),
 		errors.Is(err, service.ErrNegativeWeight),
 		errors.Is(err, service.ErrInvalidOrderID),
+		errors.Is(err, service.ErrDuplicateOrderID),
 		errors.Is(err, service.ErrPackageWeightExceeded),
 		errors.Is(err, service.ErrUnknownPackageType),
 		errors.Is(err, service.ErrUnknownWrapperType),
+		errors.Is(err, service.ErrInvalidExtensionDays),
+		errors.Is(err, service.ErrEmptyReturnPolicyName),
+		errors.Is(err, service.ErrInvalidReturnWindow),
+		errors.Is(err, service.ErrInvalidReturnFee),
+		errors.Is(err, service.ErrEmptyCustomerSegment),
+		errors.Is(err, service.ErrInvalidShiftCash),
+		errors.Is(err, exporter.ErrUnsupportedReportFormat),
+		errors.Is(err, repository.ErrInvalidCustomerID),
 		errors.Is(err, service.ErrNegativeCost):
 		return fiber.StatusBadRequest, err.Error()
 
+	// Precondition Failed errors
+	case errors.Is(err, service.ErrVersionMismatch):
+		return fiber.StatusPreconditionFailed, err.Error()
+
 	// Conflict errors
 	case errors.Is(err, service.ErrOrderExists),
+		errors.Is(err, repository.ErrOrderAlreadyExists),
 		errors.Is(err, service.ErrOrderAlreadyDelivered),
-		errors.Is(err, service.ErrWrongState):
+		errors.Is(err, service.ErrWrongState),
+		errors.Is(err, service.ErrInvalidTransition),
+		errors.Is(err, service.ErrExtensionNotAllowed),
+		errors.Is(err, service.ErrExtensionLimitExceeded),
+		errors.Is(err, service.ErrExtensionDaysExceeded),
+		errors.Is(err, service.ErrOrderNotReturnable),
+		errors.Is(err, service.ErrOrderItemUnavailable),
+		errors.Is(err, repository.ErrReturnPolicyAlreadyExists),
+		errors.Is(err, repository.ErrShiftAlreadyOpen),
+		errors.Is(err, repository.ErrShiftClosed),
+		errors.Is(err, repository.ErrConcurrentModification),
+		errors.Is(err, repository.ErrNoFreeStorageCell),
+		errors.Is(err, repository.ErrStorageCellAlreadyExists),
+		errors.Is(err, repository.ErrStorageCellOccupied):
 		return fiber.StatusConflict, err.Error()
 
+	// Payment Required errors
+	case errors.Is(err, service.ErrPaymentDeclined):
+		return fiber.StatusPaymentRequired, err.Error()
+
 	// Forbidden errors
-	case errors.Is(err, service.ErrWrongCustomer):
+	case errors.Is(err, service.ErrWrongCustomer),
+		errors.Is(err, service.ErrForeignPickupPoint),
+		errors.Is(err, service.ErrPickupPointNotAssigned),
+		errors.Is(err, service.ErrImportJobUserRequired):
 		return fiber.StatusForbidden, err.Error()
 
 	// Gone errors
@@ -87,10 +168,21 @@ func processError(err error) (int, string) {
 	// Not Found errors
 	case errors.Is(err, repository.ErrOrdersNotFound),
 		errors.Is(err, repository.ErrOrderNotFound),
+		errors.Is(err, repository.ErrPickupPointNotFound),
+		errors.Is(err, repository.ErrStorageCellNotFound),
+		errors.Is(err, repository.ErrImportJobNotFound),
+		errors.Is(err, repository.ErrReturnPolicyNotFound),
+		errors.Is(err, repository.ErrOrderItemNotFound),
+		errors.Is(err, repository.ErrShiftNotFound),
+		errors.Is(err, service.ErrOrderNotInCell),
 		errors.Is(err, cache.ErrOrderNotFoundInCache),
 		errors.Is(err, cache.ErrHistoryNotFoundInCache):
 		return fiber.StatusNotFound, err.Error()
 
+	// Unsupported Media Type errors
+	case errors.Is(err, importer.ErrUnsupportedFormat):
+		return fiber.StatusUnsupportedMediaType, err.Error()
+
 	// Default case for unhandled errors
 	default:
 		return fiber.StatusInternalServerError, err.Error()
@@ -169,16 +261,40 @@ func parseOrderIDFromString(orderIDStr string) (int64, error) {
 	return orderID, nil
 }
 
+// orderETag формирует ETag заказа по его версии
+func orderETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// parseIfMatch извлекает ожидаемую версию заказа из заголовка If-Match.
+// Пустой заголовок и "*" означают, что версия не проверяется, и возвращают 0.
+func parseIfMatch(header string) (int64, error) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return 0, nil
+	}
+
+	version, err := strconv.ParseInt(strings.Trim(strings.TrimPrefix(header, "W/"), `"`), 10, 64)
+	if err != nil || version <= 0 {
+		return 0, ErrInvalidIfMatch
+	}
+
+	return version, nil
+}
+
 // validateOrderRequest проверяет корректность данных в запросе на создание заказа
 func validateOrderRequest(req orderRequest) (time.Time, *model.PackageType, *model.WrapperType, error) {
-	// Валидация веса
-	if req.Weight <= 0 {
-		return time.Time{}, nil, nil, ErrNegativeWeight
-	}
+	// Вес и стоимость заказа с товарами считаются по товарам и проверяются в сервисе
+	if len(req.Items) == 0 {
+		// Валидация веса
+		if req.Weight <= 0 {
+			return time.Time{}, nil, nil, ErrNegativeWeight
+		}
 
-	// Валидация стоимости
-	if req.Cost <= 0 {
-		return time.Time{}, nil, nil, ErrNegativeCost
+		// Валидация стоимости
+		if req.Cost <= 0 {
+			return time.Time{}, nil, nil, ErrNegativeCost
+		}
 	}
 
 	// Валидация и парсинг даты (используем уже существующую функцию)
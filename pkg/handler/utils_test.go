@@ -526,7 +526,6 @@ func TestValidateCreateUserRequest(t *testing.T) {
 			req: сreateUserRequest{
 				Username: "test",
 				Password: "password",
-				Role:     "user",
 			},
 			wantErr: nil,
 		},
@@ -535,7 +534,6 @@ func TestValidateCreateUserRequest(t *testing.T) {
 			req: сreateUserRequest{
 				Username: "",
 				Password: "password",
-				Role:     "user",
 			},
 			wantErr: ErrEmptyUsername,
 		},
@@ -544,7 +542,6 @@ func TestValidateCreateUserRequest(t *testing.T) {
 			req: сreateUserRequest{
 				Username: "test",
 				Password: "",
-				Role:     "user",
 			},
 			wantErr: ErrEmptyPassword,
 		},
@@ -553,7 +550,6 @@ func TestValidateCreateUserRequest(t *testing.T) {
 			req: сreateUserRequest{
 				Username: "",
 				Password: "",
-				Role:     "",
 			},
 			wantErr: ErrEmptyUsername,
 		},
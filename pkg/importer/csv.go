@@ -0,0 +1,27 @@
+package importer
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// csvParser читает таблицу заказов в формате CSV с заголовком в первой строке
+type csvParser struct {
+	columns   columnMapping
+	delimiter rune
+}
+
+// Parse читает таблицу CSV и сопоставляет колонки с полями заказа
+func (p csvParser) Parse(r io.Reader) ([]Record, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = p.delimiter
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return p.columns.records(rows)
+}
@@ -0,0 +1,156 @@
+package importer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var (
+	// ErrUnsupportedFormat - формат файла не зарегистрирован
+	ErrUnsupportedFormat = errors.New("неподдерживаемый формат файла с заказами")
+	// ErrMissingColumn - в заголовке таблицы нет обязательной колонки
+	ErrMissingColumn = errors.New("в файле нет обязательной колонки")
+	// ErrInvalidValue - значение ячейки не соответствует типу поля
+	ErrInvalidValue = errors.New("некорректное значение")
+	// ErrUnknownField - в настройке колонок указано неизвестное поле заказа
+	ErrUnknownField = errors.New("неизвестное поле заказа")
+)
+
+// Форматы файлов, поддерживаемые по умолчанию
+const (
+	FormatJSON   = "json"
+	FormatNDJSON = "ndjson"
+	FormatCSV    = "csv"
+	FormatXLSX   = "xlsx"
+)
+
+// DefaultFormat - формат файла, который не удалось определить по имени и типу содержимого
+const DefaultFormat = FormatJSON
+
+// Record - запись о заказе из файла курьера
+type Record struct {
+	ID            int64   `json:"id"`
+	CustomerID    int64   `json:"customer_id"`
+	PickupPointID int64   `json:"pickup_point_id,omitempty"`
+	DeadlineAt    string  `json:"deadline_at"`
+	Weight        float64 `json:"weight"`
+	Cost          float64 `json:"cost"`
+	PackageType   string  `json:"package_type,omitempty"`
+	Wrapper       string  `json:"wrapper,omitempty"`
+}
+
+// Parser читает записи о заказах из файла одного формата
+type Parser interface {
+	Parse(r io.Reader) ([]Record, error)
+}
+
+// Registry - реестр форматов файлов с заказами.
+// Формат выбирается по имени, расширению файла или типу содержимого.
+type Registry struct {
+	parsers       map[string]Parser
+	extensions    map[string]string
+	contentTypes  map[string]string
+	defaultFormat string
+}
+
+// NewRegistry создает пустой реестр форматов
+func NewRegistry() *Registry {
+	return &Registry{
+		parsers:       make(map[string]Parser),
+		extensions:    make(map[string]string),
+		contentTypes:  make(map[string]string),
+		defaultFormat: DefaultFormat,
+	}
+}
+
+// NewDefaultRegistry создает реестр с форматами JSON, NDJSON, CSV и XLSX.
+// Колонки таблиц CSV и XLSX сопоставляются с полями заказа по настройке table.
+func NewDefaultRegistry(table TableOptions) (*Registry, error) {
+	columns, err := newColumnMapping(table.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	delimiter, err := parseDelimiter(table.Delimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	registry := NewRegistry()
+	registry.Register(FormatJSON, jsonParser{}, []string{".json"}, []string{"application/json", "text/json"})
+	registry.Register(FormatNDJSON, ndjsonParser{}, []string{".ndjson", ".jsonl"},
+		[]string{"application/x-ndjson", "application/ndjson", "application/jsonl", "application/json-seq"})
+	registry.Register(FormatCSV, csvParser{columns: columns, delimiter: delimiter}, []string{".csv"},
+		[]string{"text/csv", "application/csv"})
+	registry.Register(FormatXLSX, xlsxParser{columns: columns}, []string{".xlsx"},
+		[]string{"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"})
+
+	return registry, nil
+}
+
+// Register добавляет формат в реестр. Расширения указываются с точкой, типы содержимого - без параметров.
+// Повторная регистрация формата, расширения или типа содержимого заменяет прежнюю.
+func (r *Registry) Register(format string, parser Parser, extensions, contentTypes []string) {
+	r.parsers[format] = parser
+
+	for _, ext := range extensions {
+		r.extensions[strings.ToLower(ext)] = format
+	}
+	for _, contentType := range contentTypes {
+		r.contentTypes[strings.ToLower(contentType)] = format
+	}
+}
+
+// Formats возвращает зарегистрированные форматы в алфавитном порядке
+func (r *Registry) Formats() []string {
+	formats := make([]string, 0, len(r.parsers))
+	for format := range r.parsers {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats)
+
+	return formats
+}
+
+// Resolve определяет формат файла. Явно указанный формат должен быть зарегистрирован,
+// иначе формат определяется по расширению имени файла, затем по типу содержимого.
+// Если ни то ни другое не подходит, файл считается файлом формата по умолчанию.
+func (r *Registry) Resolve(filename, contentType, format string) (string, error) {
+	if format != "" {
+		format = strings.ToLower(format)
+		if _, ok := r.parsers[format]; !ok {
+			return "", fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+		}
+		return format, nil
+	}
+
+	if ext := strings.ToLower(filepath.Ext(filename)); ext != "" {
+		if format, ok := r.extensions[ext]; ok {
+			return format, nil
+		}
+	}
+
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+		if format, ok := r.contentTypes[strings.ToLower(mediaType)]; ok {
+			return format, nil
+		}
+	}
+
+	return r.defaultFormat, nil
+}
+
+// Parse читает записи о заказах из содержимого файла указанного формата
+func (r *Registry) Parse(format string, content []byte) ([]Record, error) {
+	parser, ok := r.parsers[format]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+	}
+
+	return parser.Parse(bytes.NewReader(content))
+}
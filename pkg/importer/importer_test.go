@@ -0,0 +1,233 @@
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_Resolve(t *testing.T) {
+	t.Parallel()
+
+	registry, err := NewDefaultRegistry(TableOptions{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		filename       string
+		contentType    string
+		format         string
+		expectedFormat string
+		expectedErr    error
+	}{
+		{
+			name:           "explicit format wins",
+			filename:       "orders.json",
+			format:         "CSV",
+			expectedFormat: FormatCSV,
+		},
+		{
+			name:           "by extension",
+			filename:       "orders.JSONL",
+			contentType:    "application/json",
+			expectedFormat: FormatNDJSON,
+		},
+		{
+			name:           "by content type",
+			filename:       "orders",
+			contentType:    "text/csv; charset=utf-8",
+			expectedFormat: FormatCSV,
+		},
+		{
+			name:           "default format",
+			filename:       "orders.txt",
+			contentType:    "application/octet-stream",
+			expectedFormat: FormatJSON,
+		},
+		{
+			name:        "unknown explicit format",
+			format:      "xml",
+			expectedErr: ErrUnsupportedFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			format, err := registry.Resolve(tt.filename, tt.contentType, tt.format)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedFormat, format)
+		})
+	}
+}
+
+func TestRegistry_Parse(t *testing.T) {
+	t.Parallel()
+
+	expected := []Record{
+		{ID: 1, CustomerID: 10, DeadlineAt: "2030-01-02T15:04:05", Weight: 1.5, Cost: 100},
+		{ID: 2, CustomerID: 20, PickupPointID: 3, DeadlineAt: "48h", Weight: 2, Cost: 250.5, PackageType: "box", Wrapper: "film"},
+	}
+
+	tests := []struct {
+		name        string
+		options     TableOptions
+		format      string
+		content     []byte
+		expected    []Record
+		expectedErr error
+	}{
+		{
+			name:   "json",
+			format: FormatJSON,
+			content: []byte(`[{"id":1,"customer_id":10,"deadline_at":"2030-01-02T15:04:05","weight":1.5,"cost":100},
+				{"id":2,"customer_id":20,"pickup_point_id":3,"deadline_at":"48h","weight":2,"cost":250.5,"package_type":"box","wrapper":"film"}]`),
+			expected: expected,
+		},
+		{
+			name:   "ndjson with blank lines",
+			format: FormatNDJSON,
+			content: []byte(`{"id":1,"customer_id":10,"deadline_at":"2030-01-02T15:04:05","weight":1.5,"cost":100}
+
+{"id":2,"customer_id":20,"pickup_point_id":3,"deadline_at":"48h","weight":2,"cost":250.5,"package_type":"box","wrapper":"film"}
+`),
+			expected: expected,
+		},
+		{
+			name:   "csv with default columns",
+			format: FormatCSV,
+			content: []byte("\ufeffid,customer_id,pickup_point_id,deadline_at,weight,cost,package_type,wrapper\n" +
+				"1,10,,2030-01-02T15:04:05,1.5,100,,\n" +
+				"\n" +
+				"2,20,3,48h,2,250.5,box,film\n"),
+			expected: expected,
+		},
+		{
+			name:   "csv with custom delimiter and columns",
+			format: FormatCSV,
+			options: TableOptions{
+				Delimiter: ";",
+				Columns: map[string]string{
+					FieldID:         "Номер заказа",
+					FieldCustomerID: "Получатель",
+					FieldWeight:     "Вес, кг",
+				},
+			},
+			content: []byte("Получатель;Номер заказа;deadline_at;Вес, кг;cost;pickup_point_id;package_type;wrapper\n" +
+				"10;1;2030-01-02T15:04:05;1,5;100;;;\n" +
+				"20;2;48h;2;250,5;3;box;film\n"),
+			expected: expected,
+		},
+		{
+			name:        "csv without required column",
+			format:      FormatCSV,
+			content:     []byte("id,customer_id,deadline_at,weight\n1,10,48h,1\n"),
+			expectedErr: ErrMissingColumn,
+		},
+		{
+			name:        "csv with invalid number",
+			format:      FormatCSV,
+			content:     []byte("id,customer_id,deadline_at,weight,cost\nabc,10,48h,1,100\n"),
+			expectedErr: ErrInvalidValue,
+		},
+		{
+			name:   "xlsx with shared strings and date cell",
+			format: FormatXLSX,
+			content: newTestWorkbook(t,
+				[]string{"id", "customer_id", "deadline_at", "weight", "cost", "package_type"},
+				`<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="C1" t="s"><v>2</v></c>`+
+					`<c r="D1" t="s"><v>3</v></c><c r="E1" t="s"><v>4</v></c><c r="F1" t="s"><v>5</v></c></row>`+
+					`<row r="2"><c r="A2"><v>1</v></c><c r="B2"><v>10</v></c><c r="C2"><v>47120.5</v></c>`+
+					`<c r="D2"><v>1.5</v></c><c r="E2"><v>100</v></c></row>`+
+					`<row r="3"><c r="A3"><v>2</v></c><c r="B3"><v>20</v></c><c r="C3" t="inlineStr"><is><t>48h</t></is></c>`+
+					`<c r="E3"><v>250.5</v></c><c r="F3" t="inlineStr"><is><t>box</t></is></c></row>`),
+			expected: []Record{
+				{ID: 1, CustomerID: 10, DeadlineAt: "2029-01-02T12:00:00", Weight: 1.5, Cost: 100},
+				{ID: 2, CustomerID: 20, DeadlineAt: "48h", Cost: 250.5, PackageType: "box"},
+			},
+		},
+		{
+			name:        "not a workbook",
+			format:      FormatXLSX,
+			content:     []byte("id,customer_id\n"),
+			expectedErr: zip.ErrFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			registry, err := NewDefaultRegistry(tt.options)
+			require.NoError(t, err)
+
+			records, err := registry.Parse(tt.format, tt.content)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, records)
+		})
+	}
+}
+
+func TestNewDefaultRegistry(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewDefaultRegistry(TableOptions{Columns: map[string]string{"price": "Цена"}})
+	assert.ErrorIs(t, err, ErrUnknownField)
+
+	_, err = NewDefaultRegistry(TableOptions{Delimiter: ";;"})
+	assert.Error(t, err)
+
+	registry, err := NewDefaultRegistry(TableOptions{Delimiter: `\t`})
+	require.NoError(t, err)
+	assert.Equal(t, []string{FormatCSV, FormatJSON, FormatNDJSON, FormatXLSX}, registry.Formats())
+}
+
+// newTestWorkbook собирает минимальную книгу XLSX с одним листом
+func newTestWorkbook(t *testing.T, sharedStrings []string, sheetRows string) []byte {
+	t.Helper()
+
+	var shared bytes.Buffer
+	for _, s := range sharedStrings {
+		shared.WriteString("<si><t>" + s + "</t></si>")
+	}
+
+	parts := map[string]string{
+		"xl/workbook.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="Заказы" sheetId="1" r:id="rId1"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>
+</Relationships>`,
+		"xl/sharedStrings.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` + shared.String() + `</sst>`,
+		"xl/worksheets/sheet1.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>` + sheetRows + `</sheetData></worksheet>`,
+	}
+
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for name, content := range parts {
+		part, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = part.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+
+	return buf.Bytes()
+}
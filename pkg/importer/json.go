@@ -0,0 +1,54 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// maxNDJSONLineSize - максимальная длина строки файла NDJSON
+const maxNDJSONLineSize = 1 << 20
+
+// jsonParser читает файл с JSON-массивом заказов
+type jsonParser struct{}
+
+// Parse читает JSON-массив заказов
+func (jsonParser) Parse(r io.Reader) ([]Record, error) {
+	var records []Record
+	if err := json.NewDecoder(r).Decode(&records); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// ndjsonParser читает файл, в каждой строке которого записан JSON-объект заказа
+type ndjsonParser struct{}
+
+// Parse читает заказы построчно, пустые строки пропускаются
+func (ndjsonParser) Parse(r io.Reader) ([]Record, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxNDJSONLineSize)
+
+	records := make([]Record, 0)
+	for line := 1; scanner.Scan(); line++ {
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		var record Record
+		if err := json.Unmarshal(data, &record); err != nil {
+			return nil, fmt.Errorf("строка %d: %w", line, err)
+		}
+		records = append(records, record)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
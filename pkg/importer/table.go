@@ -0,0 +1,209 @@
+package importer
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Поля заказа, которые можно сопоставить с колонками таблицы
+const (
+	FieldID            = "id"
+	FieldCustomerID    = "customer_id"
+	FieldPickupPointID = "pickup_point_id"
+	FieldDeadlineAt    = "deadline_at"
+	FieldWeight        = "weight"
+	FieldCost          = "cost"
+	FieldPackageType   = "package_type"
+	FieldWrapper       = "wrapper"
+)
+
+// maxExactInt - наибольшее целое число, которое точно представляется дробным числом
+const maxExactInt = 1 << 53
+
+// tableFields - поля заказа в порядке проверки колонок
+var tableFields = []struct {
+	name     string
+	required bool
+}{
+	{name: FieldID, required: true},
+	{name: FieldCustomerID, required: true},
+	{name: FieldPickupPointID},
+	{name: FieldDeadlineAt, required: true},
+	{name: FieldWeight, required: true},
+	{name: FieldCost, required: true},
+	{name: FieldPackageType},
+	{name: FieldWrapper},
+}
+
+// TableOptions - настройка чтения таблиц CSV и XLSX
+type TableOptions struct {
+	// Delimiter - разделитель колонок CSV, по умолчанию запятая
+	Delimiter string
+	// Columns - заголовки колонок для полей заказа, например {"id": "Номер заказа"}.
+	// Для полей, которых нет в настройке, заголовок совпадает с именем поля.
+	Columns map[string]string
+}
+
+// columnMapping сопоставляет поле заказа с заголовком колонки в нижнем регистре
+type columnMapping map[string]string
+
+// newColumnMapping проверяет настройку колонок и дополняет ее заголовками по умолчанию
+func newColumnMapping(columns map[string]string) (columnMapping, error) {
+	mapping := make(columnMapping, len(tableFields))
+	for _, field := range tableFields {
+		mapping[field.name] = field.name
+	}
+
+	for field, header := range columns {
+		if _, ok := mapping[field]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownField, field)
+		}
+		mapping[field] = normalizeHeader(header)
+	}
+
+	return mapping, nil
+}
+
+// parseDelimiter проверяет, что разделитель CSV состоит из одного символа
+func parseDelimiter(delimiter string) (rune, error) {
+	if delimiter == "" {
+		return ',', nil
+	}
+
+	if delimiter == `\t` {
+		return '\t', nil
+	}
+
+	r, size := utf8.DecodeRuneInString(delimiter)
+	if size != len(delimiter) || r == utf8.RuneError {
+		return 0, fmt.Errorf("разделитель колонок CSV должен состоять из одного символа: %q", delimiter)
+	}
+
+	return r, nil
+}
+
+// records преобразует строки таблицы в записи о заказах. Первая строка - заголовок, пустые строки пропускаются.
+func (m columnMapping) records(rows [][]string) ([]Record, error) {
+	records := make([]Record, 0)
+	if len(rows) == 0 {
+		return records, nil
+	}
+
+	positions := make(map[string]int, len(rows[0]))
+	for i, header := range rows[0] {
+		positions[normalizeHeader(header)] = i
+	}
+
+	columns := make(map[string]int, len(m))
+	for _, field := range tableFields {
+		if i, ok := positions[m[field.name]]; ok {
+			columns[field.name] = i
+		} else if field.required {
+			return nil, fmt.Errorf("%w: %s", ErrMissingColumn, m[field.name])
+		}
+	}
+
+	for i, row := range rows[1:] {
+		if isEmptyRow(row) {
+			continue
+		}
+
+		record, err := m.record(row, columns)
+		if err != nil {
+			return nil, fmt.Errorf("строка %d: %w", i+2, err)
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// record преобразует строку таблицы в запись о заказе
+func (m columnMapping) record(row []string, columns map[string]int) (Record, error) {
+	value := func(field string) string {
+		i, ok := columns[field]
+		if !ok || i >= len(row) {
+			return ""
+		}
+		return strings.TrimSpace(row[i])
+	}
+
+	var (
+		record Record
+		err    error
+	)
+
+	if record.ID, err = parseInt(value(FieldID)); err != nil {
+		return Record{}, fmt.Errorf("колонка %s: %w", m[FieldID], err)
+	}
+	if record.CustomerID, err = parseInt(value(FieldCustomerID)); err != nil {
+		return Record{}, fmt.Errorf("колонка %s: %w", m[FieldCustomerID], err)
+	}
+	if record.PickupPointID, err = parseInt(value(FieldPickupPointID)); err != nil {
+		return Record{}, fmt.Errorf("колонка %s: %w", m[FieldPickupPointID], err)
+	}
+	if record.Weight, err = parseFloat(value(FieldWeight)); err != nil {
+		return Record{}, fmt.Errorf("колонка %s: %w", m[FieldWeight], err)
+	}
+	if record.Cost, err = parseFloat(value(FieldCost)); err != nil {
+		return Record{}, fmt.Errorf("колонка %s: %w", m[FieldCost], err)
+	}
+
+	record.DeadlineAt = value(FieldDeadlineAt)
+	record.PackageType = value(FieldPackageType)
+	record.Wrapper = value(FieldWrapper)
+
+	return record, nil
+}
+
+// parseInt разбирает целое число, пустая ячейка означает ноль.
+// Число может быть записано как дробное без дробной части, так его сохраняют электронные таблицы.
+func parseInt(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return v, nil
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || v != math.Trunc(v) || math.Abs(v) > maxExactInt {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidValue, s)
+	}
+
+	return int64(v), nil
+}
+
+// parseFloat разбирает дробное число, разделителем дробной части может быть точка или запятая
+func parseFloat(s string) (float64, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	v, err := strconv.ParseFloat(strings.Replace(s, ",", ".", 1), 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidValue, s)
+	}
+
+	return v, nil
+}
+
+// normalizeHeader приводит заголовок колонки к виду для сравнения
+func normalizeHeader(header string) string {
+	return strings.ToLower(strings.TrimSpace(strings.TrimPrefix(header, "\ufeff")))
+}
+
+// isEmptyRow проверяет, что в строке таблицы нет значений
+func isEmptyRow(row []string) bool {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+
+	return true
+}
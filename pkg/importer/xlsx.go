@@ -0,0 +1,253 @@
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// maxXLSXPartSize - максимальный размер распакованной части книги, защищает от zip-бомб
+	maxXLSXPartSize = 256 << 20
+	// xlsxDeadlineLayout - формат, в который переводятся даты из ячеек книги
+	xlsxDeadlineLayout = "2006-01-02T15:04:05"
+)
+
+// xlsxEpoch - начало отсчета дат в книгах Excel
+var xlsxEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// ErrEmptyWorkbook - в книге нет листов
+var ErrEmptyWorkbook = errors.New("в книге нет листов")
+
+// xlsxParser читает таблицу заказов с первого листа книги XLSX, заголовок - в первой строке
+type xlsxParser struct {
+	columns columnMapping
+}
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		RelationID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+// xlsxText - текст ячейки, целиком или из фрагментов с разным форматированием
+type xlsxText struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.Text
+	}
+
+	var b strings.Builder
+	for _, run := range t.Runs {
+		b.WriteString(run.Text)
+	}
+
+	return b.String()
+}
+
+type xlsxWorksheet struct {
+	Rows []struct {
+		Cells []struct {
+			Ref    string   `xml:"r,attr"`
+			Type   string   `xml:"t,attr"`
+			Value  string   `xml:"v"`
+			Inline xlsxText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// Parse читает первый лист книги и сопоставляет колонки с полями заказа.
+// Даты в колонке срока хранения переводятся из формата Excel в строку вида 2006-01-02T15:04:05.
+func (p xlsxParser) Parse(r io.Reader) ([]Record, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	book, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("файл не является книгой XLSX: %w", err)
+	}
+
+	sharedStrings, err := readXLSXSharedStrings(book)
+	if err != nil {
+		return nil, err
+	}
+
+	sheetPath, err := firstXLSXSheet(book)
+	if err != nil {
+		return nil, err
+	}
+
+	var sheet xlsxWorksheet
+	if err = decodeXLSXPart(book, sheetPath, &sheet); err != nil {
+		return nil, err
+	}
+
+	rows := make([][]string, 0, len(sheet.Rows))
+	for _, sheetRow := range sheet.Rows {
+		row := make([]string, 0, len(sheetRow.Cells))
+		for i, cell := range sheetRow.Cells {
+			column := i
+			if cell.Ref != "" {
+				if column, err = xlsxColumnIndex(cell.Ref); err != nil {
+					return nil, err
+				}
+			}
+			for len(row) <= column {
+				row = append(row, "")
+			}
+
+			switch cell.Type {
+			case "s":
+				index, err := strconv.Atoi(cell.Value)
+				if err != nil || index < 0 || index >= len(sharedStrings) {
+					return nil, fmt.Errorf("ячейка %s ссылается на несуществующую строку", cell.Ref)
+				}
+				row[column] = sharedStrings[index]
+			case "inlineStr":
+				row[column] = cell.Inline.String()
+			default:
+				row[column] = cell.Value
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	records, err := p.columns.records(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range records {
+		records[i].DeadlineAt = xlsxDeadline(records[i].DeadlineAt)
+	}
+
+	return records, nil
+}
+
+// readXLSXSharedStrings читает общую таблицу строк книги, если она есть
+func readXLSXSharedStrings(book *zip.Reader) ([]string, error) {
+	var shared xlsxSharedStrings
+	err := decodeXLSXPart(book, "xl/sharedStrings.xml", &shared)
+	if errors.Is(err, errXLSXPartNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	strs := make([]string, 0, len(shared.Items))
+	for _, item := range shared.Items {
+		strs = append(strs, item.String())
+	}
+
+	return strs, nil
+}
+
+// firstXLSXSheet возвращает путь к первому листу книги
+func firstXLSXSheet(book *zip.Reader) (string, error) {
+	var workbook xlsxWorkbook
+	if err := decodeXLSXPart(book, "xl/workbook.xml", &workbook); err != nil {
+		return "", err
+	}
+	if len(workbook.Sheets) == 0 {
+		return "", ErrEmptyWorkbook
+	}
+
+	var rels xlsxRelationships
+	if err := decodeXLSXPart(book, "xl/_rels/workbook.xml.rels", &rels); err != nil {
+		return "", err
+	}
+
+	for _, rel := range rels.Relationships {
+		if rel.ID != workbook.Sheets[0].RelationID {
+			continue
+		}
+
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/"), nil
+		}
+		return path.Join("xl", rel.Target), nil
+	}
+
+	return "", ErrEmptyWorkbook
+}
+
+// errXLSXPartNotFound - в архиве книги нет нужной части
+var errXLSXPartNotFound = errors.New("в книге XLSX нет части")
+
+// decodeXLSXPart разбирает XML-часть книги
+func decodeXLSXPart(book *zip.Reader, name string, v any) error {
+	for _, file := range book.File {
+		if file.Name != name {
+			continue
+		}
+
+		part, err := file.Open()
+		if err != nil {
+			return err
+		}
+		defer part.Close()
+
+		if err = xml.NewDecoder(io.LimitReader(part, maxXLSXPartSize)).Decode(v); err != nil {
+			return fmt.Errorf("ошибка чтения %s: %w", name, err)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", errXLSXPartNotFound, name)
+}
+
+// xlsxColumnIndex возвращает номер колонки ячейки по ее адресу, например 2 для C7
+func xlsxColumnIndex(ref string) (int, error) {
+	column := 0
+	for _, r := range ref {
+		if r >= 'A' && r <= 'Z' {
+			column = column*26 + int(r-'A'+1)
+			continue
+		}
+		break
+	}
+
+	if column == 0 {
+		return 0, fmt.Errorf("некорректный адрес ячейки %q", ref)
+	}
+
+	return column - 1, nil
+}
+
+// xlsxDeadline переводит дату Excel (число дней от 30.12.1899) в строку,
+// остальные значения возвращает без изменений
+func xlsxDeadline(value string) string {
+	days, err := strconv.ParseFloat(value, 64)
+	if err != nil || days <= 0 {
+		return value
+	}
+
+	return xlsxEpoch.Add(time.Duration(days * float64(24*time.Hour))).Round(time.Second).Format(xlsxDeadlineLayout)
+}
@@ -27,6 +27,11 @@ var (
 		Help: "Общее количество заказов, возвращенных курьеру",
 	})
 
+	OrdersExpired = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pvz_orders_expired_total",
+		Help: "Общее количество заказов с истекшим сроком хранения",
+	})
+
 	OrdersProcessingTime = promauto.NewHistogram(prometheus.HistogramOpts{
 		Name:    "pvz_order_processing_seconds",
 		Help:    "Время обработки заказов (от принятия до доставки)",
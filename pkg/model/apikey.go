@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// APIKey - ключ доступа к API для интеграций и сервисных учетных записей.
+// Ключ действует от имени пользователя, но только в пределах своих областей действия (scopes).
+// В базе хранятся префикс для поиска и хеш ключа, сам ключ показывается только при создании.
+type APIKey struct {
+	ID         int64      `json:"id" db:"id"`
+	UserID     int64      `json:"user_id" db:"user_id"`
+	Name       string     `json:"name" db:"name"`
+	Prefix     string     `json:"prefix" db:"prefix"`
+	KeyHash    string     `json:"-" db:"key_hash"`
+	Scopes     []string   `json:"scopes" db:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty" db:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty" db:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+}
@@ -15,6 +15,20 @@ const (
 	AuditLogTypeResponse AuditLogType = "RESPONSE"
 	// AuditLogTypeOrderStatus представляет тип аудит-лога для изменений статуса заказа
 	AuditLogTypeOrderStatus AuditLogType = "ORDER_STATUS"
+	// AuditLogTypeSecurity представляет тип аудит-лога для событий безопасности (блокировки входа)
+	AuditLogTypeSecurity AuditLogType = "SECURITY"
+	// AuditLogTypeOrderExtension представляет тип аудит-лога для продлений срока хранения заказа
+	AuditLogTypeOrderExtension AuditLogType = "ORDER_EXTENSION"
+	// AuditLogTypeOrderReturn представляет тип аудит-лога для условий возврата заказа клиентом
+	AuditLogTypeOrderReturn AuditLogType = "ORDER_RETURN"
+	// AuditLogTypePayment представляет тип аудит-лога для оплаты заказа при получении
+	AuditLogTypePayment AuditLogType = "PAYMENT"
+	// AuditLogTypeRefund представляет тип аудит-лога для возврата денег за возвращенный заказ
+	AuditLogTypeRefund AuditLogType = "REFUND"
+	// AuditLogTypeShiftOpen представляет тип аудит-лога для открытия смены ПВЗ
+	AuditLogTypeShiftOpen AuditLogType = "SHIFT_OPEN"
+	// AuditLogTypeShiftClose представляет тип аудит-лога для закрытия смены ПВЗ со сверкой кассы
+	AuditLogTypeShiftClose AuditLogType = "SHIFT_CLOSE"
 )
 
 // AuditLog представляет структуру аудит-лога для бизнес-логики
@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// IdempotencyRecord - запрос, выполненный с ключом идемпотентности, и его сохраненный ответ.
+// Ключ действует в пределах пользователя, пока запрос выполняется, StatusCode равен 0.
+type IdempotencyRecord struct {
+	Username    string    `db:"username"`
+	Key         string    `db:"key"`
+	RequestHash string    `db:"request_hash"`
+	StatusCode  int       `db:"status_code"`
+	Response    []byte    `db:"response"`
+	CreatedAt   time.Time `db:"created_at"`
+	ExpiresAt   time.Time `db:"expires_at"`
+}
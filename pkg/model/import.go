@@ -0,0 +1,45 @@
+package model
+
+// ImportRowStatus - результат обработки одной записи файла импорта
+type ImportRowStatus string
+
+const (
+	ImportRowAccepted ImportRowStatus = "accepted" // заказ принят в ПВЗ
+	ImportRowValid    ImportRowStatus = "valid"    // заказ прошел проверку при пробном импорте
+	ImportRowFailed   ImportRowStatus = "failed"   // заказ не принят из-за ошибки
+	ImportRowSkipped  ImportRowStatus = "skipped"  // запись корректна, но пакетный импорт отменен из-за других записей
+)
+
+// ImportOptions - параметры импорта заказов из файла
+type ImportOptions struct {
+	DryRun bool   `json:"dry_run"`          // только проверить записи, не создавая заказы
+	Bulk   bool   `json:"bulk"`             // принять все заказы одной транзакцией или не принимать ни один
+	Format string `json:"format,omitempty"` // формат файла; если не указан, определяется по имени и типу содержимого
+}
+
+// ImportFile - загруженный файл с заказами
+type ImportFile struct {
+	Name        string // имя файла, по расширению определяется формат
+	ContentType string // тип содержимого, если формат не удалось определить по имени
+	Content     []byte
+}
+
+// ImportRowResult - результат обработки одной записи файла импорта
+type ImportRowResult struct {
+	Row     int             `json:"row"` // номер записи в файле, начиная с 1
+	OrderID int64           `json:"order_id"`
+	Status  ImportRowStatus `json:"status"`
+	Code    string          `json:"code,omitempty"` // машиночитаемый код ошибки
+	Error   string          `json:"error,omitempty"`
+}
+
+// ImportResult - отчет об импорте заказов из файла
+type ImportResult struct {
+	DryRun    bool              `json:"dry_run"`
+	Bulk      bool              `json:"bulk"`
+	Format    string            `json:"format"`
+	Total     int               `json:"total"`
+	Succeeded int               `json:"succeeded"`
+	Failed    int               `json:"failed"`
+	Rows      []ImportRowResult `json:"rows"`
+}
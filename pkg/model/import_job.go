@@ -0,0 +1,41 @@
+package model
+
+import "time"
+
+// ImportJobStatus - состояние задачи импорта заказов
+type ImportJobStatus string
+
+const (
+	ImportJobPending   ImportJobStatus = "pending"   // задача ожидает обработки
+	ImportJobRunning   ImportJobStatus = "running"   // записи файла обрабатываются
+	ImportJobCompleted ImportJobStatus = "completed" // все записи файла обработаны
+	ImportJobFailed    ImportJobStatus = "failed"    // задача прервана ошибкой
+)
+
+// ImportJob - фоновая задача импорта заказов из файла.
+// Задачи хранятся в БД, поэтому незавершенные задачи продолжаются после перезапуска сервиса.
+type ImportJob struct {
+	ID         int64             `json:"id" db:"id"`
+	UserID     int64             `json:"user_id" db:"user_id"` // пользователь, загрузивший файл; от его имени принимаются заказы
+	Filename   string            `json:"filename" db:"filename"`
+	Format     string            `json:"format" db:"format"` // формат файла, определенный при создании задачи
+	Status     ImportJobStatus   `json:"status" db:"status"`
+	DryRun     bool              `json:"dry_run" db:"dry_run"`
+	Bulk       bool              `json:"bulk" db:"bulk"`
+	Content    []byte            `json:"-" db:"content"`
+	Total      int               `json:"total" db:"total"`
+	Processed  int               `json:"processed" db:"processed"`
+	Succeeded  int               `json:"succeeded" db:"succeeded"`
+	Failed     int               `json:"failed" db:"failed"`
+	Errors     []ImportRowResult `json:"errors" db:"errors"` // записи, которые не удалось принять
+	Error      string            `json:"error,omitempty" db:"error"`
+	CreatedAt  time.Time         `json:"created_at" db:"created_at"`
+	StartedAt  *time.Time        `json:"started_at,omitempty" db:"started_at"`
+	FinishedAt *time.Time        `json:"finished_at,omitempty" db:"finished_at"`
+	UpdatedAt  time.Time         `json:"updated_at" db:"updated_at"`
+}
+
+// Finished - задача завершена и больше не изменится
+func (j ImportJob) Finished() bool {
+	return j.Status == ImportJobCompleted || j.Status == ImportJobFailed
+}
@@ -5,15 +5,21 @@ import (
 )
 
 type Order struct {
-	ID          int64        `json:"id"`
-	CustomerID  int64        `json:"customer_id"`
-	State       OrderState   `json:"state"`
-	Weight      float64      `json:"weight"`
-	Cost        float64      `json:"cost"`
-	PackageType *PackageType `json:"package_type,omitempty"`
-	Wrapper     *WrapperType `json:"wrapper,omitempty"`
-	DeadlineAt  time.Time    `json:"deadline_at"`
-	UpdatedAt   time.Time    `json:"updated_at"`
-	DeliveredAt *time.Time   `json:"delivered_at,omitempty"`
-	ReturnedAt  *time.Time   `json:"returned_at,omitempty"`
+	ID            int64        `json:"id"`
+	CustomerID    int64        `json:"customer_id"`
+	PickupPointID int64        `json:"pickup_point_id"`
+	State         OrderState   `json:"state"`
+	Weight        float64      `json:"weight"`
+	Cost          float64      `json:"cost"`
+	PackageType   *PackageType `json:"package_type,omitempty"`
+	Wrapper       *WrapperType `json:"wrapper,omitempty"`
+	PaymentMode   PaymentMode  `json:"payment_mode"`
+	DeadlineAt    time.Time    `json:"deadline_at"`
+	UpdatedAt     time.Time    `json:"updated_at"`
+	DeliveredAt   *time.Time   `json:"delivered_at,omitempty"`
+	ReturnedAt    *time.Time   `json:"returned_at,omitempty"`
+	StorageCellID *int64       `json:"storage_cell_id,omitempty"`
+	Version       int64        `json:"version"` // увеличивается при каждом изменении заказа
+	ImportJobID   *int64       `json:"-"`       // задача импорта, создавшая заказ
+	Items         []OrderItem  `json:"items,omitempty" db:"-"`
 }
@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// OrderExtension - продление срока хранения заказа по просьбе клиента
+type OrderExtension struct {
+	ID                 int64     `json:"id" db:"id"`
+	OrderID            int64     `json:"order_id" db:"order_id"`
+	Days               int       `json:"days" db:"days"`
+	Fee                float64   `json:"fee" db:"fee"` // плата за продление, добавляется к стоимости заказа
+	PreviousDeadlineAt time.Time `json:"previous_deadline_at" db:"previous_deadline_at"`
+	DeadlineAt         time.Time `json:"deadline_at" db:"deadline_at"`
+	ExtendedBy         *int64    `json:"extended_by,omitempty" db:"extended_by"`
+	ExtendedAt         time.Time `json:"extended_at" db:"extended_at"`
+}
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// OrderFilter - условия выборки заказов для списков и выгрузки
+type OrderFilter struct {
+	CustomerID    int64        // 0 - заказы всех клиентов
+	PickupPointID int64        // 0 - заказы всех ПВЗ
+	InPickupPoint bool         // только заказы, которые хранятся в ПВЗ и доступны к выдаче
+	States        []OrderState // пустой список - заказы в любом статусе
+	UpdatedFrom   *time.Time   // заказы, измененные не раньше этого времени
+	UpdatedTo     *time.Time   // заказы, измененные раньше этого времени
+	Search        string       // подстрока ID заказа или ID клиента
+}
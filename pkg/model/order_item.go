@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// OrderItemState - статус товара в заказе
+type OrderItemState string
+
+const (
+	// ItemStateAccepted - товар принят в ПВЗ вместе с заказом
+	ItemStateAccepted OrderItemState = "accepted"
+	// ItemStateDelivered - товар выдан клиенту
+	ItemStateDelivered OrderItemState = "delivered"
+	// ItemStateRefused - клиент отказался от товара при выдаче заказа
+	ItemStateRefused OrderItemState = "refused"
+	// ItemStateReturned - клиент вернул выданный товар
+	ItemStateReturned OrderItemState = "returned"
+)
+
+// OrderItem - товар в составе заказа
+type OrderItem struct {
+	ID        int64          `json:"id" db:"id"`
+	OrderID   int64          `json:"order_id" db:"order_id"`
+	Name      string         `json:"name" db:"name"`
+	Weight    float64        `json:"weight" db:"weight"`
+	Cost      float64        `json:"cost" db:"cost"`
+	State     OrderItemState `json:"state" db:"state"`
+	UpdatedAt time.Time      `json:"updated_at" db:"updated_at"`
+}
@@ -0,0 +1,81 @@
+package model
+
+import "time"
+
+// ReturnReason - причина возврата заказа клиентом
+type ReturnReason string
+
+const (
+	ReturnReasonDefective      ReturnReason = "defective"
+	ReturnReasonWrongItem      ReturnReason = "wrong_item"
+	ReturnReasonNotAsDescribed ReturnReason = "not_as_described"
+	ReturnReasonChangedMind    ReturnReason = "changed_mind"
+	ReturnReasonOther          ReturnReason = "other"
+)
+
+// Valid - причина входит в список известных причин возврата
+func (r ReturnReason) Valid() bool {
+	switch r {
+	case ReturnReasonDefective, ReturnReasonWrongItem, ReturnReasonNotAsDescribed, ReturnReasonChangedMind, ReturnReasonOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// ReturnCondition - состояние возвращаемого заказа при приемке
+type ReturnCondition string
+
+const (
+	ReturnConditionIntact  ReturnCondition = "intact"
+	ReturnConditionDamaged ReturnCondition = "damaged"
+	ReturnConditionOpened  ReturnCondition = "opened"
+)
+
+// Valid - состояние входит в список известных состояний возвращаемого заказа
+func (c ReturnCondition) Valid() bool {
+	switch c {
+	case ReturnConditionIntact, ReturnConditionDamaged, ReturnConditionOpened:
+		return true
+	default:
+		return false
+	}
+}
+
+// ReturnDetails - сведения о возврате, которые сообщает клиент и фиксирует сотрудник ПВЗ
+type ReturnDetails struct {
+	Reason    ReturnReason    `json:"reason" db:"reason"`
+	Comment   string          `json:"comment,omitempty" db:"comment"`
+	Condition ReturnCondition `json:"condition" db:"condition"`
+	Photos    []string        `json:"photos,omitempty" db:"photos"` // ссылки на фотографии заказа
+}
+
+// OrderReturn - возврат заказа клиентом вместе с условиями примененной политики возврата
+type OrderReturn struct {
+	ID         int64 `json:"id" db:"id"`
+	OrderID    int64 `json:"order_id" db:"order_id"`
+	CustomerID int64 `json:"customer_id" db:"customer_id"`
+	ReturnDetails
+	ItemIDs            []int64   `json:"item_ids,omitempty" db:"item_ids"` // возвращенные товары заказа с товарами
+	PolicyID           *int64    `json:"policy_id,omitempty" db:"policy_id"`
+	Policy             string    `json:"policy,omitempty" db:"policy"` // название политики на момент возврата
+	Fee                float64   `json:"fee" db:"fee"`                 // удержание за возврат
+	Refund             float64   `json:"refund" db:"refund"`           // сумма к возврату клиенту
+	RequiresInspection bool      `json:"requires_inspection" db:"requires_inspection"`
+	ReturnedBy         *int64    `json:"returned_by,omitempty" db:"returned_by"`
+	ReturnedAt         time.Time `json:"returned_at" db:"returned_at"`
+}
+
+// ReturnFilter - условия выборки возвращенных заказов по сведениям о возврате.
+// Пустое поле не ограничивает выборку.
+type ReturnFilter struct {
+	Reason    ReturnReason
+	Condition ReturnCondition
+}
+
+// ReturnedOrder - возвращенный заказ вместе со сведениями о возврате.
+// Return пуст у заказов, возвращенных до появления сведений о возврате.
+type ReturnedOrder struct {
+	Order
+	Return *OrderReturn `json:"return,omitempty"`
+}
@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// OrderStateTransition - запись о смене статуса заказа
+type OrderStateTransition struct {
+	ID        int64       `json:"id" db:"id"`
+	OrderID   int64       `json:"order_id" db:"order_id"`
+	FromState *OrderState `json:"from_state,omitempty" db:"from_state"` // nil - заказ только что принят
+	ToState   OrderState  `json:"to_state" db:"to_state"`
+	ChangedBy *int64      `json:"changed_by,omitempty" db:"changed_by"`
+	ChangedAt time.Time   `json:"changed_at" db:"changed_at"`
+}
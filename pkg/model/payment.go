@@ -0,0 +1,82 @@
+package model
+
+import "time"
+
+// PaymentMode - способ оплаты заказа
+type PaymentMode string
+
+const (
+	// PaymentModePrepaid - заказ оплачен онлайн при оформлении
+	PaymentModePrepaid PaymentMode = "prepaid"
+	// PaymentModeOnPickup - заказ оплачивается в ПВЗ при получении
+	PaymentModeOnPickup PaymentMode = "on_pickup"
+)
+
+// Valid - способ оплаты входит в список известных способов
+func (m PaymentMode) Valid() bool {
+	switch m {
+	case PaymentModePrepaid, PaymentModeOnPickup:
+		return true
+	default:
+		return false
+	}
+}
+
+// PaymentMethod - способ расчета с клиентом в ПВЗ
+type PaymentMethod string
+
+const (
+	// PaymentMethodCash - наличными
+	PaymentMethodCash PaymentMethod = "cash"
+	// PaymentMethodCard - картой через платежный терминал
+	PaymentMethodCard PaymentMethod = "card"
+	// PaymentMethodMixed - частично наличными, частично картой
+	PaymentMethodMixed PaymentMethod = "mixed"
+	// PaymentMethodOnline - онлайн, используется только в возвратах денег за предоплаченные заказы
+	PaymentMethodOnline PaymentMethod = "online"
+)
+
+// PaymentDetails - сведения об оплате, которые сотрудник ПВЗ передает при выдаче заказа.
+// CashAmount указывается только для смешанной оплаты, остаток суммы оплачивается картой.
+type PaymentDetails struct {
+	Method     PaymentMethod `json:"method"`
+	Amount     float64       `json:"amount"`
+	CashAmount float64       `json:"cash_amount,omitempty"`
+}
+
+// Payment - оплата заказа клиентом при получении
+type Payment struct {
+	ID            int64         `json:"id" db:"id"`
+	OrderID       int64         `json:"order_id" db:"order_id"`
+	Method        PaymentMethod `json:"method" db:"method"`
+	Amount        float64       `json:"amount" db:"amount"`
+	CashAmount    float64       `json:"cash_amount" db:"cash_amount"`
+	CardAmount    float64       `json:"card_amount" db:"card_amount"`
+	TransactionID *string       `json:"transaction_id,omitempty" db:"transaction_id"` // операция платежного терминала
+	OperatorID    *int64        `json:"operator_id,omitempty" db:"operator_id"`
+	PaidAt        time.Time     `json:"paid_at" db:"paid_at"`
+}
+
+// Refund - возврат денег клиенту за возвращенный заказ
+type Refund struct {
+	ID            int64         `json:"id" db:"id"`
+	OrderID       int64         `json:"order_id" db:"order_id"`
+	ReturnID      int64         `json:"return_id" db:"return_id"`
+	PaymentID     *int64        `json:"payment_id,omitempty" db:"payment_id"` // пуст у предоплаченных заказов
+	Method        PaymentMethod `json:"method" db:"method"`
+	Amount        float64       `json:"amount" db:"amount"`
+	CashAmount    float64       `json:"cash_amount" db:"cash_amount"`
+	CardAmount    float64       `json:"card_amount" db:"card_amount"`
+	TransactionID *string       `json:"transaction_id,omitempty" db:"transaction_id"` // операция платежного терминала
+	OperatorID    *int64        `json:"operator_id,omitempty" db:"operator_id"`
+	RefundedAt    time.Time     `json:"refunded_at" db:"refunded_at"`
+}
+
+// OrderPayments - оплата заказа и возвраты денег по нему.
+// Payment пуст у предоплаченных и еще не выданных заказов.
+type OrderPayments struct {
+	OrderID     int64       `json:"order_id"`
+	PaymentMode PaymentMode `json:"payment_mode"`
+	Payment     *Payment    `json:"payment,omitempty"`
+	Refunds     []Refund    `json:"refunds"`
+}
@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// PickupPoint - пункт выдачи заказов (ПВЗ)
+type PickupPoint struct {
+	ID        int64     `json:"id" db:"id"`
+	Name      string    `json:"name" db:"name"`
+	Address   string    `json:"address" db:"address"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
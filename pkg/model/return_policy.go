@@ -0,0 +1,39 @@
+package model
+
+import (
+	"math"
+	"time"
+)
+
+// ReturnPolicy - правила возврата заказа клиентом.
+// Политика применяется к заказам в указанной упаковке и (или) клиентам указанного сегмента,
+// политика без упаковки и сегмента действует по умолчанию.
+type ReturnPolicy struct {
+	ID                 int64        `json:"id" db:"id"`
+	Name               string       `json:"name" db:"name"`
+	PackageType        *PackageType `json:"package_type,omitempty" db:"package_type"`         // nil - заказы в любой упаковке
+	CustomerSegment    *string      `json:"customer_segment,omitempty" db:"customer_segment"` // nil - клиенты любого сегмента
+	ReturnWindowHours  int          `json:"return_window_hours" db:"return_window_hours"`
+	NonReturnable      bool         `json:"non_returnable" db:"non_returnable"`
+	FeePercent         float64      `json:"fee_percent" db:"fee_percent"` // удерживается из стоимости заказа при возврате
+	RequiresInspection bool         `json:"requires_inspection" db:"requires_inspection"`
+	CreatedAt          time.Time    `json:"created_at" db:"created_at"`
+	UpdatedAt          time.Time    `json:"updated_at" db:"updated_at"`
+}
+
+// ReturnWindow - срок, в течение которого клиент может вернуть выданный заказ
+func (p ReturnPolicy) ReturnWindow() time.Duration {
+	return time.Duration(p.ReturnWindowHours) * time.Hour
+}
+
+// Fee - сумма, удерживаемая при возврате заказа стоимостью cost, округленная до копеек
+func (p ReturnPolicy) Fee(cost float64) float64 {
+	return math.Round(cost*p.FeePercent) / 100
+}
+
+// CustomerSegment - сегмент клиента, по которому выбирается политика возврата
+type CustomerSegment struct {
+	CustomerID int64     `json:"customer_id" db:"customer_id"`
+	Segment    string    `json:"segment" db:"segment"`
+	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+}
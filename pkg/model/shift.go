@@ -0,0 +1,64 @@
+package model
+
+import "time"
+
+// ShiftState - состояние смены ПВЗ
+type ShiftState string
+
+const (
+	// ShiftStateOpen - смена открыта, операции ПВЗ попадают в ее отчет
+	ShiftStateOpen ShiftState = "open"
+	// ShiftStateClosed - смена закрыта, отчет сверки сохранен и больше не меняется
+	ShiftStateClosed ShiftState = "closed"
+)
+
+// Shift - смена ПВЗ, по итогам которой сверяется касса
+type Shift struct {
+	ID            int64        `json:"id" db:"id"`
+	PickupPointID int64        `json:"pickup_point_id" db:"pickup_point_id"`
+	State         ShiftState   `json:"state" db:"state"`
+	OpeningCash   float64      `json:"opening_cash" db:"opening_cash"`           // наличные в кассе при открытии смены
+	CountedCash   *float64     `json:"counted_cash,omitempty" db:"counted_cash"` // наличные, пересчитанные при закрытии смены
+	OpenedBy      *int64       `json:"opened_by,omitempty" db:"opened_by"`       // сотрудник, открывший смену
+	OpenedAt      time.Time    `json:"opened_at" db:"opened_at"`                 // начало смены
+	ClosedBy      *int64       `json:"closed_by,omitempty" db:"closed_by"`       // сотрудник, закрывший смену
+	ClosedAt      *time.Time   `json:"closed_at,omitempty" db:"closed_at"`       // nil - смена открыта
+	Report        *ShiftReport `json:"report,omitempty" db:"report"`             // отчет сверки закрытой смены
+}
+
+// ShiftTotals - итоги операций ПВЗ за смену
+type ShiftTotals struct {
+	Handouts       int     `json:"handouts" db:"handouts"`               // выданные заказы
+	HandoutAmount  float64 `json:"handout_amount" db:"handout_amount"`   // стоимость выданных заказов
+	Payments       int     `json:"payments" db:"payments"`               // заказы, оплаченные при получении
+	CashReceived   float64 `json:"cash_received" db:"cash_received"`     // получено наличными
+	CardReceived   float64 `json:"card_received" db:"card_received"`     // получено картой
+	Returns        int     `json:"returns" db:"returns"`                 // принятые возвраты от клиентов
+	ReturnAmount   float64 `json:"return_amount" db:"return_amount"`     // сумма к возврату по принятым возвратам
+	CashRefunded   float64 `json:"cash_refunded" db:"cash_refunded"`     // возвращено наличными
+	CardRefunded   float64 `json:"card_refunded" db:"card_refunded"`     // возвращено на карту
+	OnlineRefunded float64 `json:"online_refunded" db:"online_refunded"` // возвращено онлайн по предоплаченным заказам
+	CourierReturns int     `json:"courier_returns" db:"courier_returns"` // заказы, возвращенные курьеру
+}
+
+// ShiftOperatorTotals - итоги операций одного сотрудника за смену
+type ShiftOperatorTotals struct {
+	OperatorID *int64 `json:"operator_id,omitempty" db:"operator_id"` // nil - операции без сотрудника или удаленного сотрудника
+	Username   string `json:"username" db:"username"`
+	ShiftTotals
+}
+
+// ShiftReport - отчет сверки кассы за смену
+type ShiftReport struct {
+	ShiftID        int64                 `json:"shift_id"`
+	PickupPointID  int64                 `json:"pickup_point_id"`
+	From           time.Time             `json:"from"`
+	To             time.Time             `json:"to"`
+	Final          bool                  `json:"final"` // false - предварительный отчет открытой смены
+	Operators      []ShiftOperatorTotals `json:"operators"`
+	Totals         ShiftTotals           `json:"totals"`
+	OpeningCash    float64               `json:"opening_cash"`
+	ExpectedCash   float64               `json:"expected_cash"` // наличные в кассе по данным операций
+	CountedCash    *float64              `json:"counted_cash,omitempty"`
+	CashDifference *float64              `json:"cash_difference,omitempty"` // пересчитанные наличные минус ожидаемые
+}
@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// StorageCell - ячейка стеллажа в ПВЗ, в которой хранятся принятые заказы
+type StorageCell struct {
+	ID            int64        `json:"id" db:"id"`
+	PickupPointID int64        `json:"pickup_point_id" db:"pickup_point_id"`
+	Rack          string       `json:"rack" db:"rack"`
+	Code          string       `json:"code" db:"code"`
+	Capacity      int          `json:"capacity" db:"capacity"`
+	MaxWeight     float64      `json:"max_weight" db:"max_weight"`
+	PackageType   *PackageType `json:"package_type,omitempty" db:"package_type"` // nil - универсальная ячейка
+	CreatedAt     time.Time    `json:"created_at" db:"created_at"`
+}
+
+// CellOccupancy - заполненность ячейки хранения
+type CellOccupancy struct {
+	StorageCell
+	OrdersCount int     `json:"orders_count" db:"orders_count"`
+	Weight      float64 `json:"weight" db:"weight"`
+}
@@ -6,8 +6,24 @@ const (
 	StateAccepted  OrderState = "accepted"
 	StateDelivered OrderState = "delivered"
 	StateReturned  OrderState = "returned"
+	// StateReturnedToCourier - заказ передан курьеру, конечный статус
+	StateReturnedToCourier OrderState = "returned_to_courier"
+	// StateExpired - срок хранения заказа истек, заказ ожидает возврата курьеру
+	StateExpired OrderState = "expired"
+	// StateLost - заказ утерян, конечный статус
+	StateLost OrderState = "lost"
 )
 
+// Valid - статус входит в список известных статусов заказа
+func (s OrderState) Valid() bool {
+	switch s {
+	case StateAccepted, StateDelivered, StateReturned, StateReturnedToCourier, StateExpired, StateLost:
+		return true
+	default:
+		return false
+	}
+}
+
 type PackageType string
 
 const (
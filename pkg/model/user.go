@@ -2,11 +2,32 @@ package model
 
 import "time"
 
+const (
+	// RoleAdmin - администратор, имеет доступ ко всем операциям
+	RoleAdmin = "admin"
+	// RoleOperator - сотрудник ПВЗ, принимает, выдает заказы и оформляет возвраты
+	RoleOperator = "operator"
+	// RoleCourier - курьер, сдает заказы в ПВЗ и забирает возвраты
+	RoleCourier = "courier"
+	// RoleAuditor - аудитор, имеет доступ только на чтение
+	RoleAuditor = "auditor"
+)
+
+const (
+	// UserStatusPending - учетная запись создана через регистрацию и ждет подтверждения администратором
+	UserStatusPending = "pending"
+	// UserStatusActive - учетная запись подтверждена и может работать с API
+	UserStatusActive = "active"
+)
+
 type User struct {
-	ID           int64     `json:"id" db:"id"`
-	Username     string    `json:"username" db:"username"`
-	PasswordHash string    `json:"-" db:"password_hash"`
-	Role         string    `json:"role" db:"role"`
-	CreatedAt    time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+	ID           int64  `json:"id" db:"id"`
+	Username     string `json:"username" db:"username"`
+	PasswordHash string `json:"-" db:"password_hash"`
+	Role         string `json:"role" db:"role"`
+	Status       string `json:"status" db:"status"`
+	// PickupPointID - ПВЗ, в котором работает пользователь. Администратор может быть не привязан к ПВЗ.
+	PickupPointID *int64    `json:"pickup_point_id,omitempty" db:"pickup_point_id"`
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
 }
@@ -0,0 +1,166 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+var (
+	// ErrPermissionDenied возникает, когда у роли пользователя нет нужного права
+	ErrPermissionDenied = errors.New("недостаточно прав для выполнения операции")
+	// ErrUnknownOperation возникает, когда операция не описана в таблице прав
+	ErrUnknownOperation = errors.New("операция не описана в таблице прав доступа")
+	// ErrUserNotActive возникает, когда учетная запись еще не подтверждена администратором
+	ErrUserNotActive = errors.New("учетная запись ожидает подтверждения администратором")
+	// ErrScopeDenied возникает, когда операция не входит в области действия API-ключа
+	ErrScopeDenied = errors.New("операция не входит в области действия API-ключа")
+)
+
+// Permission определяет право на выполнение группы операций
+type Permission string
+
+const (
+	// PermOrdersRead - просмотр заказов, возвратов и истории
+	PermOrdersRead Permission = "orders:read"
+	// PermOrdersAccept - прием заказов от курьера
+	PermOrdersAccept Permission = "orders:accept"
+	// PermOrdersProcess - выдача заказов клиенту и прием возвратов
+	PermOrdersProcess Permission = "orders:process"
+	// PermOrdersReturnToCourier - возврат заказов курьеру
+	PermOrdersReturnToCourier Permission = "orders:return_to_courier"
+	// PermUsersRead - просмотр пользователей
+	PermUsersRead Permission = "users:read"
+	// PermUsersManage - создание, изменение и удаление пользователей
+	PermUsersManage Permission = "users:manage"
+	// PermPickupPointsRead - просмотр ПВЗ
+	PermPickupPointsRead Permission = "pickup_points:read"
+	// PermPickupPointsManage - создание, изменение и удаление ПВЗ, привязка сотрудников к ПВЗ
+	PermPickupPointsManage Permission = "pickup_points:manage"
+	// PermReturnPoliciesManage - создание, изменение и удаление политик возврата, назначение сегментов клиентов
+	PermReturnPoliciesManage Permission = "return_policies:manage"
+	// PermShiftsRead - просмотр смен ПВЗ и отчетов сверки кассы
+	PermShiftsRead Permission = "shifts:read"
+	// PermShiftsManage - открытие и закрытие смен ПВЗ
+	PermShiftsManage Permission = "shifts:manage"
+	// PermDatabaseClear - очистка базы данных
+	PermDatabaseClear Permission = "db:clear"
+)
+
+// rolePermissions - права, выданные каждой роли
+var rolePermissions = map[string][]Permission{
+	model.RoleAdmin: {
+		PermOrdersRead,
+		PermOrdersAccept,
+		PermOrdersProcess,
+		PermOrdersReturnToCourier,
+		PermUsersRead,
+		PermUsersManage,
+		PermPickupPointsRead,
+		PermPickupPointsManage,
+		PermReturnPoliciesManage,
+		PermShiftsRead,
+		PermShiftsManage,
+		PermDatabaseClear,
+	},
+	model.RoleOperator: {
+		PermOrdersRead,
+		PermOrdersAccept,
+		PermOrdersProcess,
+		PermOrdersReturnToCourier,
+		PermPickupPointsRead,
+		PermShiftsRead,
+		PermShiftsManage,
+	},
+	model.RoleCourier: {
+		PermOrdersRead,
+		PermOrdersAccept,
+		PermOrdersReturnToCourier,
+		PermPickupPointsRead,
+	},
+	model.RoleAuditor: {
+		PermOrdersRead,
+		PermUsersRead,
+		PermPickupPointsRead,
+		PermShiftsRead,
+	},
+}
+
+type ctxKey struct{}
+
+// IsKnownRole проверяет, описана ли роль в модели прав
+func IsKnownRole(role string) bool {
+	_, ok := rolePermissions[role]
+	return ok
+}
+
+// HasPermission проверяет, выдано ли роли указанное право
+func HasPermission(role string, permission Permission) bool {
+	for _, p := range rolePermissions[role] {
+		if p == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Authorize проверяет, может ли пользователь выполнить операцию, описанную правилом
+func Authorize(user model.User, rule Rule) error {
+	if rule.Public {
+		return nil
+	}
+
+	if user.Status != model.UserStatusActive {
+		return ErrUserNotActive
+	}
+
+	if rule.Permission == "" {
+		return nil
+	}
+
+	if !HasPermission(user.Role, rule.Permission) {
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
+
+// AuthorizeTarget проверяет, может ли пользователь выполнить операцию над учетной записью targetID.
+// Операцию, помеченную в правиле как Self, пользователь может выполнить над собственной учетной записью
+// без права Permission, над чужими - только с ним.
+func AuthorizeTarget(user model.User, rule Rule, targetID int64) error {
+	if rule.Self && targetID > 0 && targetID == user.ID && user.Status == model.UserStatusActive {
+		return nil
+	}
+
+	return Authorize(user, rule)
+}
+
+// AuthorizeScopes проверяет, входит ли право, нужное для операции, в области действия API-ключа.
+// Проверяется дополнительно к Authorize: ключ не может дать больше прав, чем роль владельца.
+func AuthorizeScopes(scopes []string, rule Rule) error {
+	if rule.Public || rule.Permission == "" {
+		return nil
+	}
+
+	for _, scope := range scopes {
+		if Permission(scope) == rule.Permission {
+			return nil
+		}
+	}
+
+	return ErrScopeDenied
+}
+
+// ContextWithUser сохраняет аутентифицированного пользователя в контексте
+func ContextWithUser(ctx context.Context, user model.User) context.Context {
+	return context.WithValue(ctx, ctxKey{}, user)
+}
+
+// UserFromContext возвращает аутентифицированного пользователя из контекста
+func UserFromContext(ctx context.Context) (model.User, bool) {
+	user, ok := ctx.Value(ctxKey{}).(model.User)
+	return user, ok
+}
@@ -0,0 +1,176 @@
+package rbac
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	pb "gitlab.ozon.dev/gojhw1/pkg/gen/proto"
+)
+
+// Rule связывает операцию API в HTTP и gRPC с правом, необходимым для ее вызова
+type Rule struct {
+	Method     string     // HTTP-метод
+	Path       string     // шаблон HTTP-маршрута, параметры задаются как :name
+	RPC        string     // полное имя gRPC-метода
+	Permission Permission // пустое значение - достаточно аутентификации
+	Public     bool       // операция доступна без аутентификации
+	Self       bool       // над собственной учетной записью (параметр :id) операция доступна без права Permission
+}
+
+// rules - единая таблица прав для REST и gRPC API.
+// Правила проверяются по порядку, поэтому точные пути идут раньше путей с параметрами.
+var rules = []Rule{
+	// Аутентификация
+	{Method: fiber.MethodPost, Path: "/api/v1/auth/login", Public: true},
+	{Method: fiber.MethodPost, Path: "/api/v1/auth/refresh", Public: true},
+	{Method: fiber.MethodPost, Path: "/api/v1/auth/logout", Public: true},
+	{Method: fiber.MethodPost, Path: "/api/v1/auth/unlock", Permission: PermUsersManage},
+
+	// Пользователи
+	{Method: fiber.MethodPost, Path: "/api/v1/users/register", RPC: pb.UserRPCHandler_CreateUser_FullMethodName, Public: true},
+	{Method: fiber.MethodGet, Path: "/api/v1/users", RPC: pb.UserRPCHandler_ListUsers_FullMethodName, Permission: PermUsersRead},
+	{Method: fiber.MethodGet, Path: "/api/v1/users/:id", RPC: pb.UserRPCHandler_GetUser_FullMethodName, Permission: PermUsersRead},
+	{Method: fiber.MethodPut, Path: "/api/v1/users/:id", RPC: pb.UserRPCHandler_UpdateUser_FullMethodName, Permission: PermUsersManage},
+	{Method: fiber.MethodDelete, Path: "/api/v1/users/:id", RPC: pb.UserRPCHandler_DeleteUser_FullMethodName, Permission: PermUsersManage},
+	{Method: fiber.MethodPut, Path: "/api/v1/users/:id/password", RPC: pb.UserRPCHandler_UpdatePassword_FullMethodName, Permission: PermUsersManage, Self: true},
+	{Method: fiber.MethodPost, Path: "/api/v1/users/:id/approve", RPC: pb.UserRPCHandler_ApproveUser_FullMethodName, Permission: PermUsersManage},
+	{Method: fiber.MethodPost, Path: "/api/v1/users/:id/promote", RPC: pb.UserRPCHandler_PromoteUser_FullMethodName, Permission: PermUsersManage},
+	{Method: fiber.MethodPut, Path: "/api/v1/users/:id/pickup-point", RPC: pb.UserRPCHandler_AssignPickupPoint_FullMethodName, Permission: PermPickupPointsManage},
+	{Method: fiber.MethodPost, Path: "/api/v1/users/:id/api-keys", RPC: pb.UserRPCHandler_CreateAPIKey_FullMethodName, Permission: PermUsersManage},
+	{Method: fiber.MethodGet, Path: "/api/v1/users/:id/api-keys", RPC: pb.UserRPCHandler_ListAPIKeys_FullMethodName, Permission: PermUsersManage},
+	{Method: fiber.MethodDelete, Path: "/api/v1/users/:id/api-keys/:keyId", RPC: pb.UserRPCHandler_RevokeAPIKey_FullMethodName, Permission: PermUsersManage},
+
+	// Пункты выдачи заказов
+	{Method: fiber.MethodGet, Path: "/api/v1/pickup-points", RPC: pb.PickupPointRPCHandler_ListPickupPoints_FullMethodName, Permission: PermPickupPointsRead},
+	{Method: fiber.MethodPost, Path: "/api/v1/pickup-points", RPC: pb.PickupPointRPCHandler_CreatePickupPoint_FullMethodName, Permission: PermPickupPointsManage},
+	{Method: fiber.MethodGet, Path: "/api/v1/pickup-points/:id", RPC: pb.PickupPointRPCHandler_GetPickupPoint_FullMethodName, Permission: PermPickupPointsRead},
+	{Method: fiber.MethodPut, Path: "/api/v1/pickup-points/:id", RPC: pb.PickupPointRPCHandler_UpdatePickupPoint_FullMethodName, Permission: PermPickupPointsManage},
+	{Method: fiber.MethodDelete, Path: "/api/v1/pickup-points/:id", RPC: pb.PickupPointRPCHandler_DeletePickupPoint_FullMethodName, Permission: PermPickupPointsManage},
+
+	// Ячейки хранения
+	{Method: fiber.MethodGet, Path: "/api/v1/storage-cells", RPC: pb.StorageRPCHandler_ListStorageCells_FullMethodName, Permission: PermOrdersRead},
+	{Method: fiber.MethodPost, Path: "/api/v1/storage-cells", RPC: pb.StorageRPCHandler_CreateStorageCell_FullMethodName, Permission: PermPickupPointsManage},
+	{Method: fiber.MethodDelete, Path: "/api/v1/storage-cells/:id", RPC: pb.StorageRPCHandler_DeleteStorageCell_FullMethodName, Permission: PermPickupPointsManage},
+
+	// Заказы
+	{Method: fiber.MethodPost, Path: "/api/v1/orders", RPC: pb.OrderRPCHandler_CreateOrder_FullMethodName, Permission: PermOrdersAccept},
+	{Method: fiber.MethodGet, Path: "/api/v1/orders", RPC: pb.OrderRPCHandler_ListOrders_FullMethodName, Permission: PermOrdersRead},
+	{Method: fiber.MethodGet, Path: "/api/v1/orders/history", RPC: pb.OrderRPCHandler_OrderHistory_FullMethodName, Permission: PermOrdersRead},
+	{Method: fiber.MethodGet, Path: "/api/v1/orders/export", RPC: pb.OrderRPCHandler_ExportOrders_FullMethodName, Permission: PermOrdersRead},
+	{Method: fiber.MethodPost, Path: "/api/v1/orders/accept", RPC: pb.OrderRPCHandler_AcceptOrdersFromFile_FullMethodName, Permission: PermOrdersAccept},
+	{Method: fiber.MethodGet, Path: "/api/v1/orders/:id", RPC: pb.OrderRPCHandler_GetOrder_FullMethodName, Permission: PermOrdersRead},
+	{Method: fiber.MethodGet, Path: "/api/v1/orders/:id/timeline", RPC: pb.OrderRPCHandler_OrderTimeline_FullMethodName, Permission: PermOrdersRead},
+	{Method: fiber.MethodGet, Path: "/api/v1/orders/:id/payments", RPC: pb.OrderRPCHandler_GetOrderPayments_FullMethodName, Permission: PermOrdersRead},
+	{Method: fiber.MethodGet, Path: "/api/v1/orders/:id/location", RPC: pb.StorageRPCHandler_LocateOrder_FullMethodName, Permission: PermOrdersRead},
+	{Method: fiber.MethodDelete, Path: "/api/v1/orders/:id/return", RPC: pb.OrderRPCHandler_ReturnToCourier_FullMethodName, Permission: PermOrdersReturnToCourier},
+	{Method: fiber.MethodPost, Path: "/api/v1/orders/:id/extend", RPC: pb.OrderRPCHandler_ExtendStorage_FullMethodName, Permission: PermOrdersProcess},
+	{Method: fiber.MethodPut, Path: "/api/v1/orders/:id/process", RPC: pb.OrderRPCHandler_ProcessCustomer_FullMethodName, Permission: PermOrdersProcess},
+
+	// Задачи импорта заказов из файла
+	{Method: fiber.MethodGet, Path: "/api/v1/imports/:id", RPC: pb.OrderRPCHandler_GetImportJob_FullMethodName, Permission: PermOrdersAccept},
+	{RPC: pb.OrderRPCHandler_ImportOrders_FullMethodName, Permission: PermOrdersAccept},
+	{RPC: pb.OrderRPCHandler_SubmitImportJob_FullMethodName, Permission: PermOrdersAccept},
+	{RPC: pb.OrderRPCHandler_WatchImportJob_FullMethodName, Permission: PermOrdersAccept},
+
+	// Возвраты
+	{Method: fiber.MethodGet, Path: "/api/v1/returns", RPC: pb.OrderRPCHandler_ListReturns_FullMethodName, Permission: PermOrdersRead},
+	{Method: fiber.MethodGet, Path: "/api/v1/returns/courier", RPC: pb.OrderRPCHandler_ListCourierReturns_FullMethodName, Permission: PermOrdersRead},
+
+	// Политики возврата
+	{Method: fiber.MethodGet, Path: "/api/v1/return-policies", RPC: pb.ReturnPolicyRPCHandler_ListReturnPolicies_FullMethodName, Permission: PermOrdersRead},
+	{Method: fiber.MethodPost, Path: "/api/v1/return-policies", RPC: pb.ReturnPolicyRPCHandler_CreateReturnPolicy_FullMethodName, Permission: PermReturnPoliciesManage},
+	{Method: fiber.MethodGet, Path: "/api/v1/return-policies/:id", RPC: pb.ReturnPolicyRPCHandler_GetReturnPolicy_FullMethodName, Permission: PermOrdersRead},
+	{Method: fiber.MethodPut, Path: "/api/v1/return-policies/:id", RPC: pb.ReturnPolicyRPCHandler_UpdateReturnPolicy_FullMethodName, Permission: PermReturnPoliciesManage},
+	{Method: fiber.MethodDelete, Path: "/api/v1/return-policies/:id", RPC: pb.ReturnPolicyRPCHandler_DeleteReturnPolicy_FullMethodName, Permission: PermReturnPoliciesManage},
+	{Method: fiber.MethodPut, Path: "/api/v1/customers/:id/segment", RPC: pb.ReturnPolicyRPCHandler_SetCustomerSegment_FullMethodName, Permission: PermReturnPoliciesManage},
+
+	// Смены ПВЗ и сверка кассы
+	{Method: fiber.MethodGet, Path: "/api/v1/shifts", RPC: pb.ShiftRPCHandler_ListShifts_FullMethodName, Permission: PermShiftsRead},
+	{Method: fiber.MethodPost, Path: "/api/v1/shifts", RPC: pb.ShiftRPCHandler_OpenShift_FullMethodName, Permission: PermShiftsManage},
+	{Method: fiber.MethodGet, Path: "/api/v1/shifts/current", RPC: pb.ShiftRPCHandler_GetCurrentShift_FullMethodName, Permission: PermShiftsRead},
+	{Method: fiber.MethodGet, Path: "/api/v1/shifts/:id", RPC: pb.ShiftRPCHandler_GetShift_FullMethodName, Permission: PermShiftsRead},
+	{Method: fiber.MethodPost, Path: "/api/v1/shifts/:id/close", RPC: pb.ShiftRPCHandler_CloseShift_FullMethodName, Permission: PermShiftsManage},
+	{Method: fiber.MethodGet, Path: "/api/v1/shifts/:id/report", RPC: pb.ShiftRPCHandler_ExportShiftReport_FullMethodName, Permission: PermShiftsRead},
+
+	// Операции с базой данных
+	{Method: fiber.MethodDelete, Path: "/api/v1/db", RPC: pb.OrderRPCHandler_ClearDatabase_FullMethodName, Permission: PermDatabaseClear},
+
+	// Служебные gRPC-методы
+	{RPC: "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo"},
+	{RPC: "/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo"},
+}
+
+// RuleForHTTP находит правило для HTTP-запроса по методу и фактическому пути
+func RuleForHTTP(method, path string) (Rule, bool) {
+	// Fiber регистрирует HEAD вместе с каждым GET-маршрутом
+	if method == fiber.MethodHead {
+		method = fiber.MethodGet
+	}
+
+	for _, rule := range rules {
+		if rule.Method == method && matchPath(rule.Path, path) {
+			return rule, true
+		}
+	}
+
+	return Rule{}, false
+}
+
+// RuleForRPC находит правило для gRPC-метода по его полному имени
+func RuleForRPC(fullMethod string) (Rule, bool) {
+	for _, rule := range rules {
+		if rule.RPC != "" && rule.RPC == fullMethod {
+			return rule, true
+		}
+	}
+
+	return Rule{}, false
+}
+
+// PathParam возвращает значение параметра name из фактического пути запроса по шаблону маршрута.
+// Если путь не соответствует шаблону или параметра в шаблоне нет, возвращается пустая строка.
+func PathParam(pattern, path, name string) string {
+	if !matchPath(pattern, path) {
+		return ""
+	}
+
+	patternParts := strings.Split(strings.Trim(pattern, "/"), "/")
+	pathParts := strings.Split(strings.Trim(path, "/"), "/")
+
+	for i, part := range patternParts {
+		if part == ":"+name {
+			return pathParts[i]
+		}
+	}
+
+	return ""
+}
+
+// matchPath сравнивает путь запроса с шаблоном маршрута посегментно
+func matchPath(pattern, path string) bool {
+	if pattern == "" {
+		return false
+	}
+
+	patternParts := strings.Split(strings.Trim(pattern, "/"), "/")
+	pathParts := strings.Split(strings.Trim(path, "/"), "/")
+
+	if len(patternParts) != len(pathParts) {
+		return false
+	}
+
+	for i, part := range patternParts {
+		if strings.HasPrefix(part, ":") {
+			if pathParts[i] == "" {
+				return false
+			}
+			continue
+		}
+
+		if part != pathParts[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"gitlab.ozon.dev/gojhw1/pkg/db"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+var (
+	// ErrAPIKeyNotFound определяет ошибку, которая возникает, когда API-ключ не найден
+	ErrAPIKeyNotFound = errors.New("API-ключ не найден")
+)
+
+const apiKeyColumns = "id, user_id, name, prefix, key_hash, scopes, expires_at, last_used_at, created_at"
+
+// PostgresAPIKeyRepository реализация репозитория для работы с API-ключами в PostgreSQL
+type PostgresAPIKeyRepository struct {
+	pool *db.Pool
+}
+
+// NewPostgresAPIKeyRepository создает новый репозиторий API-ключей
+func NewPostgresAPIKeyRepository(pool *db.Pool) *PostgresAPIKeyRepository {
+	return &PostgresAPIKeyRepository{
+		pool: pool,
+	}
+}
+
+// Create сохраняет новый API-ключ и возвращает его с заполненными ID и датой создания
+func (r *PostgresAPIKeyRepository) Create(ctx context.Context, key model.APIKey) (model.APIKey, error) {
+	key.CreatedAt = time.Now()
+
+	err := r.pool.QueryRow(ctx, `
+        INSERT INTO api_keys (user_id, name, prefix, key_hash, scopes, expires_at, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        RETURNING id`,
+		key.UserID,
+		key.Name,
+		key.Prefix,
+		key.KeyHash,
+		key.Scopes,
+		key.ExpiresAt,
+		key.CreatedAt,
+	).Scan(&key.ID)
+	if err != nil {
+		return model.APIKey{}, fmt.Errorf("ошибка сохранения API-ключа: %w", err)
+	}
+
+	return key, nil
+}
+
+// ListByUser возвращает API-ключи пользователя
+func (r *PostgresAPIKeyRepository) ListByUser(ctx context.Context, userID int64) ([]model.APIKey, error) {
+	var keys []model.APIKey
+
+	err := pgxscan.Select(ctx, r.pool, &keys,
+		"SELECT "+apiKeyColumns+" FROM api_keys WHERE user_id = $1 ORDER BY id",
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения API-ключей: %w", err)
+	}
+
+	return keys, nil
+}
+
+// GetByPrefix находит API-ключ по его публичному префиксу
+func (r *PostgresAPIKeyRepository) GetByPrefix(ctx context.Context, prefix string) (model.APIKey, error) {
+	var key model.APIKey
+
+	err := pgxscan.Get(ctx, r.pool, &key,
+		"SELECT "+apiKeyColumns+" FROM api_keys WHERE prefix = $1",
+		prefix,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.APIKey{}, ErrAPIKeyNotFound
+		}
+		return model.APIKey{}, fmt.Errorf("ошибка получения API-ключа: %w", err)
+	}
+
+	return key, nil
+}
+
+// Delete удаляет API-ключ пользователя
+func (r *PostgresAPIKeyRepository) Delete(ctx context.Context, userID, keyID int64) error {
+	commandTag, err := r.pool.Exec(ctx, "DELETE FROM api_keys WHERE id = $1 AND user_id = $2", keyID, userID)
+	if err != nil {
+		return fmt.Errorf("ошибка удаления API-ключа: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return ErrAPIKeyNotFound
+	}
+
+	return nil
+}
+
+// TouchLastUsed обновляет время последнего использования API-ключа
+func (r *PostgresAPIKeyRepository) TouchLastUsed(ctx context.Context, keyID int64, usedAt time.Time) error {
+	if _, err := r.pool.Exec(ctx, "UPDATE api_keys SET last_used_at = $2 WHERE id = $1", keyID, usedAt); err != nil {
+		return fmt.Errorf("ошибка обновления времени использования API-ключа: %w", err)
+	}
+
+	return nil
+}
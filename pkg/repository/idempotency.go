@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"gitlab.ozon.dev/gojhw1/pkg/db"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+// PostgresIdempotencyRepository реализация репозитория для работы с ключами идемпотентности в PostgreSQL
+type PostgresIdempotencyRepository struct {
+	pool *db.Pool
+}
+
+// NewPostgresIdempotencyRepository создает новый репозиторий ключей идемпотентности
+func NewPostgresIdempotencyRepository(pool *db.Pool) *PostgresIdempotencyRepository {
+	return &PostgresIdempotencyRepository{
+		pool: pool,
+	}
+}
+
+// Reserve занимает ключ за новым запросом.
+// Если ключ уже занят и не истек, возвращает сохраненную запись и false, иначе - новую запись и true.
+func (r *PostgresIdempotencyRepository) Reserve(ctx context.Context, record model.IdempotencyRecord) (model.IdempotencyRecord, bool, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return model.IdempotencyRecord{}, false, fmt.Errorf("%w: %w", ErrTransactionStartError, err)
+	}
+	defer tx.Rollback(ctx)
+
+	existing, err := getIdempotencyRecord(ctx, tx, record.Username, record.Key)
+	switch {
+	case err == nil && existing.ExpiresAt.After(record.CreatedAt):
+		return existing, false, nil
+	case err == nil:
+		// Просроченный ключ можно использовать повторно
+		if _, err = tx.Exec(ctx, "DELETE FROM idempotency_keys WHERE username = $1 AND key = $2",
+			record.Username, record.Key); err != nil {
+			return model.IdempotencyRecord{}, false, fmt.Errorf("ошибка удаления просроченного ключа идемпотентности: %w", err)
+		}
+	case !errors.Is(err, pgx.ErrNoRows):
+		return model.IdempotencyRecord{}, false, err
+	}
+
+	commandTag, err := tx.Exec(ctx, `
+        INSERT INTO idempotency_keys (username, key, request_hash, created_at, expires_at)
+        VALUES ($1, $2, $3, $4, $5)
+        ON CONFLICT (username, key) DO NOTHING`,
+		record.Username,
+		record.Key,
+		record.RequestHash,
+		record.CreatedAt,
+		record.ExpiresAt,
+	)
+	if err != nil {
+		return model.IdempotencyRecord{}, false, fmt.Errorf("ошибка сохранения ключа идемпотентности: %w", err)
+	}
+
+	// Ключ занял параллельный запрос с тем же ключом
+	if commandTag.RowsAffected() == 0 {
+		existing, err = getIdempotencyRecord(ctx, tx, record.Username, record.Key)
+		if err != nil {
+			return model.IdempotencyRecord{}, false, err
+		}
+		return existing, false, nil
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return model.IdempotencyRecord{}, false, err
+	}
+
+	return record, true, nil
+}
+
+// Complete сохраняет ответ на запрос, выполненный с ключом
+func (r *PostgresIdempotencyRepository) Complete(ctx context.Context, username, key string, statusCode int, response []byte) error {
+	_, err := r.pool.Exec(ctx, `
+        UPDATE idempotency_keys SET status_code = $3, response = $4
+        WHERE username = $1 AND key = $2`,
+		username,
+		key,
+		statusCode,
+		response,
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка сохранения ответа по ключу идемпотентности: %w", err)
+	}
+
+	return nil
+}
+
+// Release освобождает ключ, запрос по которому не завершился успешно
+func (r *PostgresIdempotencyRepository) Release(ctx context.Context, username, key string) error {
+	_, err := r.pool.Exec(ctx,
+		"DELETE FROM idempotency_keys WHERE username = $1 AND key = $2 AND status_code = 0",
+		username,
+		key,
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка освобождения ключа идемпотентности: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteExpired удаляет ключи, срок хранения которых истек, и возвращает их количество
+func (r *PostgresIdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	commandTag, err := r.pool.Exec(ctx, "DELETE FROM idempotency_keys WHERE expires_at <= $1", now)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка удаления просроченных ключей идемпотентности: %w", err)
+	}
+
+	return commandTag.RowsAffected(), nil
+}
+
+// getIdempotencyRecord блокирует и возвращает запись о ключе в рамках транзакции tx
+func getIdempotencyRecord(ctx context.Context, tx pgx.Tx, username, key string) (model.IdempotencyRecord, error) {
+	var record model.IdempotencyRecord
+	err := pgxscan.Get(ctx, tx, &record, `
+        SELECT username, key, request_hash, status_code, response, created_at, expires_at
+        FROM idempotency_keys
+        WHERE username = $1 AND key = $2
+        FOR UPDATE`,
+		username,
+		key,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.IdempotencyRecord{}, err
+		}
+		return model.IdempotencyRecord{}, fmt.Errorf("ошибка получения ключа идемпотентности: %w", err)
+	}
+
+	return record, nil
+}
@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"gitlab.ozon.dev/gojhw1/pkg/db"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+// ErrImportJobNotFound - задача импорта не найдена
+var ErrImportJobNotFound = errors.New("задача импорта не найдена")
+
+// importJobColumns - поля задачи импорта без содержимого файла
+const importJobColumns = `
+            id, user_id, filename, format, status, dry_run, bulk, total, processed, succeeded, failed,
+            errors, COALESCE(error, '') AS error, created_at, started_at, finished_at, updated_at`
+
+// PostgresImportJobRepository реализация репозитория для работы с задачами импорта в PostgreSQL
+type PostgresImportJobRepository struct {
+	pool *db.Pool
+}
+
+// NewPostgresImportJobRepository создает новый репозиторий задач импорта
+func NewPostgresImportJobRepository(pool *db.Pool) *PostgresImportJobRepository {
+	return &PostgresImportJobRepository{
+		pool: pool,
+	}
+}
+
+// Create сохраняет новую задачу импорта вместе с содержимым файла
+func (r *PostgresImportJobRepository) Create(ctx context.Context, job model.ImportJob) (model.ImportJob, error) {
+	var created model.ImportJob
+	err := pgxscan.Get(ctx, r.pool, &created, `
+        INSERT INTO import_jobs (user_id, filename, format, status, dry_run, bulk, content, total, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $9)
+        RETURNING`+importJobColumns,
+		job.UserID,
+		job.Filename,
+		job.Format,
+		job.Status,
+		job.DryRun,
+		job.Bulk,
+		job.Content,
+		job.Total,
+		job.CreatedAt,
+	)
+	if err != nil {
+		return model.ImportJob{}, fmt.Errorf("ошибка создания задачи импорта: %w", err)
+	}
+
+	return created, nil
+}
+
+// GetByID возвращает задачу импорта без содержимого файла
+func (r *PostgresImportJobRepository) GetByID(ctx context.Context, id int64) (model.ImportJob, error) {
+	var job model.ImportJob
+	err := pgxscan.Get(ctx, r.pool, &job, "SELECT"+importJobColumns+" FROM import_jobs WHERE id = $1", id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ImportJob{}, fmt.Errorf("%w: %d", ErrImportJobNotFound, id)
+		}
+		return model.ImportJob{}, fmt.Errorf("ошибка получения задачи импорта: %w", err)
+	}
+
+	return job, nil
+}
+
+// ClaimNext переводит самую старую ожидающую задачу в обработку и возвращает ее вместе с содержимым файла.
+// Если ожидающих задач нет, возвращает false. Задачу, которую уже забрал другой обработчик, пропускает.
+func (r *PostgresImportJobRepository) ClaimNext(ctx context.Context, now time.Time) (model.ImportJob, bool, error) {
+	var job model.ImportJob
+	err := pgxscan.Get(ctx, r.pool, &job, `
+        UPDATE import_jobs
+        SET status = $1, started_at = COALESCE(started_at, $3), updated_at = $3
+        WHERE id = (
+            SELECT id FROM import_jobs
+            WHERE status = $2
+            ORDER BY id
+            LIMIT 1
+            FOR UPDATE SKIP LOCKED
+        )
+        RETURNING content,`+importJobColumns,
+		model.ImportJobRunning,
+		model.ImportJobPending,
+		now,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ImportJob{}, false, nil
+		}
+		return model.ImportJob{}, false, fmt.Errorf("ошибка получения задачи импорта из очереди: %w", err)
+	}
+
+	return job, true, nil
+}
+
+// UpdateProgress сохраняет ход обработки задачи и ее состояние
+func (r *PostgresImportJobRepository) UpdateProgress(ctx context.Context, job model.ImportJob) error {
+	_, err := r.pool.Exec(ctx, `
+        UPDATE import_jobs
+        SET status = $2, processed = $3, succeeded = $4, failed = $5, errors = $6, updated_at = $7
+        WHERE id = $1`,
+		job.ID,
+		job.Status,
+		job.Processed,
+		job.Succeeded,
+		job.Failed,
+		job.Errors,
+		job.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка сохранения хода задачи импорта: %w", err)
+	}
+
+	return nil
+}
+
+// Touch отмечает, что задача все еще обрабатывается, чтобы она не считалась прерванной
+func (r *PostgresImportJobRepository) Touch(ctx context.Context, id int64, now time.Time) error {
+	_, err := r.pool.Exec(ctx,
+		"UPDATE import_jobs SET updated_at = $2 WHERE id = $1 AND status = $3",
+		id,
+		now,
+		model.ImportJobRunning,
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка обновления времени обработки задачи импорта: %w", err)
+	}
+
+	return nil
+}
+
+// Finish сохраняет результат завершенной задачи и удаляет содержимое файла
+func (r *PostgresImportJobRepository) Finish(ctx context.Context, job model.ImportJob) error {
+	_, err := r.pool.Exec(ctx, `
+        UPDATE import_jobs
+        SET status = $2, processed = $3, succeeded = $4, failed = $5, errors = $6, error = NULLIF($7, ''),
+            content = NULL, finished_at = $8, updated_at = $8
+        WHERE id = $1`,
+		job.ID,
+		job.Status,
+		job.Processed,
+		job.Succeeded,
+		job.Failed,
+		job.Errors,
+		job.Error,
+		job.FinishedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка сохранения результата задачи импорта: %w", err)
+	}
+
+	return nil
+}
+
+// RequeueStale возвращает в очередь задачи, которые обрабатываются, но не обновлялись с момента before,
+// например из-за остановки сервиса. Возвращает количество таких задач.
+func (r *PostgresImportJobRepository) RequeueStale(ctx context.Context, before time.Time) (int64, error) {
+	commandTag, err := r.pool.Exec(ctx,
+		"UPDATE import_jobs SET status = $1 WHERE status = $2 AND updated_at < $3",
+		model.ImportJobPending,
+		model.ImportJobRunning,
+		before,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка возврата зависших задач импорта в очередь: %w", err)
+	}
+
+	return commandTag.RowsAffected(), nil
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
+	"sort"
 
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/jackc/pgx/v5"
@@ -25,6 +25,8 @@ var (
 	ErrInvalidCustomerID = errors.New("недопустимый ID клиента")
 	// ErrTransactionStartError - ошибка начала транзакции
 	ErrTransactionStartError = errors.New("ошибка начала транзакции")
+	// ErrConcurrentModification - заказ изменен параллельным запросом после того, как его прочитали
+	ErrConcurrentModification = errors.New("заказ уже изменен другим запросом, получите актуальную версию")
 )
 
 type PostgresOrderRepository struct {
@@ -38,34 +40,56 @@ func NewPostgresOrderRepository(pool *db.Pool) *PostgresOrderRepository {
 	}
 }
 
-// Create создает новый заказ в базе данных
-func (r *PostgresOrderRepository) Create(ctx context.Context, order model.Order) error {
+// Create создает новый заказ в базе данных вместе с товарами и записывает начальный статус в историю переходов.
+// В той же транзакции заказ размещается в свободной ячейке своего ПВЗ по тем же правилам, что и в CreateBatch;
+// заказ ПВЗ без ячеек принимается без ячейки, а если подходящей ячейки нет, заказ не создается.
+// ID записанных товаров проставляются в order.Items. Возвращает заказ с заполненной ячейкой хранения.
+func (r *PostgresOrderRepository) Create(ctx context.Context, order model.Order, transition model.OrderStateTransition) (model.Order, error) {
 	if order.ID <= 0 {
-		return fmt.Errorf("%w: %d", ErrInvalidOrderID, order.ID)
+		return model.Order{}, fmt.Errorf("%w: %d", ErrInvalidOrderID, order.ID)
 	}
 
 	if order.CustomerID <= 0 {
-		return fmt.Errorf("%w: %d", ErrInvalidCustomerID, order.CustomerID)
+		return model.Order{}, fmt.Errorf("%w: %d", ErrInvalidCustomerID, order.CustomerID)
 	}
 
 	tx, err := r.pool.Begin(ctx)
 	if err != nil {
-		return fmt.Errorf("%w: %w", ErrTransactionStartError, err)
+		return model.Order{}, fmt.Errorf("%w: %w", ErrTransactionStartError, err)
 	}
 	defer tx.Rollback(ctx)
 
 	var exists bool
 	err = tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM orders WHERE id = $1 FOR UPDATE)", order.ID).Scan(&exists)
 	if err != nil {
-		return fmt.Errorf("ошибка проверки существования заказа: %w", err)
+		return model.Order{}, fmt.Errorf("ошибка проверки существования заказа: %w", err)
 	}
 	if exists {
-		return fmt.Errorf("%w: %d", ErrOrderAlreadyExists, order.ID)
+		return model.Order{}, fmt.Errorf("%w: %d", ErrOrderAlreadyExists, order.ID)
+	}
+
+	err = tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM pickup_points WHERE id = $1)", order.PickupPointID).Scan(&exists)
+	if err != nil {
+		return model.Order{}, fmt.Errorf("ошибка проверки существования ПВЗ: %w", err)
+	}
+	if !exists {
+		return model.Order{}, fmt.Errorf("%w: %d", ErrPickupPointNotFound, order.PickupPointID)
 	}
 
+	cells, err := lockCellOccupancy(ctx, tx, []int64{order.PickupPointID})
+	if err != nil {
+		return model.Order{}, err
+	}
+
+	created := []model.Order{order}
+	if err = assignCells(created, cells); err != nil {
+		return model.Order{}, err
+	}
+	order = created[0]
+
 	_, err = tx.Exec(ctx, `
         INSERT INTO orders 
-        (id, customer_id, state_id, weight, cost, package_type_id, wrapper_type_id, deadline_at, updated_at, delivered_at, returned_at) 
+        (id, customer_id, state_id, weight, cost, package_type_id, wrapper_type_id, deadline_at, updated_at, delivered_at, returned_at, pickup_point_id, payment_mode, storage_cell_id, import_job_id) 
         VALUES (
         $1, 
         $2, 
@@ -77,7 +101,11 @@ func (r *PostgresOrderRepository) Create(ctx context.Context, order model.Order)
         $8, 
         $9, 
         $10, 
-        $11)`,
+        $11,
+        $12,
+        $13,
+        $14,
+        $15)`,
 		order.ID,
 		order.CustomerID,
 		string(order.State),
@@ -89,15 +117,32 @@ func (r *PostgresOrderRepository) Create(ctx context.Context, order model.Order)
 		order.UpdatedAt,
 		order.DeliveredAt,
 		order.ReturnedAt,
+		order.PickupPointID,
+		getPaymentModeStr(order.PaymentMode),
+		order.StorageCellID,
+		order.ImportJobID,
 	)
 	if err != nil {
-		return fmt.Errorf("ошибка добавления заказа: %w", err)
+		return model.Order{}, fmt.Errorf("ошибка добавления заказа: %w", err)
 	}
 
-	return tx.Commit(ctx)
+	if err = insertOrderItems(ctx, tx, order.Items); err != nil {
+		return model.Order{}, err
+	}
+
+	if err = insertTransition(ctx, tx, transition); err != nil {
+		return model.Order{}, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return model.Order{}, err
+	}
+
+	return order, nil
 }
 
-// Update обновляет существующий заказ в базе данных
+// Update обновляет существующий заказ в базе данных.
+// Если версия заказа в базе отличается от order.Version, возвращается ErrConcurrentModification.
 func (r *PostgresOrderRepository) Update(ctx context.Context, order model.Order) error {
 	tx, err := r.pool.Begin(ctx)
 	if err != nil {
@@ -105,66 +150,73 @@ func (r *PostgresOrderRepository) Update(ctx context.Context, order model.Order)
 	}
 	defer tx.Rollback(ctx)
 
-	var exists bool
-	err = tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM orders WHERE id = $1 FOR UPDATE)", order.ID).Scan(&exists)
-	if err != nil {
-		return fmt.Errorf("ошибка блокировки заказа: %w", err)
+	if err = lockOrderVersion(ctx, tx, order.ID, order.Version); err != nil {
+		return err
 	}
-	if !exists {
-		return fmt.Errorf("%w: %d", ErrOrderNotFound, order.ID)
+
+	if err = updateOrder(ctx, tx, order); err != nil {
+		return err
 	}
 
-	commandTag, err := tx.Exec(ctx, `
-        UPDATE orders SET 
-        customer_id = $2, 
-        state_id = (SELECT id FROM order_states WHERE name = $3), 
-        weight = $4, 
-        cost = $5, 
-        package_type_id = (SELECT id FROM package_types WHERE name = $6), 
-        wrapper_type_id = (SELECT id FROM wrapper_types WHERE name = $7), 
-        deadline_at = $8, 
-        updated_at = $9, 
-        delivered_at = $10, 
-        returned_at = $11
-        WHERE id = $1`,
-		order.ID,
-		order.CustomerID,
-		string(order.State),
-		order.Weight,
-		order.Cost,
-		getPackageTypeStr(order.PackageType),
-		getWrapperTypeStr(order.Wrapper),
-		order.DeadlineAt,
-		order.UpdatedAt,
-		order.DeliveredAt,
-		order.ReturnedAt)
+	return tx.Commit(ctx)
+}
 
+// UpdateState сохраняет заказ с новым статусом и записывает переход в историю в одной транзакции.
+// Если версия заказа в базе отличается от order.Version, возвращается ErrConcurrentModification.
+func (r *PostgresOrderRepository) UpdateState(ctx context.Context, order model.Order, transition model.OrderStateTransition) error {
+	tx, err := r.pool.Begin(ctx)
 	if err != nil {
-		return fmt.Errorf("ошибка обновления заказа: %w", err)
+		return fmt.Errorf("%w: %w", ErrTransactionStartError, err)
 	}
+	defer tx.Rollback(ctx)
 
-	if commandTag.RowsAffected() == 0 {
-		return fmt.Errorf("%w: %d", ErrOrderNotFound, order.ID)
+	if err = lockOrderVersion(ctx, tx, order.ID, order.Version); err != nil {
+		return err
+	}
+
+	if err = updateOrder(ctx, tx, order); err != nil {
+		return err
+	}
+
+	if err = insertTransition(ctx, tx, transition); err != nil {
+		return err
 	}
 
 	return tx.Commit(ctx)
 }
 
-// Delete удаляет заказ по ID
-func (r *PostgresOrderRepository) Delete(ctx context.Context, id int64) error {
+// UpdateStates изменяет статусы нескольких заказов в одной транзакции и записывает переходы в историю.
+// Если версия хотя бы одного заказа в базе отличается от переданной, не изменяется ни один заказ
+// и возвращается ErrConcurrentModification.
+func (r *PostgresOrderRepository) UpdateStates(ctx context.Context, orders []model.Order, transitions []model.OrderStateTransition) error {
+	if len(orders) != len(transitions) {
+		return fmt.Errorf("количество заказов (%d) не совпадает с количеством переходов (%d)", len(orders), len(transitions))
+	}
+
 	tx, err := r.pool.Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("%w: %w", ErrTransactionStartError, err)
 	}
 	defer tx.Rollback(ctx)
 
-	var exists bool
-	err = tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM orders WHERE id = $1 FOR UPDATE)", id).Scan(&exists)
+	if err = updateStates(ctx, tx, orders, transitions); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Delete удаляет заказ по ID.
+// Если версия заказа в базе отличается от version, возвращается ErrConcurrentModification.
+func (r *PostgresOrderRepository) Delete(ctx context.Context, id, version int64) error {
+	tx, err := r.pool.Begin(ctx)
 	if err != nil {
-		return fmt.Errorf("ошибка блокировки заказа: %w", err)
+		return fmt.Errorf("%w: %w", ErrTransactionStartError, err)
 	}
-	if !exists {
-		return fmt.Errorf("%w: %d", ErrOrderNotFound, id)
+	defer tx.Rollback(ctx)
+
+	if err = lockOrderVersion(ctx, tx, id, version); err != nil {
+		return err
 	}
 
 	commandTag, err := tx.Exec(ctx, "DELETE FROM orders WHERE id = $1", id)
@@ -179,13 +231,24 @@ func (r *PostgresOrderRepository) Delete(ctx context.Context, id int64) error {
 	return tx.Commit(ctx)
 }
 
-// GetByID возвращает заказ по ID
+// ImportedByJob проверяет, что заказ создан задачей импорта jobID
+func (r *PostgresOrderRepository) ImportedByJob(ctx context.Context, orderID, jobID int64) (bool, error) {
+	var imported bool
+	err := r.pool.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM orders WHERE id = $1 AND import_job_id = $2)", orderID, jobID).Scan(&imported)
+	if err != nil {
+		return false, fmt.Errorf("ошибка проверки задачи импорта заказа: %w", err)
+	}
+
+	return imported, nil
+}
+
+// GetByID возвращает заказ по ID вместе с товарами
 func (r *PostgresOrderRepository) GetByID(ctx context.Context, id int64) (model.Order, error) {
 	var order model.Order
 	err := pgxscan.Get(ctx, r.pool, &order, `
         SELECT 
             o.id, 
-			o.customer_id, 
+			o.customer_id, o.pickup_point_id, 
             os.name AS state, 
             o.weight, 
 			o.cost, 
@@ -194,7 +257,8 @@ func (r *PostgresOrderRepository) GetByID(ctx context.Context, id int64) (model.
             o.deadline_at, 
 			o.updated_at, 
 			o.delivered_at, 
-			o.returned_at
+			o.returned_at, 
+			o.storage_cell_id, o.version, o.payment_mode
         FROM orders o
         JOIN order_states os ON o.state_id = os.id
         LEFT JOIN package_types pt ON o.package_type_id = pt.id
@@ -208,15 +272,20 @@ func (r *PostgresOrderRepository) GetByID(ctx context.Context, id int64) (model.
 		return model.Order{}, fmt.Errorf("ошибка поиска заказа: %w", err)
 	}
 
+	if order.Items, err = r.ListItems(ctx, id); err != nil {
+		return model.Order{}, err
+	}
+
 	return order, nil
 }
 
-// List возвращает список заказов с возможностью поиска
-func (r *PostgresOrderRepository) List(ctx context.Context, searchTerm string) ([]model.Order, error) {
+// List возвращает список заказов с возможностью поиска.
+// Если pickupPointID больше 0, возвращаются только заказы этого ПВЗ.
+func (r *PostgresOrderRepository) List(ctx context.Context, pickupPointID int64, searchTerm string) ([]model.Order, error) {
 	var orders []model.Order
 	query := `
         SELECT 
-            o.id, o.customer_id, 
+            o.id, o.customer_id, o.pickup_point_id, 
             os.name as state, 
             o.weight, o.cost, 
             pt.name as package_type, 
@@ -224,7 +293,8 @@ func (r *PostgresOrderRepository) List(ctx context.Context, searchTerm string) (
             o.deadline_at, 
 			o.updated_at, 
 			o.delivered_at, 
-			o.returned_at
+			o.returned_at, 
+			o.storage_cell_id, o.version, o.payment_mode
         FROM orders o
         JOIN order_states os ON o.state_id = os.id
         LEFT JOIN package_types pt ON o.package_type_id = pt.id
@@ -237,6 +307,11 @@ func (r *PostgresOrderRepository) List(ctx context.Context, searchTerm string) (
 		args = append(args, "%"+searchTerm+"%")
 	}
 
+	if pickupPointID > 0 {
+		query += fmt.Sprintf(" AND o.pickup_point_id = $%d", len(args)+1)
+		args = append(args, pickupPointID)
+	}
+
 	query += " ORDER BY o.updated_at DESC"
 
 	var err error
@@ -256,49 +331,17 @@ func (r *PostgresOrderRepository) List(ctx context.Context, searchTerm string) (
 	return orders, nil
 }
 
-// ListWithCursor выполняет выборку заказов с использованием курсорной пагинации по ID
-func (r *PostgresOrderRepository) ListWithCursor(ctx context.Context, cursorID int64, limit int, customerID int64, filterPVZ bool, searchTerm string) ([]model.Order, error) {
+// ListWithCursor выполняет выборку заказов с использованием курсорной пагинации по ID.
+// Если pickupPointID больше 0, возвращаются только заказы этого ПВЗ.
+func (r *PostgresOrderRepository) ListWithCursor(ctx context.Context, cursorID int64, limit int, customerID, pickupPointID int64, filterPVZ bool, searchTerm string) ([]model.Order, error) {
 	var orders []model.Order
-	var queryArgs []any
 
-	query := `
-        SELECT 
-            o.id, 
-			o.customer_id, 
-            os.name as state, 
-            o.weight, 
-			o.cost, 
-            pt.name as package_type, 
-            wt.name as wrapper, 
-            o.deadline_at, 
-            o.updated_at, 
-            o.delivered_at, 
-            o.returned_at
-        FROM orders o
-        JOIN order_states os ON o.state_id = os.id
-        LEFT JOIN package_types pt ON o.package_type_id = pt.id
-        LEFT JOIN wrapper_types wt ON o.wrapper_type_id = wt.id
-        WHERE 1=1`
-
-	// Добавляем поиск по тексту
-	if searchTerm != "" {
-		query += " AND (CAST(o.id AS TEXT) LIKE $1 OR CAST(o.customer_id AS TEXT) LIKE $1)"
-		queryArgs = append(queryArgs, "%"+searchTerm+"%")
-	}
-
-	// Условия фильтрации по заказчику
-	if customerID > 0 {
-		paramNum := len(queryArgs) + 1
-		query += fmt.Sprintf(" AND o.customer_id = $%d", paramNum)
-		queryArgs = append(queryArgs, customerID)
-	}
-
-	// Условие фильтрации для заказов, доступных в ПВЗ
-	if filterPVZ {
-		paramNum := len(queryArgs) + 1
-		query += fmt.Sprintf(" AND os.name = 'accepted' AND o.deadline_at > $%d", paramNum)
-		queryArgs = append(queryArgs, time.Now())
-	}
+	query, queryArgs := orderFilterQuery(model.OrderFilter{
+		CustomerID:    customerID,
+		PickupPointID: pickupPointID,
+		InPickupPoint: filterPVZ,
+		Search:        searchTerm,
+	})
 
 	// Условие для курсорной пагинации по ID
 	if cursorID > 0 {
@@ -323,14 +366,16 @@ func (r *PostgresOrderRepository) ListWithCursor(ctx context.Context, cursorID i
 	return orders, nil
 }
 
-// ListReturnsWithCursor выполняет выборку возвращенных заказов с использованием курсорной пагинации по ID
-func (r *PostgresOrderRepository) ListReturnsWithCursor(ctx context.Context, cursorID int64, limit int, searchTerm string) ([]model.Order, error) {
+// ListReturnsWithCursor выполняет выборку возвращенных заказов с использованием курсорной пагинации по ID.
+// Если pickupPointID больше 0, возвращаются только возвраты этого ПВЗ.
+// Непустой filter оставляет только возвраты с указанными причиной и состоянием заказа.
+func (r *PostgresOrderRepository) ListReturnsWithCursor(ctx context.Context, cursorID int64, limit int, pickupPointID int64, searchTerm string, filter model.ReturnFilter) ([]model.Order, error) {
 	var orders []model.Order
 	var queryArgs []any
 
 	query := `
         SELECT 
-            o.id, o.customer_id, 
+            o.id, o.customer_id, o.pickup_point_id, 
             os.name as state, 
             o.weight, o.cost, 
             pt.name as package_type, 
@@ -338,7 +383,8 @@ func (r *PostgresOrderRepository) ListReturnsWithCursor(ctx context.Context, cur
             o.deadline_at, 
             o.updated_at, 
             o.delivered_at, 
-            o.returned_at
+            o.returned_at, 
+            o.storage_cell_id, o.version, o.payment_mode
         FROM orders o
         JOIN order_states os ON o.state_id = os.id
         LEFT JOIN package_types pt ON o.package_type_id = pt.id
@@ -351,6 +397,17 @@ func (r *PostgresOrderRepository) ListReturnsWithCursor(ctx context.Context, cur
 		queryArgs = append(queryArgs, "%"+searchTerm+"%")
 	}
 
+	// Условия фильтрации по причине возврата и состоянию заказа
+	condition, queryArgs := returnFilterCondition(filter, queryArgs)
+	query += condition
+
+	// Условие фильтрации по ПВЗ
+	if pickupPointID > 0 {
+		paramNum := len(queryArgs) + 1
+		query += fmt.Sprintf(" AND o.pickup_point_id = $%d", paramNum)
+		queryArgs = append(queryArgs, pickupPointID)
+	}
+
 	// Условие для курсорной пагинации по ID
 	if cursorID > 0 {
 		paramNum := len(queryArgs) + 1
@@ -380,7 +437,7 @@ func (r *PostgresOrderRepository) ListActual(ctx context.Context) ([]model.Order
 	err := pgxscan.Select(ctx, r.pool, &orders, `
 		SELECT 
 			o.id, 
-			o.customer_id, 
+			o.customer_id, o.pickup_point_id, 
 			os.name AS state, 
 			o.weight, 
 			o.cost, 
@@ -389,7 +446,8 @@ func (r *PostgresOrderRepository) ListActual(ctx context.Context) ([]model.Order
 			o.deadline_at, 
 			o.updated_at, 
 			o.delivered_at, 
-			o.returned_at
+			o.returned_at, 
+			o.storage_cell_id, o.version, o.payment_mode
 		FROM orders o
 		JOIN order_states os ON o.state_id = os.id
 		LEFT JOIN package_types pt ON o.package_type_id = pt.id
@@ -406,3 +464,137 @@ func (r *PostgresOrderRepository) ListActual(ctx context.Context) ([]model.Order
 
 	return orders, nil
 }
+
+// ListTransitions возвращает историю смены статусов заказа в хронологическом порядке
+func (r *PostgresOrderRepository) ListTransitions(ctx context.Context, orderID int64) ([]model.OrderStateTransition, error) {
+	var transitions []model.OrderStateTransition
+	err := pgxscan.Select(ctx, r.pool, &transitions, `
+        SELECT
+            t.id,
+            t.order_id,
+            fs.name AS from_state,
+            ts.name AS to_state,
+            t.changed_by,
+            t.changed_at
+        FROM order_state_transitions t
+        LEFT JOIN order_states fs ON t.from_state_id = fs.id
+        JOIN order_states ts ON t.to_state_id = ts.id
+        WHERE t.order_id = $1
+        ORDER BY t.changed_at, t.id`, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения истории статусов заказа: %w", err)
+	}
+
+	return transitions, nil
+}
+
+// lockOrderVersion блокирует заказ до конца транзакции tx и сверяет его версию с ожидаемой
+func lockOrderVersion(ctx context.Context, tx pgx.Tx, id, version int64) error {
+	var current int64
+	err := tx.QueryRow(ctx, "SELECT version FROM orders WHERE id = $1 FOR UPDATE", id).Scan(&current)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%w: %d", ErrOrderNotFound, id)
+		}
+		return fmt.Errorf("ошибка блокировки заказа: %w", err)
+	}
+
+	if current != version {
+		return fmt.Errorf("%w: заказ %d, версия %d, ожидалась %d", ErrConcurrentModification, id, current, version)
+	}
+
+	return nil
+}
+
+// updateOrder сохраняет поля заказа и статусы его товаров в рамках транзакции tx и увеличивает версию заказа
+func updateOrder(ctx context.Context, tx pgx.Tx, order model.Order) error {
+	commandTag, err := tx.Exec(ctx, `
+        UPDATE orders SET 
+        customer_id = $2, 
+        state_id = (SELECT id FROM order_states WHERE name = $3), 
+        weight = $4, 
+        cost = $5, 
+        package_type_id = (SELECT id FROM package_types WHERE name = $6), 
+        wrapper_type_id = (SELECT id FROM wrapper_types WHERE name = $7), 
+        deadline_at = $8, 
+        updated_at = $9, 
+        delivered_at = $10, 
+        returned_at = $11, 
+        pickup_point_id = $12, 
+        storage_cell_id = $13, 
+        version = version + 1
+        WHERE id = $1 AND version = $14`,
+		order.ID,
+		order.CustomerID,
+		string(order.State),
+		order.Weight,
+		order.Cost,
+		getPackageTypeStr(order.PackageType),
+		getWrapperTypeStr(order.Wrapper),
+		order.DeadlineAt,
+		order.UpdatedAt,
+		order.DeliveredAt,
+		order.ReturnedAt,
+		order.PickupPointID,
+		order.StorageCellID,
+		order.Version)
+
+	if err != nil {
+		return fmt.Errorf("ошибка обновления заказа: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: заказ %d", ErrConcurrentModification, order.ID)
+	}
+
+	return updateOrderItems(ctx, tx, order.Items)
+}
+
+// updateStates блокирует заказы в транзакции, проверяя их версии, и записывает новые статусы вместе с переходами
+func updateStates(ctx context.Context, tx pgx.Tx, orders []model.Order, transitions []model.OrderStateTransition) error {
+	// Заказы блокируются в порядке ID, чтобы параллельные транзакции не ждали друг друга по кругу
+	locked := make([]model.Order, len(orders))
+	copy(locked, orders)
+	sort.Slice(locked, func(i, j int) bool { return locked[i].ID < locked[j].ID })
+
+	for _, order := range locked {
+		if err := lockOrderVersion(ctx, tx, order.ID, order.Version); err != nil {
+			return err
+		}
+	}
+
+	for i, order := range orders {
+		if err := updateOrder(ctx, tx, order); err != nil {
+			return err
+		}
+
+		if err := insertTransition(ctx, tx, transitions[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertTransition записывает смену статуса заказа в рамках транзакции tx
+func insertTransition(ctx context.Context, tx pgx.Tx, transition model.OrderStateTransition) error {
+	_, err := tx.Exec(ctx, `
+        INSERT INTO order_state_transitions (order_id, from_state_id, to_state_id, changed_by, changed_at)
+        VALUES (
+        $1,
+        (SELECT id FROM order_states WHERE name = $2),
+        (SELECT id FROM order_states WHERE name = $3),
+        $4,
+        $5)`,
+		transition.OrderID,
+		getOrderStateStr(transition.FromState),
+		string(transition.ToState),
+		transition.ChangedBy,
+		transition.ChangedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка записи смены статуса заказа: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,253 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+// ordersImportColumns - поля временной таблицы, в которую копируются принимаемые заказы
+var ordersImportColumns = []string{
+	"id", "customer_id", "pickup_point_id", "state", "weight", "cost", "package_type", "wrapper_type",
+	"deadline_at", "updated_at", "storage_cell_id", "version", "payment_mode", "import_job_id", "changed_by", "changed_at",
+}
+
+// CreateBatch создает заказы одной транзакцией и записывает их начальные статусы в историю переходов.
+// Заказы копируются через COPY во временную таблицу и переносятся в orders одним запросом.
+// Заказы размещаются по свободным ячейкам своих ПВЗ по тем же правилам, что и в Create,
+// заказы ПВЗ без ячеек принимаются без ячейки.
+// Если хотя бы один заказ уже существует, его ПВЗ не найден или для него нет подходящей ячейки,
+// не создается ни один заказ. Возвращает заказы с заполненными ячейками хранения.
+func (r *PostgresOrderRepository) CreateBatch(ctx context.Context, orders []model.Order, transitions []model.OrderStateTransition) ([]model.Order, error) {
+	if len(orders) != len(transitions) {
+		return nil, fmt.Errorf("количество заказов (%d) не совпадает с количеством переходов (%d)", len(orders), len(transitions))
+	}
+	if len(orders) == 0 {
+		return orders, nil
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrTransactionStartError, err)
+	}
+	defer tx.Rollback(ctx)
+
+	pickupPointIDs := uniquePickupPointIDs(orders)
+	if err = lockPickupPoints(ctx, tx, pickupPointIDs); err != nil {
+		return nil, err
+	}
+
+	cells, err := lockCellOccupancy(ctx, tx, pickupPointIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	created := make([]model.Order, len(orders))
+	copy(created, orders)
+	if err = assignCells(created, cells); err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `
+        CREATE TEMP TABLE orders_import (
+            id BIGINT,
+            customer_id BIGINT,
+            pickup_point_id BIGINT,
+            state TEXT,
+            weight DOUBLE PRECISION,
+            cost DOUBLE PRECISION,
+            package_type TEXT,
+            wrapper_type TEXT,
+            deadline_at TIMESTAMP WITH TIME ZONE,
+            updated_at TIMESTAMP WITH TIME ZONE,
+            storage_cell_id BIGINT,
+            version BIGINT,
+            payment_mode TEXT,
+            import_job_id BIGINT,
+            changed_by BIGINT,
+            changed_at TIMESTAMP WITH TIME ZONE
+        ) ON COMMIT DROP`)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка создания временной таблицы импорта: %w", err)
+	}
+
+	_, err = tx.CopyFrom(ctx, pgx.Identifier{"orders_import"}, ordersImportColumns,
+		pgx.CopyFromSlice(len(created), func(i int) ([]any, error) {
+			order := created[i]
+			return []any{
+				order.ID,
+				order.CustomerID,
+				order.PickupPointID,
+				string(order.State),
+				order.Weight,
+				order.Cost,
+				getPackageTypeStr(order.PackageType),
+				getWrapperTypeStr(order.Wrapper),
+				order.DeadlineAt,
+				order.UpdatedAt,
+				order.StorageCellID,
+				order.Version,
+				getPaymentModeStr(order.PaymentMode),
+				order.ImportJobID,
+				transitions[i].ChangedBy,
+				transitions[i].ChangedAt,
+			}, nil
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка копирования заказов во временную таблицу: %w", err)
+	}
+
+	var existing []int64
+	err = pgxscan.Select(ctx, tx, &existing,
+		"SELECT s.id FROM orders_import s JOIN orders o ON o.id = s.id ORDER BY s.id LIMIT 10")
+	if err != nil {
+		return nil, fmt.Errorf("ошибка проверки существования заказов: %w", err)
+	}
+	if len(existing) > 0 {
+		return nil, fmt.Errorf("%w: %v", ErrOrderAlreadyExists, existing)
+	}
+
+	// ON CONFLICT защищает от заказов, созданных параллельно после проверки
+	commandTag, err := tx.Exec(ctx, `
+        INSERT INTO orders
+        (id, customer_id, state_id, weight, cost, package_type_id, wrapper_type_id, deadline_at, updated_at, pickup_point_id, storage_cell_id, version, payment_mode, import_job_id)
+        SELECT s.id, s.customer_id, st.id, s.weight, s.cost, pt.id, wt.id, s.deadline_at, s.updated_at, s.pickup_point_id, s.storage_cell_id, s.version, s.payment_mode, s.import_job_id
+        FROM orders_import s
+        JOIN order_states st ON st.name = s.state
+        LEFT JOIN package_types pt ON pt.name = s.package_type
+        LEFT JOIN wrapper_types wt ON wt.name = s.wrapper_type
+        ON CONFLICT (id) DO NOTHING`)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка добавления заказов: %w", err)
+	}
+	if commandTag.RowsAffected() != int64(len(created)) {
+		return nil, fmt.Errorf("%w: добавлено %d из %d", ErrOrderAlreadyExists, commandTag.RowsAffected(), len(created))
+	}
+
+	_, err = tx.Exec(ctx, `
+        INSERT INTO order_state_transitions (order_id, from_state_id, to_state_id, changed_by, changed_at)
+        SELECT s.id, NULL, st.id, s.changed_by, s.changed_at
+        FROM orders_import s
+        JOIN order_states st ON st.name = s.state`)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка записи смены статуса заказов: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// uniquePickupPointIDs возвращает ПВЗ заказов без повторов
+func uniquePickupPointIDs(orders []model.Order) []int64 {
+	seen := make(map[int64]struct{})
+	ids := make([]int64, 0)
+
+	for _, order := range orders {
+		if _, ok := seen[order.PickupPointID]; ok {
+			continue
+		}
+		seen[order.PickupPointID] = struct{}{}
+		ids = append(ids, order.PickupPointID)
+	}
+
+	return ids
+}
+
+// lockPickupPoints проверяет, что все ПВЗ существуют, и защищает их от удаления до конца транзакции
+func lockPickupPoints(ctx context.Context, tx pgx.Tx, ids []int64) error {
+	var found []int64
+	err := pgxscan.Select(ctx, tx, &found, "SELECT id FROM pickup_points WHERE id = ANY($1) FOR SHARE", ids)
+	if err != nil {
+		return fmt.Errorf("ошибка проверки существования ПВЗ: %w", err)
+	}
+
+	if len(found) == len(ids) {
+		return nil
+	}
+
+	exists := make(map[int64]struct{}, len(found))
+	for _, id := range found {
+		exists[id] = struct{}{}
+	}
+	for _, id := range ids {
+		if _, ok := exists[id]; !ok {
+			return fmt.Errorf("%w: %d", ErrPickupPointNotFound, id)
+		}
+	}
+
+	return nil
+}
+
+// lockCellOccupancy блокирует ячейки ПВЗ до конца транзакции и возвращает их заполненность
+// в порядке выбора ячейки: сначала специализированные, затем по стеллажу и коду
+func lockCellOccupancy(ctx context.Context, tx pgx.Tx, pickupPointIDs []int64) ([]model.CellOccupancy, error) {
+	_, err := tx.Exec(ctx, "SELECT id FROM storage_cells WHERE pickup_point_id = ANY($1) ORDER BY id FOR UPDATE", pickupPointIDs)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка блокировки ячеек ПВЗ: %w", err)
+	}
+
+	var cells []model.CellOccupancy
+	err = pgxscan.Select(ctx, tx, &cells, `
+        SELECT`+storageCellColumns+`,
+            COUNT(o.id) AS orders_count,
+            COALESCE(SUM(o.weight), 0) AS weight
+        FROM storage_cells sc
+        LEFT JOIN package_types pt ON sc.package_type_id = pt.id
+        LEFT JOIN orders o ON o.storage_cell_id = sc.id
+        WHERE sc.pickup_point_id = ANY($1)
+        GROUP BY sc.id, pt.name
+        ORDER BY sc.package_type_id IS NULL, sc.rack, sc.code`, pickupPointIDs)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения заполненности ячеек: %w", err)
+	}
+
+	return cells, nil
+}
+
+// assignCells размещает заказы по ячейкам их ПВЗ, учитывая уже размещенные в этой же операции заказы.
+// Подходят ячейки для упаковки заказа и универсальные ячейки, cells должны быть упорядочены по приоритету выбора.
+func assignCells(orders []model.Order, cells []model.CellOccupancy) error {
+	byPickupPoint := make(map[int64][]*model.CellOccupancy)
+	for i := range cells {
+		byPickupPoint[cells[i].PickupPointID] = append(byPickupPoint[cells[i].PickupPointID], &cells[i])
+	}
+
+	for i := range orders {
+		candidates, ok := byPickupPoint[orders[i].PickupPointID]
+		if !ok {
+			continue
+		}
+
+		var chosen *model.CellOccupancy
+		for _, cell := range candidates {
+			if cell.PackageType != nil && (orders[i].PackageType == nil || *cell.PackageType != *orders[i].PackageType) {
+				continue
+			}
+			if cell.OrdersCount >= cell.Capacity || cell.Weight+orders[i].Weight > cell.MaxWeight {
+				continue
+			}
+
+			chosen = cell
+			break
+		}
+
+		if chosen == nil {
+			return fmt.Errorf("%w: заказ %d", ErrNoFreeStorageCell, orders[i].ID)
+		}
+
+		chosen.OrdersCount++
+		chosen.Weight += orders[i].Weight
+
+		cellID := chosen.ID
+		orders[i].StorageCellID = &cellID
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+func TestAssignCells(t *testing.T) {
+	t.Parallel()
+
+	box := model.PackageBox
+	film := model.PackageFilm
+
+	cell := func(id, pickupPointID int64, packageType *model.PackageType, capacity, count int, maxWeight, weight float64) model.CellOccupancy {
+		return model.CellOccupancy{
+			StorageCell: model.StorageCell{
+				ID:            id,
+				PickupPointID: pickupPointID,
+				Capacity:      capacity,
+				MaxWeight:     maxWeight,
+				PackageType:   packageType,
+			},
+			OrdersCount: count,
+			Weight:      weight,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		orders        []model.Order
+		cells         []model.CellOccupancy
+		expectedCells []*int64
+		expectedErr   error
+	}{
+		{
+			name: "specialized cell first, then universal",
+			orders: []model.Order{
+				{ID: 1, PickupPointID: 1, PackageType: &box, Weight: 1},
+				{ID: 2, PickupPointID: 1, PackageType: &box, Weight: 1},
+				{ID: 3, PickupPointID: 1, PackageType: &film, Weight: 1},
+			},
+			cells: []model.CellOccupancy{
+				cell(10, 1, &box, 1, 0, 10, 0),
+				cell(20, 1, nil, 5, 0, 10, 0),
+			},
+			expectedCells: []*int64{ptr(int64(10)), ptr(int64(20)), ptr(int64(20))},
+		},
+		{
+			name: "weight limit counts orders of the same batch",
+			orders: []model.Order{
+				{ID: 1, PickupPointID: 1, Weight: 6},
+				{ID: 2, PickupPointID: 1, Weight: 6},
+			},
+			cells: []model.CellOccupancy{
+				cell(10, 1, nil, 5, 0, 10, 0),
+				cell(20, 1, nil, 5, 0, 10, 0),
+			},
+			expectedCells: []*int64{ptr(int64(10)), ptr(int64(20))},
+		},
+		{
+			name: "pickup point without cells",
+			orders: []model.Order{
+				{ID: 1, PickupPointID: 2, Weight: 1},
+			},
+			cells: []model.CellOccupancy{
+				cell(10, 1, nil, 5, 0, 10, 0),
+			},
+			expectedCells: []*int64{nil},
+		},
+		{
+			name: "no free cell",
+			orders: []model.Order{
+				{ID: 1, PickupPointID: 1, Weight: 1},
+			},
+			cells: []model.CellOccupancy{
+				cell(10, 1, nil, 1, 1, 10, 1),
+			},
+			expectedErr: ErrNoFreeStorageCell,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := assignCells(tt.orders, tt.cells)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			for i, order := range tt.orders {
+				assert.Equal(t, tt.expectedCells[i], order.StorageCellID, "заказ %d", order.ID)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
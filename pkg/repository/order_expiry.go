@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+// ListExpired возвращает до limit принятых заказов, срок хранения которых истек к моменту now,
+// в порядке окончания срока хранения
+func (r *PostgresOrderRepository) ListExpired(ctx context.Context, now time.Time, limit int) ([]model.Order, error) {
+	var orders []model.Order
+
+	query, queryArgs := orderFilterQuery(model.OrderFilter{States: []model.OrderState{model.StateAccepted}})
+
+	paramNum := len(queryArgs) + 1
+	query += fmt.Sprintf(" AND o.deadline_at <= $%d ORDER BY o.deadline_at, o.id LIMIT $%d", paramNum, paramNum+1)
+	queryArgs = append(queryArgs, now, limit)
+
+	err := pgxscan.Select(ctx, r.pool, &orders, query, queryArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при поиске заказов с истекшим сроком хранения: %w", err)
+	}
+
+	return orders, nil
+}
+
+// ListCourierReturnsWithCursor выполняет выборку заказов, которые нужно вернуть курьеру, с курсорной пагинацией по ID:
+// заказов с истекшим сроком хранения и принятых заказов, срок хранения которых истекает раньше until.
+// Если pickupPointID больше 0, возвращаются только заказы этого ПВЗ.
+func (r *PostgresOrderRepository) ListCourierReturnsWithCursor(ctx context.Context, cursorID int64, limit int, pickupPointID int64, until time.Time) ([]model.Order, error) {
+	var orders []model.Order
+
+	query, queryArgs := orderFilterQuery(model.OrderFilter{PickupPointID: pickupPointID})
+
+	paramNum := len(queryArgs) + 1
+	query += fmt.Sprintf(" AND (os.name = 'expired' OR (os.name = 'accepted' AND o.deadline_at < $%d))", paramNum)
+	queryArgs = append(queryArgs, until)
+
+	// Условие для курсорной пагинации по ID
+	if cursorID > 0 {
+		paramNum = len(queryArgs) + 1
+		query += fmt.Sprintf(" AND o.id < $%d", paramNum)
+		queryArgs = append(queryArgs, cursorID)
+	}
+
+	// Сортировка по ID в порядке убывания
+	query += " ORDER BY o.id DESC"
+
+	// Добавление лимита
+	paramNum = len(queryArgs) + 1
+	query += fmt.Sprintf(" LIMIT $%d", paramNum)
+	queryArgs = append(queryArgs, limit)
+
+	err := pgxscan.Select(ctx, r.pool, &orders, query, queryArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении списка заказов для возврата курьеру: %w", err)
+	}
+
+	return orders, nil
+}
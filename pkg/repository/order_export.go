@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+// orderFilterQuery возвращает запрос выборки заказов с условиями фильтра и его параметры.
+// К запросу можно добавлять условия через AND, сортировку и лимит.
+func orderFilterQuery(filter model.OrderFilter) (string, []any) {
+	var queryArgs []any
+
+	query := `
+        SELECT 
+            o.id, 
+			o.customer_id, o.pickup_point_id, 
+            os.name as state, 
+            o.weight, 
+			o.cost, 
+            pt.name as package_type, 
+            wt.name as wrapper, 
+            o.deadline_at, 
+            o.updated_at, 
+            o.delivered_at, 
+            o.returned_at, 
+            o.storage_cell_id, o.version, o.payment_mode
+        FROM orders o
+        JOIN order_states os ON o.state_id = os.id
+        LEFT JOIN package_types pt ON o.package_type_id = pt.id
+        LEFT JOIN wrapper_types wt ON o.wrapper_type_id = wt.id
+        WHERE 1=1`
+
+	// Добавляем поиск по тексту
+	if filter.Search != "" {
+		query += " AND (CAST(o.id AS TEXT) LIKE $1 OR CAST(o.customer_id AS TEXT) LIKE $1)"
+		queryArgs = append(queryArgs, "%"+filter.Search+"%")
+	}
+
+	// Условия фильтрации по заказчику
+	if filter.CustomerID > 0 {
+		paramNum := len(queryArgs) + 1
+		query += fmt.Sprintf(" AND o.customer_id = $%d", paramNum)
+		queryArgs = append(queryArgs, filter.CustomerID)
+	}
+
+	// Условие фильтрации по ПВЗ
+	if filter.PickupPointID > 0 {
+		paramNum := len(queryArgs) + 1
+		query += fmt.Sprintf(" AND o.pickup_point_id = $%d", paramNum)
+		queryArgs = append(queryArgs, filter.PickupPointID)
+	}
+
+	// Условие фильтрации для заказов, которые сейчас хранятся в ПВЗ и доступны к выдаче
+	if filter.InPickupPoint {
+		paramNum := len(queryArgs) + 1
+		query += fmt.Sprintf(" AND os.name = 'accepted' AND o.deadline_at > $%d", paramNum)
+		queryArgs = append(queryArgs, time.Now())
+	}
+
+	// Условие фильтрации по статусам
+	if len(filter.States) > 0 {
+		states := make([]string, 0, len(filter.States))
+		for _, state := range filter.States {
+			states = append(states, string(state))
+		}
+
+		paramNum := len(queryArgs) + 1
+		query += fmt.Sprintf(" AND os.name = ANY($%d)", paramNum)
+		queryArgs = append(queryArgs, states)
+	}
+
+	// Условия фильтрации по времени последнего изменения
+	if filter.UpdatedFrom != nil {
+		paramNum := len(queryArgs) + 1
+		query += fmt.Sprintf(" AND o.updated_at >= $%d", paramNum)
+		queryArgs = append(queryArgs, *filter.UpdatedFrom)
+	}
+	if filter.UpdatedTo != nil {
+		paramNum := len(queryArgs) + 1
+		query += fmt.Sprintf(" AND o.updated_at < $%d", paramNum)
+		queryArgs = append(queryArgs, *filter.UpdatedTo)
+	}
+
+	return query, queryArgs
+}
+
+// Export передает в fn заказы, подходящие под фильтр, в порядке убывания ID.
+// Заказы читаются из БД по мере обработки и не загружаются в память целиком.
+// Ошибка fn прерывает выгрузку и возвращается без изменений.
+func (r *PostgresOrderRepository) Export(ctx context.Context, filter model.OrderFilter, fn func(order model.Order) error) error {
+	query, queryArgs := orderFilterQuery(filter)
+	query += " ORDER BY o.id DESC"
+
+	rows, err := r.pool.Query(ctx, query, queryArgs...)
+	if err != nil {
+		return fmt.Errorf("ошибка при выгрузке заказов: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		order, err := pgx.RowToStructByName[model.Order](rows)
+		if err != nil {
+			return fmt.Errorf("ошибка чтения заказа при выгрузке: %w", err)
+		}
+
+		if err = fn(order); err != nil {
+			return err
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("ошибка при выгрузке заказов: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+func TestOrderFilterQuery(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		filter             model.OrderFilter
+		expectedConditions []string
+		expectedArgs       []any
+	}{
+		{
+			name: "without filter",
+		},
+		{
+			name: "all conditions",
+			filter: model.OrderFilter{
+				CustomerID:    10,
+				PickupPointID: 3,
+				States:        []model.OrderState{model.StateAccepted, model.StateExpired},
+				UpdatedFrom:   &from,
+				UpdatedTo:     &to,
+				Search:        "42",
+			},
+			expectedConditions: []string{
+				"LIKE $1",
+				"o.customer_id = $2",
+				"o.pickup_point_id = $3",
+				"os.name = ANY($4)",
+				"o.updated_at >= $5",
+				"o.updated_at < $6",
+			},
+			expectedArgs: []any{"%42%", int64(10), int64(3), []string{"accepted", "expired"}, from, to},
+		},
+		{
+			name:               "states only",
+			filter:             model.OrderFilter{States: []model.OrderState{model.StateDelivered}},
+			expectedConditions: []string{"os.name = ANY($1)"},
+			expectedArgs:       []any{[]string{"delivered"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			query, args := orderFilterQuery(tt.filter)
+
+			for _, condition := range tt.expectedConditions {
+				assert.Contains(t, query, condition)
+			}
+			assert.Equal(t, tt.expectedArgs, args)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+// Extend сохраняет заказ с новым сроком хранения и записывает продление в одной транзакции.
+// Если версия заказа в базе отличается от order.Version, возвращается ErrConcurrentModification.
+func (r *PostgresOrderRepository) Extend(ctx context.Context, order model.Order, extension model.OrderExtension) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrTransactionStartError, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err = lockOrderVersion(ctx, tx, order.ID, order.Version); err != nil {
+		return err
+	}
+
+	if err = updateOrder(ctx, tx, order); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+        INSERT INTO order_extensions (order_id, days, fee, previous_deadline_at, deadline_at, extended_by, extended_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		extension.OrderID,
+		extension.Days,
+		extension.Fee,
+		extension.PreviousDeadlineAt,
+		extension.DeadlineAt,
+		extension.ExtendedBy,
+		extension.ExtendedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка записи продления срока хранения заказа: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ListExtensions возвращает продления срока хранения заказа в хронологическом порядке
+func (r *PostgresOrderRepository) ListExtensions(ctx context.Context, orderID int64) ([]model.OrderExtension, error) {
+	var extensions []model.OrderExtension
+	err := pgxscan.Select(ctx, r.pool, &extensions, `
+        SELECT id, order_id, days, fee, previous_deadline_at, deadline_at, extended_by, extended_at
+        FROM order_extensions
+        WHERE order_id = $1
+        ORDER BY extended_at, id`, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения продлений срока хранения заказа: %w", err)
+	}
+
+	return extensions, nil
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+// ErrOrderItemNotFound - товар не найден в заказе
+var ErrOrderItemNotFound = errors.New("товар не найден в заказе")
+
+// ListItems возвращает товары заказа в порядке добавления
+func (r *PostgresOrderRepository) ListItems(ctx context.Context, orderID int64) ([]model.OrderItem, error) {
+	var items []model.OrderItem
+	err := pgxscan.Select(ctx, r.pool, &items, `
+        SELECT id, order_id, name, weight, cost, state, updated_at
+        FROM order_items
+        WHERE order_id = $1
+        ORDER BY id`, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения товаров заказа: %w", err)
+	}
+
+	return items, nil
+}
+
+// insertOrderItems записывает товары заказа в рамках транзакции tx.
+// ID записанных товаров проставляются в items.
+func insertOrderItems(ctx context.Context, tx pgx.Tx, items []model.OrderItem) error {
+	for i := range items {
+		err := tx.QueryRow(ctx, `
+            INSERT INTO order_items (order_id, name, weight, cost, state, updated_at)
+            VALUES ($1, $2, $3, $4, $5, $6)
+            RETURNING id`,
+			items[i].OrderID,
+			items[i].Name,
+			items[i].Weight,
+			items[i].Cost,
+			string(items[i].State),
+			items[i].UpdatedAt,
+		).Scan(&items[i].ID)
+		if err != nil {
+			return fmt.Errorf("ошибка добавления товара заказа: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// updateOrderItems сохраняет статусы товаров заказа в рамках транзакции tx
+func updateOrderItems(ctx context.Context, tx pgx.Tx, items []model.OrderItem) error {
+	for _, item := range items {
+		commandTag, err := tx.Exec(ctx, `
+            UPDATE order_items SET state = $3, updated_at = $4
+            WHERE id = $1 AND order_id = $2`,
+			item.ID,
+			item.OrderID,
+			string(item.State),
+			item.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("ошибка обновления товара заказа: %w", err)
+		}
+		if commandTag.RowsAffected() == 0 {
+			return fmt.Errorf("%w: товар %d заказа %d", ErrOrderItemNotFound, item.ID, item.OrderID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+// ReturnOrders сохраняет возвращенные заказы, переходы статусов, сведения о возвратах и возвраты денег в одной транзакции.
+// Если версия хотя бы одного заказа в базе отличается от переданной, не изменяется ни один заказ
+// и возвращается ErrConcurrentModification. ID записанных возвратов проставляются в returns,
+// возврат денег связывается с возвратом своего заказа, ID записанных возвратов денег проставляются в refunds.
+func (r *PostgresOrderRepository) ReturnOrders(ctx context.Context, orders []model.Order, transitions []model.OrderStateTransition, returns []model.OrderReturn, refunds []model.Refund) error {
+	if len(orders) != len(transitions) || len(orders) != len(returns) {
+		return fmt.Errorf("количество заказов (%d) не совпадает с количеством переходов (%d) или возвратов (%d)",
+			len(orders), len(transitions), len(returns))
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrTransactionStartError, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err = updateStates(ctx, tx, orders, transitions); err != nil {
+		return err
+	}
+
+	returnIDs := make(map[int64]int64, len(returns))
+	for i := range returns {
+		if returns[i].ID, err = insertReturn(ctx, tx, returns[i]); err != nil {
+			return err
+		}
+		returnIDs[returns[i].OrderID] = returns[i].ID
+	}
+
+	for i := range refunds {
+		returnID, ok := returnIDs[refunds[i].OrderID]
+		if !ok {
+			return fmt.Errorf("возврат денег по заказу %d без возврата заказа", refunds[i].OrderID)
+		}
+		refunds[i].ReturnID = returnID
+
+		if refunds[i].ID, err = insertRefund(ctx, tx, refunds[i]); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ListOrderReturns возвращает сведения о возвратах заказов с переданными ID
+func (r *PostgresOrderRepository) ListOrderReturns(ctx context.Context, orderIDs []int64) ([]model.OrderReturn, error) {
+	var returns []model.OrderReturn
+	err := pgxscan.Select(ctx, r.pool, &returns, `
+        SELECT id, order_id, customer_id, reason, comment, condition, photos, item_ids,
+               policy_id, policy, fee, refund, requires_inspection, returned_by, returned_at
+        FROM order_returns
+        WHERE order_id = ANY($1)`, orderIDs)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения сведений о возвратах заказов: %w", err)
+	}
+
+	return returns, nil
+}
+
+// insertReturn записывает сведения о возврате заказа в рамках транзакции tx и возвращает ID записи
+func insertReturn(ctx context.Context, tx pgx.Tx, ret model.OrderReturn) (int64, error) {
+	photos := ret.Photos
+	if photos == nil {
+		photos = []string{}
+	}
+	itemIDs := ret.ItemIDs
+	if itemIDs == nil {
+		itemIDs = []int64{}
+	}
+
+	var id int64
+	err := tx.QueryRow(ctx, `
+        INSERT INTO order_returns (
+            order_id, customer_id, reason, comment, condition, photos, item_ids,
+            policy_id, policy, fee, refund, requires_inspection, returned_by, returned_at
+        )
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+        RETURNING id`,
+		ret.OrderID,
+		ret.CustomerID,
+		ret.Reason,
+		ret.Comment,
+		ret.Condition,
+		photos,
+		itemIDs,
+		ret.PolicyID,
+		ret.Policy,
+		ret.Fee,
+		ret.Refund,
+		ret.RequiresInspection,
+		ret.ReturnedBy,
+		ret.ReturnedAt,
+	).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка записи сведений о возврате заказа: %w", err)
+	}
+
+	return id, nil
+}
+
+// returnFilterCondition возвращает условие выборки возвращенных заказов по сведениям о возврате
+// и дополняет аргументы запроса queryArgs. Для пустого фильтра возвращается пустое условие.
+func returnFilterCondition(filter model.ReturnFilter, queryArgs []any) (string, []any) {
+	var conditions []string
+
+	if filter.Reason != "" {
+		queryArgs = append(queryArgs, string(filter.Reason))
+		conditions = append(conditions, fmt.Sprintf("r.reason = $%d", len(queryArgs)))
+	}
+
+	if filter.Condition != "" {
+		queryArgs = append(queryArgs, string(filter.Condition))
+		conditions = append(conditions, fmt.Sprintf("r.condition = $%d", len(queryArgs)))
+	}
+
+	if len(conditions) == 0 {
+		return "", queryArgs
+	}
+
+	return " AND EXISTS (SELECT 1 FROM order_returns r WHERE r.order_id = o.id AND " +
+		strings.Join(conditions, " AND ") + ")", queryArgs
+}
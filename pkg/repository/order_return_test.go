@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+func TestReturnFilterCondition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		filter            model.ReturnFilter
+		queryArgs         []any
+		expectedCondition string
+		expectedArgs      []any
+	}{
+		{
+			name:         "without filter",
+			queryArgs:    []any{"%42%"},
+			expectedArgs: []any{"%42%"},
+		},
+		{
+			name:              "reason and condition",
+			filter:            model.ReturnFilter{Reason: model.ReturnReasonDefective, Condition: model.ReturnConditionDamaged},
+			expectedCondition: " AND EXISTS (SELECT 1 FROM order_returns r WHERE r.order_id = o.id AND r.reason = $1 AND r.condition = $2)",
+			expectedArgs:      []any{"defective", "damaged"},
+		},
+		{
+			name:              "condition after search",
+			filter:            model.ReturnFilter{Condition: model.ReturnConditionOpened},
+			queryArgs:         []any{"%42%"},
+			expectedCondition: " AND EXISTS (SELECT 1 FROM order_returns r WHERE r.order_id = o.id AND r.condition = $2)",
+			expectedArgs:      []any{"%42%", "opened"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			condition, args := returnFilterCondition(tt.filter, tt.queryArgs)
+
+			assert.Equal(t, tt.expectedCondition, condition)
+			assert.Equal(t, tt.expectedArgs, args)
+		})
+	}
+}
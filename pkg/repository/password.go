@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// ErrInvalidPasswordHash возникает, когда сохраненный хеш пароля не удается разобрать
+var ErrInvalidPasswordHash = errors.New("некорректный формат хеша пароля")
+
+// argon2Params описывает параметры argon2id, с которыми был получен хеш
+type argon2Params struct {
+	memory  uint32 // объем памяти в КиБ
+	time    uint32 // число проходов
+	threads uint8  // степень параллелизма
+	keyLen  uint32 // длина ключа в байтах
+}
+
+// currentArgon2Params - параметры для новых хешей (рекомендация OWASP для argon2id).
+// Хеши с другими параметрами пересчитываются при успешном входе пользователя.
+var currentArgon2Params = argon2Params{
+	memory:  19 * 1024,
+	time:    2,
+	threads: 1,
+	keyLen:  32,
+}
+
+const argon2SaltSize = 16
+
+// Допустимые параметры сохраненного хеша argon2id. Параметры берутся из базы данных и передаются
+// в argon2.IDKey без изменений: нулевые time и threads вызывают панику, а большой memory
+// заставил бы каждый вход выделять столько памяти, сколько указано в хеше.
+const (
+	argon2MaxMemory  = 256 * 1024 // 256 МиБ
+	argon2MaxTime    = 16
+	argon2MaxThreads = 16
+	argon2MaxKeyLen  = 64
+)
+
+// generateSalt генерирует случайный соль заданного размера
+func generateSalt(size int) ([]byte, error) {
+	salt := make([]byte, size)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	return salt, nil
+}
+
+// hashPassword хеширует пароль с использованием argon2id.
+// Результат хранится в формате PHC: $argon2id$v=19$m=...,t=...,p=...$соль$хеш
+func hashPassword(password string) (string, error) {
+	salt, err := generateSalt(argon2SaltSize)
+	if err != nil {
+		return "", err
+	}
+
+	return encodeArgon2Hash(password, salt, currentArgon2Params), nil
+}
+
+// encodeArgon2Hash вычисляет argon2id и кодирует результат вместе с параметрами
+func encodeArgon2Hash(password string, salt []byte, params argon2Params) string {
+	key := argon2.IDKey([]byte(password), salt, params.time, params.memory, params.threads, params.keyLen)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		params.memory,
+		params.time,
+		params.threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key))
+}
+
+// verifyPassword проверяет пароль по сохраненному хешу.
+// Поддерживает формат argon2id и устаревший формат соль:хеш на основе SHA-256.
+// needsRehash равен true, если хеш верен, но получен устаревшим алгоритмом или параметрами.
+func verifyPassword(storedHash, password string) (ok, needsRehash bool) {
+	if !strings.HasPrefix(storedHash, "$argon2id$") {
+		return checkLegacyPassword(storedHash, password), true
+	}
+
+	params, salt, key, err := decodeArgon2Hash(storedHash)
+	if err != nil {
+		return false, false
+	}
+
+	candidate := argon2.IDKey([]byte(password), salt, params.time, params.memory, params.threads, params.keyLen)
+	if subtle.ConstantTimeCompare(candidate, key) != 1 {
+		return false, false
+	}
+
+	return true, params != currentArgon2Params
+}
+
+// decodeArgon2Hash разбирает хеш в формате PHC на параметры, соль и ключ
+func decodeArgon2Hash(encoded string) (argon2Params, []byte, []byte, error) {
+	// "", "argon2id", "v=19", "m=...,t=...,p=...", соль, хеш
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 {
+		return argon2Params{}, nil, nil, ErrInvalidPasswordHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return argon2Params{}, nil, nil, ErrInvalidPasswordHash
+	}
+
+	var params argon2Params
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.time, &params.threads); err != nil {
+		return argon2Params{}, nil, nil, ErrInvalidPasswordHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return argon2Params{}, nil, nil, ErrInvalidPasswordHash
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return argon2Params{}, nil, nil, ErrInvalidPasswordHash
+	}
+	params.keyLen = uint32(len(key))
+
+	if !params.valid() {
+		return argon2Params{}, nil, nil, ErrInvalidPasswordHash
+	}
+
+	return params, salt, key, nil
+}
+
+// valid проверяет, что параметры argon2id находятся в допустимых пределах
+func (p argon2Params) valid() bool {
+	return p.memory >= 8*uint32(p.threads) && p.memory <= argon2MaxMemory &&
+		p.time >= 1 && p.time <= argon2MaxTime &&
+		p.threads >= 1 && p.threads <= argon2MaxThreads &&
+		p.keyLen <= argon2MaxKeyLen
+}
+
+// checkLegacyPassword проверяет пароль по устаревшему хешу в формате соль:хеш (один раунд SHA-256)
+func checkLegacyPassword(storedHash, password string) bool {
+	parts := strings.Split(storedHash, ":")
+	if len(parts) != 2 {
+		return false
+	}
+
+	salt, err := base64.StdEncoding.DecodeString(parts[0])
+	if err != nil {
+		return false
+	}
+
+	storedPasswordHash, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return false
+	}
+
+	hash := sha256.New()
+	hash.Write(salt)
+	hash.Write([]byte(password))
+	hashedPassword := hash.Sum(nil)
+
+	return subtle.ConstantTimeCompare(hashedPassword, storedPasswordHash) == 1
+}
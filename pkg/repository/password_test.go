@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// legacyHash формирует хеш в устаревшем формате соль:хеш
+func legacyHash(salt []byte, password string) string {
+	hash := sha256.New()
+	hash.Write(salt)
+	hash.Write([]byte(password))
+
+	return fmt.Sprintf("%s:%s",
+		base64.StdEncoding.EncodeToString(salt),
+		base64.StdEncoding.EncodeToString(hash.Sum(nil)))
+}
+
+func TestHashPassword(t *testing.T) {
+	t.Parallel()
+
+	hash, err := hashPassword("secret")
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=19456,t=2,p=1$"))
+
+	other, err := hashPassword("secret")
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other, "соль должна быть случайной")
+}
+
+func TestDecodeArgon2Hash_InvalidParams(t *testing.T) {
+	t.Parallel()
+
+	salt := base64.RawStdEncoding.EncodeToString([]byte("0123456789abcdef"))
+	key := base64.RawStdEncoding.EncodeToString(make([]byte, 32))
+
+	tests := []struct {
+		name   string
+		params string
+	}{
+		{name: "zero memory", params: "m=0,t=1,p=1"},
+		{name: "memory below threads minimum", params: "m=8,t=1,p=2"},
+		{name: "memory over limit", params: "m=4194304,t=1,p=1"},
+		{name: "zero time", params: "m=1024,t=0,p=1"},
+		{name: "time over limit", params: "m=1024,t=1000,p=1"},
+		{name: "zero threads", params: "m=1024,t=1,p=0"},
+		{name: "threads over limit", params: "m=1024,t=1,p=64"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, _, err := decodeArgon2Hash(fmt.Sprintf("$argon2id$v=19$%s$%s$%s", tt.params, salt, key))
+			assert.ErrorIs(t, err, ErrInvalidPasswordHash)
+		})
+	}
+}
+
+func TestVerifyPassword(t *testing.T) {
+	t.Parallel()
+
+	current, err := hashPassword("secret")
+	require.NoError(t, err)
+
+	weakParams := argon2Params{memory: 1024, time: 1, threads: 1, keyLen: 32}
+	outdated := encodeArgon2Hash("secret", []byte("0123456789abcdef"), weakParams)
+
+	legacy := legacyHash([]byte("0123456789abcdef"), "secret")
+
+	tests := []struct {
+		name            string
+		storedHash      string
+		password        string
+		wantOK          bool
+		wantNeedsRehash bool
+	}{
+		{
+			name:       "current hash - correct password",
+			storedHash: current,
+			password:   "secret",
+			wantOK:     true,
+		},
+		{
+			name:       "current hash - wrong password",
+			storedHash: current,
+			password:   "wrong",
+		},
+		{
+			name:            "outdated parameters - correct password",
+			storedHash:      outdated,
+			password:        "secret",
+			wantOK:          true,
+			wantNeedsRehash: true,
+		},
+		{
+			name:            "legacy hash - correct password",
+			storedHash:      legacy,
+			password:        "secret",
+			wantOK:          true,
+			wantNeedsRehash: true,
+		},
+		{
+			name:       "legacy hash - wrong password",
+			storedHash: legacy,
+			password:   "wrong",
+		},
+		{
+			name:       "malformed argon2 hash",
+			storedHash: "$argon2id$v=19$m=oops$salt$hash",
+			password:   "secret",
+		},
+		{
+			name:       "argon2 hash with zero time",
+			storedHash: "$argon2id$v=19$m=1024,t=0,p=1$MDEyMzQ1Njc4OWFiY2RlZg$MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY",
+			password:   "secret",
+		},
+		{
+			name:       "empty hash",
+			storedHash: "",
+			password:   "secret",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ok, needsRehash := verifyPassword(tt.storedHash, tt.password)
+
+			assert.Equal(t, tt.wantOK, ok)
+			if tt.wantOK {
+				assert.Equal(t, tt.wantNeedsRehash, needsRehash)
+			}
+		})
+	}
+}
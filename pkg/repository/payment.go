@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+var (
+	// ErrPaymentNotFound - оплата заказа не найдена
+	ErrPaymentNotFound = errors.New("оплата заказа не найдена")
+)
+
+// DeliverOrders сохраняет выданные заказы, переходы статусов и оплаты заказов в одной транзакции.
+// Если версия хотя бы одного заказа в базе отличается от переданной, не изменяется ни один заказ
+// и возвращается ErrConcurrentModification. ID записанных оплат проставляются в payments.
+func (r *PostgresOrderRepository) DeliverOrders(ctx context.Context, orders []model.Order, transitions []model.OrderStateTransition, payments []model.Payment) error {
+	if len(orders) != len(transitions) {
+		return fmt.Errorf("количество заказов (%d) не совпадает с количеством переходов (%d)", len(orders), len(transitions))
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrTransactionStartError, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err = updateStates(ctx, tx, orders, transitions); err != nil {
+		return err
+	}
+
+	for i := range payments {
+		if payments[i].ID, err = insertPayment(ctx, tx, payments[i]); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// GetPayment возвращает оплату заказа
+func (r *PostgresOrderRepository) GetPayment(ctx context.Context, orderID int64) (model.Payment, error) {
+	var payment model.Payment
+	err := pgxscan.Get(ctx, r.pool, &payment, `
+        SELECT id, order_id, method, amount, cash_amount, card_amount, transaction_id, operator_id, paid_at
+        FROM order_payments
+        WHERE order_id = $1`, orderID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Payment{}, fmt.Errorf("%w: заказ %d", ErrPaymentNotFound, orderID)
+		}
+		return model.Payment{}, fmt.Errorf("ошибка получения оплаты заказа: %w", err)
+	}
+
+	return payment, nil
+}
+
+// ListPayments возвращает оплаты заказов с переданными ID
+func (r *PostgresOrderRepository) ListPayments(ctx context.Context, orderIDs []int64) ([]model.Payment, error) {
+	var payments []model.Payment
+	err := pgxscan.Select(ctx, r.pool, &payments, `
+        SELECT id, order_id, method, amount, cash_amount, card_amount, transaction_id, operator_id, paid_at
+        FROM order_payments
+        WHERE order_id = ANY($1)`, orderIDs)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения оплат заказов: %w", err)
+	}
+
+	return payments, nil
+}
+
+// ListRefunds возвращает возвраты денег по заказу в хронологическом порядке
+func (r *PostgresOrderRepository) ListRefunds(ctx context.Context, orderID int64) ([]model.Refund, error) {
+	var refunds []model.Refund
+	err := pgxscan.Select(ctx, r.pool, &refunds, `
+        SELECT id, order_id, return_id, payment_id, method, amount, cash_amount, card_amount,
+               transaction_id, operator_id, refunded_at
+        FROM order_refunds
+        WHERE order_id = $1
+        ORDER BY refunded_at, id`, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения возвратов денег по заказу: %w", err)
+	}
+
+	return refunds, nil
+}
+
+// insertPayment записывает оплату заказа в рамках транзакции tx и возвращает ID записи
+func insertPayment(ctx context.Context, tx pgx.Tx, payment model.Payment) (int64, error) {
+	var id int64
+	err := tx.QueryRow(ctx, `
+        INSERT INTO order_payments (order_id, method, amount, cash_amount, card_amount, transaction_id, operator_id, paid_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+        RETURNING id`,
+		payment.OrderID,
+		string(payment.Method),
+		payment.Amount,
+		payment.CashAmount,
+		payment.CardAmount,
+		payment.TransactionID,
+		payment.OperatorID,
+		payment.PaidAt,
+	).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка записи оплаты заказа: %w", err)
+	}
+
+	return id, nil
+}
+
+// insertRefund записывает возврат денег по заказу в рамках транзакции tx и возвращает ID записи
+func insertRefund(ctx context.Context, tx pgx.Tx, refund model.Refund) (int64, error) {
+	var id int64
+	err := tx.QueryRow(ctx, `
+        INSERT INTO order_refunds (
+            order_id, return_id, payment_id, method, amount, cash_amount, card_amount,
+            transaction_id, operator_id, refunded_at
+        )
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+        RETURNING id`,
+		refund.OrderID,
+		refund.ReturnID,
+		refund.PaymentID,
+		string(refund.Method),
+		refund.Amount,
+		refund.CashAmount,
+		refund.CardAmount,
+		refund.TransactionID,
+		refund.OperatorID,
+		refund.RefundedAt,
+	).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка записи возврата денег по заказу: %w", err)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"gitlab.ozon.dev/gojhw1/pkg/db"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+var (
+	// ErrPickupPointNotFound определяет ошибку, которая возникает, когда ПВЗ не найден
+	ErrPickupPointNotFound = errors.New("ПВЗ не найден")
+	// ErrPickupPointAlreadyExists определяет ошибку, которая возникает, когда ПВЗ с таким названием уже существует
+	ErrPickupPointAlreadyExists = errors.New("ПВЗ с таким названием уже существует")
+	// ErrPickupPointInUse определяет ошибку, которая возникает при удалении ПВЗ, в котором есть заказы
+	ErrPickupPointInUse = errors.New("в ПВЗ есть заказы, удаление невозможно")
+)
+
+// PostgresPickupPointRepository реализация репозитория для работы с ПВЗ в PostgreSQL
+type PostgresPickupPointRepository struct {
+	pool *db.Pool
+}
+
+// NewPostgresPickupPointRepository создает новый репозиторий ПВЗ
+func NewPostgresPickupPointRepository(pool *db.Pool) *PostgresPickupPointRepository {
+	return &PostgresPickupPointRepository{
+		pool: pool,
+	}
+}
+
+// Create создает новый ПВЗ и возвращает его с заполненным ID
+func (r *PostgresPickupPointRepository) Create(ctx context.Context, point model.PickupPoint) (model.PickupPoint, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return model.PickupPoint{}, fmt.Errorf("%w: %w", ErrTransactionStartError, err)
+	}
+	defer tx.Rollback(ctx)
+
+	var exists bool
+	err = tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM pickup_points WHERE name = $1 FOR UPDATE)", point.Name).Scan(&exists)
+	if err != nil {
+		return model.PickupPoint{}, fmt.Errorf("ошибка проверки существования ПВЗ: %w", err)
+	}
+	if exists {
+		return model.PickupPoint{}, ErrPickupPointAlreadyExists
+	}
+
+	now := time.Now()
+	point.CreatedAt = now
+	point.UpdatedAt = now
+
+	err = tx.QueryRow(ctx, `
+        INSERT INTO pickup_points (name, address, created_at, updated_at)
+        VALUES ($1, $2, $3, $4)
+        RETURNING id`,
+		point.Name,
+		point.Address,
+		point.CreatedAt,
+		point.UpdatedAt,
+	).Scan(&point.ID)
+	if err != nil {
+		return model.PickupPoint{}, fmt.Errorf("ошибка создания ПВЗ: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return model.PickupPoint{}, err
+	}
+
+	return point, nil
+}
+
+// Update обновляет название и адрес ПВЗ
+func (r *PostgresPickupPointRepository) Update(ctx context.Context, point model.PickupPoint) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrTransactionStartError, err)
+	}
+	defer tx.Rollback(ctx)
+
+	var exists bool
+	err = tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM pickup_points WHERE name = $1 AND id <> $2 FOR UPDATE)",
+		point.Name, point.ID).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("ошибка проверки существования ПВЗ: %w", err)
+	}
+	if exists {
+		return ErrPickupPointAlreadyExists
+	}
+
+	commandTag, err := tx.Exec(ctx, `
+        UPDATE pickup_points
+        SET name = $2, address = $3, updated_at = $4
+        WHERE id = $1`,
+		point.ID,
+		point.Name,
+		point.Address,
+		time.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка обновления ПВЗ: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return ErrPickupPointNotFound
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Delete удаляет ПВЗ, если в нем нет заказов. Сотрудники ПВЗ остаются без привязки.
+func (r *PostgresPickupPointRepository) Delete(ctx context.Context, id int64) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrTransactionStartError, err)
+	}
+	defer tx.Rollback(ctx)
+
+	var existing model.PickupPoint
+	err = pgxscan.Get(ctx, tx, &existing, "SELECT id FROM pickup_points WHERE id = $1 FOR UPDATE", id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrPickupPointNotFound
+		}
+		return fmt.Errorf("ошибка получения ПВЗ для удаления: %w", err)
+	}
+
+	var hasOrders bool
+	err = tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM orders WHERE pickup_point_id = $1)", id).Scan(&hasOrders)
+	if err != nil {
+		return fmt.Errorf("ошибка проверки заказов ПВЗ: %w", err)
+	}
+	if hasOrders {
+		return ErrPickupPointInUse
+	}
+
+	if _, err = tx.Exec(ctx, "DELETE FROM pickup_points WHERE id = $1", id); err != nil {
+		return fmt.Errorf("ошибка удаления ПВЗ: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+// GetByID получает ПВЗ по ID
+func (r *PostgresPickupPointRepository) GetByID(ctx context.Context, id int64) (model.PickupPoint, error) {
+	var point model.PickupPoint
+	err := pgxscan.Get(ctx, r.pool, &point,
+		"SELECT id, name, address, created_at, updated_at FROM pickup_points WHERE id = $1",
+		id,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.PickupPoint{}, ErrPickupPointNotFound
+		}
+		return model.PickupPoint{}, fmt.Errorf("ошибка получения ПВЗ: %w", err)
+	}
+
+	return point, nil
+}
+
+// List возвращает список ПВЗ
+func (r *PostgresPickupPointRepository) List(ctx context.Context) ([]model.PickupPoint, error) {
+	var points []model.PickupPoint
+	err := pgxscan.Select(ctx, r.pool, &points,
+		"SELECT id, name, address, created_at, updated_at FROM pickup_points ORDER BY id",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения списка ПВЗ: %w", err)
+	}
+
+	return points, nil
+}
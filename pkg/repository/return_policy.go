@@ -0,0 +1,246 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"gitlab.ozon.dev/gojhw1/pkg/db"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+var (
+	// ErrReturnPolicyNotFound определяет ошибку, которая возникает, когда политика возврата не найдена
+	ErrReturnPolicyNotFound = errors.New("политика возврата не найдена")
+	// ErrReturnPolicyAlreadyExists определяет ошибку, которая возникает, когда для упаковки и сегмента уже есть политика возврата
+	ErrReturnPolicyAlreadyExists = errors.New("политика возврата для этой упаковки и сегмента клиентов уже существует")
+)
+
+// returnPolicyColumns - общий список полей политики возврата для выборок
+const returnPolicyColumns = `
+            rp.id, rp.name, pt.name AS package_type, rp.customer_segment, rp.return_window_hours,
+            rp.non_returnable, rp.fee_percent, rp.requires_inspection, rp.created_at, rp.updated_at`
+
+// PostgresReturnPolicyRepository реализация репозитория для работы с политиками возврата в PostgreSQL
+type PostgresReturnPolicyRepository struct {
+	pool *db.Pool
+}
+
+// NewPostgresReturnPolicyRepository создает новый репозиторий политик возврата
+func NewPostgresReturnPolicyRepository(pool *db.Pool) *PostgresReturnPolicyRepository {
+	return &PostgresReturnPolicyRepository{
+		pool: pool,
+	}
+}
+
+// Create создает новую политику возврата и возвращает ее с заполненным ID
+func (r *PostgresReturnPolicyRepository) Create(ctx context.Context, policy model.ReturnPolicy) (model.ReturnPolicy, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return model.ReturnPolicy{}, fmt.Errorf("%w: %w", ErrTransactionStartError, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err = checkReturnPolicyScope(ctx, tx, policy); err != nil {
+		return model.ReturnPolicy{}, err
+	}
+
+	now := time.Now()
+	policy.CreatedAt = now
+	policy.UpdatedAt = now
+
+	err = tx.QueryRow(ctx, `
+        INSERT INTO return_policies (name, package_type_id, customer_segment, return_window_hours,
+                                     non_returnable, fee_percent, requires_inspection, created_at, updated_at)
+        VALUES ($1, (SELECT id FROM package_types WHERE name = $2), $3, $4, $5, $6, $7, $8, $9)
+        RETURNING id`,
+		policy.Name,
+		getPackageTypeStr(policy.PackageType),
+		policy.CustomerSegment,
+		policy.ReturnWindowHours,
+		policy.NonReturnable,
+		policy.FeePercent,
+		policy.RequiresInspection,
+		policy.CreatedAt,
+		policy.UpdatedAt,
+	).Scan(&policy.ID)
+	if err != nil {
+		return model.ReturnPolicy{}, fmt.Errorf("ошибка создания политики возврата: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return model.ReturnPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+// Update изменяет политику возврата
+func (r *PostgresReturnPolicyRepository) Update(ctx context.Context, policy model.ReturnPolicy) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrTransactionStartError, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err = checkReturnPolicyScope(ctx, tx, policy); err != nil {
+		return err
+	}
+
+	commandTag, err := tx.Exec(ctx, `
+        UPDATE return_policies
+        SET name = $2,
+            package_type_id = (SELECT id FROM package_types WHERE name = $3),
+            customer_segment = $4,
+            return_window_hours = $5,
+            non_returnable = $6,
+            fee_percent = $7,
+            requires_inspection = $8,
+            updated_at = $9
+        WHERE id = $1`,
+		policy.ID,
+		policy.Name,
+		getPackageTypeStr(policy.PackageType),
+		policy.CustomerSegment,
+		policy.ReturnWindowHours,
+		policy.NonReturnable,
+		policy.FeePercent,
+		policy.RequiresInspection,
+		time.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка обновления политики возврата: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return ErrReturnPolicyNotFound
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Delete удаляет политику возврата
+func (r *PostgresReturnPolicyRepository) Delete(ctx context.Context, id int64) error {
+	commandTag, err := r.pool.Exec(ctx, "DELETE FROM return_policies WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("ошибка удаления политики возврата: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return ErrReturnPolicyNotFound
+	}
+
+	return nil
+}
+
+// GetByID получает политику возврата по ID
+func (r *PostgresReturnPolicyRepository) GetByID(ctx context.Context, id int64) (model.ReturnPolicy, error) {
+	var policy model.ReturnPolicy
+	err := pgxscan.Get(ctx, r.pool, &policy, `
+        SELECT`+returnPolicyColumns+`
+        FROM return_policies rp
+        LEFT JOIN package_types pt ON rp.package_type_id = pt.id
+        WHERE rp.id = $1`, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ReturnPolicy{}, ErrReturnPolicyNotFound
+		}
+		return model.ReturnPolicy{}, fmt.Errorf("ошибка получения политики возврата: %w", err)
+	}
+
+	return policy, nil
+}
+
+// List возвращает список политик возврата
+func (r *PostgresReturnPolicyRepository) List(ctx context.Context) ([]model.ReturnPolicy, error) {
+	var policies []model.ReturnPolicy
+	err := pgxscan.Select(ctx, r.pool, &policies, `
+        SELECT`+returnPolicyColumns+`
+        FROM return_policies rp
+        LEFT JOIN package_types pt ON rp.package_type_id = pt.id
+        ORDER BY rp.id`)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения списка политик возврата: %w", err)
+	}
+
+	return policies, nil
+}
+
+// Match возвращает политику возврата для заказа в упаковке packageType клиента customerID.
+// Из подходящих политик выбирается самая точная: сначала учитывается сегмент клиента, затем упаковка.
+func (r *PostgresReturnPolicyRepository) Match(ctx context.Context, packageType *model.PackageType, customerID int64) (model.ReturnPolicy, error) {
+	var policy model.ReturnPolicy
+	err := pgxscan.Get(ctx, r.pool, &policy, `
+        SELECT`+returnPolicyColumns+`
+        FROM return_policies rp
+        LEFT JOIN package_types pt ON rp.package_type_id = pt.id
+        LEFT JOIN customer_segments cs ON cs.customer_id = $2
+        WHERE (rp.package_type_id IS NULL OR pt.name = $1)
+          AND (rp.customer_segment IS NULL OR rp.customer_segment = cs.segment)
+        ORDER BY rp.customer_segment IS NULL, rp.package_type_id IS NULL
+        LIMIT 1`,
+		getPackageTypeStr(packageType),
+		customerID,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ReturnPolicy{}, ErrReturnPolicyNotFound
+		}
+		return model.ReturnPolicy{}, fmt.Errorf("ошибка выбора политики возврата: %w", err)
+	}
+
+	return policy, nil
+}
+
+// SetCustomerSegment сохраняет сегмент клиента
+func (r *PostgresReturnPolicyRepository) SetCustomerSegment(ctx context.Context, segment model.CustomerSegment) error {
+	_, err := r.pool.Exec(ctx, `
+        INSERT INTO customer_segments (customer_id, segment, updated_at)
+        VALUES ($1, $2, $3)
+        ON CONFLICT (customer_id) DO UPDATE SET segment = EXCLUDED.segment, updated_at = EXCLUDED.updated_at`,
+		segment.CustomerID,
+		segment.Segment,
+		segment.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка сохранения сегмента клиента: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteCustomerSegment удаляет сегмент клиента, после чего к его заказам применяются общие политики
+func (r *PostgresReturnPolicyRepository) DeleteCustomerSegment(ctx context.Context, customerID int64) error {
+	if _, err := r.pool.Exec(ctx, "DELETE FROM customer_segments WHERE customer_id = $1", customerID); err != nil {
+		return fmt.Errorf("ошибка удаления сегмента клиента: %w", err)
+	}
+
+	return nil
+}
+
+// checkReturnPolicyScope проверяет, что для упаковки и сегмента политики нет другой политики возврата
+func checkReturnPolicyScope(ctx context.Context, tx pgx.Tx, policy model.ReturnPolicy) error {
+	var exists bool
+	err := tx.QueryRow(ctx, `
+        SELECT EXISTS(
+            SELECT 1 FROM return_policies rp
+            LEFT JOIN package_types pt ON rp.package_type_id = pt.id
+            WHERE COALESCE(pt.name, '') = $1 AND COALESCE(rp.customer_segment, '') = $2 AND rp.id <> $3
+            FOR UPDATE OF rp
+        )`,
+		getPackageTypeStr(policy.PackageType),
+		getCustomerSegmentStr(policy.CustomerSegment),
+		policy.ID,
+	).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("ошибка проверки существования политики возврата: %w", err)
+	}
+	if exists {
+		return ErrReturnPolicyAlreadyExists
+	}
+
+	return nil
+}
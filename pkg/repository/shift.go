@@ -0,0 +1,259 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"gitlab.ozon.dev/gojhw1/pkg/db"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+var (
+	// ErrShiftNotFound определяет ошибку, которая возникает, когда смена не найдена
+	ErrShiftNotFound = errors.New("смена не найдена")
+	// ErrShiftAlreadyOpen определяет ошибку, которая возникает при открытии второй смены в ПВЗ
+	ErrShiftAlreadyOpen = errors.New("в ПВЗ уже открыта смена")
+	// ErrShiftClosed определяет ошибку, которая возникает при изменении закрытой смены
+	ErrShiftClosed = errors.New("смена закрыта и не может быть изменена")
+)
+
+// shiftColumns - общий список полей смены для выборок
+const shiftColumns = `
+            s.id, s.pickup_point_id,
+            CASE WHEN s.closed_at IS NULL THEN 'open' ELSE 'closed' END AS state,
+            s.opening_cash, s.counted_cash, s.opened_by, s.opened_at, s.closed_by, s.closed_at, s.report`
+
+// PostgresShiftRepository реализация репозитория для работы со сменами ПВЗ в PostgreSQL
+type PostgresShiftRepository struct {
+	pool *db.Pool
+}
+
+// NewPostgresShiftRepository создает новый репозиторий смен
+func NewPostgresShiftRepository(pool *db.Pool) *PostgresShiftRepository {
+	return &PostgresShiftRepository{
+		pool: pool,
+	}
+}
+
+// Open открывает смену в ПВЗ и возвращает ее с заполненным ID.
+// Если в ПВЗ уже открыта смена, возвращается ErrShiftAlreadyOpen.
+func (r *PostgresShiftRepository) Open(ctx context.Context, shift model.Shift) (model.Shift, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return model.Shift{}, fmt.Errorf("%w: %w", ErrTransactionStartError, err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Блокировка ПВЗ не дает параллельно открыть в нем вторую смену
+	var exists bool
+	err = tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM pickup_points WHERE id = $1 FOR UPDATE)", shift.PickupPointID).Scan(&exists)
+	if err != nil {
+		return model.Shift{}, fmt.Errorf("ошибка проверки существования ПВЗ: %w", err)
+	}
+	if !exists {
+		return model.Shift{}, ErrPickupPointNotFound
+	}
+
+	err = tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM shifts WHERE pickup_point_id = $1 AND closed_at IS NULL)",
+		shift.PickupPointID).Scan(&exists)
+	if err != nil {
+		return model.Shift{}, fmt.Errorf("ошибка проверки открытой смены: %w", err)
+	}
+	if exists {
+		return model.Shift{}, ErrShiftAlreadyOpen
+	}
+
+	err = tx.QueryRow(ctx, `
+        INSERT INTO shifts (pickup_point_id, opening_cash, opened_by, opened_at)
+        VALUES ($1, $2, $3, $4)
+        RETURNING id`,
+		shift.PickupPointID,
+		shift.OpeningCash,
+		shift.OpenedBy,
+		shift.OpenedAt,
+	).Scan(&shift.ID)
+	if err != nil {
+		return model.Shift{}, fmt.Errorf("ошибка открытия смены: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return model.Shift{}, err
+	}
+
+	shift.State = model.ShiftStateOpen
+	return shift, nil
+}
+
+// Close закрывает смену и сохраняет ее отчет сверки.
+// Закрытую смену изменить нельзя, повторное закрытие возвращает ErrShiftClosed.
+func (r *PostgresShiftRepository) Close(ctx context.Context, shift model.Shift) error {
+	commandTag, err := r.pool.Exec(ctx, `
+        UPDATE shifts
+        SET counted_cash = $2,
+            closed_by = $3,
+            closed_at = $4,
+            report = $5
+        WHERE id = $1 AND closed_at IS NULL`,
+		shift.ID,
+		shift.CountedCash,
+		shift.ClosedBy,
+		shift.ClosedAt,
+		shift.Report,
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка закрытия смены: %w", err)
+	}
+
+	if commandTag.RowsAffected() > 0 {
+		return nil
+	}
+
+	if _, err = r.GetByID(ctx, shift.ID); err != nil {
+		return err
+	}
+
+	return ErrShiftClosed
+}
+
+// GetByID получает смену по ID
+func (r *PostgresShiftRepository) GetByID(ctx context.Context, id int64) (model.Shift, error) {
+	var shift model.Shift
+	err := pgxscan.Get(ctx, r.pool, &shift, `
+        SELECT`+shiftColumns+`
+        FROM shifts s
+        WHERE s.id = $1`, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Shift{}, ErrShiftNotFound
+		}
+		return model.Shift{}, fmt.Errorf("ошибка получения смены: %w", err)
+	}
+
+	return shift, nil
+}
+
+// GetOpen получает открытую смену ПВЗ
+func (r *PostgresShiftRepository) GetOpen(ctx context.Context, pickupPointID int64) (model.Shift, error) {
+	var shift model.Shift
+	err := pgxscan.Get(ctx, r.pool, &shift, `
+        SELECT`+shiftColumns+`
+        FROM shifts s
+        WHERE s.pickup_point_id = $1 AND s.closed_at IS NULL`, pickupPointID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Shift{}, ErrShiftNotFound
+		}
+		return model.Shift{}, fmt.Errorf("ошибка получения открытой смены: %w", err)
+	}
+
+	return shift, nil
+}
+
+// ListWithCursor возвращает смены в порядке убывания ID.
+// Если pickupPointID больше 0, возвращаются только смены этого ПВЗ.
+func (r *PostgresShiftRepository) ListWithCursor(ctx context.Context, cursorID int64, limit int, pickupPointID int64) ([]model.Shift, error) {
+	var shifts []model.Shift
+
+	query := `
+        SELECT` + shiftColumns + `
+        FROM shifts s
+        WHERE 1=1`
+	var queryArgs []any
+
+	if pickupPointID > 0 {
+		queryArgs = append(queryArgs, pickupPointID)
+		query += fmt.Sprintf(" AND s.pickup_point_id = $%d", len(queryArgs))
+	}
+
+	// Условие для курсорной пагинации по ID
+	if cursorID > 0 {
+		queryArgs = append(queryArgs, cursorID)
+		query += fmt.Sprintf(" AND s.id < $%d", len(queryArgs))
+	}
+
+	queryArgs = append(queryArgs, limit)
+	query += fmt.Sprintf(" ORDER BY s.id DESC LIMIT $%d", len(queryArgs))
+
+	if err := pgxscan.Select(ctx, r.pool, &shifts, query, queryArgs...); err != nil {
+		return nil, fmt.Errorf("ошибка получения списка смен: %w", err)
+	}
+
+	return shifts, nil
+}
+
+// ListOperatorTotals возвращает итоги операций ПВЗ за период [from, to) по каждому сотруднику:
+// выдачи и возвраты курьеру берутся из истории статусов заказов, оплаты, возвраты от клиентов
+// и возвраты денег - из их журналов. Операции без сотрудника собираются в строку с пустым operator_id.
+func (r *PostgresShiftRepository) ListOperatorTotals(ctx context.Context, pickupPointID int64, from, to time.Time) ([]model.ShiftOperatorTotals, error) {
+	var totals []model.ShiftOperatorTotals
+	err := pgxscan.Select(ctx, r.pool, &totals, `
+        SELECT a.operator_id, COALESCE(u.username, '') AS username,
+               SUM(a.handouts)::INTEGER AS handouts, SUM(a.handout_amount) AS handout_amount,
+               SUM(a.payments)::INTEGER AS payments, SUM(a.cash_received) AS cash_received,
+               SUM(a.card_received) AS card_received,
+               SUM(a.returns)::INTEGER AS returns, SUM(a.return_amount) AS return_amount,
+               SUM(a.cash_refunded) AS cash_refunded, SUM(a.card_refunded) AS card_refunded,
+               SUM(a.online_refunded) AS online_refunded,
+               SUM(a.courier_returns)::INTEGER AS courier_returns
+        FROM (
+            SELECT t.changed_by AS operator_id,
+                   COUNT(*) FILTER (WHERE os.name = 'delivered') AS handouts,
+                   COALESCE(SUM(o.cost) FILTER (WHERE os.name = 'delivered'), 0) AS handout_amount,
+                   0 AS payments, 0 AS cash_received, 0 AS card_received,
+                   0 AS returns, 0 AS return_amount,
+                   0 AS cash_refunded, 0 AS card_refunded, 0 AS online_refunded,
+                   COUNT(*) FILTER (WHERE os.name = 'returned_to_courier') AS courier_returns
+            FROM order_state_transitions t
+            JOIN orders o ON o.id = t.order_id
+            JOIN order_states os ON os.id = t.to_state_id
+            WHERE o.pickup_point_id = $1 AND t.changed_at >= $2 AND t.changed_at < $3
+              AND os.name IN ('delivered', 'returned_to_courier')
+            GROUP BY t.changed_by
+
+            UNION ALL
+
+            SELECT p.operator_id, 0, 0,
+                   COUNT(*), SUM(p.cash_amount), SUM(p.card_amount),
+                   0, 0, 0, 0, 0, 0
+            FROM order_payments p
+            JOIN orders o ON o.id = p.order_id
+            WHERE o.pickup_point_id = $1 AND p.paid_at >= $2 AND p.paid_at < $3
+            GROUP BY p.operator_id
+
+            UNION ALL
+
+            SELECT ret.returned_by, 0, 0, 0, 0, 0,
+                   COUNT(*), SUM(ret.refund),
+                   0, 0, 0, 0
+            FROM order_returns ret
+            JOIN orders o ON o.id = ret.order_id
+            WHERE o.pickup_point_id = $1 AND ret.returned_at >= $2 AND ret.returned_at < $3
+            GROUP BY ret.returned_by
+
+            UNION ALL
+
+            SELECT rf.operator_id, 0, 0, 0, 0, 0, 0, 0,
+                   SUM(rf.cash_amount), SUM(rf.card_amount), SUM(rf.amount - rf.cash_amount - rf.card_amount),
+                   0
+            FROM order_refunds rf
+            JOIN orders o ON o.id = rf.order_id
+            WHERE o.pickup_point_id = $1 AND rf.refunded_at >= $2 AND rf.refunded_at < $3
+            GROUP BY rf.operator_id
+        ) a
+        LEFT JOIN users u ON u.id = a.operator_id
+        GROUP BY a.operator_id, u.username
+        ORDER BY a.operator_id NULLS LAST`,
+		pickupPointID,
+		from,
+		to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения итогов смены: %w", err)
+	}
+
+	return totals, nil
+}
@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"gitlab.ozon.dev/gojhw1/pkg/db"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+var (
+	// ErrStorageCellNotFound определяет ошибку, которая возникает, когда ячейка хранения не найдена
+	ErrStorageCellNotFound = errors.New("ячейка хранения не найдена")
+	// ErrStorageCellAlreadyExists определяет ошибку, которая возникает, когда в ПВЗ уже есть ячейка с таким кодом
+	ErrStorageCellAlreadyExists = errors.New("ячейка с таким кодом уже существует в ПВЗ")
+	// ErrStorageCellOccupied определяет ошибку, которая возникает при удалении ячейки, в которой лежат заказы
+	ErrStorageCellOccupied = errors.New("в ячейке хранятся заказы, удаление невозможно")
+	// ErrNoFreeStorageCell определяет ошибку, которая возникает, когда для заказа не нашлось подходящей свободной ячейки
+	ErrNoFreeStorageCell = errors.New("нет свободной ячейки, подходящей для заказа")
+)
+
+// storageCellColumns - общий список полей ячейки для выборок
+const storageCellColumns = `
+            sc.id, sc.pickup_point_id, sc.rack, sc.code, sc.capacity, sc.max_weight,
+            pt.name AS package_type, sc.created_at`
+
+// PostgresStorageCellRepository реализация репозитория для работы с ячейками хранения в PostgreSQL
+type PostgresStorageCellRepository struct {
+	pool *db.Pool
+}
+
+// NewPostgresStorageCellRepository создает новый репозиторий ячеек хранения
+func NewPostgresStorageCellRepository(pool *db.Pool) *PostgresStorageCellRepository {
+	return &PostgresStorageCellRepository{
+		pool: pool,
+	}
+}
+
+// Create создает новую ячейку хранения и возвращает ее с заполненным ID
+func (r *PostgresStorageCellRepository) Create(ctx context.Context, cell model.StorageCell) (model.StorageCell, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return model.StorageCell{}, fmt.Errorf("%w: %w", ErrTransactionStartError, err)
+	}
+	defer tx.Rollback(ctx)
+
+	var exists bool
+	err = tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM pickup_points WHERE id = $1 FOR SHARE)", cell.PickupPointID).Scan(&exists)
+	if err != nil {
+		return model.StorageCell{}, fmt.Errorf("ошибка проверки существования ПВЗ: %w", err)
+	}
+	if !exists {
+		return model.StorageCell{}, ErrPickupPointNotFound
+	}
+
+	err = tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM storage_cells WHERE pickup_point_id = $1 AND code = $2 FOR UPDATE)",
+		cell.PickupPointID, cell.Code).Scan(&exists)
+	if err != nil {
+		return model.StorageCell{}, fmt.Errorf("ошибка проверки существования ячейки: %w", err)
+	}
+	if exists {
+		return model.StorageCell{}, ErrStorageCellAlreadyExists
+	}
+
+	cell.CreatedAt = time.Now()
+
+	err = tx.QueryRow(ctx, `
+        INSERT INTO storage_cells (pickup_point_id, rack, code, capacity, max_weight, package_type_id, created_at)
+        VALUES ($1, $2, $3, $4, $5, (SELECT id FROM package_types WHERE name = $6), $7)
+        RETURNING id`,
+		cell.PickupPointID,
+		cell.Rack,
+		cell.Code,
+		cell.Capacity,
+		cell.MaxWeight,
+		getPackageTypeStr(cell.PackageType),
+		cell.CreatedAt,
+	).Scan(&cell.ID)
+	if err != nil {
+		return model.StorageCell{}, fmt.Errorf("ошибка создания ячейки хранения: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return model.StorageCell{}, err
+	}
+
+	return cell, nil
+}
+
+// Delete удаляет пустую ячейку хранения
+func (r *PostgresStorageCellRepository) Delete(ctx context.Context, id int64) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrTransactionStartError, err)
+	}
+	defer tx.Rollback(ctx)
+
+	var exists bool
+	err = tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM storage_cells WHERE id = $1 FOR UPDATE)", id).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("ошибка блокировки ячейки: %w", err)
+	}
+	if !exists {
+		return ErrStorageCellNotFound
+	}
+
+	var occupied bool
+	err = tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM orders WHERE storage_cell_id = $1)", id).Scan(&occupied)
+	if err != nil {
+		return fmt.Errorf("ошибка проверки заказов в ячейке: %w", err)
+	}
+	if occupied {
+		return ErrStorageCellOccupied
+	}
+
+	if _, err = tx.Exec(ctx, "DELETE FROM storage_cells WHERE id = $1", id); err != nil {
+		return fmt.Errorf("ошибка удаления ячейки хранения: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+// GetByID получает ячейку хранения по ID
+func (r *PostgresStorageCellRepository) GetByID(ctx context.Context, id int64) (model.StorageCell, error) {
+	var cell model.StorageCell
+	err := pgxscan.Get(ctx, r.pool, &cell, `
+        SELECT`+storageCellColumns+`
+        FROM storage_cells sc
+        LEFT JOIN package_types pt ON sc.package_type_id = pt.id
+        WHERE sc.id = $1`, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.StorageCell{}, ErrStorageCellNotFound
+		}
+		return model.StorageCell{}, fmt.Errorf("ошибка получения ячейки хранения: %w", err)
+	}
+
+	return cell, nil
+}
+
+// ListOccupancy возвращает ячейки хранения с их заполненностью.
+// Если pickupPointID больше 0, возвращаются только ячейки этого ПВЗ.
+func (r *PostgresStorageCellRepository) ListOccupancy(ctx context.Context, pickupPointID int64) ([]model.CellOccupancy, error) {
+	query := `
+        SELECT` + storageCellColumns + `,
+            COUNT(o.id) AS orders_count,
+            COALESCE(SUM(o.weight), 0) AS weight
+        FROM storage_cells sc
+        LEFT JOIN package_types pt ON sc.package_type_id = pt.id
+        LEFT JOIN orders o ON o.storage_cell_id = sc.id`
+
+	var args []any
+	if pickupPointID > 0 {
+		query += " WHERE sc.pickup_point_id = $1"
+		args = append(args, pickupPointID)
+	}
+
+	query += `
+        GROUP BY sc.id, pt.name
+        ORDER BY sc.pickup_point_id, sc.rack, sc.code`
+
+	var cells []model.CellOccupancy
+	if err := pgxscan.Select(ctx, r.pool, &cells, query, args...); err != nil {
+		return nil, fmt.Errorf("ошибка получения заполненности ячеек: %w", err)
+	}
+
+	return cells, nil
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"gitlab.ozon.dev/gojhw1/pkg/db"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+var (
+	// ErrRefreshTokenNotFound определяет ошибку, которая возникает, когда refresh-токен не найден,
+	// отозван или истек
+	ErrRefreshTokenNotFound = errors.New("refresh-токен не найден или недействителен")
+)
+
+// PostgresTokenRepository реализация репозитория для работы с refresh-токенами в PostgreSQL
+type PostgresTokenRepository struct {
+	pool *db.Pool
+}
+
+// NewPostgresTokenRepository создает новый репозиторий refresh-токенов
+func NewPostgresTokenRepository(pool *db.Pool) *PostgresTokenRepository {
+	return &PostgresTokenRepository{
+		pool: pool,
+	}
+}
+
+// Create сохраняет новый refresh-токен
+func (r *PostgresTokenRepository) Create(ctx context.Context, token model.RefreshToken) error {
+	_, err := r.pool.Exec(ctx, `
+        INSERT INTO refresh_tokens (user_id, token_hash, expires_at, created_at)
+        VALUES ($1, $2, $3, $4)`,
+		token.UserID,
+		token.TokenHash,
+		token.ExpiresAt,
+		time.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка сохранения refresh-токена: %w", err)
+	}
+
+	return nil
+}
+
+// Rotate отзывает действующий refresh-токен и сохраняет вместо него новый в одной транзакции.
+// Возвращает ID пользователя, которому принадлежал токен.
+func (r *PostgresTokenRepository) Rotate(ctx context.Context, oldTokenHash string, newToken model.RefreshToken) (int64, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrTransactionStartError, err)
+	}
+	defer tx.Rollback(ctx)
+
+	now := time.Now()
+
+	var existing model.RefreshToken
+	err = pgxscan.Get(ctx, tx, &existing, `
+        SELECT id, user_id, token_hash, expires_at, revoked_at, created_at
+        FROM refresh_tokens
+        WHERE token_hash = $1 AND revoked_at IS NULL AND expires_at > $2
+        FOR UPDATE`,
+		oldTokenHash,
+		now,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, ErrRefreshTokenNotFound
+		}
+		return 0, fmt.Errorf("ошибка получения refresh-токена: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, "UPDATE refresh_tokens SET revoked_at = $2 WHERE id = $1", existing.ID, now); err != nil {
+		return 0, fmt.Errorf("ошибка отзыва refresh-токена: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+        INSERT INTO refresh_tokens (user_id, token_hash, expires_at, created_at)
+        VALUES ($1, $2, $3, $4)`,
+		existing.UserID,
+		newToken.TokenHash,
+		newToken.ExpiresAt,
+		now,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка сохранения refresh-токена: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return existing.UserID, nil
+}
+
+// Revoke отзывает refresh-токен по его хешу
+func (r *PostgresTokenRepository) Revoke(ctx context.Context, tokenHash string) error {
+	commandTag, err := r.pool.Exec(ctx,
+		"UPDATE refresh_tokens SET revoked_at = $2 WHERE token_hash = $1 AND revoked_at IS NULL",
+		tokenHash,
+		time.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка отзыва refresh-токена: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return ErrRefreshTokenNotFound
+	}
+
+	return nil
+}
@@ -18,6 +18,8 @@ var (
 	ErrUserNotFound = errors.New("пользователь не найден")
 	// ErrUserAlreadyExists определяет ошибку, которая возникает, когда пользователь с таким именем уже существует в репозитории
 	ErrUserAlreadyExists = errors.New("пользователь с таким именем уже существует")
+	// ErrUserAlreadyActive определяет ошибку, которая возникает при повторном подтверждении пользователя
+	ErrUserAlreadyActive = errors.New("пользователь уже подтвержден")
 )
 
 // PostgresUserRepository реализация репозитория для работы с пользователями в PostgreSQL
@@ -51,19 +53,26 @@ func (r *PostgresUserRepository) Create(ctx context.Context, user model.User, pl
 	}
 
 	// Хешируем пароль
-	passwordHash, err := hashPasswordSHA256(plainPassword)
+	passwordHash, err := hashPassword(plainPassword)
 	if err != nil {
 		return fmt.Errorf("ошибка хеширования пароля: %w", err)
 	}
 
+	// Учетная запись без явного статуса ждет подтверждения администратором
+	if user.Status == "" {
+		user.Status = model.UserStatusPending
+	}
+
 	now := time.Now()
 
 	_, err = tx.Exec(ctx, `
-        INSERT INTO users (username, password_hash, role, created_at, updated_at)
-        VALUES ($1, $2, $3, $4, $5)`,
+        INSERT INTO users (username, password_hash, role, status, pickup_point_id, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)`,
 		user.Username,
 		passwordHash,
 		user.Role,
+		user.Status,
+		user.PickupPointID,
 		now,
 		now,
 	)
@@ -133,7 +142,7 @@ func (r *PostgresUserRepository) UpdatePassword(ctx context.Context, userID int6
 	}
 
 	// Хешируем новый пароль
-	passwordHash, err := hashPasswordSHA256(newPassword)
+	passwordHash, err := hashPassword(newPassword)
 	if err != nil {
 		return fmt.Errorf("ошибка хеширования пароля: %w", err)
 	}
@@ -159,6 +168,80 @@ func (r *PostgresUserRepository) UpdatePassword(ctx context.Context, userID int6
 	return tx.Commit(ctx)
 }
 
+// Approve подтверждает учетную запись пользователя и, если указана, назначает ему роль
+func (r *PostgresUserRepository) Approve(ctx context.Context, userID int64, role string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrTransactionStartError, err)
+	}
+	defer tx.Rollback(ctx)
+
+	var existingUser model.User
+	err = pgxscan.Get(ctx, tx, &existingUser,
+		"SELECT id, status FROM users WHERE id = $1 FOR UPDATE", userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrUserNotFound
+		}
+		return fmt.Errorf("ошибка получения пользователя для подтверждения: %w", err)
+	}
+
+	if existingUser.Status == model.UserStatusActive {
+		return ErrUserAlreadyActive
+	}
+
+	_, err = tx.Exec(ctx, `
+        UPDATE users
+        SET status = $2, role = COALESCE(NULLIF($3, ''), role), updated_at = $4
+        WHERE id = $1`,
+		userID,
+		model.UserStatusActive,
+		role,
+		time.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка подтверждения пользователя: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+// AssignPickupPoint привязывает пользователя к ПВЗ. Если pickupPointID равен nil, привязка снимается.
+func (r *PostgresUserRepository) AssignPickupPoint(ctx context.Context, userID int64, pickupPointID *int64) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrTransactionStartError, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if pickupPointID != nil {
+		var exists bool
+		err = tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM pickup_points WHERE id = $1 FOR SHARE)", *pickupPointID).Scan(&exists)
+		if err != nil {
+			return fmt.Errorf("ошибка проверки существования ПВЗ: %w", err)
+		}
+		if !exists {
+			return ErrPickupPointNotFound
+		}
+	}
+
+	commandTag, err := tx.Exec(ctx,
+		"UPDATE users SET pickup_point_id = $2, updated_at = $3 WHERE id = $1",
+		userID,
+		pickupPointID,
+		time.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка привязки пользователя к ПВЗ: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return ErrUserNotFound
+	}
+
+	return tx.Commit(ctx)
+}
+
 // Delete удаляет пользователя по ID
 func (r *PostgresUserRepository) Delete(ctx context.Context, id int64) error {
 	tx, err := r.pool.Begin(ctx)
@@ -193,7 +276,7 @@ func (r *PostgresUserRepository) Delete(ctx context.Context, id int64) error {
 func (r *PostgresUserRepository) GetByID(ctx context.Context, id int64) (model.User, error) {
 	var user model.User
 	err := pgxscan.Get(ctx, r.pool, &user,
-		"SELECT id, username, password_hash, role, created_at, updated_at FROM users WHERE id = $1",
+		"SELECT id, username, password_hash, role, status, pickup_point_id, created_at, updated_at FROM users WHERE id = $1",
 		id,
 	)
 
@@ -211,7 +294,7 @@ func (r *PostgresUserRepository) GetByID(ctx context.Context, id int64) (model.U
 func (r *PostgresUserRepository) GetByUsername(ctx context.Context, username string) (model.User, error) {
 	var user model.User
 	err := pgxscan.Get(ctx, r.pool, &user,
-		"SELECT id, username, password_hash, role, created_at, updated_at FROM users WHERE username = $1",
+		"SELECT id, username, password_hash, role, status, pickup_point_id, created_at, updated_at FROM users WHERE username = $1",
 		username,
 	)
 
@@ -228,7 +311,7 @@ func (r *PostgresUserRepository) GetByUsername(ctx context.Context, username str
 // List возвращает список всех пользователей
 func (r *PostgresUserRepository) List(ctx context.Context, searchTerm string) ([]model.User, error) {
 	var users []model.User
-	query := "SELECT id, username, role, created_at, updated_at FROM users WHERE 1=1"
+	query := "SELECT id, username, role, status, pickup_point_id, created_at, updated_at FROM users WHERE 1=1"
 	var args []any
 
 	if searchTerm != "" {
@@ -252,15 +335,50 @@ func (r *PostgresUserRepository) List(ctx context.Context, searchTerm string) ([
 	return users, nil
 }
 
-// CheckPassword проверяет правильность пароля для указанного пользователя
+// CheckPassword проверяет правильность пароля для указанного пользователя.
+// Если пароль верен, но хеш получен устаревшим алгоритмом, пароль перехешируется.
 func (r *PostgresUserRepository) CheckPassword(ctx context.Context, username, password string) bool {
-
-	var storedPasswordHash string
-	err := r.pool.QueryRow(ctx, "SELECT password_hash FROM users WHERE username = $1", username).Scan(&storedPasswordHash)
+	var (
+		userID             int64
+		storedPasswordHash string
+	)
+	err := r.pool.QueryRow(ctx, "SELECT id, password_hash FROM users WHERE username = $1", username).Scan(&userID, &storedPasswordHash)
 	if err != nil {
 		logger.Errorf("Ошибка при получении пользователя %s: %v", username, err)
 		return false
 	}
 
-	return checkPassword(storedPasswordHash, password)
+	ok, needsRehash := verifyPassword(storedPasswordHash, password)
+	if !ok {
+		return false
+	}
+
+	if needsRehash {
+		if err := r.rehashPassword(ctx, userID, storedPasswordHash, password); err != nil {
+			logger.Warnf("Ошибка при обновлении хеша пароля пользователя %s: %v", username, err)
+		}
+	}
+
+	return true
+}
+
+// rehashPassword сохраняет пароль, захешированный текущим алгоритмом.
+// Хеш обновляется, только если он не изменился с момента проверки.
+func (r *PostgresUserRepository) rehashPassword(ctx context.Context, userID int64, oldHash, password string) error {
+	newHash, err := hashPassword(password)
+	if err != nil {
+		return fmt.Errorf("ошибка хеширования пароля: %w", err)
+	}
+
+	_, err = r.pool.Exec(ctx,
+		"UPDATE users SET password_hash = $2 WHERE id = $1 AND password_hash = $3",
+		userID,
+		newHash,
+		oldHash,
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка обновления хеша пароля: %w", err)
+	}
+
+	return nil
 }
@@ -1,18 +1,21 @@
 package repository
 
 import (
-	"crypto/rand"
-	"crypto/sha256"
-	"crypto/subtle"
 	"database/sql"
-	"encoding/base64"
 	"encoding/json"
-	"fmt"
-	"strings"
 
 	"gitlab.ozon.dev/gojhw1/pkg/model"
 )
 
+// getOrderStateStr преобразует указатель на OrderState в строку
+func getOrderStateStr(state *model.OrderState) string {
+	if state == nil {
+		return ""
+	}
+
+	return string(*state)
+}
+
 // getPackageTypeStr преобразует указатель на PackageType в строку
 func getPackageTypeStr(pt *model.PackageType) string {
 	if pt == nil {
@@ -31,6 +34,24 @@ func getWrapperTypeStr(wt *model.WrapperType) string {
 	return string(*wt)
 }
 
+// getPaymentModeStr преобразует способ оплаты заказа в строку, пустой способ означает предоплату
+func getPaymentModeStr(mode model.PaymentMode) string {
+	if mode == "" {
+		return string(model.PaymentModePrepaid)
+	}
+
+	return string(mode)
+}
+
+// getCustomerSegmentStr преобразует указатель на сегмент клиента в строку
+func getCustomerSegmentStr(segment *string) string {
+	if segment == nil {
+		return ""
+	}
+
+	return *segment
+}
+
 // nullableString возвращает sql.NullString из строки
 func nullableString(s string) sql.NullString {
 	return sql.NullString{
@@ -169,56 +190,3 @@ func fromAuditLogs(logs []model.AuditLog) ([]model.AuditLogDB, error) {
 
 	return dbLogs, nil
 }
-
-// generateSalt генерирует случайный соль заданного размера
-func generateSalt(size int) ([]byte, error) {
-	salt := make([]byte, size)
-	_, err := rand.Read(salt)
-	if err != nil {
-		return nil, err
-	}
-
-	return salt, nil
-}
-
-// hashPasswordSHA256 хеширует пароль с использованием SHA-256 и соли
-func hashPasswordSHA256(password string) (string, error) {
-	salt, err := generateSalt(16)
-	if err != nil {
-		return "", err
-	}
-
-	hash := sha256.New()
-	hash.Write(salt)
-	hash.Write([]byte(password))
-	hashedPassword := hash.Sum(nil)
-
-	return fmt.Sprintf("%s:%s",
-		base64.StdEncoding.EncodeToString(salt),
-		base64.StdEncoding.EncodeToString(hashedPassword)), nil
-}
-
-// checkPassword проверяет, соответствует ли введенный пароль хешу
-func checkPassword(storedHash, password string) bool {
-	parts := strings.Split(storedHash, ":")
-	if len(parts) != 2 {
-		return false
-	}
-
-	salt, err := base64.StdEncoding.DecodeString(parts[0])
-	if err != nil {
-		return false
-	}
-
-	storedPasswordHash, err := base64.StdEncoding.DecodeString(parts[1])
-	if err != nil {
-		return false
-	}
-
-	hash := sha256.New()
-	hash.Write(salt)
-	hash.Write([]byte(password))
-	hashedPassword := hash.Sum(nil)
-
-	return subtle.ConstantTimeCompare(hashedPassword, storedPasswordHash) == 1
-}
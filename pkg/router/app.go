@@ -7,24 +7,32 @@ import (
 	"github.com/gofiber/contrib/otelfiber"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/adaptor"
-	"github.com/gofiber/fiber/v2/middleware/basicauth"
 	"github.com/gofiber/fiber/v2/middleware/recover"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"gitlab.ozon.dev/gojhw1/pkg/handler"
 	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/service"
 )
 
 type orderServiceInterface interface {
-	AcceptOrder(ctx context.Context, id, customerID int64, deadline time.Time, weight, cost float64, packageType *model.PackageType, wrapper *model.WrapperType) error
-	ReturnOrderToCourier(ctx context.Context, id int64) error
-	DeliverOrder(ctx context.Context, id, customerID int64, now time.Time) error
-	ProcessReturnOrder(ctx context.Context, id, customerID int64, now time.Time) error
+	AcceptOrder(ctx context.Context, id, customerID, pickupPointID int64, deadline time.Time, weight, cost float64, packageType *model.PackageType, wrapper *model.WrapperType, paymentMode model.PaymentMode) error
+	AcceptOrderWithItems(ctx context.Context, id, customerID, pickupPointID int64, deadline time.Time, items []model.OrderItem, packageType *model.PackageType, wrapper *model.WrapperType, paymentMode model.PaymentMode) error
+	ReturnOrderToCourier(ctx context.Context, id, version int64) error
+	ExtendStorage(ctx context.Context, id, version int64, days int) (model.Order, model.OrderExtension, error)
+	DeliverOrder(ctx context.Context, id, version, customerID int64, itemIDs []int64, payment *model.PaymentDetails, now time.Time) error
+	ProcessReturnOrder(ctx context.Context, id, version, customerID int64, itemIDs []int64, details model.ReturnDetails, now time.Time) error
+	DeliverOrders(ctx context.Context, ids []int64, customerID int64, itemIDs []int64, payment *model.PaymentDetails, now time.Time) error
+	ProcessReturnOrders(ctx context.Context, ids []int64, customerID int64, itemIDs []int64, details model.ReturnDetails, now time.Time) error
 	OrderHistory(ctx context.Context, searchTerm string) ([]model.Order, error)
-	AcceptOrdersFromFile(ctx context.Context, filename string) error
 	GetOrderByID(ctx context.Context, id int64) (model.Order, error)
+	LocateOrder(ctx context.Context, id int64) (model.StorageCell, error)
+	OrderTimeline(ctx context.Context, id int64) ([]model.OrderStateTransition, error)
+	GetOrderPayments(ctx context.Context, id int64) (model.OrderPayments, error)
 	ClearDatabase(ctx context.Context) error
 	ListOrdersWithCursor(ctx context.Context, cursorID int64, limit int, customerID int64, filterPVZ bool, searchTerm string) ([]model.Order, error)
-	ListReturnsWithCursor(ctx context.Context, cursorID int64, limit int, searchTerm string) ([]model.Order, error)
+	ListReturnsWithCursor(ctx context.Context, cursorID int64, limit int, searchTerm string, filter model.ReturnFilter) ([]model.ReturnedOrder, error)
+	ListCourierReturnsWithCursor(ctx context.Context, cursorID int64, limit int) ([]model.Order, error)
+	ExportOrders(ctx context.Context, filter model.OrderFilter) (service.OrderExport, error)
 }
 
 type userRepository interface {
@@ -36,6 +44,67 @@ type userRepository interface {
 	GetByUsername(ctx context.Context, username string) (model.User, error)
 	List(ctx context.Context, searchTerm string) ([]model.User, error)
 	CheckPassword(ctx context.Context, username, password string) bool
+	Approve(ctx context.Context, userID int64, role string) error
+	AssignPickupPoint(ctx context.Context, userID int64, pickupPointID *int64) error
+}
+
+type pickupPointRepository interface {
+	Create(ctx context.Context, point model.PickupPoint) (model.PickupPoint, error)
+	Update(ctx context.Context, point model.PickupPoint) error
+	Delete(ctx context.Context, id int64) error
+	GetByID(ctx context.Context, id int64) (model.PickupPoint, error)
+	List(ctx context.Context) ([]model.PickupPoint, error)
+}
+
+type storageServiceInterface interface {
+	CreateCell(ctx context.Context, cell model.StorageCell) (model.StorageCell, error)
+	DeleteCell(ctx context.Context, id int64) error
+	Occupancy(ctx context.Context) ([]model.CellOccupancy, error)
+}
+
+type returnPolicyServiceInterface interface {
+	CreatePolicy(ctx context.Context, policy model.ReturnPolicy) (model.ReturnPolicy, error)
+	UpdatePolicy(ctx context.Context, policy model.ReturnPolicy) (model.ReturnPolicy, error)
+	DeletePolicy(ctx context.Context, id int64) error
+	GetPolicy(ctx context.Context, id int64) (model.ReturnPolicy, error)
+	ListPolicies(ctx context.Context) ([]model.ReturnPolicy, error)
+	SetCustomerSegment(ctx context.Context, customerID int64, segment string) (model.CustomerSegment, error)
+}
+
+type shiftServiceInterface interface {
+	OpenShift(ctx context.Context, pickupPointID int64, openingCash float64) (model.Shift, error)
+	CurrentShift(ctx context.Context, pickupPointID int64) (model.Shift, error)
+	GetShift(ctx context.Context, id int64) (model.Shift, error)
+	ListShiftsWithCursor(ctx context.Context, cursorID int64, limit int) ([]model.Shift, error)
+	CloseShift(ctx context.Context, id int64, countedCash float64) (model.Shift, error)
+	ShiftReport(ctx context.Context, id int64) (model.ShiftReport, error)
+}
+
+type authServiceInterface interface {
+	Login(ctx context.Context, username, password, ip string) (model.TokenPair, error)
+	Refresh(ctx context.Context, refreshToken string) (model.TokenPair, error)
+	Logout(ctx context.Context, refreshToken string) error
+	Unlock(ctx context.Context, username, ip string) error
+	Authenticate(ctx context.Context, username, password, ip string) error
+	ParseAccessToken(accessToken string) (int64, error)
+}
+
+type apiKeyServiceInterface interface {
+	Create(ctx context.Context, userID int64, name string, scopes []string, expiresAt *time.Time) (model.APIKey, string, error)
+	List(ctx context.Context, userID int64) ([]model.APIKey, error)
+	Revoke(ctx context.Context, userID, keyID int64) error
+	AuthenticateAPIKey(ctx context.Context, rawKey string) (model.User, model.APIKey, error)
+}
+
+type idempotencyServiceInterface interface {
+	Begin(ctx context.Context, username, key string, request []byte) (*model.IdempotencyRecord, error)
+	Complete(ctx context.Context, username, key string, statusCode int, response []byte) error
+	Release(ctx context.Context, username, key string) error
+}
+
+type importJobServiceInterface interface {
+	Submit(ctx context.Context, file model.ImportFile, options model.ImportOptions) (model.ImportJob, error)
+	Get(ctx context.Context, id int64) (model.ImportJob, error)
 }
 
 type auditLoggerInterface interface {
@@ -44,8 +113,9 @@ type auditLoggerInterface interface {
 	Shutdown()
 }
 
-// InitFiberApp инициализирует экземпляр приложения Fiber
-func InitFiberApp(ctx context.Context, orderService orderServiceInterface, userRepo userRepository, auditLogger auditLoggerInterface) *fiber.App {
+// InitFiberApp инициализирует экземпляр приложения Fiber.
+// basicAuthFallback разрешает аутентификацию по Basic Auth наряду с access-токенами.
+func InitFiberApp(ctx context.Context, orderService orderServiceInterface, userRepo userRepository, pickupPointRepo pickupPointRepository, storageService storageServiceInterface, returnPolicyService returnPolicyServiceInterface, shiftService shiftServiceInterface, authService authServiceInterface, apiKeyService apiKeyServiceInterface, idempotencyService idempotencyServiceInterface, importJobService importJobServiceInterface, auditLogger auditLoggerInterface, basicAuthFallback bool) *fiber.App {
 
 	// Создание экземпляра Fiber
 	app := fiber.New(fiber.Config{
@@ -80,28 +150,30 @@ func InitFiberApp(ctx context.Context, orderService orderServiceInterface, userR
 	// Настройка обработчиков
 	orderHandler := handler.NewOrderHandler(orderService)
 	userHandler := handler.NewUserHandler(userRepo)
+	authHandler := handler.NewAuthHandler(authService)
+	apiKeyHandler := handler.NewAPIKeyHandler(apiKeyService)
+	pickupPointHandler := handler.NewPickupPointHandler(pickupPointRepo)
+	storageHandler := handler.NewStorageHandler(storageService)
+	returnPolicyHandler := handler.NewReturnPolicyHandler(returnPolicyService)
+	importHandler := handler.NewImportHandler(importJobService)
+	shiftHandler := handler.NewShiftHandler(shiftService)
 
 	// Регистрация публичных маршрутов для пользователей (без аутентификации)
 	app.Post("/api/v1/users/register", userHandler.CreateUser)
 
-	// Настройка middleware для Basic Authentication
-	authConfig := basicauth.Config{
-		Realm: "Restricted Area",
-		Authorizer: func(username, password string) bool {
-			return userRepo.CheckPassword(ctx, username, password)
-		},
-		Unauthorized: func(c *fiber.Ctx) error {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"error": "Требуется авторизация",
-			})
-		},
-		ContextUsername: "username",
-	}
+	// Регистрация публичных маршрутов для входа и обновления токенов
+	app.Post("/api/v1/auth/login", authHandler.Login)
+	app.Post("/api/v1/auth/refresh", authHandler.Refresh)
+	app.Post("/api/v1/auth/logout", authHandler.Logout)
 
-	// Применяем Basic Auth middleware ко всем защищенным API маршрутам
-	api := app.Group("/api/v1", basicauth.New(authConfig))
+	// Применяем middleware аутентификации ко всем защищенным API маршрутам
+	api := app.Group("/api/v1", AuthMiddleware(authService, apiKeyService, basicAuthFallback))
 	api.Use(AuditMiddleware(auditLogger))
 	api.Use(otelfiber.Middleware(otelfiber.WithServerName("pvz-app")))
+	api.Use(PermissionMiddleware(userRepo))
+
+	// Снятие блокировки входа после неудачных попыток
+	api.Post("/auth/unlock", authHandler.Unlock)
 
 	// Регистрация защищенных маршрутов для пользователей
 	users := api.Group("/users")
@@ -110,20 +182,68 @@ func InitFiberApp(ctx context.Context, orderService orderServiceInterface, userR
 	users.Put("/:id", userHandler.UpdateUser)
 	users.Delete("/:id", userHandler.DeleteUser)
 	users.Put("/:id/password", userHandler.UpdatePassword)
-
-	// Регистрация защищенных маршрутов для заказов
-	orders := api.Group("/orders")
+	users.Post("/:id/approve", userHandler.ApproveUser)
+	users.Post("/:id/promote", userHandler.PromoteUser)
+	users.Put("/:id/pickup-point", userHandler.AssignPickupPoint)
+	users.Post("/:id/api-keys", apiKeyHandler.CreateAPIKey)
+	users.Get("/:id/api-keys", apiKeyHandler.ListAPIKeys)
+	users.Delete("/:id/api-keys/:keyId", apiKeyHandler.RevokeAPIKey)
+
+	// Регистрация защищенных маршрутов для ПВЗ
+	pickupPoints := api.Group("/pickup-points")
+	pickupPoints.Get("/", pickupPointHandler.ListPickupPoints)
+	pickupPoints.Post("/", pickupPointHandler.CreatePickupPoint)
+	pickupPoints.Get("/:id", pickupPointHandler.GetPickupPoint)
+	pickupPoints.Put("/:id", pickupPointHandler.UpdatePickupPoint)
+	pickupPoints.Delete("/:id", pickupPointHandler.DeletePickupPoint)
+
+	// Регистрация защищенных маршрутов для ячеек хранения
+	storageCells := api.Group("/storage-cells")
+	storageCells.Get("/", storageHandler.Occupancy)
+	storageCells.Post("/", storageHandler.CreateCell)
+	storageCells.Delete("/:id", storageHandler.DeleteCell)
+
+	// Регистрация защищенных маршрутов для заказов, изменяющие запросы можно повторять с ключом идемпотентности
+	orders := api.Group("/orders", IdempotencyMiddleware(idempotencyService))
 	orders.Post("/", orderHandler.CreateOrder)
 	orders.Get("/", orderHandler.ListOrders)
 	orders.Get("/history", orderHandler.OrderHistory)
-	orders.Post("/accept", orderHandler.AcceptOrdersFromFile)
+	orders.Get("/export", orderHandler.ExportOrders)
+	orders.Post("/accept", importHandler.AcceptOrdersFromFile)
 	orders.Get("/:id", orderHandler.GetOrder)
+	orders.Get("/:id/location", orderHandler.LocateOrder)
+	orders.Get("/:id/timeline", orderHandler.OrderTimeline)
+	orders.Get("/:id/payments", orderHandler.OrderPayments)
 	orders.Delete("/:id/return", orderHandler.ReturnToCourier)
+	orders.Post("/:id/extend", orderHandler.ExtendStorage)
 	orders.Put("/:id/process", orderHandler.ProcessCustomer)
 
+	// Маршрут для задач импорта заказов из файла
+	imports := api.Group("/imports")
+	imports.Get("/:id", importHandler.GetImportJob)
+
 	// Маршрут для возвратов
 	returns := api.Group("/returns")
 	returns.Get("/", orderHandler.ListReturns)
+	returns.Get("/courier", orderHandler.ListCourierReturns)
+
+	// Регистрация защищенных маршрутов для политик возврата и сегментов клиентов
+	returnPolicies := api.Group("/return-policies")
+	returnPolicies.Get("/", returnPolicyHandler.ListReturnPolicies)
+	returnPolicies.Post("/", returnPolicyHandler.CreateReturnPolicy)
+	returnPolicies.Get("/:id", returnPolicyHandler.GetReturnPolicy)
+	returnPolicies.Put("/:id", returnPolicyHandler.UpdateReturnPolicy)
+	returnPolicies.Delete("/:id", returnPolicyHandler.DeleteReturnPolicy)
+	api.Put("/customers/:id/segment", returnPolicyHandler.SetCustomerSegment)
+
+	// Регистрация защищенных маршрутов для смен ПВЗ и сверки кассы
+	shifts := api.Group("/shifts")
+	shifts.Get("/", shiftHandler.ListShifts)
+	shifts.Post("/", shiftHandler.OpenShift)
+	shifts.Get("/current", shiftHandler.CurrentShift)
+	shifts.Get("/:id", shiftHandler.GetShift)
+	shifts.Post("/:id/close", shiftHandler.CloseShift)
+	shifts.Get("/:id/report", shiftHandler.ShiftReport)
 
 	// Маршрут для операций с базой данных
 	db := api.Group("/db")
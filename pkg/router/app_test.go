@@ -2,12 +2,20 @@ package router
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/rbac"
+	"gitlab.ozon.dev/gojhw1/pkg/repository"
+	"gitlab.ozon.dev/gojhw1/pkg/service"
 	"go.uber.org/mock/gomock"
 )
 
@@ -19,12 +27,103 @@ func TestInitFiberApp(t *testing.T) {
 	// Создаем моки необходимых интерфейсов
 	mockOrderService := NewMockorderServiceInterface(ctrl)
 	mockUserRepo := NewMockuserRepository(ctrl)
+	mockPickupPointRepo := NewMockpickupPointRepository(ctrl)
+	mockStorageService := NewMockstorageServiceInterface(ctrl)
+	mockReturnPolicyService := NewMockreturnPolicyServiceInterface(ctrl)
+	mockShiftService := NewMockshiftServiceInterface(ctrl)
 	mockAuditLogger := NewMockauditLoggerInterface(ctrl)
+	mockAuthService := NewMockauthServiceInterface(ctrl)
+	mockAPIKeyService := NewMockapiKeyServiceInterface(ctrl)
+	mockIdempotencyService := NewMockidempotencyServiceInterface(ctrl)
+	mockImportJobService := NewMockimportJobServiceInterface(ctrl)
+
+	// Настраиваем проверку access-токенов: токен ссылается на пользователя по ID
+	mockAuthService.EXPECT().
+		ParseAccessToken("valid-token").
+		Return(int64(1), nil).
+		AnyTimes()
+
+	mockAuthService.EXPECT().
+		ParseAccessToken("deleted-user-token").
+		Return(int64(99), nil).
+		AnyTimes()
+
+	mockAuthService.EXPECT().
+		ParseAccessToken(gomock.Not(gomock.AnyOf("valid-token", "deleted-user-token"))).
+		Return(int64(0), errors.New("invalid token")).
+		AnyTimes()
 
 	// Настраиваем ожидаемое поведение для аутентификации
+	mockAuthService.EXPECT().
+		Authenticate(gomock.Any(), "testuser", "testpass", gomock.Any()).
+		Return(nil).
+		AnyTimes()
+
+	mockAuthService.EXPECT().
+		Authenticate(gomock.Any(), "courier", "courierpass", gomock.Any()).
+		Return(nil).
+		AnyTimes()
+
+	mockAuthService.EXPECT().
+		Authenticate(gomock.Any(), "testuser", "wrongpass", gomock.Any()).
+		Return(fmt.Errorf("%w: повторите через 15m0s", service.ErrAccountLocked)).
+		AnyTimes()
+
+	// Настраиваем проверку API-ключей: ключ администратора ограничен чтением заказов
+	mockAPIKeyService.EXPECT().
+		AuthenticateAPIKey(gomock.Any(), "pvz_reader_secret").
+		Return(
+			model.User{ID: 1, Username: "testuser", Role: model.RoleAdmin, Status: model.UserStatusActive},
+			model.APIKey{ID: 1, UserID: 1, Prefix: "reader", Scopes: []string{string(rbac.PermOrdersRead)}},
+			nil,
+		).
+		AnyTimes()
+
+	mockAPIKeyService.EXPECT().
+		AuthenticateAPIKey(gomock.Any(), gomock.Not("pvz_reader_secret")).
+		Return(model.User{}, model.APIKey{}, service.ErrInvalidAPIKey).
+		AnyTimes()
+
+	// Настраиваем роли пользователей для проверки прав
+	mockUserRepo.EXPECT().
+		GetByUsername(gomock.Any(), "testuser").
+		Return(model.User{ID: 1, Username: "testuser", Role: model.RoleAdmin, Status: model.UserStatusActive}, nil).
+		AnyTimes()
+
+	mockUserRepo.EXPECT().
+		GetByUsername(gomock.Any(), "courier").
+		Return(model.User{ID: 2, Username: "courier", Role: model.RoleCourier, Status: model.UserStatusActive}, nil).
+		AnyTimes()
+
+	mockAuthService.EXPECT().
+		Authenticate(gomock.Any(), "newuser", "newpass", gomock.Any()).
+		Return(nil).
+		AnyTimes()
+
+	mockUserRepo.EXPECT().
+		GetByUsername(gomock.Any(), "newuser").
+		Return(model.User{ID: 3, Username: "newuser", Role: model.RoleCourier, Status: model.UserStatusPending}, nil).
+		AnyTimes()
+
+	mockUserRepo.EXPECT().
+		GetByID(gomock.Any(), int64(1)).
+		Return(model.User{ID: 1, Username: "testuser", Role: model.RoleAdmin, Status: model.UserStatusActive}, nil).
+		AnyTimes()
+
+	mockUserRepo.EXPECT().
+		GetByID(gomock.Any(), int64(99)).
+		Return(model.User{}, repository.ErrUserNotFound).
+		AnyTimes()
+
+	// Курьер может сменить только собственный пароль
 	mockUserRepo.EXPECT().
-		CheckPassword(gomock.Any(), "testuser", "testpass").
-		Return(true).
+		GetByID(gomock.Any(), int64(2)).
+		Return(model.User{ID: 2, Username: "courier", Role: model.RoleCourier, Status: model.UserStatusActive}, nil).
+		AnyTimes()
+
+	mockUserRepo.EXPECT().
+		UpdatePassword(gomock.Any(), int64(2), "new_password123").
+		Return(nil).
 		AnyTimes()
 
 	mockUserRepo.EXPECT().
@@ -33,7 +132,7 @@ func TestInitFiberApp(t *testing.T) {
 		AnyTimes()
 
 	mockOrderService.EXPECT().
-		ListReturnsWithCursor(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		ListReturnsWithCursor(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil, nil).
 		AnyTimes()
 
@@ -42,14 +141,40 @@ func TestInitFiberApp(t *testing.T) {
 		Return(nil, nil).
 		AnyTimes()
 
+	mockOrderService.EXPECT().
+		ListCourierReturnsWithCursor(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		AnyTimes()
+
+	mockOrderService.EXPECT().
+		ExportOrders(gomock.Any(), gomock.Any()).
+		Return(func(func(model.Order) error) error { return nil }, nil).
+		AnyTimes()
+
+	mockReturnPolicyService.EXPECT().
+		ListPolicies(gomock.Any()).
+		Return(nil, nil).
+		AnyTimes()
+
 	mockAuditLogger.EXPECT().
 		Log(gomock.Any(), gomock.Any()).
 		Return().
 		AnyTimes()
 
+	// Настраиваем ключи идемпотентности: первый ключ уже выполнен, второй использован с другим запросом
+	mockIdempotencyService.EXPECT().
+		Begin(gomock.Any(), "testuser", "retry-1", gomock.Any()).
+		Return(&model.IdempotencyRecord{StatusCode: fiber.StatusOK, Response: []byte(`{"message":"Заказы выданы"}`)}, nil).
+		AnyTimes()
+
+	mockIdempotencyService.EXPECT().
+		Begin(gomock.Any(), "testuser", "reused-1", gomock.Any()).
+		Return(nil, fmt.Errorf("%w: reused-1", service.ErrIdempotencyKeyReused)).
+		AnyTimes()
+
 	// Инициализируем приложение
 	ctx := context.Background()
-	app := InitFiberApp(ctx, mockOrderService, mockUserRepo, mockAuditLogger)
+	app := InitFiberApp(ctx, mockOrderService, mockUserRepo, mockPickupPointRepo, mockStorageService, mockReturnPolicyService, mockShiftService, mockAuthService, mockAPIKeyService, mockIdempotencyService, mockImportJobService, mockAuditLogger, true)
 
 	// Проверяем незащищенные маршруты
 	t.Run("Public routes", func(t *testing.T) {
@@ -77,11 +202,26 @@ func TestInitFiberApp(t *testing.T) {
 				path:   "/api/v1/orders",
 				method: fiber.MethodGet,
 			},
+			{
+				name:   "export orders",
+				path:   "/api/v1/orders/export",
+				method: fiber.MethodGet,
+			},
 			{
 				name:   "get returns",
 				path:   "/api/v1/returns",
 				method: fiber.MethodGet,
 			},
+			{
+				name:   "get courier returns",
+				path:   "/api/v1/returns/courier",
+				method: fiber.MethodGet,
+			},
+			{
+				name:   "get return policies",
+				path:   "/api/v1/return-policies",
+				method: fiber.MethodGet,
+			},
 		}
 
 		for _, tt := range tests {
@@ -120,4 +260,250 @@ func TestInitFiberApp(t *testing.T) {
 			})
 		}
 	})
+
+	// Проверяем аутентификацию по access-токену
+	t.Run("Protected routes with bearer token", func(t *testing.T) {
+		tests := []struct {
+			name           string
+			token          string
+			expectedStatus int
+		}{
+			{
+				name:           "valid token",
+				token:          "valid-token",
+				expectedStatus: fiber.StatusOK,
+			},
+			{
+				name:           "invalid token",
+				token:          "forged-token",
+				expectedStatus: fiber.StatusUnauthorized,
+			},
+			{
+				name:           "token of deleted user",
+				token:          "deleted-user-token",
+				expectedStatus: fiber.StatusUnauthorized,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				req := httptest.NewRequest(fiber.MethodGet, "/api/v1/returns", nil)
+				req.Header.Set(fiber.HeaderAuthorization, "Bearer "+tt.token)
+
+				resp, err := app.Test(req, -1)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			})
+		}
+	})
+
+	// Проверяем, что без явного включения Basic Auth не принимается
+	t.Run("Basic auth disabled", func(t *testing.T) {
+		tokenOnlyApp := InitFiberApp(ctx, mockOrderService, mockUserRepo, mockPickupPointRepo, mockStorageService, mockReturnPolicyService, mockShiftService, mockAuthService, mockAPIKeyService, mockIdempotencyService, mockImportJobService, mockAuditLogger, false)
+
+		req := httptest.NewRequest(fiber.MethodGet, "/api/v1/orders", nil)
+		req.SetBasicAuth("testuser", "testpass")
+
+		resp, err := tokenOnlyApp.Test(req, -1)
+		require.NoError(t, err)
+
+		assert.Equal(t, fiber.StatusUnauthorized, resp.StatusCode)
+	})
+
+	// Проверяем, что при блокировке входа после неудачных попыток возвращается 429
+	t.Run("Basic auth locked", func(t *testing.T) {
+		req := httptest.NewRequest(fiber.MethodGet, "/api/v1/orders", nil)
+		req.SetBasicAuth("testuser", "wrongpass")
+
+		resp, err := app.Test(req, -1)
+		require.NoError(t, err)
+
+		assert.Equal(t, fiber.StatusTooManyRequests, resp.StatusCode)
+	})
+
+	// Проверяем аутентификацию по API-ключу и ограничение его областями действия
+	t.Run("API key", func(t *testing.T) {
+		tests := []struct {
+			name           string
+			path           string
+			method         string
+			key            string
+			expectedStatus int
+		}{
+			{
+				name:           "scope allows operation",
+				path:           "/api/v1/returns",
+				method:         fiber.MethodGet,
+				key:            "pvz_reader_secret",
+				expectedStatus: fiber.StatusOK,
+			},
+			{
+				name:           "scope denies operation",
+				path:           "/api/v1/db",
+				method:         fiber.MethodDelete,
+				key:            "pvz_reader_secret",
+				expectedStatus: fiber.StatusForbidden,
+			},
+			{
+				name:           "invalid key",
+				path:           "/api/v1/returns",
+				method:         fiber.MethodGet,
+				key:            "pvz_unknown_secret",
+				expectedStatus: fiber.StatusUnauthorized,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				req := httptest.NewRequest(tt.method, tt.path, nil)
+				req.Header.Set("X-API-Key", tt.key)
+
+				resp, err := app.Test(req, -1)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			})
+		}
+	})
+
+	// Проверяем, что повтор запроса с ключом идемпотентности не выполняет операцию заново
+	t.Run("Idempotency key", func(t *testing.T) {
+		tests := []struct {
+			name           string
+			key            string
+			expectedStatus int
+			expectedBody   string
+			replayed       bool
+		}{
+			{
+				name:           "replay completed request",
+				key:            "retry-1",
+				expectedStatus: fiber.StatusOK,
+				expectedBody:   `{"message":"Заказы выданы"}`,
+				replayed:       true,
+			},
+			{
+				name:           "key reused with other request",
+				key:            "reused-1",
+				expectedStatus: fiber.StatusUnprocessableEntity,
+				expectedBody:   "ключ идемпотентности уже использован с другим запросом",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				req := httptest.NewRequest(fiber.MethodPut, "/api/v1/orders/1/process", strings.NewReader(`{"customer_id":1,"action":"handout","order_ids":[1]}`))
+				req.Header.Set(fiber.HeaderAuthorization, "Bearer valid-token")
+				req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+				req.Header.Set("Idempotency-Key", tt.key)
+
+				resp, err := app.Test(req, -1)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				assert.Contains(t, string(body), tt.expectedBody)
+
+				if tt.replayed {
+					assert.Equal(t, "true", resp.Header.Get("Idempotent-Replayed"))
+				}
+			})
+		}
+	})
+
+	// Проверяем защищенные маршруты для роли без нужных прав
+	t.Run("Protected routes with insufficient role", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			path   string
+			method string
+		}{
+			{
+				name:   "get users",
+				path:   "/api/v1/users",
+				method: fiber.MethodGet,
+			},
+			{
+				name:   "clear database",
+				path:   "/api/v1/db",
+				method: fiber.MethodDelete,
+			},
+			{
+				name:   "create return policy",
+				path:   "/api/v1/return-policies",
+				method: fiber.MethodPost,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				req := httptest.NewRequest(tt.method, tt.path, nil)
+				req.SetBasicAuth("courier", "courierpass")
+
+				resp, err := app.Test(req, -1)
+				require.NoError(t, err)
+
+				assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+			})
+		}
+	})
+
+	// Проверяем, что пользователь без права управления пользователями может сменить только свой пароль
+	t.Run("Update own password", func(t *testing.T) {
+		tests := []struct {
+			name           string
+			path           string
+			expectedStatus int
+		}{
+			{
+				name:           "own password",
+				path:           "/api/v1/users/2/password",
+				expectedStatus: fiber.StatusOK,
+			},
+			{
+				name:           "other user password",
+				path:           "/api/v1/users/1/password",
+				expectedStatus: fiber.StatusForbidden,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				req := httptest.NewRequest(fiber.MethodPut, tt.path, strings.NewReader(`{"password":"new_password123"}`))
+				req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+				req.SetBasicAuth("courier", "courierpass")
+
+				resp, err := app.Test(req, -1)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			})
+		}
+	})
+
+	// Проверяем, что неподтвержденный пользователь не получает доступа к API
+	t.Run("Protected routes with pending user", func(t *testing.T) {
+		req := httptest.NewRequest(fiber.MethodGet, "/api/v1/orders", nil)
+		req.SetBasicAuth("newuser", "newpass")
+
+		resp, err := app.Test(req, -1)
+		require.NoError(t, err)
+
+		assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+	})
+
+	// Проверяем, что каждый защищенный маршрут описан в таблице прав
+	t.Run("Every API route has permission rule", func(t *testing.T) {
+		for _, route := range app.GetRoutes(true) {
+			if route.Method == fiber.MethodHead || !strings.HasPrefix(route.Path, "/api/v1") {
+				continue
+			}
+
+			_, ok := rbac.RuleForHTTP(route.Method, route.Path)
+			assert.True(t, ok, "нет правила для %s %s", route.Method, route.Path)
+		}
+	})
 }
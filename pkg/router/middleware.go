@@ -2,20 +2,111 @@ package router
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"gitlab.ozon.dev/gojhw1/pkg/metrics"
 	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/rbac"
+	"gitlab.ozon.dev/gojhw1/pkg/service"
 )
 
 type logger interface {
 	Log(ctx context.Context, log model.AuditLog)
 }
 
+type userProvider interface {
+	GetByID(ctx context.Context, id int64) (model.User, error)
+	GetByUsername(ctx context.Context, username string) (model.User, error)
+}
+
+type authenticator interface {
+	ParseAccessToken(accessToken string) (int64, error)
+	Authenticate(ctx context.Context, username, password, ip string) error
+}
+
+type apiKeyAuthenticator interface {
+	AuthenticateAPIKey(ctx context.Context, rawKey string) (model.User, model.APIKey, error)
+}
+
+// apiKeyHeader - заголовок, в котором интеграции передают API-ключ
+const apiKeyHeader = "X-API-Key"
+
+// AuthMiddleware создает middleware аутентификации по access-токену из заголовка
+// "Authorization: Bearer <token>" или по API-ключу из заголовка X-API-Key.
+// Если basicAuthFallback включен, дополнительно принимается Basic Auth.
+// Пароли Basic Auth проверяются с защитой от перебора, при блокировке возвращается 429.
+// ID пользователя из access-токена или API-ключа сохраняется в c.Locals("user_id"),
+// имя пользователя из Basic Auth - в c.Locals("username"), области действия API-ключа - в c.Locals("api_key_scopes").
+func AuthMiddleware(auth authenticator, apiKeys apiKeyAuthenticator, basicAuthFallback bool) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if rawKey := c.Get(apiKeyHeader); rawKey != "" {
+			user, key, err := apiKeys.AuthenticateAPIKey(c.UserContext(), rawKey)
+			if err != nil {
+				return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+					"error": service.ErrInvalidAPIKey.Error(),
+				})
+			}
+
+			c.Locals("user_id", user.ID)
+			c.Locals("api_key_scopes", key.Scopes)
+			return c.Next()
+		}
+
+		header := c.Get(fiber.HeaderAuthorization)
+
+		switch {
+		case strings.HasPrefix(header, "Bearer "):
+			userID, err := auth.ParseAccessToken(strings.TrimPrefix(header, "Bearer "))
+			if err == nil {
+				c.Locals("user_id", userID)
+				return c.Next()
+			}
+		case basicAuthFallback && strings.HasPrefix(header, "Basic "):
+			username, password, ok := parseBasicAuth(header)
+			if !ok {
+				break
+			}
+
+			err := auth.Authenticate(c.UserContext(), username, password, c.IP())
+			if err == nil {
+				c.Locals("username", username)
+				return c.Next()
+			}
+
+			if errors.Is(err, service.ErrAccountLocked) {
+				return c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+					"error": err.Error(),
+				})
+			}
+		}
+
+		if basicAuthFallback {
+			c.Set(fiber.HeaderWWWAuthenticate, `Basic realm="Restricted Area"`)
+		}
+
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Требуется авторизация",
+		})
+	}
+}
+
+// parseBasicAuth извлекает учетные данные из заголовка Basic Auth
+func parseBasicAuth(header string) (username, password string, ok bool) {
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(header, "Basic "))
+	if err != nil {
+		return "", "", false
+	}
+
+	return strings.Cut(string(decoded), ":")
+}
+
 // AuditMiddleware создает middleware для логирования запросов и ответов
 func AuditMiddleware(logger logger) fiber.Handler {
 	return func(c *fiber.Ctx) error {
@@ -70,6 +161,120 @@ func AuditMiddleware(logger logger) fiber.Handler {
 	}
 }
 
+// PermissionMiddleware создает middleware для проверки прав пользователя по таблице прав rbac.
+// Должен подключаться после аутентификации, которая кладет ID пользователя в c.Locals("user_id")
+// или имя пользователя в c.Locals("username"). Имя найденного пользователя сохраняется в c.Locals("username").
+func PermissionMiddleware(users userProvider) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		rule, ok := rbac.RuleForHTTP(c.Method(), c.Path())
+		if !ok {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": rbac.ErrUnknownOperation.Error(),
+			})
+		}
+
+		if rule.Public {
+			return c.Next()
+		}
+
+		var user model.User
+		var err error
+		if userID, ok := c.Locals("user_id").(int64); ok {
+			user, err = users.GetByID(c.UserContext(), userID)
+		} else {
+			username, _ := c.Locals("username").(string)
+			user, err = users.GetByUsername(c.UserContext(), username)
+		}
+		if err != nil {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "Требуется авторизация",
+			})
+		}
+
+		// Параметры маршрута на этом этапе еще не разобраны, поэтому ID берется из пути по шаблону правила
+		targetID, _ := strconv.ParseInt(rbac.PathParam(rule.Path, c.Path(), "id"), 10, 64)
+		if err = rbac.AuthorizeTarget(user, rule, targetID); err != nil {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+
+		if scopes, ok := c.Locals("api_key_scopes").([]string); ok {
+			if err = rbac.AuthorizeScopes(scopes, rule); err != nil {
+				return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+					"error": err.Error(),
+				})
+			}
+		}
+
+		c.Locals("username", user.Username)
+		c.SetUserContext(rbac.ContextWithUser(c.UserContext(), user))
+
+		return c.Next()
+	}
+}
+
+// Заголовки ключа идемпотентности запроса и признака ответа, восстановленного по этому ключу
+const (
+	idempotencyKeyHeader     = "Idempotency-Key"
+	idempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+// IdempotencyMiddleware создает middleware, которое выполняет изменяющий запрос с заголовком Idempotency-Key не более одного раза.
+// Успешный ответ сохраняется, и повтор запроса с тем же ключом получает его без повторного выполнения.
+// Ключ, использованный с другим запросом, отклоняется с кодом 422, повтор еще выполняющегося запроса - с кодом 409.
+// Должен подключаться после аутентификации, ключи хранятся отдельно для каждого пользователя.
+func IdempotencyMiddleware(idempotency idempotencyServiceInterface) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		key := c.Get(idempotencyKeyHeader)
+		if key == "" || c.Method() == fiber.MethodGet || c.Method() == fiber.MethodHead {
+			return c.Next()
+		}
+
+		ctx := c.UserContext()
+		username, _ := c.Locals("username").(string)
+
+		request := append([]byte(c.Method()+" "+c.OriginalURL()+"\n"), c.Body()...)
+		record, err := idempotency.Begin(ctx, username, key, request)
+		if err != nil {
+			switch {
+			case errors.Is(err, service.ErrInvalidIdempotencyKey):
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+			case errors.Is(err, service.ErrIdempotencyKeyReused):
+				return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{"error": err.Error()})
+			case errors.Is(err, service.ErrIdempotencyKeyInProgress):
+				return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+			default:
+				return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error": "Ошибка при проверке ключа идемпотентности",
+				})
+			}
+		}
+
+		if record != nil {
+			c.Set(idempotentReplayedHeader, "true")
+			c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSONCharsetUTF8)
+			return c.Status(record.StatusCode).Send(record.Response)
+		}
+
+		if err = c.Next(); err != nil {
+			_ = idempotency.Release(ctx, username, key)
+			return err
+		}
+
+		statusCode := c.Response().StatusCode()
+		if statusCode < fiber.StatusOK || statusCode >= fiber.StatusMultipleChoices {
+			_ = idempotency.Release(ctx, username, key)
+			return nil
+		}
+
+		response := append([]byte(nil), c.Response().Body()...)
+		_ = idempotency.Complete(ctx, username, key, statusCode, response)
+
+		return nil
+	}
+}
+
 func MetricsMiddleware() fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		start := time.Now()
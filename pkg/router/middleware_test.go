@@ -0,0 +1,76 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/gojhw1/pkg/cache"
+	"gitlab.ozon.dev/gojhw1/pkg/service"
+	"go.uber.org/mock/gomock"
+)
+
+// staticPasswords проверяет пароли по фиксированному списку пользователей
+type staticPasswords map[string]string
+
+func (p staticPasswords) CheckPassword(_ context.Context, username, password string) bool {
+	expected, ok := p[username]
+	return ok && expected == password
+}
+
+// basicOnlyAuthenticator принимает только Basic Auth через LoginGuard
+type basicOnlyAuthenticator struct {
+	*service.LoginGuard
+}
+
+func (basicOnlyAuthenticator) ParseAccessToken(string) (int64, error) {
+	return 0, errors.New("invalid token")
+}
+
+func TestAuthMiddleware_LoginGuardLockout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAuditLogger := NewMockauditLoggerInterface(ctrl)
+	mockAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).Times(1)
+
+	guard := service.NewLoginGuard(
+		staticPasswords{"testuser": "testpass", "courier": "courierpass"},
+		cache.NewInMemoryAttemptStore(),
+		mockAuditLogger,
+		service.LockoutPolicy{MaxAttempts: 3, IPMaxAttempts: 10, Window: time.Minute, LockDuration: 15 * time.Minute},
+	)
+
+	app := fiber.New()
+	app.Use(AuthMiddleware(basicOnlyAuthenticator{guard}, nil, true))
+	app.Get("/", func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusOK)
+	})
+
+	tests := []struct {
+		name           string
+		username       string
+		password       string
+		expectedStatus int
+	}{
+		{name: "first failure", username: "testuser", password: "wrongpass", expectedStatus: fiber.StatusUnauthorized},
+		{name: "second failure", username: "testuser", password: "wrongpass", expectedStatus: fiber.StatusUnauthorized},
+		{name: "failure reaching limit", username: "testuser", password: "wrongpass", expectedStatus: fiber.StatusUnauthorized},
+		{name: "locked with correct password", username: "testuser", password: "testpass", expectedStatus: fiber.StatusTooManyRequests},
+		{name: "other user not locked", username: "courier", password: "courierpass", expectedStatus: fiber.StatusOK},
+	}
+
+	// Шаги выполняются последовательно: каждый следующий зависит от накопленных неудачных попыток
+	for _, tt := range tests {
+		req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+		req.SetBasicAuth(tt.username, tt.password)
+
+		resp, err := app.Test(req, -1)
+		require.NoError(t, err, tt.name)
+
+		assert.Equal(t, tt.expectedStatus, resp.StatusCode, tt.name)
+	}
+}
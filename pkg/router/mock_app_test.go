@@ -15,6 +15,7 @@ import (
 	time "time"
 
 	model "gitlab.ozon.dev/gojhw1/pkg/model"
+	service "gitlab.ozon.dev/gojhw1/pkg/service"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -43,17 +44,17 @@ func (m *MockorderServiceInterface) EXPECT() *MockorderServiceInterfaceMockRecor
 }
 
 // AcceptOrder mocks base method.
-func (m *MockorderServiceInterface) AcceptOrder(ctx context.Context, id, customerID int64, deadline time.Time, weight, cost float64, packageType *model.PackageType, wrapper *model.WrapperType) error {
+func (m *MockorderServiceInterface) AcceptOrder(ctx context.Context, id, customerID, pickupPointID int64, deadline time.Time, weight, cost float64, packageType *model.PackageType, wrapper *model.WrapperType, paymentMode model.PaymentMode) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AcceptOrder", ctx, id, customerID, deadline, weight, cost, packageType, wrapper)
+	ret := m.ctrl.Call(m, "AcceptOrder", ctx, id, customerID, pickupPointID, deadline, weight, cost, packageType, wrapper, paymentMode)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AcceptOrder indicates an expected call of AcceptOrder.
-func (mr *MockorderServiceInterfaceMockRecorder) AcceptOrder(ctx, id, customerID, deadline, weight, cost, packageType, wrapper any) *MockorderServiceInterfaceAcceptOrderCall {
+func (mr *MockorderServiceInterfaceMockRecorder) AcceptOrder(ctx, id, customerID, pickupPointID, deadline, weight, cost, packageType, wrapper, paymentMode any) *MockorderServiceInterfaceAcceptOrderCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOrder", reflect.TypeOf((*MockorderServiceInterface)(nil).AcceptOrder), ctx, id, customerID, deadline, weight, cost, packageType, wrapper)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOrder", reflect.TypeOf((*MockorderServiceInterface)(nil).AcceptOrder), ctx, id, customerID, pickupPointID, deadline, weight, cost, packageType, wrapper, paymentMode)
 	return &MockorderServiceInterfaceAcceptOrderCall{Call: call}
 }
 
@@ -69,51 +70,51 @@ func (c *MockorderServiceInterfaceAcceptOrderCall) Return(arg0 error) *Mockorder
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockorderServiceInterfaceAcceptOrderCall) Do(f func(context.Context, int64, int64, time.Time, float64, float64, *model.PackageType, *model.WrapperType) error) *MockorderServiceInterfaceAcceptOrderCall {
+func (c *MockorderServiceInterfaceAcceptOrderCall) Do(f func(context.Context, int64, int64, int64, time.Time, float64, float64, *model.PackageType, *model.WrapperType, model.PaymentMode) error) *MockorderServiceInterfaceAcceptOrderCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockorderServiceInterfaceAcceptOrderCall) DoAndReturn(f func(context.Context, int64, int64, time.Time, float64, float64, *model.PackageType, *model.WrapperType) error) *MockorderServiceInterfaceAcceptOrderCall {
+func (c *MockorderServiceInterfaceAcceptOrderCall) DoAndReturn(f func(context.Context, int64, int64, int64, time.Time, float64, float64, *model.PackageType, *model.WrapperType, model.PaymentMode) error) *MockorderServiceInterfaceAcceptOrderCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// AcceptOrdersFromFile mocks base method.
-func (m *MockorderServiceInterface) AcceptOrdersFromFile(ctx context.Context, filename string) error {
+// AcceptOrderWithItems mocks base method.
+func (m *MockorderServiceInterface) AcceptOrderWithItems(ctx context.Context, id, customerID, pickupPointID int64, deadline time.Time, items []model.OrderItem, packageType *model.PackageType, wrapper *model.WrapperType, paymentMode model.PaymentMode) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AcceptOrdersFromFile", ctx, filename)
+	ret := m.ctrl.Call(m, "AcceptOrderWithItems", ctx, id, customerID, pickupPointID, deadline, items, packageType, wrapper, paymentMode)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// AcceptOrdersFromFile indicates an expected call of AcceptOrdersFromFile.
-func (mr *MockorderServiceInterfaceMockRecorder) AcceptOrdersFromFile(ctx, filename any) *MockorderServiceInterfaceAcceptOrdersFromFileCall {
+// AcceptOrderWithItems indicates an expected call of AcceptOrderWithItems.
+func (mr *MockorderServiceInterfaceMockRecorder) AcceptOrderWithItems(ctx, id, customerID, pickupPointID, deadline, items, packageType, wrapper, paymentMode any) *MockorderServiceInterfaceAcceptOrderWithItemsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOrdersFromFile", reflect.TypeOf((*MockorderServiceInterface)(nil).AcceptOrdersFromFile), ctx, filename)
-	return &MockorderServiceInterfaceAcceptOrdersFromFileCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOrderWithItems", reflect.TypeOf((*MockorderServiceInterface)(nil).AcceptOrderWithItems), ctx, id, customerID, pickupPointID, deadline, items, packageType, wrapper, paymentMode)
+	return &MockorderServiceInterfaceAcceptOrderWithItemsCall{Call: call}
 }
 
-// MockorderServiceInterfaceAcceptOrdersFromFileCall wrap *gomock.Call
-type MockorderServiceInterfaceAcceptOrdersFromFileCall struct {
+// MockorderServiceInterfaceAcceptOrderWithItemsCall wrap *gomock.Call
+type MockorderServiceInterfaceAcceptOrderWithItemsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockorderServiceInterfaceAcceptOrdersFromFileCall) Return(arg0 error) *MockorderServiceInterfaceAcceptOrdersFromFileCall {
+func (c *MockorderServiceInterfaceAcceptOrderWithItemsCall) Return(arg0 error) *MockorderServiceInterfaceAcceptOrderWithItemsCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockorderServiceInterfaceAcceptOrdersFromFileCall) Do(f func(context.Context, string) error) *MockorderServiceInterfaceAcceptOrdersFromFileCall {
+func (c *MockorderServiceInterfaceAcceptOrderWithItemsCall) Do(f func(context.Context, int64, int64, int64, time.Time, []model.OrderItem, *model.PackageType, *model.WrapperType, model.PaymentMode) error) *MockorderServiceInterfaceAcceptOrderWithItemsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockorderServiceInterfaceAcceptOrdersFromFileCall) DoAndReturn(f func(context.Context, string) error) *MockorderServiceInterfaceAcceptOrdersFromFileCall {
+func (c *MockorderServiceInterfaceAcceptOrderWithItemsCall) DoAndReturn(f func(context.Context, int64, int64, int64, time.Time, []model.OrderItem, *model.PackageType, *model.WrapperType, model.PaymentMode) error) *MockorderServiceInterfaceAcceptOrderWithItemsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -157,17 +158,17 @@ func (c *MockorderServiceInterfaceClearDatabaseCall) DoAndReturn(f func(context.
 }
 
 // DeliverOrder mocks base method.
-func (m *MockorderServiceInterface) DeliverOrder(ctx context.Context, id, customerID int64, now time.Time) error {
+func (m *MockorderServiceInterface) DeliverOrder(ctx context.Context, id, version, customerID int64, itemIDs []int64, payment *model.PaymentDetails, now time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeliverOrder", ctx, id, customerID, now)
+	ret := m.ctrl.Call(m, "DeliverOrder", ctx, id, version, customerID, itemIDs, payment, now)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeliverOrder indicates an expected call of DeliverOrder.
-func (mr *MockorderServiceInterfaceMockRecorder) DeliverOrder(ctx, id, customerID, now any) *MockorderServiceInterfaceDeliverOrderCall {
+func (mr *MockorderServiceInterfaceMockRecorder) DeliverOrder(ctx, id, version, customerID, itemIDs, payment, now any) *MockorderServiceInterfaceDeliverOrderCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverOrder", reflect.TypeOf((*MockorderServiceInterface)(nil).DeliverOrder), ctx, id, customerID, now)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverOrder", reflect.TypeOf((*MockorderServiceInterface)(nil).DeliverOrder), ctx, id, version, customerID, itemIDs, payment, now)
 	return &MockorderServiceInterfaceDeliverOrderCall{Call: call}
 }
 
@@ -183,13 +184,130 @@ func (c *MockorderServiceInterfaceDeliverOrderCall) Return(arg0 error) *Mockorde
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockorderServiceInterfaceDeliverOrderCall) Do(f func(context.Context, int64, int64, time.Time) error) *MockorderServiceInterfaceDeliverOrderCall {
+func (c *MockorderServiceInterfaceDeliverOrderCall) Do(f func(context.Context, int64, int64, int64, []int64, *model.PaymentDetails, time.Time) error) *MockorderServiceInterfaceDeliverOrderCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockorderServiceInterfaceDeliverOrderCall) DoAndReturn(f func(context.Context, int64, int64, time.Time) error) *MockorderServiceInterfaceDeliverOrderCall {
+func (c *MockorderServiceInterfaceDeliverOrderCall) DoAndReturn(f func(context.Context, int64, int64, int64, []int64, *model.PaymentDetails, time.Time) error) *MockorderServiceInterfaceDeliverOrderCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeliverOrders mocks base method.
+func (m *MockorderServiceInterface) DeliverOrders(ctx context.Context, ids []int64, customerID int64, itemIDs []int64, payment *model.PaymentDetails, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverOrders", ctx, ids, customerID, itemIDs, payment, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeliverOrders indicates an expected call of DeliverOrders.
+func (mr *MockorderServiceInterfaceMockRecorder) DeliverOrders(ctx, ids, customerID, itemIDs, payment, now any) *MockorderServiceInterfaceDeliverOrdersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverOrders", reflect.TypeOf((*MockorderServiceInterface)(nil).DeliverOrders), ctx, ids, customerID, itemIDs, payment, now)
+	return &MockorderServiceInterfaceDeliverOrdersCall{Call: call}
+}
+
+// MockorderServiceInterfaceDeliverOrdersCall wrap *gomock.Call
+type MockorderServiceInterfaceDeliverOrdersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceDeliverOrdersCall) Return(arg0 error) *MockorderServiceInterfaceDeliverOrdersCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceDeliverOrdersCall) Do(f func(context.Context, []int64, int64, []int64, *model.PaymentDetails, time.Time) error) *MockorderServiceInterfaceDeliverOrdersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceDeliverOrdersCall) DoAndReturn(f func(context.Context, []int64, int64, []int64, *model.PaymentDetails, time.Time) error) *MockorderServiceInterfaceDeliverOrdersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ExportOrders mocks base method.
+func (m *MockorderServiceInterface) ExportOrders(ctx context.Context, filter model.OrderFilter) (service.OrderExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportOrders", ctx, filter)
+	ret0, _ := ret[0].(service.OrderExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportOrders indicates an expected call of ExportOrders.
+func (mr *MockorderServiceInterfaceMockRecorder) ExportOrders(ctx, filter any) *MockorderServiceInterfaceExportOrdersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportOrders", reflect.TypeOf((*MockorderServiceInterface)(nil).ExportOrders), ctx, filter)
+	return &MockorderServiceInterfaceExportOrdersCall{Call: call}
+}
+
+// MockorderServiceInterfaceExportOrdersCall wrap *gomock.Call
+type MockorderServiceInterfaceExportOrdersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceExportOrdersCall) Return(arg0 service.OrderExport, arg1 error) *MockorderServiceInterfaceExportOrdersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceExportOrdersCall) Do(f func(context.Context, model.OrderFilter) (service.OrderExport, error)) *MockorderServiceInterfaceExportOrdersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceExportOrdersCall) DoAndReturn(f func(context.Context, model.OrderFilter) (service.OrderExport, error)) *MockorderServiceInterfaceExportOrdersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ExtendStorage mocks base method.
+func (m *MockorderServiceInterface) ExtendStorage(ctx context.Context, id, version int64, days int) (model.Order, model.OrderExtension, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendStorage", ctx, id, version, days)
+	ret0, _ := ret[0].(model.Order)
+	ret1, _ := ret[1].(model.OrderExtension)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ExtendStorage indicates an expected call of ExtendStorage.
+func (mr *MockorderServiceInterfaceMockRecorder) ExtendStorage(ctx, id, version, days any) *MockorderServiceInterfaceExtendStorageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendStorage", reflect.TypeOf((*MockorderServiceInterface)(nil).ExtendStorage), ctx, id, version, days)
+	return &MockorderServiceInterfaceExtendStorageCall{Call: call}
+}
+
+// MockorderServiceInterfaceExtendStorageCall wrap *gomock.Call
+type MockorderServiceInterfaceExtendStorageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceExtendStorageCall) Return(arg0 model.Order, arg1 model.OrderExtension, arg2 error) *MockorderServiceInterfaceExtendStorageCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceExtendStorageCall) Do(f func(context.Context, int64, int64, int) (model.Order, model.OrderExtension, error)) *MockorderServiceInterfaceExtendStorageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceExtendStorageCall) DoAndReturn(f func(context.Context, int64, int64, int) (model.Order, model.OrderExtension, error)) *MockorderServiceInterfaceExtendStorageCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -210,549 +328,2368 @@ func (mr *MockorderServiceInterfaceMockRecorder) GetOrderByID(ctx, id any) *Mock
 	return &MockorderServiceInterfaceGetOrderByIDCall{Call: call}
 }
 
-// MockorderServiceInterfaceGetOrderByIDCall wrap *gomock.Call
-type MockorderServiceInterfaceGetOrderByIDCall struct {
+// MockorderServiceInterfaceGetOrderByIDCall wrap *gomock.Call
+type MockorderServiceInterfaceGetOrderByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceGetOrderByIDCall) Return(arg0 model.Order, arg1 error) *MockorderServiceInterfaceGetOrderByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceGetOrderByIDCall) Do(f func(context.Context, int64) (model.Order, error)) *MockorderServiceInterfaceGetOrderByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceGetOrderByIDCall) DoAndReturn(f func(context.Context, int64) (model.Order, error)) *MockorderServiceInterfaceGetOrderByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetOrderPayments mocks base method.
+func (m *MockorderServiceInterface) GetOrderPayments(ctx context.Context, id int64) (model.OrderPayments, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderPayments", ctx, id)
+	ret0, _ := ret[0].(model.OrderPayments)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderPayments indicates an expected call of GetOrderPayments.
+func (mr *MockorderServiceInterfaceMockRecorder) GetOrderPayments(ctx, id any) *MockorderServiceInterfaceGetOrderPaymentsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderPayments", reflect.TypeOf((*MockorderServiceInterface)(nil).GetOrderPayments), ctx, id)
+	return &MockorderServiceInterfaceGetOrderPaymentsCall{Call: call}
+}
+
+// MockorderServiceInterfaceGetOrderPaymentsCall wrap *gomock.Call
+type MockorderServiceInterfaceGetOrderPaymentsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceGetOrderPaymentsCall) Return(arg0 model.OrderPayments, arg1 error) *MockorderServiceInterfaceGetOrderPaymentsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceGetOrderPaymentsCall) Do(f func(context.Context, int64) (model.OrderPayments, error)) *MockorderServiceInterfaceGetOrderPaymentsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceGetOrderPaymentsCall) DoAndReturn(f func(context.Context, int64) (model.OrderPayments, error)) *MockorderServiceInterfaceGetOrderPaymentsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListCourierReturnsWithCursor mocks base method.
+func (m *MockorderServiceInterface) ListCourierReturnsWithCursor(ctx context.Context, cursorID int64, limit int) ([]model.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCourierReturnsWithCursor", ctx, cursorID, limit)
+	ret0, _ := ret[0].([]model.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCourierReturnsWithCursor indicates an expected call of ListCourierReturnsWithCursor.
+func (mr *MockorderServiceInterfaceMockRecorder) ListCourierReturnsWithCursor(ctx, cursorID, limit any) *MockorderServiceInterfaceListCourierReturnsWithCursorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCourierReturnsWithCursor", reflect.TypeOf((*MockorderServiceInterface)(nil).ListCourierReturnsWithCursor), ctx, cursorID, limit)
+	return &MockorderServiceInterfaceListCourierReturnsWithCursorCall{Call: call}
+}
+
+// MockorderServiceInterfaceListCourierReturnsWithCursorCall wrap *gomock.Call
+type MockorderServiceInterfaceListCourierReturnsWithCursorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceListCourierReturnsWithCursorCall) Return(arg0 []model.Order, arg1 error) *MockorderServiceInterfaceListCourierReturnsWithCursorCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceListCourierReturnsWithCursorCall) Do(f func(context.Context, int64, int) ([]model.Order, error)) *MockorderServiceInterfaceListCourierReturnsWithCursorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceListCourierReturnsWithCursorCall) DoAndReturn(f func(context.Context, int64, int) ([]model.Order, error)) *MockorderServiceInterfaceListCourierReturnsWithCursorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListOrdersWithCursor mocks base method.
+func (m *MockorderServiceInterface) ListOrdersWithCursor(ctx context.Context, cursorID int64, limit int, customerID int64, filterPVZ bool, searchTerm string) ([]model.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrdersWithCursor", ctx, cursorID, limit, customerID, filterPVZ, searchTerm)
+	ret0, _ := ret[0].([]model.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrdersWithCursor indicates an expected call of ListOrdersWithCursor.
+func (mr *MockorderServiceInterfaceMockRecorder) ListOrdersWithCursor(ctx, cursorID, limit, customerID, filterPVZ, searchTerm any) *MockorderServiceInterfaceListOrdersWithCursorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrdersWithCursor", reflect.TypeOf((*MockorderServiceInterface)(nil).ListOrdersWithCursor), ctx, cursorID, limit, customerID, filterPVZ, searchTerm)
+	return &MockorderServiceInterfaceListOrdersWithCursorCall{Call: call}
+}
+
+// MockorderServiceInterfaceListOrdersWithCursorCall wrap *gomock.Call
+type MockorderServiceInterfaceListOrdersWithCursorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceListOrdersWithCursorCall) Return(arg0 []model.Order, arg1 error) *MockorderServiceInterfaceListOrdersWithCursorCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceListOrdersWithCursorCall) Do(f func(context.Context, int64, int, int64, bool, string) ([]model.Order, error)) *MockorderServiceInterfaceListOrdersWithCursorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceListOrdersWithCursorCall) DoAndReturn(f func(context.Context, int64, int, int64, bool, string) ([]model.Order, error)) *MockorderServiceInterfaceListOrdersWithCursorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListReturnsWithCursor mocks base method.
+func (m *MockorderServiceInterface) ListReturnsWithCursor(ctx context.Context, cursorID int64, limit int, searchTerm string, filter model.ReturnFilter) ([]model.ReturnedOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReturnsWithCursor", ctx, cursorID, limit, searchTerm, filter)
+	ret0, _ := ret[0].([]model.ReturnedOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReturnsWithCursor indicates an expected call of ListReturnsWithCursor.
+func (mr *MockorderServiceInterfaceMockRecorder) ListReturnsWithCursor(ctx, cursorID, limit, searchTerm, filter any) *MockorderServiceInterfaceListReturnsWithCursorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReturnsWithCursor", reflect.TypeOf((*MockorderServiceInterface)(nil).ListReturnsWithCursor), ctx, cursorID, limit, searchTerm, filter)
+	return &MockorderServiceInterfaceListReturnsWithCursorCall{Call: call}
+}
+
+// MockorderServiceInterfaceListReturnsWithCursorCall wrap *gomock.Call
+type MockorderServiceInterfaceListReturnsWithCursorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceListReturnsWithCursorCall) Return(arg0 []model.ReturnedOrder, arg1 error) *MockorderServiceInterfaceListReturnsWithCursorCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceListReturnsWithCursorCall) Do(f func(context.Context, int64, int, string, model.ReturnFilter) ([]model.ReturnedOrder, error)) *MockorderServiceInterfaceListReturnsWithCursorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceListReturnsWithCursorCall) DoAndReturn(f func(context.Context, int64, int, string, model.ReturnFilter) ([]model.ReturnedOrder, error)) *MockorderServiceInterfaceListReturnsWithCursorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LocateOrder mocks base method.
+func (m *MockorderServiceInterface) LocateOrder(ctx context.Context, id int64) (model.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LocateOrder", ctx, id)
+	ret0, _ := ret[0].(model.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LocateOrder indicates an expected call of LocateOrder.
+func (mr *MockorderServiceInterfaceMockRecorder) LocateOrder(ctx, id any) *MockorderServiceInterfaceLocateOrderCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LocateOrder", reflect.TypeOf((*MockorderServiceInterface)(nil).LocateOrder), ctx, id)
+	return &MockorderServiceInterfaceLocateOrderCall{Call: call}
+}
+
+// MockorderServiceInterfaceLocateOrderCall wrap *gomock.Call
+type MockorderServiceInterfaceLocateOrderCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceLocateOrderCall) Return(arg0 model.StorageCell, arg1 error) *MockorderServiceInterfaceLocateOrderCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceLocateOrderCall) Do(f func(context.Context, int64) (model.StorageCell, error)) *MockorderServiceInterfaceLocateOrderCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceLocateOrderCall) DoAndReturn(f func(context.Context, int64) (model.StorageCell, error)) *MockorderServiceInterfaceLocateOrderCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// OrderHistory mocks base method.
+func (m *MockorderServiceInterface) OrderHistory(ctx context.Context, searchTerm string) ([]model.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderHistory", ctx, searchTerm)
+	ret0, _ := ret[0].([]model.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrderHistory indicates an expected call of OrderHistory.
+func (mr *MockorderServiceInterfaceMockRecorder) OrderHistory(ctx, searchTerm any) *MockorderServiceInterfaceOrderHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderHistory", reflect.TypeOf((*MockorderServiceInterface)(nil).OrderHistory), ctx, searchTerm)
+	return &MockorderServiceInterfaceOrderHistoryCall{Call: call}
+}
+
+// MockorderServiceInterfaceOrderHistoryCall wrap *gomock.Call
+type MockorderServiceInterfaceOrderHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceOrderHistoryCall) Return(arg0 []model.Order, arg1 error) *MockorderServiceInterfaceOrderHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceOrderHistoryCall) Do(f func(context.Context, string) ([]model.Order, error)) *MockorderServiceInterfaceOrderHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceOrderHistoryCall) DoAndReturn(f func(context.Context, string) ([]model.Order, error)) *MockorderServiceInterfaceOrderHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// OrderTimeline mocks base method.
+func (m *MockorderServiceInterface) OrderTimeline(ctx context.Context, id int64) ([]model.OrderStateTransition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderTimeline", ctx, id)
+	ret0, _ := ret[0].([]model.OrderStateTransition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrderTimeline indicates an expected call of OrderTimeline.
+func (mr *MockorderServiceInterfaceMockRecorder) OrderTimeline(ctx, id any) *MockorderServiceInterfaceOrderTimelineCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderTimeline", reflect.TypeOf((*MockorderServiceInterface)(nil).OrderTimeline), ctx, id)
+	return &MockorderServiceInterfaceOrderTimelineCall{Call: call}
+}
+
+// MockorderServiceInterfaceOrderTimelineCall wrap *gomock.Call
+type MockorderServiceInterfaceOrderTimelineCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceOrderTimelineCall) Return(arg0 []model.OrderStateTransition, arg1 error) *MockorderServiceInterfaceOrderTimelineCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceOrderTimelineCall) Do(f func(context.Context, int64) ([]model.OrderStateTransition, error)) *MockorderServiceInterfaceOrderTimelineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceOrderTimelineCall) DoAndReturn(f func(context.Context, int64) ([]model.OrderStateTransition, error)) *MockorderServiceInterfaceOrderTimelineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ProcessReturnOrder mocks base method.
+func (m *MockorderServiceInterface) ProcessReturnOrder(ctx context.Context, id, version, customerID int64, itemIDs []int64, details model.ReturnDetails, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessReturnOrder", ctx, id, version, customerID, itemIDs, details, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessReturnOrder indicates an expected call of ProcessReturnOrder.
+func (mr *MockorderServiceInterfaceMockRecorder) ProcessReturnOrder(ctx, id, version, customerID, itemIDs, details, now any) *MockorderServiceInterfaceProcessReturnOrderCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessReturnOrder", reflect.TypeOf((*MockorderServiceInterface)(nil).ProcessReturnOrder), ctx, id, version, customerID, itemIDs, details, now)
+	return &MockorderServiceInterfaceProcessReturnOrderCall{Call: call}
+}
+
+// MockorderServiceInterfaceProcessReturnOrderCall wrap *gomock.Call
+type MockorderServiceInterfaceProcessReturnOrderCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceProcessReturnOrderCall) Return(arg0 error) *MockorderServiceInterfaceProcessReturnOrderCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceProcessReturnOrderCall) Do(f func(context.Context, int64, int64, int64, []int64, model.ReturnDetails, time.Time) error) *MockorderServiceInterfaceProcessReturnOrderCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceProcessReturnOrderCall) DoAndReturn(f func(context.Context, int64, int64, int64, []int64, model.ReturnDetails, time.Time) error) *MockorderServiceInterfaceProcessReturnOrderCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ProcessReturnOrders mocks base method.
+func (m *MockorderServiceInterface) ProcessReturnOrders(ctx context.Context, ids []int64, customerID int64, itemIDs []int64, details model.ReturnDetails, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessReturnOrders", ctx, ids, customerID, itemIDs, details, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessReturnOrders indicates an expected call of ProcessReturnOrders.
+func (mr *MockorderServiceInterfaceMockRecorder) ProcessReturnOrders(ctx, ids, customerID, itemIDs, details, now any) *MockorderServiceInterfaceProcessReturnOrdersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessReturnOrders", reflect.TypeOf((*MockorderServiceInterface)(nil).ProcessReturnOrders), ctx, ids, customerID, itemIDs, details, now)
+	return &MockorderServiceInterfaceProcessReturnOrdersCall{Call: call}
+}
+
+// MockorderServiceInterfaceProcessReturnOrdersCall wrap *gomock.Call
+type MockorderServiceInterfaceProcessReturnOrdersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceProcessReturnOrdersCall) Return(arg0 error) *MockorderServiceInterfaceProcessReturnOrdersCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceProcessReturnOrdersCall) Do(f func(context.Context, []int64, int64, []int64, model.ReturnDetails, time.Time) error) *MockorderServiceInterfaceProcessReturnOrdersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceProcessReturnOrdersCall) DoAndReturn(f func(context.Context, []int64, int64, []int64, model.ReturnDetails, time.Time) error) *MockorderServiceInterfaceProcessReturnOrdersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReturnOrderToCourier mocks base method.
+func (m *MockorderServiceInterface) ReturnOrderToCourier(ctx context.Context, id, version int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReturnOrderToCourier", ctx, id, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReturnOrderToCourier indicates an expected call of ReturnOrderToCourier.
+func (mr *MockorderServiceInterfaceMockRecorder) ReturnOrderToCourier(ctx, id, version any) *MockorderServiceInterfaceReturnOrderToCourierCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnOrderToCourier", reflect.TypeOf((*MockorderServiceInterface)(nil).ReturnOrderToCourier), ctx, id, version)
+	return &MockorderServiceInterfaceReturnOrderToCourierCall{Call: call}
+}
+
+// MockorderServiceInterfaceReturnOrderToCourierCall wrap *gomock.Call
+type MockorderServiceInterfaceReturnOrderToCourierCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceInterfaceReturnOrderToCourierCall) Return(arg0 error) *MockorderServiceInterfaceReturnOrderToCourierCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceInterfaceReturnOrderToCourierCall) Do(f func(context.Context, int64, int64) error) *MockorderServiceInterfaceReturnOrderToCourierCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceInterfaceReturnOrderToCourierCall) DoAndReturn(f func(context.Context, int64, int64) error) *MockorderServiceInterfaceReturnOrderToCourierCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockuserRepository is a mock of userRepository interface.
+type MockuserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockuserRepositoryMockRecorder is the mock recorder for MockuserRepository.
+type MockuserRepositoryMockRecorder struct {
+	mock *MockuserRepository
+}
+
+// NewMockuserRepository creates a new mock instance.
+func NewMockuserRepository(ctrl *gomock.Controller) *MockuserRepository {
+	mock := &MockuserRepository{ctrl: ctrl}
+	mock.recorder = &MockuserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserRepository) EXPECT() *MockuserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Approve mocks base method.
+func (m *MockuserRepository) Approve(ctx context.Context, userID int64, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockuserRepositoryMockRecorder) Approve(ctx, userID, role any) *MockuserRepositoryApproveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockuserRepository)(nil).Approve), ctx, userID, role)
+	return &MockuserRepositoryApproveCall{Call: call}
+}
+
+// MockuserRepositoryApproveCall wrap *gomock.Call
+type MockuserRepositoryApproveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserRepositoryApproveCall) Return(arg0 error) *MockuserRepositoryApproveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserRepositoryApproveCall) Do(f func(context.Context, int64, string) error) *MockuserRepositoryApproveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserRepositoryApproveCall) DoAndReturn(f func(context.Context, int64, string) error) *MockuserRepositoryApproveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AssignPickupPoint mocks base method.
+func (m *MockuserRepository) AssignPickupPoint(ctx context.Context, userID int64, pickupPointID *int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignPickupPoint", ctx, userID, pickupPointID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignPickupPoint indicates an expected call of AssignPickupPoint.
+func (mr *MockuserRepositoryMockRecorder) AssignPickupPoint(ctx, userID, pickupPointID any) *MockuserRepositoryAssignPickupPointCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignPickupPoint", reflect.TypeOf((*MockuserRepository)(nil).AssignPickupPoint), ctx, userID, pickupPointID)
+	return &MockuserRepositoryAssignPickupPointCall{Call: call}
+}
+
+// MockuserRepositoryAssignPickupPointCall wrap *gomock.Call
+type MockuserRepositoryAssignPickupPointCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserRepositoryAssignPickupPointCall) Return(arg0 error) *MockuserRepositoryAssignPickupPointCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserRepositoryAssignPickupPointCall) Do(f func(context.Context, int64, *int64) error) *MockuserRepositoryAssignPickupPointCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserRepositoryAssignPickupPointCall) DoAndReturn(f func(context.Context, int64, *int64) error) *MockuserRepositoryAssignPickupPointCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CheckPassword mocks base method.
+func (m *MockuserRepository) CheckPassword(ctx context.Context, username, password string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckPassword", ctx, username, password)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CheckPassword indicates an expected call of CheckPassword.
+func (mr *MockuserRepositoryMockRecorder) CheckPassword(ctx, username, password any) *MockuserRepositoryCheckPasswordCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPassword", reflect.TypeOf((*MockuserRepository)(nil).CheckPassword), ctx, username, password)
+	return &MockuserRepositoryCheckPasswordCall{Call: call}
+}
+
+// MockuserRepositoryCheckPasswordCall wrap *gomock.Call
+type MockuserRepositoryCheckPasswordCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserRepositoryCheckPasswordCall) Return(arg0 bool) *MockuserRepositoryCheckPasswordCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserRepositoryCheckPasswordCall) Do(f func(context.Context, string, string) bool) *MockuserRepositoryCheckPasswordCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserRepositoryCheckPasswordCall) DoAndReturn(f func(context.Context, string, string) bool) *MockuserRepositoryCheckPasswordCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Create mocks base method.
+func (m *MockuserRepository) Create(ctx context.Context, user model.User, plainPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, user, plainPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockuserRepositoryMockRecorder) Create(ctx, user, plainPassword any) *MockuserRepositoryCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockuserRepository)(nil).Create), ctx, user, plainPassword)
+	return &MockuserRepositoryCreateCall{Call: call}
+}
+
+// MockuserRepositoryCreateCall wrap *gomock.Call
+type MockuserRepositoryCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserRepositoryCreateCall) Return(arg0 error) *MockuserRepositoryCreateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserRepositoryCreateCall) Do(f func(context.Context, model.User, string) error) *MockuserRepositoryCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserRepositoryCreateCall) DoAndReturn(f func(context.Context, model.User, string) error) *MockuserRepositoryCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MockuserRepository) Delete(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockuserRepositoryMockRecorder) Delete(ctx, id any) *MockuserRepositoryDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockuserRepository)(nil).Delete), ctx, id)
+	return &MockuserRepositoryDeleteCall{Call: call}
+}
+
+// MockuserRepositoryDeleteCall wrap *gomock.Call
+type MockuserRepositoryDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserRepositoryDeleteCall) Return(arg0 error) *MockuserRepositoryDeleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserRepositoryDeleteCall) Do(f func(context.Context, int64) error) *MockuserRepositoryDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserRepositoryDeleteCall) DoAndReturn(f func(context.Context, int64) error) *MockuserRepositoryDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockuserRepository) GetByID(ctx context.Context, id int64) (model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockuserRepositoryMockRecorder) GetByID(ctx, id any) *MockuserRepositoryGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockuserRepository)(nil).GetByID), ctx, id)
+	return &MockuserRepositoryGetByIDCall{Call: call}
+}
+
+// MockuserRepositoryGetByIDCall wrap *gomock.Call
+type MockuserRepositoryGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserRepositoryGetByIDCall) Return(arg0 model.User, arg1 error) *MockuserRepositoryGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserRepositoryGetByIDCall) Do(f func(context.Context, int64) (model.User, error)) *MockuserRepositoryGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserRepositoryGetByIDCall) DoAndReturn(f func(context.Context, int64) (model.User, error)) *MockuserRepositoryGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByUsername mocks base method.
+func (m *MockuserRepository) GetByUsername(ctx context.Context, username string) (model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUsername", ctx, username)
+	ret0, _ := ret[0].(model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUsername indicates an expected call of GetByUsername.
+func (mr *MockuserRepositoryMockRecorder) GetByUsername(ctx, username any) *MockuserRepositoryGetByUsernameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUsername", reflect.TypeOf((*MockuserRepository)(nil).GetByUsername), ctx, username)
+	return &MockuserRepositoryGetByUsernameCall{Call: call}
+}
+
+// MockuserRepositoryGetByUsernameCall wrap *gomock.Call
+type MockuserRepositoryGetByUsernameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserRepositoryGetByUsernameCall) Return(arg0 model.User, arg1 error) *MockuserRepositoryGetByUsernameCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserRepositoryGetByUsernameCall) Do(f func(context.Context, string) (model.User, error)) *MockuserRepositoryGetByUsernameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserRepositoryGetByUsernameCall) DoAndReturn(f func(context.Context, string) (model.User, error)) *MockuserRepositoryGetByUsernameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockuserRepository) List(ctx context.Context, searchTerm string) ([]model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, searchTerm)
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockuserRepositoryMockRecorder) List(ctx, searchTerm any) *MockuserRepositoryListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockuserRepository)(nil).List), ctx, searchTerm)
+	return &MockuserRepositoryListCall{Call: call}
+}
+
+// MockuserRepositoryListCall wrap *gomock.Call
+type MockuserRepositoryListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserRepositoryListCall) Return(arg0 []model.User, arg1 error) *MockuserRepositoryListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserRepositoryListCall) Do(f func(context.Context, string) ([]model.User, error)) *MockuserRepositoryListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserRepositoryListCall) DoAndReturn(f func(context.Context, string) ([]model.User, error)) *MockuserRepositoryListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m *MockuserRepository) Update(ctx context.Context, user model.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockuserRepositoryMockRecorder) Update(ctx, user any) *MockuserRepositoryUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockuserRepository)(nil).Update), ctx, user)
+	return &MockuserRepositoryUpdateCall{Call: call}
+}
+
+// MockuserRepositoryUpdateCall wrap *gomock.Call
+type MockuserRepositoryUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserRepositoryUpdateCall) Return(arg0 error) *MockuserRepositoryUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserRepositoryUpdateCall) Do(f func(context.Context, model.User) error) *MockuserRepositoryUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserRepositoryUpdateCall) DoAndReturn(f func(context.Context, model.User) error) *MockuserRepositoryUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdatePassword mocks base method.
+func (m *MockuserRepository) UpdatePassword(ctx context.Context, userID int64, newPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", ctx, userID, newPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockuserRepositoryMockRecorder) UpdatePassword(ctx, userID, newPassword any) *MockuserRepositoryUpdatePasswordCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockuserRepository)(nil).UpdatePassword), ctx, userID, newPassword)
+	return &MockuserRepositoryUpdatePasswordCall{Call: call}
+}
+
+// MockuserRepositoryUpdatePasswordCall wrap *gomock.Call
+type MockuserRepositoryUpdatePasswordCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserRepositoryUpdatePasswordCall) Return(arg0 error) *MockuserRepositoryUpdatePasswordCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserRepositoryUpdatePasswordCall) Do(f func(context.Context, int64, string) error) *MockuserRepositoryUpdatePasswordCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserRepositoryUpdatePasswordCall) DoAndReturn(f func(context.Context, int64, string) error) *MockuserRepositoryUpdatePasswordCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockpickupPointRepository is a mock of pickupPointRepository interface.
+type MockpickupPointRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockpickupPointRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockpickupPointRepositoryMockRecorder is the mock recorder for MockpickupPointRepository.
+type MockpickupPointRepositoryMockRecorder struct {
+	mock *MockpickupPointRepository
+}
+
+// NewMockpickupPointRepository creates a new mock instance.
+func NewMockpickupPointRepository(ctrl *gomock.Controller) *MockpickupPointRepository {
+	mock := &MockpickupPointRepository{ctrl: ctrl}
+	mock.recorder = &MockpickupPointRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpickupPointRepository) EXPECT() *MockpickupPointRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockpickupPointRepository) Create(ctx context.Context, point model.PickupPoint) (model.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, point)
+	ret0, _ := ret[0].(model.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockpickupPointRepositoryMockRecorder) Create(ctx, point any) *MockpickupPointRepositoryCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockpickupPointRepository)(nil).Create), ctx, point)
+	return &MockpickupPointRepositoryCreateCall{Call: call}
+}
+
+// MockpickupPointRepositoryCreateCall wrap *gomock.Call
+type MockpickupPointRepositoryCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpickupPointRepositoryCreateCall) Return(arg0 model.PickupPoint, arg1 error) *MockpickupPointRepositoryCreateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpickupPointRepositoryCreateCall) Do(f func(context.Context, model.PickupPoint) (model.PickupPoint, error)) *MockpickupPointRepositoryCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpickupPointRepositoryCreateCall) DoAndReturn(f func(context.Context, model.PickupPoint) (model.PickupPoint, error)) *MockpickupPointRepositoryCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MockpickupPointRepository) Delete(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockpickupPointRepositoryMockRecorder) Delete(ctx, id any) *MockpickupPointRepositoryDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockpickupPointRepository)(nil).Delete), ctx, id)
+	return &MockpickupPointRepositoryDeleteCall{Call: call}
+}
+
+// MockpickupPointRepositoryDeleteCall wrap *gomock.Call
+type MockpickupPointRepositoryDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpickupPointRepositoryDeleteCall) Return(arg0 error) *MockpickupPointRepositoryDeleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpickupPointRepositoryDeleteCall) Do(f func(context.Context, int64) error) *MockpickupPointRepositoryDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpickupPointRepositoryDeleteCall) DoAndReturn(f func(context.Context, int64) error) *MockpickupPointRepositoryDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockpickupPointRepository) GetByID(ctx context.Context, id int64) (model.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(model.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockpickupPointRepositoryMockRecorder) GetByID(ctx, id any) *MockpickupPointRepositoryGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockpickupPointRepository)(nil).GetByID), ctx, id)
+	return &MockpickupPointRepositoryGetByIDCall{Call: call}
+}
+
+// MockpickupPointRepositoryGetByIDCall wrap *gomock.Call
+type MockpickupPointRepositoryGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpickupPointRepositoryGetByIDCall) Return(arg0 model.PickupPoint, arg1 error) *MockpickupPointRepositoryGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpickupPointRepositoryGetByIDCall) Do(f func(context.Context, int64) (model.PickupPoint, error)) *MockpickupPointRepositoryGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpickupPointRepositoryGetByIDCall) DoAndReturn(f func(context.Context, int64) (model.PickupPoint, error)) *MockpickupPointRepositoryGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockpickupPointRepository) List(ctx context.Context) ([]model.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]model.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockpickupPointRepositoryMockRecorder) List(ctx any) *MockpickupPointRepositoryListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockpickupPointRepository)(nil).List), ctx)
+	return &MockpickupPointRepositoryListCall{Call: call}
+}
+
+// MockpickupPointRepositoryListCall wrap *gomock.Call
+type MockpickupPointRepositoryListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpickupPointRepositoryListCall) Return(arg0 []model.PickupPoint, arg1 error) *MockpickupPointRepositoryListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpickupPointRepositoryListCall) Do(f func(context.Context) ([]model.PickupPoint, error)) *MockpickupPointRepositoryListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpickupPointRepositoryListCall) DoAndReturn(f func(context.Context) ([]model.PickupPoint, error)) *MockpickupPointRepositoryListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m *MockpickupPointRepository) Update(ctx context.Context, point model.PickupPoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, point)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockpickupPointRepositoryMockRecorder) Update(ctx, point any) *MockpickupPointRepositoryUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockpickupPointRepository)(nil).Update), ctx, point)
+	return &MockpickupPointRepositoryUpdateCall{Call: call}
+}
+
+// MockpickupPointRepositoryUpdateCall wrap *gomock.Call
+type MockpickupPointRepositoryUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpickupPointRepositoryUpdateCall) Return(arg0 error) *MockpickupPointRepositoryUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpickupPointRepositoryUpdateCall) Do(f func(context.Context, model.PickupPoint) error) *MockpickupPointRepositoryUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpickupPointRepositoryUpdateCall) DoAndReturn(f func(context.Context, model.PickupPoint) error) *MockpickupPointRepositoryUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockstorageServiceInterface is a mock of storageServiceInterface interface.
+type MockstorageServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockstorageServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockstorageServiceInterfaceMockRecorder is the mock recorder for MockstorageServiceInterface.
+type MockstorageServiceInterfaceMockRecorder struct {
+	mock *MockstorageServiceInterface
+}
+
+// NewMockstorageServiceInterface creates a new mock instance.
+func NewMockstorageServiceInterface(ctrl *gomock.Controller) *MockstorageServiceInterface {
+	mock := &MockstorageServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockstorageServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstorageServiceInterface) EXPECT() *MockstorageServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateCell mocks base method.
+func (m *MockstorageServiceInterface) CreateCell(ctx context.Context, cell model.StorageCell) (model.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCell", ctx, cell)
+	ret0, _ := ret[0].(model.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCell indicates an expected call of CreateCell.
+func (mr *MockstorageServiceInterfaceMockRecorder) CreateCell(ctx, cell any) *MockstorageServiceInterfaceCreateCellCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCell", reflect.TypeOf((*MockstorageServiceInterface)(nil).CreateCell), ctx, cell)
+	return &MockstorageServiceInterfaceCreateCellCall{Call: call}
+}
+
+// MockstorageServiceInterfaceCreateCellCall wrap *gomock.Call
+type MockstorageServiceInterfaceCreateCellCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockstorageServiceInterfaceCreateCellCall) Return(arg0 model.StorageCell, arg1 error) *MockstorageServiceInterfaceCreateCellCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockstorageServiceInterfaceCreateCellCall) Do(f func(context.Context, model.StorageCell) (model.StorageCell, error)) *MockstorageServiceInterfaceCreateCellCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockstorageServiceInterfaceCreateCellCall) DoAndReturn(f func(context.Context, model.StorageCell) (model.StorageCell, error)) *MockstorageServiceInterfaceCreateCellCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteCell mocks base method.
+func (m *MockstorageServiceInterface) DeleteCell(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCell", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCell indicates an expected call of DeleteCell.
+func (mr *MockstorageServiceInterfaceMockRecorder) DeleteCell(ctx, id any) *MockstorageServiceInterfaceDeleteCellCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCell", reflect.TypeOf((*MockstorageServiceInterface)(nil).DeleteCell), ctx, id)
+	return &MockstorageServiceInterfaceDeleteCellCall{Call: call}
+}
+
+// MockstorageServiceInterfaceDeleteCellCall wrap *gomock.Call
+type MockstorageServiceInterfaceDeleteCellCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockstorageServiceInterfaceDeleteCellCall) Return(arg0 error) *MockstorageServiceInterfaceDeleteCellCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockstorageServiceInterfaceDeleteCellCall) Do(f func(context.Context, int64) error) *MockstorageServiceInterfaceDeleteCellCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockstorageServiceInterfaceDeleteCellCall) DoAndReturn(f func(context.Context, int64) error) *MockstorageServiceInterfaceDeleteCellCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Occupancy mocks base method.
+func (m *MockstorageServiceInterface) Occupancy(ctx context.Context) ([]model.CellOccupancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Occupancy", ctx)
+	ret0, _ := ret[0].([]model.CellOccupancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Occupancy indicates an expected call of Occupancy.
+func (mr *MockstorageServiceInterfaceMockRecorder) Occupancy(ctx any) *MockstorageServiceInterfaceOccupancyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Occupancy", reflect.TypeOf((*MockstorageServiceInterface)(nil).Occupancy), ctx)
+	return &MockstorageServiceInterfaceOccupancyCall{Call: call}
+}
+
+// MockstorageServiceInterfaceOccupancyCall wrap *gomock.Call
+type MockstorageServiceInterfaceOccupancyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockstorageServiceInterfaceOccupancyCall) Return(arg0 []model.CellOccupancy, arg1 error) *MockstorageServiceInterfaceOccupancyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockstorageServiceInterfaceOccupancyCall) Do(f func(context.Context) ([]model.CellOccupancy, error)) *MockstorageServiceInterfaceOccupancyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockstorageServiceInterfaceOccupancyCall) DoAndReturn(f func(context.Context) ([]model.CellOccupancy, error)) *MockstorageServiceInterfaceOccupancyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockreturnPolicyServiceInterface is a mock of returnPolicyServiceInterface interface.
+type MockreturnPolicyServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockreturnPolicyServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockreturnPolicyServiceInterfaceMockRecorder is the mock recorder for MockreturnPolicyServiceInterface.
+type MockreturnPolicyServiceInterfaceMockRecorder struct {
+	mock *MockreturnPolicyServiceInterface
+}
+
+// NewMockreturnPolicyServiceInterface creates a new mock instance.
+func NewMockreturnPolicyServiceInterface(ctrl *gomock.Controller) *MockreturnPolicyServiceInterface {
+	mock := &MockreturnPolicyServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockreturnPolicyServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreturnPolicyServiceInterface) EXPECT() *MockreturnPolicyServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreatePolicy mocks base method.
+func (m *MockreturnPolicyServiceInterface) CreatePolicy(ctx context.Context, policy model.ReturnPolicy) (model.ReturnPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePolicy", ctx, policy)
+	ret0, _ := ret[0].(model.ReturnPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePolicy indicates an expected call of CreatePolicy.
+func (mr *MockreturnPolicyServiceInterfaceMockRecorder) CreatePolicy(ctx, policy any) *MockreturnPolicyServiceInterfaceCreatePolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePolicy", reflect.TypeOf((*MockreturnPolicyServiceInterface)(nil).CreatePolicy), ctx, policy)
+	return &MockreturnPolicyServiceInterfaceCreatePolicyCall{Call: call}
+}
+
+// MockreturnPolicyServiceInterfaceCreatePolicyCall wrap *gomock.Call
+type MockreturnPolicyServiceInterfaceCreatePolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockreturnPolicyServiceInterfaceCreatePolicyCall) Return(arg0 model.ReturnPolicy, arg1 error) *MockreturnPolicyServiceInterfaceCreatePolicyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockreturnPolicyServiceInterfaceCreatePolicyCall) Do(f func(context.Context, model.ReturnPolicy) (model.ReturnPolicy, error)) *MockreturnPolicyServiceInterfaceCreatePolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockreturnPolicyServiceInterfaceCreatePolicyCall) DoAndReturn(f func(context.Context, model.ReturnPolicy) (model.ReturnPolicy, error)) *MockreturnPolicyServiceInterfaceCreatePolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeletePolicy mocks base method.
+func (m *MockreturnPolicyServiceInterface) DeletePolicy(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePolicy", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePolicy indicates an expected call of DeletePolicy.
+func (mr *MockreturnPolicyServiceInterfaceMockRecorder) DeletePolicy(ctx, id any) *MockreturnPolicyServiceInterfaceDeletePolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePolicy", reflect.TypeOf((*MockreturnPolicyServiceInterface)(nil).DeletePolicy), ctx, id)
+	return &MockreturnPolicyServiceInterfaceDeletePolicyCall{Call: call}
+}
+
+// MockreturnPolicyServiceInterfaceDeletePolicyCall wrap *gomock.Call
+type MockreturnPolicyServiceInterfaceDeletePolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockreturnPolicyServiceInterfaceDeletePolicyCall) Return(arg0 error) *MockreturnPolicyServiceInterfaceDeletePolicyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockreturnPolicyServiceInterfaceDeletePolicyCall) Do(f func(context.Context, int64) error) *MockreturnPolicyServiceInterfaceDeletePolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockreturnPolicyServiceInterfaceDeletePolicyCall) DoAndReturn(f func(context.Context, int64) error) *MockreturnPolicyServiceInterfaceDeletePolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetPolicy mocks base method.
+func (m *MockreturnPolicyServiceInterface) GetPolicy(ctx context.Context, id int64) (model.ReturnPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicy", ctx, id)
+	ret0, _ := ret[0].(model.ReturnPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicy indicates an expected call of GetPolicy.
+func (mr *MockreturnPolicyServiceInterfaceMockRecorder) GetPolicy(ctx, id any) *MockreturnPolicyServiceInterfaceGetPolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicy", reflect.TypeOf((*MockreturnPolicyServiceInterface)(nil).GetPolicy), ctx, id)
+	return &MockreturnPolicyServiceInterfaceGetPolicyCall{Call: call}
+}
+
+// MockreturnPolicyServiceInterfaceGetPolicyCall wrap *gomock.Call
+type MockreturnPolicyServiceInterfaceGetPolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockreturnPolicyServiceInterfaceGetPolicyCall) Return(arg0 model.ReturnPolicy, arg1 error) *MockreturnPolicyServiceInterfaceGetPolicyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockreturnPolicyServiceInterfaceGetPolicyCall) Do(f func(context.Context, int64) (model.ReturnPolicy, error)) *MockreturnPolicyServiceInterfaceGetPolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockreturnPolicyServiceInterfaceGetPolicyCall) DoAndReturn(f func(context.Context, int64) (model.ReturnPolicy, error)) *MockreturnPolicyServiceInterfaceGetPolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListPolicies mocks base method.
+func (m *MockreturnPolicyServiceInterface) ListPolicies(ctx context.Context) ([]model.ReturnPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPolicies", ctx)
+	ret0, _ := ret[0].([]model.ReturnPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPolicies indicates an expected call of ListPolicies.
+func (mr *MockreturnPolicyServiceInterfaceMockRecorder) ListPolicies(ctx any) *MockreturnPolicyServiceInterfaceListPoliciesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicies", reflect.TypeOf((*MockreturnPolicyServiceInterface)(nil).ListPolicies), ctx)
+	return &MockreturnPolicyServiceInterfaceListPoliciesCall{Call: call}
+}
+
+// MockreturnPolicyServiceInterfaceListPoliciesCall wrap *gomock.Call
+type MockreturnPolicyServiceInterfaceListPoliciesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockreturnPolicyServiceInterfaceListPoliciesCall) Return(arg0 []model.ReturnPolicy, arg1 error) *MockreturnPolicyServiceInterfaceListPoliciesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockreturnPolicyServiceInterfaceListPoliciesCall) Do(f func(context.Context) ([]model.ReturnPolicy, error)) *MockreturnPolicyServiceInterfaceListPoliciesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockreturnPolicyServiceInterfaceListPoliciesCall) DoAndReturn(f func(context.Context) ([]model.ReturnPolicy, error)) *MockreturnPolicyServiceInterfaceListPoliciesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetCustomerSegment mocks base method.
+func (m *MockreturnPolicyServiceInterface) SetCustomerSegment(ctx context.Context, customerID int64, segment string) (model.CustomerSegment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCustomerSegment", ctx, customerID, segment)
+	ret0, _ := ret[0].(model.CustomerSegment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCustomerSegment indicates an expected call of SetCustomerSegment.
+func (mr *MockreturnPolicyServiceInterfaceMockRecorder) SetCustomerSegment(ctx, customerID, segment any) *MockreturnPolicyServiceInterfaceSetCustomerSegmentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCustomerSegment", reflect.TypeOf((*MockreturnPolicyServiceInterface)(nil).SetCustomerSegment), ctx, customerID, segment)
+	return &MockreturnPolicyServiceInterfaceSetCustomerSegmentCall{Call: call}
+}
+
+// MockreturnPolicyServiceInterfaceSetCustomerSegmentCall wrap *gomock.Call
+type MockreturnPolicyServiceInterfaceSetCustomerSegmentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockreturnPolicyServiceInterfaceSetCustomerSegmentCall) Return(arg0 model.CustomerSegment, arg1 error) *MockreturnPolicyServiceInterfaceSetCustomerSegmentCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockreturnPolicyServiceInterfaceSetCustomerSegmentCall) Do(f func(context.Context, int64, string) (model.CustomerSegment, error)) *MockreturnPolicyServiceInterfaceSetCustomerSegmentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockreturnPolicyServiceInterfaceSetCustomerSegmentCall) DoAndReturn(f func(context.Context, int64, string) (model.CustomerSegment, error)) *MockreturnPolicyServiceInterfaceSetCustomerSegmentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdatePolicy mocks base method.
+func (m *MockreturnPolicyServiceInterface) UpdatePolicy(ctx context.Context, policy model.ReturnPolicy) (model.ReturnPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePolicy", ctx, policy)
+	ret0, _ := ret[0].(model.ReturnPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePolicy indicates an expected call of UpdatePolicy.
+func (mr *MockreturnPolicyServiceInterfaceMockRecorder) UpdatePolicy(ctx, policy any) *MockreturnPolicyServiceInterfaceUpdatePolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePolicy", reflect.TypeOf((*MockreturnPolicyServiceInterface)(nil).UpdatePolicy), ctx, policy)
+	return &MockreturnPolicyServiceInterfaceUpdatePolicyCall{Call: call}
+}
+
+// MockreturnPolicyServiceInterfaceUpdatePolicyCall wrap *gomock.Call
+type MockreturnPolicyServiceInterfaceUpdatePolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockreturnPolicyServiceInterfaceUpdatePolicyCall) Return(arg0 model.ReturnPolicy, arg1 error) *MockreturnPolicyServiceInterfaceUpdatePolicyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockreturnPolicyServiceInterfaceUpdatePolicyCall) Do(f func(context.Context, model.ReturnPolicy) (model.ReturnPolicy, error)) *MockreturnPolicyServiceInterfaceUpdatePolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockreturnPolicyServiceInterfaceUpdatePolicyCall) DoAndReturn(f func(context.Context, model.ReturnPolicy) (model.ReturnPolicy, error)) *MockreturnPolicyServiceInterfaceUpdatePolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockshiftServiceInterface is a mock of shiftServiceInterface interface.
+type MockshiftServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockshiftServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockshiftServiceInterfaceMockRecorder is the mock recorder for MockshiftServiceInterface.
+type MockshiftServiceInterfaceMockRecorder struct {
+	mock *MockshiftServiceInterface
+}
+
+// NewMockshiftServiceInterface creates a new mock instance.
+func NewMockshiftServiceInterface(ctrl *gomock.Controller) *MockshiftServiceInterface {
+	mock := &MockshiftServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockshiftServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockshiftServiceInterface) EXPECT() *MockshiftServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CloseShift mocks base method.
+func (m *MockshiftServiceInterface) CloseShift(ctx context.Context, id int64, countedCash float64) (model.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseShift", ctx, id, countedCash)
+	ret0, _ := ret[0].(model.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseShift indicates an expected call of CloseShift.
+func (mr *MockshiftServiceInterfaceMockRecorder) CloseShift(ctx, id, countedCash any) *MockshiftServiceInterfaceCloseShiftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShift", reflect.TypeOf((*MockshiftServiceInterface)(nil).CloseShift), ctx, id, countedCash)
+	return &MockshiftServiceInterfaceCloseShiftCall{Call: call}
+}
+
+// MockshiftServiceInterfaceCloseShiftCall wrap *gomock.Call
+type MockshiftServiceInterfaceCloseShiftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockshiftServiceInterfaceCloseShiftCall) Return(arg0 model.Shift, arg1 error) *MockshiftServiceInterfaceCloseShiftCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockshiftServiceInterfaceCloseShiftCall) Do(f func(context.Context, int64, float64) (model.Shift, error)) *MockshiftServiceInterfaceCloseShiftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockshiftServiceInterfaceCloseShiftCall) DoAndReturn(f func(context.Context, int64, float64) (model.Shift, error)) *MockshiftServiceInterfaceCloseShiftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CurrentShift mocks base method.
+func (m *MockshiftServiceInterface) CurrentShift(ctx context.Context, pickupPointID int64) (model.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CurrentShift", ctx, pickupPointID)
+	ret0, _ := ret[0].(model.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CurrentShift indicates an expected call of CurrentShift.
+func (mr *MockshiftServiceInterfaceMockRecorder) CurrentShift(ctx, pickupPointID any) *MockshiftServiceInterfaceCurrentShiftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentShift", reflect.TypeOf((*MockshiftServiceInterface)(nil).CurrentShift), ctx, pickupPointID)
+	return &MockshiftServiceInterfaceCurrentShiftCall{Call: call}
+}
+
+// MockshiftServiceInterfaceCurrentShiftCall wrap *gomock.Call
+type MockshiftServiceInterfaceCurrentShiftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockshiftServiceInterfaceCurrentShiftCall) Return(arg0 model.Shift, arg1 error) *MockshiftServiceInterfaceCurrentShiftCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockshiftServiceInterfaceCurrentShiftCall) Do(f func(context.Context, int64) (model.Shift, error)) *MockshiftServiceInterfaceCurrentShiftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockshiftServiceInterfaceCurrentShiftCall) DoAndReturn(f func(context.Context, int64) (model.Shift, error)) *MockshiftServiceInterfaceCurrentShiftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetShift mocks base method.
+func (m *MockshiftServiceInterface) GetShift(ctx context.Context, id int64) (model.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShift", ctx, id)
+	ret0, _ := ret[0].(model.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShift indicates an expected call of GetShift.
+func (mr *MockshiftServiceInterfaceMockRecorder) GetShift(ctx, id any) *MockshiftServiceInterfaceGetShiftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShift", reflect.TypeOf((*MockshiftServiceInterface)(nil).GetShift), ctx, id)
+	return &MockshiftServiceInterfaceGetShiftCall{Call: call}
+}
+
+// MockshiftServiceInterfaceGetShiftCall wrap *gomock.Call
+type MockshiftServiceInterfaceGetShiftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockshiftServiceInterfaceGetShiftCall) Return(arg0 model.Shift, arg1 error) *MockshiftServiceInterfaceGetShiftCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockshiftServiceInterfaceGetShiftCall) Do(f func(context.Context, int64) (model.Shift, error)) *MockshiftServiceInterfaceGetShiftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockshiftServiceInterfaceGetShiftCall) DoAndReturn(f func(context.Context, int64) (model.Shift, error)) *MockshiftServiceInterfaceGetShiftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListShiftsWithCursor mocks base method.
+func (m *MockshiftServiceInterface) ListShiftsWithCursor(ctx context.Context, cursorID int64, limit int) ([]model.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListShiftsWithCursor", ctx, cursorID, limit)
+	ret0, _ := ret[0].([]model.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShiftsWithCursor indicates an expected call of ListShiftsWithCursor.
+func (mr *MockshiftServiceInterfaceMockRecorder) ListShiftsWithCursor(ctx, cursorID, limit any) *MockshiftServiceInterfaceListShiftsWithCursorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShiftsWithCursor", reflect.TypeOf((*MockshiftServiceInterface)(nil).ListShiftsWithCursor), ctx, cursorID, limit)
+	return &MockshiftServiceInterfaceListShiftsWithCursorCall{Call: call}
+}
+
+// MockshiftServiceInterfaceListShiftsWithCursorCall wrap *gomock.Call
+type MockshiftServiceInterfaceListShiftsWithCursorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockshiftServiceInterfaceListShiftsWithCursorCall) Return(arg0 []model.Shift, arg1 error) *MockshiftServiceInterfaceListShiftsWithCursorCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockshiftServiceInterfaceListShiftsWithCursorCall) Do(f func(context.Context, int64, int) ([]model.Shift, error)) *MockshiftServiceInterfaceListShiftsWithCursorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockshiftServiceInterfaceListShiftsWithCursorCall) DoAndReturn(f func(context.Context, int64, int) ([]model.Shift, error)) *MockshiftServiceInterfaceListShiftsWithCursorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// OpenShift mocks base method.
+func (m *MockshiftServiceInterface) OpenShift(ctx context.Context, pickupPointID int64, openingCash float64) (model.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenShift", ctx, pickupPointID, openingCash)
+	ret0, _ := ret[0].(model.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenShift indicates an expected call of OpenShift.
+func (mr *MockshiftServiceInterfaceMockRecorder) OpenShift(ctx, pickupPointID, openingCash any) *MockshiftServiceInterfaceOpenShiftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenShift", reflect.TypeOf((*MockshiftServiceInterface)(nil).OpenShift), ctx, pickupPointID, openingCash)
+	return &MockshiftServiceInterfaceOpenShiftCall{Call: call}
+}
+
+// MockshiftServiceInterfaceOpenShiftCall wrap *gomock.Call
+type MockshiftServiceInterfaceOpenShiftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockshiftServiceInterfaceOpenShiftCall) Return(arg0 model.Shift, arg1 error) *MockshiftServiceInterfaceOpenShiftCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockshiftServiceInterfaceOpenShiftCall) Do(f func(context.Context, int64, float64) (model.Shift, error)) *MockshiftServiceInterfaceOpenShiftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockshiftServiceInterfaceOpenShiftCall) DoAndReturn(f func(context.Context, int64, float64) (model.Shift, error)) *MockshiftServiceInterfaceOpenShiftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ShiftReport mocks base method.
+func (m *MockshiftServiceInterface) ShiftReport(ctx context.Context, id int64) (model.ShiftReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShiftReport", ctx, id)
+	ret0, _ := ret[0].(model.ShiftReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShiftReport indicates an expected call of ShiftReport.
+func (mr *MockshiftServiceInterfaceMockRecorder) ShiftReport(ctx, id any) *MockshiftServiceInterfaceShiftReportCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShiftReport", reflect.TypeOf((*MockshiftServiceInterface)(nil).ShiftReport), ctx, id)
+	return &MockshiftServiceInterfaceShiftReportCall{Call: call}
+}
+
+// MockshiftServiceInterfaceShiftReportCall wrap *gomock.Call
+type MockshiftServiceInterfaceShiftReportCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockshiftServiceInterfaceShiftReportCall) Return(arg0 model.ShiftReport, arg1 error) *MockshiftServiceInterfaceShiftReportCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockshiftServiceInterfaceShiftReportCall) Do(f func(context.Context, int64) (model.ShiftReport, error)) *MockshiftServiceInterfaceShiftReportCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockshiftServiceInterfaceShiftReportCall) DoAndReturn(f func(context.Context, int64) (model.ShiftReport, error)) *MockshiftServiceInterfaceShiftReportCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockauthServiceInterface is a mock of authServiceInterface interface.
+type MockauthServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockauthServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockauthServiceInterfaceMockRecorder is the mock recorder for MockauthServiceInterface.
+type MockauthServiceInterfaceMockRecorder struct {
+	mock *MockauthServiceInterface
+}
+
+// NewMockauthServiceInterface creates a new mock instance.
+func NewMockauthServiceInterface(ctrl *gomock.Controller) *MockauthServiceInterface {
+	mock := &MockauthServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockauthServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauthServiceInterface) EXPECT() *MockauthServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockauthServiceInterface) Authenticate(ctx context.Context, username, password, ip string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, username, password, ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockauthServiceInterfaceMockRecorder) Authenticate(ctx, username, password, ip any) *MockauthServiceInterfaceAuthenticateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockauthServiceInterface)(nil).Authenticate), ctx, username, password, ip)
+	return &MockauthServiceInterfaceAuthenticateCall{Call: call}
+}
+
+// MockauthServiceInterfaceAuthenticateCall wrap *gomock.Call
+type MockauthServiceInterfaceAuthenticateCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockorderServiceInterfaceGetOrderByIDCall) Return(arg0 model.Order, arg1 error) *MockorderServiceInterfaceGetOrderByIDCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockauthServiceInterfaceAuthenticateCall) Return(arg0 error) *MockauthServiceInterfaceAuthenticateCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockorderServiceInterfaceGetOrderByIDCall) Do(f func(context.Context, int64) (model.Order, error)) *MockorderServiceInterfaceGetOrderByIDCall {
+func (c *MockauthServiceInterfaceAuthenticateCall) Do(f func(context.Context, string, string, string) error) *MockauthServiceInterfaceAuthenticateCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockorderServiceInterfaceGetOrderByIDCall) DoAndReturn(f func(context.Context, int64) (model.Order, error)) *MockorderServiceInterfaceGetOrderByIDCall {
+func (c *MockauthServiceInterfaceAuthenticateCall) DoAndReturn(f func(context.Context, string, string, string) error) *MockauthServiceInterfaceAuthenticateCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ListOrdersWithCursor mocks base method.
-func (m *MockorderServiceInterface) ListOrdersWithCursor(ctx context.Context, cursorID int64, limit int, customerID int64, filterPVZ bool, searchTerm string) ([]model.Order, error) {
+// Login mocks base method.
+func (m *MockauthServiceInterface) Login(ctx context.Context, username, password, ip string) (model.TokenPair, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListOrdersWithCursor", ctx, cursorID, limit, customerID, filterPVZ, searchTerm)
-	ret0, _ := ret[0].([]model.Order)
+	ret := m.ctrl.Call(m, "Login", ctx, username, password, ip)
+	ret0, _ := ret[0].(model.TokenPair)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListOrdersWithCursor indicates an expected call of ListOrdersWithCursor.
-func (mr *MockorderServiceInterfaceMockRecorder) ListOrdersWithCursor(ctx, cursorID, limit, customerID, filterPVZ, searchTerm any) *MockorderServiceInterfaceListOrdersWithCursorCall {
+// Login indicates an expected call of Login.
+func (mr *MockauthServiceInterfaceMockRecorder) Login(ctx, username, password, ip any) *MockauthServiceInterfaceLoginCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrdersWithCursor", reflect.TypeOf((*MockorderServiceInterface)(nil).ListOrdersWithCursor), ctx, cursorID, limit, customerID, filterPVZ, searchTerm)
-	return &MockorderServiceInterfaceListOrdersWithCursorCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockauthServiceInterface)(nil).Login), ctx, username, password, ip)
+	return &MockauthServiceInterfaceLoginCall{Call: call}
 }
 
-// MockorderServiceInterfaceListOrdersWithCursorCall wrap *gomock.Call
-type MockorderServiceInterfaceListOrdersWithCursorCall struct {
+// MockauthServiceInterfaceLoginCall wrap *gomock.Call
+type MockauthServiceInterfaceLoginCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockorderServiceInterfaceListOrdersWithCursorCall) Return(arg0 []model.Order, arg1 error) *MockorderServiceInterfaceListOrdersWithCursorCall {
+func (c *MockauthServiceInterfaceLoginCall) Return(arg0 model.TokenPair, arg1 error) *MockauthServiceInterfaceLoginCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockorderServiceInterfaceListOrdersWithCursorCall) Do(f func(context.Context, int64, int, int64, bool, string) ([]model.Order, error)) *MockorderServiceInterfaceListOrdersWithCursorCall {
+func (c *MockauthServiceInterfaceLoginCall) Do(f func(context.Context, string, string, string) (model.TokenPair, error)) *MockauthServiceInterfaceLoginCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockorderServiceInterfaceListOrdersWithCursorCall) DoAndReturn(f func(context.Context, int64, int, int64, bool, string) ([]model.Order, error)) *MockorderServiceInterfaceListOrdersWithCursorCall {
+func (c *MockauthServiceInterfaceLoginCall) DoAndReturn(f func(context.Context, string, string, string) (model.TokenPair, error)) *MockauthServiceInterfaceLoginCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ListReturnsWithCursor mocks base method.
-func (m *MockorderServiceInterface) ListReturnsWithCursor(ctx context.Context, cursorID int64, limit int, searchTerm string) ([]model.Order, error) {
+// Logout mocks base method.
+func (m *MockauthServiceInterface) Logout(ctx context.Context, refreshToken string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListReturnsWithCursor", ctx, cursorID, limit, searchTerm)
-	ret0, _ := ret[0].([]model.Order)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Logout", ctx, refreshToken)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListReturnsWithCursor indicates an expected call of ListReturnsWithCursor.
-func (mr *MockorderServiceInterfaceMockRecorder) ListReturnsWithCursor(ctx, cursorID, limit, searchTerm any) *MockorderServiceInterfaceListReturnsWithCursorCall {
+// Logout indicates an expected call of Logout.
+func (mr *MockauthServiceInterfaceMockRecorder) Logout(ctx, refreshToken any) *MockauthServiceInterfaceLogoutCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReturnsWithCursor", reflect.TypeOf((*MockorderServiceInterface)(nil).ListReturnsWithCursor), ctx, cursorID, limit, searchTerm)
-	return &MockorderServiceInterfaceListReturnsWithCursorCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockauthServiceInterface)(nil).Logout), ctx, refreshToken)
+	return &MockauthServiceInterfaceLogoutCall{Call: call}
 }
 
-// MockorderServiceInterfaceListReturnsWithCursorCall wrap *gomock.Call
-type MockorderServiceInterfaceListReturnsWithCursorCall struct {
+// MockauthServiceInterfaceLogoutCall wrap *gomock.Call
+type MockauthServiceInterfaceLogoutCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockorderServiceInterfaceListReturnsWithCursorCall) Return(arg0 []model.Order, arg1 error) *MockorderServiceInterfaceListReturnsWithCursorCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockauthServiceInterfaceLogoutCall) Return(arg0 error) *MockauthServiceInterfaceLogoutCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockorderServiceInterfaceListReturnsWithCursorCall) Do(f func(context.Context, int64, int, string) ([]model.Order, error)) *MockorderServiceInterfaceListReturnsWithCursorCall {
+func (c *MockauthServiceInterfaceLogoutCall) Do(f func(context.Context, string) error) *MockauthServiceInterfaceLogoutCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockorderServiceInterfaceListReturnsWithCursorCall) DoAndReturn(f func(context.Context, int64, int, string) ([]model.Order, error)) *MockorderServiceInterfaceListReturnsWithCursorCall {
+func (c *MockauthServiceInterfaceLogoutCall) DoAndReturn(f func(context.Context, string) error) *MockauthServiceInterfaceLogoutCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// OrderHistory mocks base method.
-func (m *MockorderServiceInterface) OrderHistory(ctx context.Context, searchTerm string) ([]model.Order, error) {
+// ParseAccessToken mocks base method.
+func (m *MockauthServiceInterface) ParseAccessToken(accessToken string) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "OrderHistory", ctx, searchTerm)
-	ret0, _ := ret[0].([]model.Order)
+	ret := m.ctrl.Call(m, "ParseAccessToken", accessToken)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// OrderHistory indicates an expected call of OrderHistory.
-func (mr *MockorderServiceInterfaceMockRecorder) OrderHistory(ctx, searchTerm any) *MockorderServiceInterfaceOrderHistoryCall {
+// ParseAccessToken indicates an expected call of ParseAccessToken.
+func (mr *MockauthServiceInterfaceMockRecorder) ParseAccessToken(accessToken any) *MockauthServiceInterfaceParseAccessTokenCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderHistory", reflect.TypeOf((*MockorderServiceInterface)(nil).OrderHistory), ctx, searchTerm)
-	return &MockorderServiceInterfaceOrderHistoryCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseAccessToken", reflect.TypeOf((*MockauthServiceInterface)(nil).ParseAccessToken), accessToken)
+	return &MockauthServiceInterfaceParseAccessTokenCall{Call: call}
 }
 
-// MockorderServiceInterfaceOrderHistoryCall wrap *gomock.Call
-type MockorderServiceInterfaceOrderHistoryCall struct {
+// MockauthServiceInterfaceParseAccessTokenCall wrap *gomock.Call
+type MockauthServiceInterfaceParseAccessTokenCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockorderServiceInterfaceOrderHistoryCall) Return(arg0 []model.Order, arg1 error) *MockorderServiceInterfaceOrderHistoryCall {
+func (c *MockauthServiceInterfaceParseAccessTokenCall) Return(arg0 int64, arg1 error) *MockauthServiceInterfaceParseAccessTokenCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockorderServiceInterfaceOrderHistoryCall) Do(f func(context.Context, string) ([]model.Order, error)) *MockorderServiceInterfaceOrderHistoryCall {
+func (c *MockauthServiceInterfaceParseAccessTokenCall) Do(f func(string) (int64, error)) *MockauthServiceInterfaceParseAccessTokenCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockorderServiceInterfaceOrderHistoryCall) DoAndReturn(f func(context.Context, string) ([]model.Order, error)) *MockorderServiceInterfaceOrderHistoryCall {
+func (c *MockauthServiceInterfaceParseAccessTokenCall) DoAndReturn(f func(string) (int64, error)) *MockauthServiceInterfaceParseAccessTokenCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ProcessReturnOrder mocks base method.
-func (m *MockorderServiceInterface) ProcessReturnOrder(ctx context.Context, id, customerID int64, now time.Time) error {
+// Refresh mocks base method.
+func (m *MockauthServiceInterface) Refresh(ctx context.Context, refreshToken string) (model.TokenPair, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ProcessReturnOrder", ctx, id, customerID, now)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Refresh", ctx, refreshToken)
+	ret0, _ := ret[0].(model.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ProcessReturnOrder indicates an expected call of ProcessReturnOrder.
-func (mr *MockorderServiceInterfaceMockRecorder) ProcessReturnOrder(ctx, id, customerID, now any) *MockorderServiceInterfaceProcessReturnOrderCall {
+// Refresh indicates an expected call of Refresh.
+func (mr *MockauthServiceInterfaceMockRecorder) Refresh(ctx, refreshToken any) *MockauthServiceInterfaceRefreshCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessReturnOrder", reflect.TypeOf((*MockorderServiceInterface)(nil).ProcessReturnOrder), ctx, id, customerID, now)
-	return &MockorderServiceInterfaceProcessReturnOrderCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockauthServiceInterface)(nil).Refresh), ctx, refreshToken)
+	return &MockauthServiceInterfaceRefreshCall{Call: call}
 }
 
-// MockorderServiceInterfaceProcessReturnOrderCall wrap *gomock.Call
-type MockorderServiceInterfaceProcessReturnOrderCall struct {
+// MockauthServiceInterfaceRefreshCall wrap *gomock.Call
+type MockauthServiceInterfaceRefreshCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockorderServiceInterfaceProcessReturnOrderCall) Return(arg0 error) *MockorderServiceInterfaceProcessReturnOrderCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockauthServiceInterfaceRefreshCall) Return(arg0 model.TokenPair, arg1 error) *MockauthServiceInterfaceRefreshCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockorderServiceInterfaceProcessReturnOrderCall) Do(f func(context.Context, int64, int64, time.Time) error) *MockorderServiceInterfaceProcessReturnOrderCall {
+func (c *MockauthServiceInterfaceRefreshCall) Do(f func(context.Context, string) (model.TokenPair, error)) *MockauthServiceInterfaceRefreshCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockorderServiceInterfaceProcessReturnOrderCall) DoAndReturn(f func(context.Context, int64, int64, time.Time) error) *MockorderServiceInterfaceProcessReturnOrderCall {
+func (c *MockauthServiceInterfaceRefreshCall) DoAndReturn(f func(context.Context, string) (model.TokenPair, error)) *MockauthServiceInterfaceRefreshCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ReturnOrderToCourier mocks base method.
-func (m *MockorderServiceInterface) ReturnOrderToCourier(ctx context.Context, id int64) error {
+// Unlock mocks base method.
+func (m *MockauthServiceInterface) Unlock(ctx context.Context, username, ip string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReturnOrderToCourier", ctx, id)
+	ret := m.ctrl.Call(m, "Unlock", ctx, username, ip)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// ReturnOrderToCourier indicates an expected call of ReturnOrderToCourier.
-func (mr *MockorderServiceInterfaceMockRecorder) ReturnOrderToCourier(ctx, id any) *MockorderServiceInterfaceReturnOrderToCourierCall {
+// Unlock indicates an expected call of Unlock.
+func (mr *MockauthServiceInterfaceMockRecorder) Unlock(ctx, username, ip any) *MockauthServiceInterfaceUnlockCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnOrderToCourier", reflect.TypeOf((*MockorderServiceInterface)(nil).ReturnOrderToCourier), ctx, id)
-	return &MockorderServiceInterfaceReturnOrderToCourierCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockauthServiceInterface)(nil).Unlock), ctx, username, ip)
+	return &MockauthServiceInterfaceUnlockCall{Call: call}
 }
 
-// MockorderServiceInterfaceReturnOrderToCourierCall wrap *gomock.Call
-type MockorderServiceInterfaceReturnOrderToCourierCall struct {
+// MockauthServiceInterfaceUnlockCall wrap *gomock.Call
+type MockauthServiceInterfaceUnlockCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockorderServiceInterfaceReturnOrderToCourierCall) Return(arg0 error) *MockorderServiceInterfaceReturnOrderToCourierCall {
+func (c *MockauthServiceInterfaceUnlockCall) Return(arg0 error) *MockauthServiceInterfaceUnlockCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockorderServiceInterfaceReturnOrderToCourierCall) Do(f func(context.Context, int64) error) *MockorderServiceInterfaceReturnOrderToCourierCall {
+func (c *MockauthServiceInterfaceUnlockCall) Do(f func(context.Context, string, string) error) *MockauthServiceInterfaceUnlockCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockorderServiceInterfaceReturnOrderToCourierCall) DoAndReturn(f func(context.Context, int64) error) *MockorderServiceInterfaceReturnOrderToCourierCall {
+func (c *MockauthServiceInterfaceUnlockCall) DoAndReturn(f func(context.Context, string, string) error) *MockauthServiceInterfaceUnlockCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// MockuserRepository is a mock of userRepository interface.
-type MockuserRepository struct {
+// MockapiKeyServiceInterface is a mock of apiKeyServiceInterface interface.
+type MockapiKeyServiceInterface struct {
 	ctrl     *gomock.Controller
-	recorder *MockuserRepositoryMockRecorder
+	recorder *MockapiKeyServiceInterfaceMockRecorder
 	isgomock struct{}
 }
 
-// MockuserRepositoryMockRecorder is the mock recorder for MockuserRepository.
-type MockuserRepositoryMockRecorder struct {
-	mock *MockuserRepository
+// MockapiKeyServiceInterfaceMockRecorder is the mock recorder for MockapiKeyServiceInterface.
+type MockapiKeyServiceInterfaceMockRecorder struct {
+	mock *MockapiKeyServiceInterface
 }
 
-// NewMockuserRepository creates a new mock instance.
-func NewMockuserRepository(ctrl *gomock.Controller) *MockuserRepository {
-	mock := &MockuserRepository{ctrl: ctrl}
-	mock.recorder = &MockuserRepositoryMockRecorder{mock}
+// NewMockapiKeyServiceInterface creates a new mock instance.
+func NewMockapiKeyServiceInterface(ctrl *gomock.Controller) *MockapiKeyServiceInterface {
+	mock := &MockapiKeyServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockapiKeyServiceInterfaceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockuserRepository) EXPECT() *MockuserRepositoryMockRecorder {
+func (m *MockapiKeyServiceInterface) EXPECT() *MockapiKeyServiceInterfaceMockRecorder {
 	return m.recorder
 }
 
-// CheckPassword mocks base method.
-func (m *MockuserRepository) CheckPassword(ctx context.Context, username, password string) bool {
+// AuthenticateAPIKey mocks base method.
+func (m *MockapiKeyServiceInterface) AuthenticateAPIKey(ctx context.Context, rawKey string) (model.User, model.APIKey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckPassword", ctx, username, password)
-	ret0, _ := ret[0].(bool)
-	return ret0
+	ret := m.ctrl.Call(m, "AuthenticateAPIKey", ctx, rawKey)
+	ret0, _ := ret[0].(model.User)
+	ret1, _ := ret[1].(model.APIKey)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// CheckPassword indicates an expected call of CheckPassword.
-func (mr *MockuserRepositoryMockRecorder) CheckPassword(ctx, username, password any) *MockuserRepositoryCheckPasswordCall {
+// AuthenticateAPIKey indicates an expected call of AuthenticateAPIKey.
+func (mr *MockapiKeyServiceInterfaceMockRecorder) AuthenticateAPIKey(ctx, rawKey any) *MockapiKeyServiceInterfaceAuthenticateAPIKeyCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPassword", reflect.TypeOf((*MockuserRepository)(nil).CheckPassword), ctx, username, password)
-	return &MockuserRepositoryCheckPasswordCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateAPIKey", reflect.TypeOf((*MockapiKeyServiceInterface)(nil).AuthenticateAPIKey), ctx, rawKey)
+	return &MockapiKeyServiceInterfaceAuthenticateAPIKeyCall{Call: call}
 }
 
-// MockuserRepositoryCheckPasswordCall wrap *gomock.Call
-type MockuserRepositoryCheckPasswordCall struct {
+// MockapiKeyServiceInterfaceAuthenticateAPIKeyCall wrap *gomock.Call
+type MockapiKeyServiceInterfaceAuthenticateAPIKeyCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockuserRepositoryCheckPasswordCall) Return(arg0 bool) *MockuserRepositoryCheckPasswordCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockapiKeyServiceInterfaceAuthenticateAPIKeyCall) Return(arg0 model.User, arg1 model.APIKey, arg2 error) *MockapiKeyServiceInterfaceAuthenticateAPIKeyCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockuserRepositoryCheckPasswordCall) Do(f func(context.Context, string, string) bool) *MockuserRepositoryCheckPasswordCall {
+func (c *MockapiKeyServiceInterfaceAuthenticateAPIKeyCall) Do(f func(context.Context, string) (model.User, model.APIKey, error)) *MockapiKeyServiceInterfaceAuthenticateAPIKeyCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockuserRepositoryCheckPasswordCall) DoAndReturn(f func(context.Context, string, string) bool) *MockuserRepositoryCheckPasswordCall {
+func (c *MockapiKeyServiceInterfaceAuthenticateAPIKeyCall) DoAndReturn(f func(context.Context, string) (model.User, model.APIKey, error)) *MockapiKeyServiceInterfaceAuthenticateAPIKeyCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Create mocks base method.
-func (m *MockuserRepository) Create(ctx context.Context, user model.User, plainPassword string) error {
+func (m *MockapiKeyServiceInterface) Create(ctx context.Context, userID int64, name string, scopes []string, expiresAt *time.Time) (model.APIKey, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, user, plainPassword)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Create", ctx, userID, name, scopes, expiresAt)
+	ret0, _ := ret[0].(model.APIKey)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockuserRepositoryMockRecorder) Create(ctx, user, plainPassword any) *MockuserRepositoryCreateCall {
+func (mr *MockapiKeyServiceInterfaceMockRecorder) Create(ctx, userID, name, scopes, expiresAt any) *MockapiKeyServiceInterfaceCreateCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockuserRepository)(nil).Create), ctx, user, plainPassword)
-	return &MockuserRepositoryCreateCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockapiKeyServiceInterface)(nil).Create), ctx, userID, name, scopes, expiresAt)
+	return &MockapiKeyServiceInterfaceCreateCall{Call: call}
 }
 
-// MockuserRepositoryCreateCall wrap *gomock.Call
-type MockuserRepositoryCreateCall struct {
+// MockapiKeyServiceInterfaceCreateCall wrap *gomock.Call
+type MockapiKeyServiceInterfaceCreateCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockuserRepositoryCreateCall) Return(arg0 error) *MockuserRepositoryCreateCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockapiKeyServiceInterfaceCreateCall) Return(arg0 model.APIKey, arg1 string, arg2 error) *MockapiKeyServiceInterfaceCreateCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockuserRepositoryCreateCall) Do(f func(context.Context, model.User, string) error) *MockuserRepositoryCreateCall {
+func (c *MockapiKeyServiceInterfaceCreateCall) Do(f func(context.Context, int64, string, []string, *time.Time) (model.APIKey, string, error)) *MockapiKeyServiceInterfaceCreateCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockuserRepositoryCreateCall) DoAndReturn(f func(context.Context, model.User, string) error) *MockuserRepositoryCreateCall {
+func (c *MockapiKeyServiceInterfaceCreateCall) DoAndReturn(f func(context.Context, int64, string, []string, *time.Time) (model.APIKey, string, error)) *MockapiKeyServiceInterfaceCreateCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// Delete mocks base method.
-func (m *MockuserRepository) Delete(ctx context.Context, id int64) error {
+// List mocks base method.
+func (m *MockapiKeyServiceInterface) List(ctx context.Context, userID int64) ([]model.APIKey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "List", ctx, userID)
+	ret0, _ := ret[0].([]model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Delete indicates an expected call of Delete.
-func (mr *MockuserRepositoryMockRecorder) Delete(ctx, id any) *MockuserRepositoryDeleteCall {
+// List indicates an expected call of List.
+func (mr *MockapiKeyServiceInterfaceMockRecorder) List(ctx, userID any) *MockapiKeyServiceInterfaceListCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockuserRepository)(nil).Delete), ctx, id)
-	return &MockuserRepositoryDeleteCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockapiKeyServiceInterface)(nil).List), ctx, userID)
+	return &MockapiKeyServiceInterfaceListCall{Call: call}
 }
 
-// MockuserRepositoryDeleteCall wrap *gomock.Call
-type MockuserRepositoryDeleteCall struct {
+// MockapiKeyServiceInterfaceListCall wrap *gomock.Call
+type MockapiKeyServiceInterfaceListCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockuserRepositoryDeleteCall) Return(arg0 error) *MockuserRepositoryDeleteCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockapiKeyServiceInterfaceListCall) Return(arg0 []model.APIKey, arg1 error) *MockapiKeyServiceInterfaceListCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockuserRepositoryDeleteCall) Do(f func(context.Context, int64) error) *MockuserRepositoryDeleteCall {
+func (c *MockapiKeyServiceInterfaceListCall) Do(f func(context.Context, int64) ([]model.APIKey, error)) *MockapiKeyServiceInterfaceListCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockuserRepositoryDeleteCall) DoAndReturn(f func(context.Context, int64) error) *MockuserRepositoryDeleteCall {
+func (c *MockapiKeyServiceInterfaceListCall) DoAndReturn(f func(context.Context, int64) ([]model.APIKey, error)) *MockapiKeyServiceInterfaceListCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetByID mocks base method.
-func (m *MockuserRepository) GetByID(ctx context.Context, id int64) (model.User, error) {
+// Revoke mocks base method.
+func (m *MockapiKeyServiceInterface) Revoke(ctx context.Context, userID, keyID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByID", ctx, id)
-	ret0, _ := ret[0].(model.User)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Revoke", ctx, userID, keyID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetByID indicates an expected call of GetByID.
-func (mr *MockuserRepositoryMockRecorder) GetByID(ctx, id any) *MockuserRepositoryGetByIDCall {
+// Revoke indicates an expected call of Revoke.
+func (mr *MockapiKeyServiceInterfaceMockRecorder) Revoke(ctx, userID, keyID any) *MockapiKeyServiceInterfaceRevokeCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockuserRepository)(nil).GetByID), ctx, id)
-	return &MockuserRepositoryGetByIDCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockapiKeyServiceInterface)(nil).Revoke), ctx, userID, keyID)
+	return &MockapiKeyServiceInterfaceRevokeCall{Call: call}
 }
 
-// MockuserRepositoryGetByIDCall wrap *gomock.Call
-type MockuserRepositoryGetByIDCall struct {
+// MockapiKeyServiceInterfaceRevokeCall wrap *gomock.Call
+type MockapiKeyServiceInterfaceRevokeCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockuserRepositoryGetByIDCall) Return(arg0 model.User, arg1 error) *MockuserRepositoryGetByIDCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockapiKeyServiceInterfaceRevokeCall) Return(arg0 error) *MockapiKeyServiceInterfaceRevokeCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockuserRepositoryGetByIDCall) Do(f func(context.Context, int64) (model.User, error)) *MockuserRepositoryGetByIDCall {
+func (c *MockapiKeyServiceInterfaceRevokeCall) Do(f func(context.Context, int64, int64) error) *MockapiKeyServiceInterfaceRevokeCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockuserRepositoryGetByIDCall) DoAndReturn(f func(context.Context, int64) (model.User, error)) *MockuserRepositoryGetByIDCall {
+func (c *MockapiKeyServiceInterfaceRevokeCall) DoAndReturn(f func(context.Context, int64, int64) error) *MockapiKeyServiceInterfaceRevokeCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetByUsername mocks base method.
-func (m *MockuserRepository) GetByUsername(ctx context.Context, username string) (model.User, error) {
+// MockidempotencyServiceInterface is a mock of idempotencyServiceInterface interface.
+type MockidempotencyServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockidempotencyServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockidempotencyServiceInterfaceMockRecorder is the mock recorder for MockidempotencyServiceInterface.
+type MockidempotencyServiceInterfaceMockRecorder struct {
+	mock *MockidempotencyServiceInterface
+}
+
+// NewMockidempotencyServiceInterface creates a new mock instance.
+func NewMockidempotencyServiceInterface(ctrl *gomock.Controller) *MockidempotencyServiceInterface {
+	mock := &MockidempotencyServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockidempotencyServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockidempotencyServiceInterface) EXPECT() *MockidempotencyServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Begin mocks base method.
+func (m *MockidempotencyServiceInterface) Begin(ctx context.Context, username, key string, request []byte) (*model.IdempotencyRecord, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByUsername", ctx, username)
-	ret0, _ := ret[0].(model.User)
+	ret := m.ctrl.Call(m, "Begin", ctx, username, key, request)
+	ret0, _ := ret[0].(*model.IdempotencyRecord)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetByUsername indicates an expected call of GetByUsername.
-func (mr *MockuserRepositoryMockRecorder) GetByUsername(ctx, username any) *MockuserRepositoryGetByUsernameCall {
+// Begin indicates an expected call of Begin.
+func (mr *MockidempotencyServiceInterfaceMockRecorder) Begin(ctx, username, key, request any) *MockidempotencyServiceInterfaceBeginCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUsername", reflect.TypeOf((*MockuserRepository)(nil).GetByUsername), ctx, username)
-	return &MockuserRepositoryGetByUsernameCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Begin", reflect.TypeOf((*MockidempotencyServiceInterface)(nil).Begin), ctx, username, key, request)
+	return &MockidempotencyServiceInterfaceBeginCall{Call: call}
 }
 
-// MockuserRepositoryGetByUsernameCall wrap *gomock.Call
-type MockuserRepositoryGetByUsernameCall struct {
+// MockidempotencyServiceInterfaceBeginCall wrap *gomock.Call
+type MockidempotencyServiceInterfaceBeginCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockuserRepositoryGetByUsernameCall) Return(arg0 model.User, arg1 error) *MockuserRepositoryGetByUsernameCall {
+func (c *MockidempotencyServiceInterfaceBeginCall) Return(arg0 *model.IdempotencyRecord, arg1 error) *MockidempotencyServiceInterfaceBeginCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockuserRepositoryGetByUsernameCall) Do(f func(context.Context, string) (model.User, error)) *MockuserRepositoryGetByUsernameCall {
+func (c *MockidempotencyServiceInterfaceBeginCall) Do(f func(context.Context, string, string, []byte) (*model.IdempotencyRecord, error)) *MockidempotencyServiceInterfaceBeginCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockuserRepositoryGetByUsernameCall) DoAndReturn(f func(context.Context, string) (model.User, error)) *MockuserRepositoryGetByUsernameCall {
+func (c *MockidempotencyServiceInterfaceBeginCall) DoAndReturn(f func(context.Context, string, string, []byte) (*model.IdempotencyRecord, error)) *MockidempotencyServiceInterfaceBeginCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// List mocks base method.
-func (m *MockuserRepository) List(ctx context.Context, searchTerm string) ([]model.User, error) {
+// Complete mocks base method.
+func (m *MockidempotencyServiceInterface) Complete(ctx context.Context, username, key string, statusCode int, response []byte) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, searchTerm)
-	ret0, _ := ret[0].([]model.User)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Complete", ctx, username, key, statusCode, response)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// List indicates an expected call of List.
-func (mr *MockuserRepositoryMockRecorder) List(ctx, searchTerm any) *MockuserRepositoryListCall {
+// Complete indicates an expected call of Complete.
+func (mr *MockidempotencyServiceInterfaceMockRecorder) Complete(ctx, username, key, statusCode, response any) *MockidempotencyServiceInterfaceCompleteCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockuserRepository)(nil).List), ctx, searchTerm)
-	return &MockuserRepositoryListCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockidempotencyServiceInterface)(nil).Complete), ctx, username, key, statusCode, response)
+	return &MockidempotencyServiceInterfaceCompleteCall{Call: call}
 }
 
-// MockuserRepositoryListCall wrap *gomock.Call
-type MockuserRepositoryListCall struct {
+// MockidempotencyServiceInterfaceCompleteCall wrap *gomock.Call
+type MockidempotencyServiceInterfaceCompleteCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockuserRepositoryListCall) Return(arg0 []model.User, arg1 error) *MockuserRepositoryListCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockidempotencyServiceInterfaceCompleteCall) Return(arg0 error) *MockidempotencyServiceInterfaceCompleteCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockuserRepositoryListCall) Do(f func(context.Context, string) ([]model.User, error)) *MockuserRepositoryListCall {
+func (c *MockidempotencyServiceInterfaceCompleteCall) Do(f func(context.Context, string, string, int, []byte) error) *MockidempotencyServiceInterfaceCompleteCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockuserRepositoryListCall) DoAndReturn(f func(context.Context, string) ([]model.User, error)) *MockuserRepositoryListCall {
+func (c *MockidempotencyServiceInterfaceCompleteCall) DoAndReturn(f func(context.Context, string, string, int, []byte) error) *MockidempotencyServiceInterfaceCompleteCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// Update mocks base method.
-func (m *MockuserRepository) Update(ctx context.Context, user model.User) error {
+// Release mocks base method.
+func (m *MockidempotencyServiceInterface) Release(ctx context.Context, username, key string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, user)
+	ret := m.ctrl.Call(m, "Release", ctx, username, key)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Update indicates an expected call of Update.
-func (mr *MockuserRepositoryMockRecorder) Update(ctx, user any) *MockuserRepositoryUpdateCall {
+// Release indicates an expected call of Release.
+func (mr *MockidempotencyServiceInterfaceMockRecorder) Release(ctx, username, key any) *MockidempotencyServiceInterfaceReleaseCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockuserRepository)(nil).Update), ctx, user)
-	return &MockuserRepositoryUpdateCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockidempotencyServiceInterface)(nil).Release), ctx, username, key)
+	return &MockidempotencyServiceInterfaceReleaseCall{Call: call}
 }
 
-// MockuserRepositoryUpdateCall wrap *gomock.Call
-type MockuserRepositoryUpdateCall struct {
+// MockidempotencyServiceInterfaceReleaseCall wrap *gomock.Call
+type MockidempotencyServiceInterfaceReleaseCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockuserRepositoryUpdateCall) Return(arg0 error) *MockuserRepositoryUpdateCall {
+func (c *MockidempotencyServiceInterfaceReleaseCall) Return(arg0 error) *MockidempotencyServiceInterfaceReleaseCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockuserRepositoryUpdateCall) Do(f func(context.Context, model.User) error) *MockuserRepositoryUpdateCall {
+func (c *MockidempotencyServiceInterfaceReleaseCall) Do(f func(context.Context, string, string) error) *MockidempotencyServiceInterfaceReleaseCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockuserRepositoryUpdateCall) DoAndReturn(f func(context.Context, model.User) error) *MockuserRepositoryUpdateCall {
+func (c *MockidempotencyServiceInterfaceReleaseCall) DoAndReturn(f func(context.Context, string, string) error) *MockidempotencyServiceInterfaceReleaseCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// UpdatePassword mocks base method.
-func (m *MockuserRepository) UpdatePassword(ctx context.Context, userID int64, newPassword string) error {
+// MockimportJobServiceInterface is a mock of importJobServiceInterface interface.
+type MockimportJobServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockimportJobServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockimportJobServiceInterfaceMockRecorder is the mock recorder for MockimportJobServiceInterface.
+type MockimportJobServiceInterfaceMockRecorder struct {
+	mock *MockimportJobServiceInterface
+}
+
+// NewMockimportJobServiceInterface creates a new mock instance.
+func NewMockimportJobServiceInterface(ctrl *gomock.Controller) *MockimportJobServiceInterface {
+	mock := &MockimportJobServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockimportJobServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimportJobServiceInterface) EXPECT() *MockimportJobServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockimportJobServiceInterface) Get(ctx context.Context, id int64) (model.ImportJob, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePassword", ctx, userID, newPassword)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(model.ImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdatePassword indicates an expected call of UpdatePassword.
-func (mr *MockuserRepositoryMockRecorder) UpdatePassword(ctx, userID, newPassword any) *MockuserRepositoryUpdatePasswordCall {
+// Get indicates an expected call of Get.
+func (mr *MockimportJobServiceInterfaceMockRecorder) Get(ctx, id any) *MockimportJobServiceInterfaceGetCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockuserRepository)(nil).UpdatePassword), ctx, userID, newPassword)
-	return &MockuserRepositoryUpdatePasswordCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockimportJobServiceInterface)(nil).Get), ctx, id)
+	return &MockimportJobServiceInterfaceGetCall{Call: call}
 }
 
-// MockuserRepositoryUpdatePasswordCall wrap *gomock.Call
-type MockuserRepositoryUpdatePasswordCall struct {
+// MockimportJobServiceInterfaceGetCall wrap *gomock.Call
+type MockimportJobServiceInterfaceGetCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockuserRepositoryUpdatePasswordCall) Return(arg0 error) *MockuserRepositoryUpdatePasswordCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockimportJobServiceInterfaceGetCall) Return(arg0 model.ImportJob, arg1 error) *MockimportJobServiceInterfaceGetCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockuserRepositoryUpdatePasswordCall) Do(f func(context.Context, int64, string) error) *MockuserRepositoryUpdatePasswordCall {
+func (c *MockimportJobServiceInterfaceGetCall) Do(f func(context.Context, int64) (model.ImportJob, error)) *MockimportJobServiceInterfaceGetCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockuserRepositoryUpdatePasswordCall) DoAndReturn(f func(context.Context, int64, string) error) *MockuserRepositoryUpdatePasswordCall {
+func (c *MockimportJobServiceInterfaceGetCall) DoAndReturn(f func(context.Context, int64) (model.ImportJob, error)) *MockimportJobServiceInterfaceGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Submit mocks base method.
+func (m *MockimportJobServiceInterface) Submit(ctx context.Context, file model.ImportFile, options model.ImportOptions) (model.ImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Submit", ctx, file, options)
+	ret0, _ := ret[0].(model.ImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Submit indicates an expected call of Submit.
+func (mr *MockimportJobServiceInterfaceMockRecorder) Submit(ctx, file, options any) *MockimportJobServiceInterfaceSubmitCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit", reflect.TypeOf((*MockimportJobServiceInterface)(nil).Submit), ctx, file, options)
+	return &MockimportJobServiceInterfaceSubmitCall{Call: call}
+}
+
+// MockimportJobServiceInterfaceSubmitCall wrap *gomock.Call
+type MockimportJobServiceInterfaceSubmitCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockimportJobServiceInterfaceSubmitCall) Return(arg0 model.ImportJob, arg1 error) *MockimportJobServiceInterfaceSubmitCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockimportJobServiceInterfaceSubmitCall) Do(f func(context.Context, model.ImportFile, model.ImportOptions) (model.ImportJob, error)) *MockimportJobServiceInterfaceSubmitCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockimportJobServiceInterfaceSubmitCall) DoAndReturn(f func(context.Context, model.ImportFile, model.ImportOptions) (model.ImportJob, error)) *MockimportJobServiceInterfaceSubmitCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gitlab.ozon.dev/gojhw1/pkg/logger"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/rbac"
+)
+
+var (
+	// ErrInvalidAPIKey - ошибка, возникающая при неизвестном, неверном или просроченном API-ключе
+	ErrInvalidAPIKey = errors.New("недействительный или просроченный API-ключ")
+	// ErrEmptyAPIKeyName - ошибка, возникающая при создании API-ключа без названия
+	ErrEmptyAPIKeyName = errors.New("название API-ключа не может быть пустым")
+	// ErrEmptyAPIKeyScopes - ошибка, возникающая при создании API-ключа без областей действия
+	ErrEmptyAPIKeyScopes = errors.New("необходимо указать хотя бы одну область действия API-ключа")
+	// ErrInvalidAPIKeyScope - ошибка, возникающая, когда область действия не выдана роли владельца ключа
+	ErrInvalidAPIKeyScope = errors.New("недопустимая область действия API-ключа")
+	// ErrInvalidAPIKeyExpiry - ошибка, возникающая, когда срок действия API-ключа уже истек
+	ErrInvalidAPIKeyExpiry = errors.New("срок действия API-ключа должен быть в будущем")
+)
+
+const (
+	apiKeyPrefix      = "pvz"
+	apiKeyIDSize      = 6
+	apiKeySecretSize  = 32
+	apiKeyPartsNumber = 3
+)
+
+type apiKeyRepository interface {
+	Create(ctx context.Context, key model.APIKey) (model.APIKey, error)
+	ListByUser(ctx context.Context, userID int64) ([]model.APIKey, error)
+	GetByPrefix(ctx context.Context, prefix string) (model.APIKey, error)
+	Delete(ctx context.Context, userID, keyID int64) error
+	TouchLastUsed(ctx context.Context, keyID int64, usedAt time.Time) error
+}
+
+type apiKeyUserRepository interface {
+	GetByID(ctx context.Context, id int64) (model.User, error)
+}
+
+// APIKeyService - сервис выпуска и проверки API-ключей.
+// Ключ имеет вид pvz_<префикс>_<секрет>: префикс хранится открыто и служит для поиска,
+// от всего ключа хранится только SHA-256 хеш.
+type APIKeyService struct {
+	keys  apiKeyRepository
+	users apiKeyUserRepository
+}
+
+// NewAPIKeyService создает сервис API-ключей
+func NewAPIKeyService(keys apiKeyRepository, users apiKeyUserRepository) *APIKeyService {
+	return &APIKeyService{
+		keys:  keys,
+		users: users,
+	}
+}
+
+// Create выпускает новый API-ключ для пользователя.
+// Области действия должны быть выданы роли пользователя. Если expiresAt равен nil, ключ бессрочный.
+// Возвращает сохраненный ключ и сам ключ, который больше нигде не хранится.
+func (s *APIKeyService) Create(ctx context.Context, userID int64, name string, scopes []string, expiresAt *time.Time) (model.APIKey, string, error) {
+	if strings.TrimSpace(name) == "" {
+		return model.APIKey{}, "", ErrEmptyAPIKeyName
+	}
+
+	if len(scopes) == 0 {
+		return model.APIKey{}, "", ErrEmptyAPIKeyScopes
+	}
+
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return model.APIKey{}, "", ErrInvalidAPIKeyExpiry
+	}
+
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return model.APIKey{}, "", err
+	}
+
+	for _, scope := range scopes {
+		if !rbac.HasPermission(user.Role, rbac.Permission(scope)) {
+			return model.APIKey{}, "", fmt.Errorf("%w: %s", ErrInvalidAPIKeyScope, scope)
+		}
+	}
+
+	rawKey, prefix, err := generateAPIKey()
+	if err != nil {
+		return model.APIKey{}, "", err
+	}
+
+	key, err := s.keys.Create(ctx, model.APIKey{
+		UserID:    userID,
+		Name:      name,
+		Prefix:    prefix,
+		KeyHash:   hashToken(rawKey),
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return model.APIKey{}, "", err
+	}
+
+	return key, rawKey, nil
+}
+
+// List возвращает API-ключи пользователя без секретной части
+func (s *APIKeyService) List(ctx context.Context, userID int64) ([]model.APIKey, error) {
+	return s.keys.ListByUser(ctx, userID)
+}
+
+// Revoke отзывает API-ключ пользователя
+func (s *APIKeyService) Revoke(ctx context.Context, userID, keyID int64) error {
+	return s.keys.Delete(ctx, userID, keyID)
+}
+
+// AuthenticateAPIKey проверяет API-ключ и возвращает его владельца и сам ключ с областями действия.
+// При успешной проверке обновляется время последнего использования ключа.
+func (s *APIKeyService) AuthenticateAPIKey(ctx context.Context, rawKey string) (model.User, model.APIKey, error) {
+	parts := strings.SplitN(rawKey, "_", apiKeyPartsNumber)
+	if len(parts) != apiKeyPartsNumber || parts[0] != apiKeyPrefix {
+		return model.User{}, model.APIKey{}, ErrInvalidAPIKey
+	}
+
+	key, err := s.keys.GetByPrefix(ctx, parts[1])
+	if err != nil {
+		return model.User{}, model.APIKey{}, fmt.Errorf("%w: %w", ErrInvalidAPIKey, err)
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashToken(rawKey)), []byte(key.KeyHash)) != 1 {
+		return model.User{}, model.APIKey{}, ErrInvalidAPIKey
+	}
+
+	now := time.Now()
+	if key.ExpiresAt != nil && !key.ExpiresAt.After(now) {
+		return model.User{}, model.APIKey{}, ErrInvalidAPIKey
+	}
+
+	user, err := s.users.GetByID(ctx, key.UserID)
+	if err != nil {
+		return model.User{}, model.APIKey{}, fmt.Errorf("%w: %w", ErrInvalidAPIKey, err)
+	}
+
+	// Ошибка обновления времени использования не должна мешать запросу
+	if err = s.keys.TouchLastUsed(ctx, key.ID, now); err != nil {
+		logger.Errorf("ошибка обновления времени использования API-ключа %s: %v", key.Prefix, err)
+	}
+	key.LastUsedAt = &now
+
+	return user, key, nil
+}
+
+// generateAPIKey генерирует новый API-ключ и возвращает его вместе с публичным префиксом
+func generateAPIKey() (string, string, error) {
+	id := make([]byte, apiKeyIDSize)
+	if _, err := rand.Read(id); err != nil {
+		return "", "", fmt.Errorf("ошибка генерации API-ключа: %w", err)
+	}
+
+	secret := make([]byte, apiKeySecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", fmt.Errorf("ошибка генерации API-ключа: %w", err)
+	}
+
+	prefix := hex.EncodeToString(id)
+
+	return apiKeyPrefix + "_" + prefix + "_" + base64.RawURLEncoding.EncodeToString(secret), prefix, nil
+}
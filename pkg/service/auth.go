@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"gitlab.ozon.dev/gojhw1/pkg/logger"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+var (
+	// ErrInvalidCredentials - ошибка, возникающая при неверном имени пользователя или пароле
+	ErrInvalidCredentials = errors.New("неверные учетные данные")
+	// ErrInvalidToken - ошибка, возникающая при недействительном, отозванном или просроченном токене
+	ErrInvalidToken = errors.New("недействительный или просроченный токен")
+	// ErrUserNotActive - ошибка, возникающая при входе пользователя, не подтвержденного администратором
+	ErrUserNotActive = errors.New("учетная запись ожидает подтверждения администратором")
+)
+
+const (
+	tokenType        = "Bearer"
+	refreshTokenSize = 32
+)
+
+type authUserRepository interface {
+	GetByID(ctx context.Context, id int64) (model.User, error)
+	GetByUsername(ctx context.Context, username string) (model.User, error)
+}
+
+type refreshTokenRepository interface {
+	Create(ctx context.Context, token model.RefreshToken) error
+	Rotate(ctx context.Context, oldTokenHash string, newToken model.RefreshToken) (int64, error)
+	Revoke(ctx context.Context, tokenHash string) error
+}
+
+// AuthService - сервис аутентификации, выдающий подписанные access-токены и отзываемые refresh-токены
+type AuthService struct {
+	users      authUserRepository
+	guard      *LoginGuard
+	tokens     refreshTokenRepository
+	secret     []byte
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+}
+
+// NewAuthService создает сервис аутентификации.
+// Пароли проверяются через guard, который защищает вход от перебора.
+// Если секрет не задан, генерируется случайный: выданные токены перестанут действовать после перезапуска.
+func NewAuthService(users authUserRepository, guard *LoginGuard, tokens refreshTokenRepository, secret string, accessTTL, refreshTTL time.Duration) *AuthService {
+	key := []byte(secret)
+	if len(key) == 0 {
+		logger.Warn("Секрет для подписи токенов не задан, используется случайный")
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			logger.Fatalf("ошибка генерации секрета для подписи токенов: %v", err)
+		}
+	}
+
+	return &AuthService{
+		users:      users,
+		guard:      guard,
+		tokens:     tokens,
+		secret:     key,
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
+	}
+}
+
+// Login проверяет учетные данные и выдает новую пару токенов.
+// ip - адрес клиента, используется для учета неудачных попыток входа.
+func (s *AuthService) Login(ctx context.Context, username, password, ip string) (model.TokenPair, error) {
+	if err := s.guard.Authenticate(ctx, username, password, ip); err != nil {
+		return model.TokenPair{}, err
+	}
+
+	user, err := s.users.GetByUsername(ctx, username)
+	if err != nil {
+		return model.TokenPair{}, fmt.Errorf("ошибка получения пользователя: %w", err)
+	}
+
+	if user.Status != model.UserStatusActive {
+		return model.TokenPair{}, ErrUserNotActive
+	}
+
+	refreshToken, refreshRecord, err := s.newRefreshToken(user.ID)
+	if err != nil {
+		return model.TokenPair{}, err
+	}
+
+	if err = s.tokens.Create(ctx, refreshRecord); err != nil {
+		return model.TokenPair{}, err
+	}
+
+	return s.issuePair(user, refreshToken)
+}
+
+// Refresh обменивает действующий refresh-токен на новую пару токенов.
+// Использованный refresh-токен отзывается.
+func (s *AuthService) Refresh(ctx context.Context, refreshToken string) (model.TokenPair, error) {
+	newRefreshToken, newRecord, err := s.newRefreshToken(0)
+	if err != nil {
+		return model.TokenPair{}, err
+	}
+
+	userID, err := s.tokens.Rotate(ctx, hashToken(refreshToken), newRecord)
+	if err != nil {
+		return model.TokenPair{}, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return model.TokenPair{}, fmt.Errorf("ошибка получения пользователя: %w", err)
+	}
+
+	if user.Status != model.UserStatusActive {
+		return model.TokenPair{}, ErrUserNotActive
+	}
+
+	return s.issuePair(user, newRefreshToken)
+}
+
+// Logout отзывает refresh-токен
+func (s *AuthService) Logout(ctx context.Context, refreshToken string) error {
+	if err := s.tokens.Revoke(ctx, hashToken(refreshToken)); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	return nil
+}
+
+// Authenticate проверяет пароль пользователя с учетом блокировки после неудачных попыток.
+// Используется для Basic Auth в HTTP и gRPC.
+func (s *AuthService) Authenticate(ctx context.Context, username, password, ip string) error {
+	return s.guard.Authenticate(ctx, username, password, ip)
+}
+
+// Unlock снимает блокировку входа для пользователя и/или IP-адреса
+func (s *AuthService) Unlock(ctx context.Context, username, ip string) error {
+	return s.guard.Unlock(ctx, username, ip)
+}
+
+// ParseAccessToken проверяет подпись и срок действия access-токена и возвращает ID пользователя.
+// Токен ссылается на пользователя по ID, а не по имени, чтобы переименование не меняло его владельца.
+func (s *AuthService) ParseAccessToken(accessToken string) (int64, error) {
+	claims := &jwt.RegisteredClaims{}
+
+	_, err := jwt.ParseWithClaims(accessToken, claims, func(*jwt.Token) (any, error) {
+		return s.secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	userID, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil || userID <= 0 {
+		return 0, ErrInvalidToken
+	}
+
+	return userID, nil
+}
+
+// issuePair подписывает access-токен и собирает ответ с парой токенов
+func (s *AuthService) issuePair(user model.User, refreshToken string) (model.TokenPair, error) {
+	now := time.Now()
+
+	claims := jwt.RegisteredClaims{
+		Subject:   strconv.FormatInt(user.ID, 10),
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(s.accessTTL)),
+	}
+
+	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
+	if err != nil {
+		return model.TokenPair{}, fmt.Errorf("ошибка подписи access-токена: %w", err)
+	}
+
+	return model.TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    tokenType,
+		ExpiresIn:    int64(s.accessTTL.Seconds()),
+	}, nil
+}
+
+// newRefreshToken генерирует случайный refresh-токен и запись для его хранения
+func (s *AuthService) newRefreshToken(userID int64) (string, model.RefreshToken, error) {
+	raw := make([]byte, refreshTokenSize)
+	if _, err := rand.Read(raw); err != nil {
+		return "", model.RefreshToken{}, fmt.Errorf("ошибка генерации refresh-токена: %w", err)
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	return token, model.RefreshToken{
+		UserID:    userID,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(s.refreshTTL),
+	}, nil
+}
+
+// hashToken возвращает SHA-256 хеш refresh-токена или API-ключа для хранения в базе.
+// Оба содержат 256 бит случайных данных, поэтому медленный KDF здесь не нужен.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
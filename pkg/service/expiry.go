@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gitlab.ozon.dev/gojhw1/pkg/logger"
+	"gitlab.ozon.dev/gojhw1/pkg/metrics"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/repository"
+)
+
+// StartExpiry - периодически переводит заказы с истекшим сроком хранения в статус expired до завершения контекста.
+// Первая проверка выполняется сразу при запуске.
+func (s *OrderService) StartExpiry(ctx context.Context, interval time.Duration, batchSize int) {
+	logger.Infof("Запуск обработки заказов с истекшим сроком хранения с интервалом %s", interval)
+
+	expire := func() {
+		expired, err := s.ExpireOrders(ctx, time.Now(), batchSize)
+		if err != nil {
+			logger.Errorf("Ошибка обработки заказов с истекшим сроком хранения: %v", err)
+		}
+		if expired > 0 {
+			logger.Infof("Заказов с истекшим сроком хранения передано в очередь на возврат курьеру: %d", expired)
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	go func() {
+		expire()
+
+		for {
+			select {
+			case <-ticker.C:
+				expire()
+			case <-ctx.Done():
+				logger.Info("Обработка заказов с истекшим сроком хранения остановлена из-за завершения контекста")
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+// ExpireOrders - переводит в статус expired все принятые заказы, срок хранения которых истек к моменту now.
+// Заказы обрабатываются пачками по batchSize, каждая пачка записывается в своей транзакции.
+// Возвращает количество заказов, переведенных в статус expired.
+func (s *OrderService) ExpireOrders(ctx context.Context, now time.Time, batchSize int) (int, error) {
+	total := 0
+
+	for {
+		orders, err := s.repo.ListExpired(ctx, now, batchSize)
+		if err != nil {
+			return total, err
+		}
+		if len(orders) == 0 {
+			return total, nil
+		}
+
+		expired, err := s.expireBatch(ctx, orders, now)
+		if err != nil {
+			return total, err
+		}
+
+		for _, order := range expired {
+			if err := s.cache.DeleteOrder(ctx, order.ID); err != nil {
+				logger.Warnf("Ошибка удаления заказа %d из кэша после истечения срока хранения: %v", order.ID, err)
+			}
+
+			s.logger.LogOrderStatusChange(ctx, order.ID, string(model.StateAccepted), string(order.State))
+			metrics.OrdersExpired.Inc()
+		}
+		total += len(expired)
+
+		// Если ни один заказ пачки не удалось изменить, следующая выборка вернет те же заказы
+		if len(expired) == 0 || len(orders) < batchSize {
+			return total, nil
+		}
+	}
+}
+
+// expireBatch - записывает пачку заказов в статусе expired в одной транзакции.
+// Если часть заказов изменена параллельным запросом, остальные заказы записываются по одному,
+// а измененные пропускаются до следующей проверки. Возвращает записанные заказы.
+func (s *OrderService) expireBatch(ctx context.Context, orders []model.Order, now time.Time) ([]model.Order, error) {
+	expired := make([]model.Order, 0, len(orders))
+	transitions := make([]model.OrderStateTransition, 0, len(orders))
+
+	for _, order := range orders {
+		if err := checkTransition(order.State, model.StateExpired); err != nil {
+			logger.Warnf("Невозможно перевести заказ %d в статус %s: %v", order.ID, model.StateExpired, err)
+			continue
+		}
+
+		oldState := order.State
+
+		order.State = model.StateExpired
+		order.UpdatedAt = now
+
+		expired = append(expired, order)
+		transitions = append(transitions, newTransition(ctx, order.ID, &oldState, order.State, now))
+	}
+
+	if len(expired) == 0 {
+		return expired, nil
+	}
+
+	err := s.repo.UpdateStates(ctx, expired, transitions)
+	if err == nil {
+		return expired, nil
+	}
+	if !errors.Is(err, repository.ErrConcurrentModification) {
+		logger.Errorf("Ошибка записи заказов с истекшим сроком хранения в БД: %v", err)
+		return nil, err
+	}
+
+	updated := make([]model.Order, 0, len(expired))
+	for i, order := range expired {
+		if err := s.repo.UpdateState(ctx, order, transitions[i]); err != nil {
+			logger.Warnf("Заказ %d не переведен в статус %s: %v", order.ID, model.StateExpired, err)
+			_ = s.dropStaleOrder(ctx, order.ID, err)
+			continue
+		}
+		updated = append(updated, order)
+	}
+
+	return updated, nil
+}
+
+// ListCourierReturnsWithCursor - возвращает заказы ПВЗ вызывающего пользователя, которые нужно вернуть курьеру сегодня:
+// заказы с истекшим сроком хранения и принятые заказы, срок хранения которых истекает до конца дня
+func (s *OrderService) ListCourierReturnsWithCursor(ctx context.Context, cursorID int64, limit int) ([]model.Order, error) {
+	pickupPointID, err := scopePickupPoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	until := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, now.Location())
+
+	logger.Debugf("Запрос списка заказов для возврата курьеру: cursorID=%d, limit=%d, pickupPointID=%d, until=%v",
+		cursorID, limit, pickupPointID, until)
+
+	orders, err := s.repo.ListCourierReturnsWithCursor(ctx, cursorID, limit, pickupPointID, until)
+	if err != nil {
+		logger.Errorf("Ошибка получения списка заказов для возврата курьеру: %v", err)
+	} else {
+		logger.Debugf("Получено %d заказов для возврата курьеру", len(orders))
+	}
+
+	return orders, err
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+
+	"gitlab.ozon.dev/gojhw1/pkg/logger"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+// OrderExport - подготовленная выгрузка заказов. Передает в fn подходящие заказы по одному,
+// ошибка fn прерывает выгрузку и возвращается без изменений.
+type OrderExport func(fn func(order model.Order) error) error
+
+// ExportOrders - проверяет права вызывающего пользователя и подготавливает выгрузку заказов его ПВЗ.
+// Ошибки доступа возвращаются сразу, до чтения заказов, чтобы обработчик мог ответить ошибкой
+// до начала записи файла. Заказы читаются из БД по мере выгрузки и не загружаются в память целиком.
+func (s *OrderService) ExportOrders(ctx context.Context, filter model.OrderFilter) (OrderExport, error) {
+	pickupPointID, err := scopePickupPoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if pickupPointID > 0 {
+		filter.PickupPointID = pickupPointID
+	}
+
+	return func(fn func(order model.Order) error) error {
+		logger.Infof("Начинаем выгрузку заказов: customerID=%d, pickupPointID=%d, states=%v, search=%s",
+			filter.CustomerID, filter.PickupPointID, filter.States, filter.Search)
+
+		exported := 0
+		err := s.repo.Export(ctx, filter, func(order model.Order) error {
+			exported++
+			return fn(order)
+		})
+		if err != nil {
+			logger.Errorf("Ошибка выгрузки заказов после %d заказов: %v", exported, err)
+			return err
+		}
+
+		logger.Infof("Выгрузка заказов завершена, выгружено %d заказов", exported)
+		return nil
+	}, nil
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"gitlab.ozon.dev/gojhw1/pkg/logger"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/rbac"
+)
+
+var (
+	// ErrInvalidExtensionDays - ошибка при указании неположительного срока продления
+	ErrInvalidExtensionDays = errors.New("срок продления должен быть положительным числом дней")
+	// ErrExtensionNotAllowed - ошибка, возникающая при попытке продлить срок хранения заказа, который не ожидает выдачи
+	ErrExtensionNotAllowed = errors.New("продлить срок хранения можно только у заказа, ожидающего выдачи")
+	// ErrExtensionLimitExceeded - ошибка, возникающая когда срок хранения заказа продлевался максимальное число раз
+	ErrExtensionLimitExceeded = errors.New("превышено допустимое количество продлений срока хранения")
+	// ErrExtensionDaysExceeded - ошибка, возникающая когда суммарный срок продления превышает допустимый
+	ErrExtensionDaysExceeded = errors.New("превышен допустимый суммарный срок продления хранения")
+)
+
+// ExtensionPolicy задает правила продления срока хранения заказа.
+// Нулевое значение ограничения означает, что ограничения нет.
+type ExtensionPolicy struct {
+	MaxExtensions int     // сколько раз можно продлить срок хранения одного заказа
+	MaxDays       int     // на сколько дней суммарно можно продлить срок хранения одного заказа
+	FeePerDay     float64 // плата за день продления, добавляется к стоимости заказа
+}
+
+// ExtendStorage - продлевает срок хранения принятого заказа на days дней по правилам продления.
+// Плата за продление добавляется к стоимости заказа, продление записывается в журнал аудита.
+// Если version больше 0, заказ должен быть именно этой версии.
+func (s *OrderService) ExtendStorage(ctx context.Context, id, version int64, days int) (model.Order, model.OrderExtension, error) {
+	if days <= 0 {
+		return model.Order{}, model.OrderExtension{}, fmt.Errorf("%w: %d", ErrInvalidExtensionDays, days)
+	}
+
+	now := time.Now()
+
+	order, err := s.loadOrder(ctx, id)
+	if err != nil {
+		return model.Order{}, model.OrderExtension{}, fmt.Errorf("ошибка при продлении срока хранения заказа Id %d: %w", id, err)
+	}
+
+	if err := checkOrderScope(ctx, order); err != nil {
+		return model.Order{}, model.OrderExtension{}, err
+	}
+
+	order, err = s.checkVersion(ctx, order, version)
+	if err != nil {
+		return model.Order{}, model.OrderExtension{}, err
+	}
+
+	if order.State != model.StateAccepted {
+		logger.Errorf("Невозможно продлить срок хранения заказа %d в статусе %s", id, order.State)
+		return model.Order{}, model.OrderExtension{}, fmt.Errorf("%w: статус %s", ErrExtensionNotAllowed, order.State)
+	}
+	if now.After(order.DeadlineAt) {
+		logger.Errorf("Срок хранения заказа %d уже истек: %v (текущая дата: %v)", id, order.DeadlineAt, now)
+		return model.Order{}, model.OrderExtension{}, fmt.Errorf("%w: %v \n Текущая дата: %v", ErrStorageExpired, order.DeadlineAt, now)
+	}
+
+	extensions, err := s.repo.ListExtensions(ctx, id)
+	if err != nil {
+		logger.Errorf("Ошибка получения продлений заказа %d из БД: %v", id, err)
+		return model.Order{}, model.OrderExtension{}, err
+	}
+	if err := s.extension.check(extensions, days); err != nil {
+		logger.Errorf("Срок хранения заказа %d нельзя продлить на %d дн.: %v", id, days, err)
+		return model.Order{}, model.OrderExtension{}, err
+	}
+
+	extension := model.OrderExtension{
+		OrderID:            id,
+		Days:               days,
+		Fee:                s.extension.fee(days),
+		PreviousDeadlineAt: order.DeadlineAt,
+		DeadlineAt:         order.DeadlineAt.AddDate(0, 0, days),
+		ExtendedAt:         now,
+	}
+	if user, ok := rbac.UserFromContext(ctx); ok && user.ID > 0 {
+		extension.ExtendedBy = &user.ID
+	}
+
+	extended := order
+	extended.DeadlineAt = extension.DeadlineAt
+	extended.Cost += extension.Fee
+	extended.UpdatedAt = now
+
+	if err := s.repo.Extend(ctx, extended, extension); err != nil {
+		logger.Errorf("Ошибка обновления заказа %d в БД при продлении срока хранения: %v", id, err)
+		return model.Order{}, model.OrderExtension{}, s.dropStaleOrder(ctx, id, err)
+	}
+	extended.Version++
+
+	if err := s.cache.SetOrder(ctx, extended); err != nil {
+		logger.Warnf("Ошибка сохранения заказа %d в кэше после продления срока хранения: %v", id, err)
+		if err := s.cache.DeleteOrder(ctx, id); err != nil {
+			logger.Warnf("Ошибка удаления заказа %d из кэша: %v", id, err)
+		}
+	}
+
+	s.logger.Log(ctx, model.AuditLog{
+		Type:      model.AuditLogTypeOrderExtension,
+		Timestamp: now,
+		OrderID:   id,
+		Body: map[string]any{
+			"days":                 extension.Days,
+			"fee":                  extension.Fee,
+			"previous_deadline_at": extension.PreviousDeadlineAt,
+			"deadline_at":          extension.DeadlineAt,
+		},
+	})
+	logger.Infof("Срок хранения заказа %d продлен на %d дн. до %v", id, days, extension.DeadlineAt)
+
+	return extended, extension, nil
+}
+
+// check проверяет, что срок хранения можно продлить еще на days дней с учетом прошлых продлений
+func (p ExtensionPolicy) check(extensions []model.OrderExtension, days int) error {
+	if p.MaxExtensions > 0 && len(extensions) >= p.MaxExtensions {
+		return fmt.Errorf("%w: не больше %d", ErrExtensionLimitExceeded, p.MaxExtensions)
+	}
+
+	total := days
+	for _, extension := range extensions {
+		total += extension.Days
+	}
+	if p.MaxDays > 0 && total > p.MaxDays {
+		return fmt.Errorf("%w: не больше %d дн., запрошено всего %d дн.", ErrExtensionDaysExceeded, p.MaxDays, total)
+	}
+
+	return nil
+}
+
+// fee возвращает плату за продление на days дней, округленную до копеек
+func (p ExtensionPolicy) fee(days int) float64 {
+	return math.Round(p.FeePerDay*float64(days)*100) / 100
+}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"errors"
+	"fmt"
+	"slices"
 
 	"gitlab.ozon.dev/gojhw1/pkg/model"
 )
@@ -49,3 +51,49 @@ func (f *defaultPackagerFactory) createPackager(baseType *model.PackageType, wra
 
 	return basePackager, nil
 }
+
+// containerTypes - упаковки-контейнеры от меньшей к большей
+var containerTypes = []model.PackageType{model.PackageBag, model.PackageBox}
+
+// fitPackageType - подбирает для заказа весом weight наименьший контейнер, не больший текущего.
+// Пленка и заказ без упаковки не перекладываются.
+func fitPackageType(packageType *model.PackageType, weight float64) *model.PackageType {
+	if packageType == nil || !slices.Contains(containerTypes, *packageType) {
+		return packageType
+	}
+
+	factory := newPackagerFactory()
+	for _, candidate := range containerTypes {
+		packager, err := factory.createPackager(&candidate, nil)
+		if err != nil {
+			continue
+		}
+		if packager.validateWeight(weight) == nil {
+			return &candidate
+		}
+		if candidate == *packageType {
+			break
+		}
+	}
+
+	return packageType
+}
+
+// packagingCost - подбирает упаковщик по типу упаковки и обертке, проверяет по нему вес заказа
+// и возвращает стоимость упаковки. Заказ без упаковки за упаковку не платит.
+func packagingCost(packageType *model.PackageType, wrapper *model.WrapperType, weight float64) (float64, error) {
+	if packageType == nil {
+		return 0, nil
+	}
+
+	packager, err := newPackagerFactory().createPackager(packageType, wrapper)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка создания упаковщика: %w", err)
+	}
+
+	if err = packager.validateWeight(weight); err != nil {
+		return 0, fmt.Errorf("ошибка проверки веса для упаковки %s: %w", *packageType, err)
+	}
+
+	return packager.getAdditionalCost(), nil
+}
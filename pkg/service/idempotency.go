@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.ozon.dev/gojhw1/pkg/logger"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+var (
+	// ErrInvalidIdempotencyKey - ошибка, возникающая при пустом или слишком длинном ключе идемпотентности
+	ErrInvalidIdempotencyKey = errors.New("ключ идемпотентности должен быть непустой строкой не длиннее 255 символов")
+	// ErrIdempotencyKeyReused - ошибка, возникающая при повторном использовании ключа с другим запросом
+	ErrIdempotencyKeyReused = errors.New("ключ идемпотентности уже использован с другим запросом")
+	// ErrIdempotencyKeyInProgress - ошибка, возникающая, когда запрос с тем же ключом еще выполняется
+	ErrIdempotencyKeyInProgress = errors.New("запрос с этим ключом идемпотентности еще выполняется")
+)
+
+const maxIdempotencyKeyLength = 255
+
+type idempotencyRepository interface {
+	Reserve(ctx context.Context, record model.IdempotencyRecord) (model.IdempotencyRecord, bool, error)
+	Complete(ctx context.Context, username, key string, statusCode int, response []byte) error
+	Release(ctx context.Context, username, key string) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+// IdempotencyService - сервис ключей идемпотентности.
+// Повторный запрос с тем же ключом получает сохраненный ответ вместо повторного выполнения операции.
+type IdempotencyService struct {
+	repo idempotencyRepository
+	ttl  time.Duration
+}
+
+// NewIdempotencyService создает сервис ключей идемпотентности, ответы хранятся в течение ttl
+func NewIdempotencyService(repo idempotencyRepository, ttl time.Duration) *IdempotencyService {
+	return &IdempotencyService{
+		repo: repo,
+		ttl:  ttl,
+	}
+}
+
+// Begin - занимает ключ за запросом пользователя.
+// Если запрос с этим ключом уже выполнен, возвращает сохраненный ответ, иначе - nil,
+// и после выполнения запроса нужно вызвать Complete или Release.
+func (s *IdempotencyService) Begin(ctx context.Context, username, key string, request []byte) (*model.IdempotencyRecord, error) {
+	if key == "" || len(key) > maxIdempotencyKeyLength {
+		return nil, ErrInvalidIdempotencyKey
+	}
+
+	hash := sha256.Sum256(request)
+	now := time.Now()
+
+	record, reserved, err := s.repo.Reserve(ctx, model.IdempotencyRecord{
+		Username:    username,
+		Key:         key,
+		RequestHash: hex.EncodeToString(hash[:]),
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(s.ttl),
+	})
+	if err != nil {
+		logger.Errorf("Ошибка резервирования ключа идемпотентности %s пользователя %s: %v", key, username, err)
+		return nil, err
+	}
+
+	if reserved {
+		return nil, nil
+	}
+
+	if record.RequestHash != hex.EncodeToString(hash[:]) {
+		logger.Warnf("Ключ идемпотентности %s пользователя %s повторно использован с другим запросом", key, username)
+		return nil, fmt.Errorf("%w: %s", ErrIdempotencyKeyReused, key)
+	}
+
+	if record.StatusCode == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrIdempotencyKeyInProgress, key)
+	}
+
+	logger.Infof("Повтор запроса с ключом идемпотентности %s пользователя %s, возвращается сохраненный ответ", key, username)
+	return &record, nil
+}
+
+// Complete - сохраняет ответ на запрос, выполненный с ключом
+func (s *IdempotencyService) Complete(ctx context.Context, username, key string, statusCode int, response []byte) error {
+	if err := s.repo.Complete(ctx, username, key, statusCode, response); err != nil {
+		logger.Errorf("Ошибка сохранения ответа по ключу идемпотентности %s пользователя %s: %v", key, username, err)
+		return err
+	}
+
+	return nil
+}
+
+// Release - освобождает ключ запроса, завершившегося ошибкой, чтобы его можно было повторить
+func (s *IdempotencyService) Release(ctx context.Context, username, key string) error {
+	if err := s.repo.Release(ctx, username, key); err != nil {
+		logger.Errorf("Ошибка освобождения ключа идемпотентности %s пользователя %s: %v", key, username, err)
+		return err
+	}
+
+	return nil
+}
+
+// StartCleanup - периодически удаляет просроченные ключи до завершения контекста
+func (s *IdempotencyService) StartCleanup(ctx context.Context, interval time.Duration) {
+	logger.Infof("Запуск очистки ключей идемпотентности с интервалом %s", interval)
+
+	ticker := time.NewTicker(interval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				deleted, err := s.repo.DeleteExpired(ctx, time.Now())
+				if err != nil {
+					logger.Errorf("Ошибка очистки ключей идемпотентности: %v", err)
+				} else if deleted > 0 {
+					logger.Debugf("Удалено просроченных ключей идемпотентности: %d", deleted)
+				}
+			case <-ctx.Done():
+				logger.Info("Очистка ключей идемпотентности остановлена из-за завершения контекста")
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,302 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.ozon.dev/gojhw1/pkg/importer"
+	"gitlab.ozon.dev/gojhw1/pkg/logger"
+	"gitlab.ozon.dev/gojhw1/pkg/metrics"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/repository"
+)
+
+// ImportOrders - принимает заказы из файла. Формат файла берется из options.Format,
+// а если он не указан - определяется по имени файла и типу содержимого.
+// По умолчанию каждая запись обрабатывается отдельно: ошибка в одной записи не отменяет прием остальных
+// и попадает в отчет вместе с кодом ошибки. При options.Bulk заказы принимаются одной транзакцией
+// или не принимается ни один. При options.DryRun записи только проверяются, заказы не создаются.
+// Ошибка возвращается, только если файл не удалось разобрать или пакетная запись в БД не удалась.
+func (s *OrderService) ImportOrders(ctx context.Context, file model.ImportFile, options model.ImportOptions) (model.ImportResult, error) {
+	logger.Infof("Начинаем импорт заказов из файла %s (пробный: %v, пакетный: %v)", file.Name, options.DryRun, options.Bulk)
+
+	format, orders, err := s.parseImportFile(file, options.Format)
+	if err != nil {
+		logger.Errorf("Ошибка чтения заказов из файла %s: %v", file.Name, err)
+		return model.ImportResult{}, err
+	}
+
+	logger.Infof("Успешно прочитано %d заказов из файла формата %s", len(orders), format)
+
+	result, err := s.ImportRecords(ctx, orders, options)
+	if err != nil {
+		return model.ImportResult{}, err
+	}
+	result.Format = format
+
+	return result, nil
+}
+
+// ImportRecords - принимает заказы, уже прочитанные из файла или полученные потоком.
+// Записи обрабатываются так же, как записи файла в ImportOrders.
+func (s *OrderService) ImportRecords(ctx context.Context, orders []importer.Record, options model.ImportOptions) (model.ImportResult, error) {
+	var (
+		result model.ImportResult
+		err    error
+	)
+	if options.Bulk && !options.DryRun {
+		result, err = s.importOrdersBulk(ctx, orders, 0)
+	} else {
+		result, err = s.importOrders(ctx, orders, 0, 0, options.DryRun, nil)
+	}
+	if err != nil {
+		logger.Errorf("Ошибка импорта заказов: %v", err)
+		return model.ImportResult{}, err
+	}
+	result.Bulk = options.Bulk
+
+	logger.Infof("Импорт заказов завершен: успешно %d, с ошибками %d", result.Succeeded, result.Failed)
+	return result, nil
+}
+
+// importProgress - вызывается после обработки каждой записи файла импорта.
+// Ошибка прерывает импорт, уже принятые заказы остаются принятыми.
+type importProgress func(row model.ImportRowResult) error
+
+// importOrders - обрабатывает записи файла по одной, ошибки записей попадают в отчет.
+// Записи до start пропускаются, так задача импорта jobID продолжает прерванный импорт.
+func (s *OrderService) importOrders(ctx context.Context, orders []importer.Record, jobID int64, start int, dryRun bool, progress importProgress) (model.ImportResult, error) {
+	result := model.ImportResult{
+		DryRun: dryRun,
+		Total:  len(orders),
+		Rows:   make([]model.ImportRowResult, 0, len(orders)),
+	}
+	seen := make(map[int64]struct{}, len(orders))
+	for _, order := range orders[:start] {
+		seen[order.ID] = struct{}{}
+	}
+
+	for i := start; i < len(orders); i++ {
+		order := orders[i]
+		row := model.ImportRowResult{
+			Row:     i + 1,
+			OrderID: order.ID,
+			Status:  model.ImportRowAccepted,
+		}
+		if dryRun {
+			row.Status = model.ImportRowValid
+		}
+
+		if err := s.importOrder(ctx, order, seen, jobID, dryRun); err != nil {
+			logger.Errorf("Ошибка принятия заказа %d из файла (запись %d): %v", order.ID, row.Row, err)
+			row.Status = model.ImportRowFailed
+			row.Code = importErrorCode(err)
+			row.Error = err.Error()
+			result.Failed++
+		} else {
+			result.Succeeded++
+		}
+
+		result.Rows = append(result.Rows, row)
+
+		if progress != nil {
+			if err := progress(row); err != nil {
+				return result, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// importOrder - проверяет запись файла импорта и, если это не пробный импорт, принимает заказ.
+// Заказ, который уже принят задачей импорта jobID, считается принятым.
+func (s *OrderService) importOrder(ctx context.Context, order importer.Record, seen map[int64]struct{}, jobID int64, dryRun bool) error {
+	if _, ok := seen[order.ID]; ok {
+		return fmt.Errorf("%w: %d", ErrDuplicateOrderID, order.ID)
+	}
+	seen[order.ID] = struct{}{}
+
+	deadline, err := parseDeadline(order.DeadlineAt)
+	if err != nil {
+		return err
+	}
+
+	packageType, wrapper := processPackaging(order.PackageType, order.Wrapper)
+
+	logger.Debugf("Обработка заказа из файла: ID=%d, CustomerID=%d, Weight=%v, Cost=%v",
+		order.ID, order.CustomerID, order.Weight, order.Cost)
+
+	now := time.Now()
+	accepted, err := s.prepareAcceptance(ctx, order.ID, order.CustomerID, order.PickupPointID, deadline,
+		order.Weight, order.Cost, packageType, wrapper, now)
+	if err == nil && !dryRun {
+		accepted.PaymentMode = model.PaymentModePrepaid
+		if jobID > 0 {
+			accepted.ImportJobID = &jobID
+		}
+		err = s.acceptOrder(ctx, accepted, now)
+	}
+
+	if err != nil && jobID > 0 && !dryRun {
+		// Задача, продолжаемая после сбоя, могла принять заказ до того, как сохранила ход
+		imported, checkErr := s.repo.ImportedByJob(ctx, order.ID, jobID)
+		if checkErr != nil {
+			logger.Warnf("Ошибка проверки задачи импорта заказа %d: %v", order.ID, checkErr)
+		}
+		if imported {
+			logger.Infof("Заказ %d уже принят задачей импорта %d до ее прерывания", order.ID, jobID)
+			return nil
+		}
+	}
+
+	return err
+}
+
+// importOrdersBulk - проверяет все записи файла и принимает заказы одной транзакцией.
+// Если хотя бы одна запись некорректна, в БД ничего не записывается, а корректные записи
+// помечаются как пропущенные. Кэш, аудит и метрики обновляются только после записи всех заказов.
+// Заказы помечаются задачей импорта jobID: если задача уже записала их до прерывания, они не записываются повторно.
+func (s *OrderService) importOrdersBulk(ctx context.Context, rows []importer.Record, jobID int64) (model.ImportResult, error) {
+	now := time.Now()
+	result := model.ImportResult{
+		Total: len(rows),
+		Rows:  make([]model.ImportRowResult, 0, len(rows)),
+	}
+
+	if jobID > 0 && len(rows) > 0 {
+		// Заказы записываются все вместе, поэтому наличие первого заказа задачи означает, что записаны все
+		imported, err := s.repo.ImportedByJob(ctx, rows[0].ID, jobID)
+		if err != nil {
+			return model.ImportResult{}, err
+		}
+		if imported {
+			logger.Infof("Заказы задачи импорта %d уже записаны до ее прерывания", jobID)
+			for i, row := range rows {
+				result.Rows = append(result.Rows, model.ImportRowResult{Row: i + 1, OrderID: row.ID, Status: model.ImportRowAccepted})
+			}
+			result.Succeeded = len(rows)
+			return result, nil
+		}
+	}
+	orders := make([]model.Order, 0, len(rows))
+	transitions := make([]model.OrderStateTransition, 0, len(rows))
+	seen := make(map[int64]struct{}, len(rows))
+
+	for i, row := range rows {
+		rowResult := model.ImportRowResult{
+			Row:     i + 1,
+			OrderID: row.ID,
+			Status:  model.ImportRowAccepted,
+		}
+
+		order, err := newImportedOrder(ctx, row, seen, now)
+		if err != nil {
+			rowResult.Status = model.ImportRowFailed
+			rowResult.Code = importErrorCode(err)
+			rowResult.Error = err.Error()
+			result.Failed++
+		} else {
+			if jobID > 0 {
+				order.ImportJobID = &jobID
+			}
+			orders = append(orders, order)
+			transitions = append(transitions, newTransition(ctx, order.ID, nil, order.State, now))
+		}
+
+		result.Rows = append(result.Rows, rowResult)
+	}
+
+	if result.Failed > 0 {
+		logger.Errorf("Пакетный импорт отменен: %d из %d записей содержат ошибки", result.Failed, result.Total)
+		for i := range result.Rows {
+			if result.Rows[i].Status == model.ImportRowAccepted {
+				result.Rows[i].Status = model.ImportRowSkipped
+			}
+		}
+		return result, nil
+	}
+
+	created, err := s.repo.CreateBatch(ctx, orders, transitions)
+	if err != nil {
+		return model.ImportResult{}, err
+	}
+
+	logs := make([]model.AuditLog, 0, len(created))
+	for _, order := range created {
+		if err := s.cache.SetOrder(ctx, order); err != nil {
+			logger.Warnf("Ошибка сохранения заказа %d в кэше после пакетного импорта: %v", order.ID, err)
+		}
+
+		logs = append(logs, model.AuditLog{
+			Timestamp: now,
+			Type:      model.AuditLogTypeOrderStatus,
+			OrderID:   order.ID,
+			OldStatus: "none",
+			NewStatus: string(order.State),
+		})
+	}
+	s.logger.LogBatch(ctx, logs)
+
+	metrics.OrdersAccepted.Add(float64(len(created)))
+
+	result.Succeeded = len(created)
+	return result, nil
+}
+
+// newImportedOrder - проверяет запись файла пакетного импорта без обращения к БД.
+// Существование заказов проверяется при записи сразу для всего файла.
+func newImportedOrder(ctx context.Context, row importer.Record, seen map[int64]struct{}, now time.Time) (model.Order, error) {
+	if _, ok := seen[row.ID]; ok {
+		return model.Order{}, fmt.Errorf("%w: %d", ErrDuplicateOrderID, row.ID)
+	}
+	seen[row.ID] = struct{}{}
+
+	deadline, err := parseDeadline(row.DeadlineAt)
+	if err != nil {
+		return model.Order{}, err
+	}
+
+	packageType, wrapper := processPackaging(row.PackageType, row.Wrapper)
+
+	return newAcceptedOrder(ctx, row.ID, row.CustomerID, row.PickupPointID, deadline,
+		row.Weight, row.Cost, packageType, wrapper, now)
+}
+
+// importErrorCode - возвращает машиночитаемый код ошибки записи файла импорта
+func importErrorCode(err error) string {
+	switch {
+	case errors.Is(err, ErrInvalidOrderID):
+		return "invalid_order_id"
+	case errors.Is(err, repository.ErrInvalidCustomerID):
+		return "invalid_customer_id"
+	case errors.Is(err, ErrDuplicateOrderID):
+		return "duplicate_order_id"
+	case errors.Is(err, ErrOrderExists), errors.Is(err, repository.ErrOrderAlreadyExists):
+		return "order_exists"
+	case errors.Is(err, ErrInvalidDateFormat):
+		return "invalid_deadline"
+	case errors.Is(err, ErrStorageDeadlinePassed):
+		return "deadline_passed"
+	case errors.Is(err, ErrNegativeWeight):
+		return "invalid_weight"
+	case errors.Is(err, ErrNegativeCost):
+		return "invalid_cost"
+	case errors.Is(err, ErrUnknownPackageType), errors.Is(err, ErrUnknownWrapperType):
+		return "invalid_packaging"
+	case errors.Is(err, ErrPackageWeightExceeded):
+		return "package_weight_exceeded"
+	case errors.Is(err, ErrPickupPointRequired), errors.Is(err, ErrPickupPointNotAssigned):
+		return "pickup_point_required"
+	case errors.Is(err, ErrForeignPickupPoint):
+		return "foreign_pickup_point"
+	case errors.Is(err, repository.ErrPickupPointNotFound):
+		return "pickup_point_not_found"
+	case errors.Is(err, repository.ErrNoFreeStorageCell):
+		return "no_free_storage_cell"
+	default:
+		return "internal_error"
+	}
+}
@@ -0,0 +1,325 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"gitlab.ozon.dev/gojhw1/pkg/logger"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/rbac"
+	"gitlab.ozon.dev/gojhw1/pkg/repository"
+)
+
+// ErrImportJobUserRequired - ошибка, возникающая при создании задачи импорта без пользователя в контексте
+var ErrImportJobUserRequired = errors.New("задачу импорта может создать только аутентифицированный пользователь")
+
+const (
+	// importProgressFlushRows - через сколько обработанных записей сохраняется ход задачи
+	importProgressFlushRows = 100
+	// importProgressFlushInterval - как часто сохраняется ход задачи при медленной обработке записей
+	importProgressFlushInterval = time.Second
+	// maxImportFilenameLength - максимальная длина имени файла задачи
+	maxImportFilenameLength = 255
+)
+
+type importJobRepository interface {
+	Create(ctx context.Context, job model.ImportJob) (model.ImportJob, error)
+	GetByID(ctx context.Context, id int64) (model.ImportJob, error)
+	ClaimNext(ctx context.Context, now time.Time) (model.ImportJob, bool, error)
+	UpdateProgress(ctx context.Context, job model.ImportJob) error
+	Touch(ctx context.Context, id int64, now time.Time) error
+	Finish(ctx context.Context, job model.ImportJob) error
+	RequeueStale(ctx context.Context, before time.Time) (int64, error)
+}
+
+type importJobUserRepository interface {
+	GetByID(ctx context.Context, id int64) (model.User, error)
+}
+
+// ImportJobService - сервис фоновых задач импорта заказов из файла.
+// Файл сохраняется в БД вместе с задачей, а записи обрабатываются фоновыми обработчиками
+// от имени загрузившего файл пользователя.
+type ImportJobService struct {
+	repo       importJobRepository
+	users      importJobUserRepository
+	orders     *OrderService
+	staleAfter time.Duration
+}
+
+// NewImportJobService создает сервис задач импорта.
+// Задача, ход которой не обновлялся дольше staleAfter, считается прерванной и возвращается в очередь.
+func NewImportJobService(repo importJobRepository, users importJobUserRepository, orders *OrderService, staleAfter time.Duration) *ImportJobService {
+	return &ImportJobService{
+		repo:       repo,
+		users:      users,
+		orders:     orders,
+		staleAfter: staleAfter,
+	}
+}
+
+// Submit - определяет формат файла, проверяет, что файл читается, и ставит его импорт в очередь
+func (s *ImportJobService) Submit(ctx context.Context, file model.ImportFile, options model.ImportOptions) (model.ImportJob, error) {
+	user, ok := rbac.UserFromContext(ctx)
+	if !ok {
+		return model.ImportJob{}, ErrImportJobUserRequired
+	}
+
+	format, orders, err := s.orders.parseImportFile(file, options.Format)
+	if err != nil {
+		logger.Errorf("Ошибка чтения заказов из файла %s: %v", file.Name, err)
+		return model.ImportJob{}, err
+	}
+
+	job, err := s.repo.Create(ctx, model.ImportJob{
+		UserID:    user.ID,
+		Filename:  importJobFilename(file.Name),
+		Format:    format,
+		Status:    model.ImportJobPending,
+		DryRun:    options.DryRun,
+		Bulk:      options.Bulk,
+		Content:   file.Content,
+		Total:     len(orders),
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		logger.Errorf("Ошибка создания задачи импорта файла %s: %v", file.Name, err)
+		return model.ImportJob{}, err
+	}
+
+	logger.Infof("Создана задача импорта %d: файл %s, записей %d, пользователь %s", job.ID, job.Filename, job.Total, user.Username)
+	return job, nil
+}
+
+// Get - возвращает задачу импорта. Сотрудник видит только свои задачи, администратор - все.
+func (s *ImportJobService) Get(ctx context.Context, id int64) (model.ImportJob, error) {
+	job, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return model.ImportJob{}, err
+	}
+
+	if user, ok := rbac.UserFromContext(ctx); ok && user.Role != model.RoleAdmin && user.ID != job.UserID {
+		return model.ImportJob{}, fmt.Errorf("%w: %d", repository.ErrImportJobNotFound, id)
+	}
+
+	return job, nil
+}
+
+// Start - запускает обработчики задач импорта, которые опрашивают очередь до завершения контекста
+func (s *ImportJobService) Start(ctx context.Context, workers int, pollInterval time.Duration) {
+	logger.Infof("Запуск обработчиков задач импорта: %d, интервал опроса %s", workers, pollInterval)
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			ticker := time.NewTicker(pollInterval)
+			defer ticker.Stop()
+
+			for {
+				s.processPending(ctx)
+
+				select {
+				case <-ticker.C:
+				case <-ctx.Done():
+					logger.Info("Обработчик задач импорта остановлен из-за завершения контекста")
+					return
+				}
+			}
+		}()
+	}
+}
+
+// processPending - возвращает в очередь прерванные задачи и обрабатывает ожидающие, пока они есть
+func (s *ImportJobService) processPending(ctx context.Context) {
+	requeued, err := s.repo.RequeueStale(ctx, time.Now().Add(-s.staleAfter))
+	if err != nil {
+		logger.Errorf("Ошибка возврата прерванных задач импорта в очередь: %v", err)
+	} else if requeued > 0 {
+		logger.Warnf("Возвращено в очередь прерванных задач импорта: %d", requeued)
+	}
+
+	for ctx.Err() == nil {
+		job, ok, err := s.repo.ClaimNext(ctx, time.Now())
+		if err != nil {
+			logger.Errorf("Ошибка получения задачи импорта из очереди: %v", err)
+			return
+		}
+		if !ok {
+			return
+		}
+
+		s.run(ctx, job)
+	}
+}
+
+// run - обрабатывает задачу импорта. При завершении ctx ход задачи сохраняется, и она возвращается в очередь.
+func (s *ImportJobService) run(ctx context.Context, job model.ImportJob) {
+	logger.Infof("Начата обработка задачи импорта %d: обработано %d из %d", job.ID, job.Processed, job.Total)
+
+	// Запись, начатая до остановки сервиса, дописывается, поэтому обращения к БД не зависят от ctx
+	jobCtx := context.WithoutCancel(ctx)
+
+	stopHeartbeat := s.heartbeat(jobCtx, job.ID)
+	defer stopHeartbeat()
+
+	user, err := s.users.GetByID(jobCtx, job.UserID)
+	if err != nil {
+		s.fail(jobCtx, job, err)
+		return
+	}
+	if err = rbac.Authorize(user, rbac.Rule{Permission: rbac.PermOrdersAccept}); err != nil {
+		s.fail(jobCtx, job, err)
+		return
+	}
+	jobCtx = rbac.ContextWithUser(jobCtx, user)
+
+	_, orders, err := s.orders.parseImportFile(model.ImportFile{Name: job.Filename, Content: job.Content}, job.Format)
+	if err != nil {
+		s.fail(jobCtx, job, err)
+		return
+	}
+
+	if job.Bulk && !job.DryRun {
+		result, err := s.orders.importOrdersBulk(jobCtx, orders, job.ID)
+		if err != nil {
+			s.fail(jobCtx, job, err)
+			return
+		}
+
+		job.Processed = result.Total
+		job.Succeeded = result.Succeeded
+		job.Failed = result.Failed
+		job.Errors = failedImportRows(result.Rows)
+		s.finish(jobCtx, job)
+		return
+	}
+
+	lastFlush := time.Now()
+	_, err = s.orders.importOrders(jobCtx, orders, job.ID, job.Processed, job.DryRun, func(row model.ImportRowResult) error {
+		job.Processed++
+		if row.Status == model.ImportRowFailed {
+			job.Failed++
+			job.Errors = append(job.Errors, row)
+		} else {
+			job.Succeeded++
+		}
+
+		if job.Processed%importProgressFlushRows == 0 || time.Since(lastFlush) >= importProgressFlushInterval {
+			lastFlush = time.Now()
+			job.UpdatedAt = lastFlush
+			if err := s.repo.UpdateProgress(jobCtx, job); err != nil {
+				logger.Warnf("Ошибка сохранения хода задачи импорта %d: %v", job.ID, err)
+			}
+		}
+
+		return ctx.Err()
+	})
+	if err != nil {
+		job.Status = model.ImportJobPending
+		job.UpdatedAt = time.Now()
+		if err = s.repo.UpdateProgress(jobCtx, job); err != nil {
+			logger.Errorf("Ошибка возврата задачи импорта %d в очередь: %v", job.ID, err)
+			return
+		}
+
+		logger.Infof("Задача импорта %d прервана на записи %d из %d и возвращена в очередь", job.ID, job.Processed, job.Total)
+		return
+	}
+
+	s.finish(jobCtx, job)
+}
+
+// heartbeat - периодически отмечает, что задача обрабатывается, чтобы долгая обработка
+// (например, пакетного импорта, который не сохраняет ход) не возвращала ее в очередь.
+// Возвращает функцию, останавливающую отметки.
+func (s *ImportJobService) heartbeat(ctx context.Context, jobID int64) func() {
+	interval := s.staleAfter / 3
+	if interval <= 0 {
+		return func() {}
+	}
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := s.repo.Touch(ctx, jobID, time.Now()); err != nil {
+					logger.Warnf("Ошибка отметки обработки задачи импорта %d: %v", jobID, err)
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		wg.Wait()
+	}
+}
+
+// finish - сохраняет результат успешно обработанной задачи
+func (s *ImportJobService) finish(ctx context.Context, job model.ImportJob) {
+	now := time.Now()
+	job.Status = model.ImportJobCompleted
+	job.FinishedAt = &now
+
+	if err := s.repo.Finish(ctx, job); err != nil {
+		logger.Errorf("Ошибка сохранения результата задачи импорта %d: %v", job.ID, err)
+		return
+	}
+
+	logger.Infof("Задача импорта %d завершена: успешно %d, с ошибками %d", job.ID, job.Succeeded, job.Failed)
+}
+
+// fail - завершает задачу с ошибкой
+func (s *ImportJobService) fail(ctx context.Context, job model.ImportJob, cause error) {
+	now := time.Now()
+	job.Status = model.ImportJobFailed
+	job.Error = cause.Error()
+	job.FinishedAt = &now
+
+	if err := s.repo.Finish(ctx, job); err != nil {
+		logger.Errorf("Ошибка сохранения результата задачи импорта %d: %v", job.ID, err)
+		return
+	}
+
+	logger.Errorf("Задача импорта %d завершилась ошибкой: %v", job.ID, cause)
+}
+
+// failedImportRows - возвращает записи отчета об импорте, которые не удалось принять
+func failedImportRows(rows []model.ImportRowResult) []model.ImportRowResult {
+	failed := make([]model.ImportRowResult, 0)
+	for _, row := range rows {
+		if row.Status == model.ImportRowFailed {
+			failed = append(failed, row)
+		}
+	}
+
+	return failed
+}
+
+// importJobFilename - оставляет от имени загруженного файла только имя без пути
+func importJobFilename(filename string) string {
+	filename = filepath.Base(filename)
+	if filename == "." || filename == string(filepath.Separator) {
+		filename = "orders.json"
+	}
+
+	runes := []rune(filename)
+	if len(runes) > maxImportFilenameLength {
+		filename = string(runes[:maxImportFilenameLength])
+	}
+
+	return filename
+}
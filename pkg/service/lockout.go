@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.ozon.dev/gojhw1/pkg/logger"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+// ErrAccountLocked - ошибка, возникающая при попытке входа во время временной блокировки
+var ErrAccountLocked = errors.New("слишком много неудачных попыток входа, вход временно заблокирован")
+
+const (
+	usernameKeyPrefix = "user:"
+	ipKeyPrefix       = "ip:"
+)
+
+type passwordChecker interface {
+	CheckPassword(ctx context.Context, username, password string) bool
+}
+
+type attemptStore interface {
+	AddFailure(ctx context.Context, key string, window time.Duration) (int64, error)
+	Lock(ctx context.Context, key string, ttl time.Duration) error
+	LockTTL(ctx context.Context, key string) (time.Duration, error)
+	Reset(ctx context.Context, key string) error
+}
+
+type securityLogger interface {
+	Log(ctx context.Context, log model.AuditLog)
+}
+
+// LockoutPolicy задает пороги блокировки и задержки при неудачных попытках входа
+type LockoutPolicy struct {
+	MaxAttempts   int64         // неудачных попыток для одного пользователя до блокировки
+	IPMaxAttempts int64         // неудачных попыток с одного IP-адреса до блокировки
+	Window        time.Duration // окно подсчета неудачных попыток
+	LockDuration  time.Duration // длительность блокировки
+	BaseDelay     time.Duration // задержка после первой неудачной попытки, далее удваивается
+	MaxDelay      time.Duration // максимальная задержка ответа
+}
+
+// LoginGuard проверяет пароль с защитой от перебора: считает неудачные попытки
+// по имени пользователя и IP-адресу, замедляет ответы и временно блокирует вход
+type LoginGuard struct {
+	users  passwordChecker
+	store  attemptStore
+	audit  securityLogger
+	policy LockoutPolicy
+}
+
+// NewLoginGuard создает защиту входа от перебора паролей
+func NewLoginGuard(users passwordChecker, store attemptStore, audit securityLogger, policy LockoutPolicy) *LoginGuard {
+	return &LoginGuard{
+		users:  users,
+		store:  store,
+		audit:  audit,
+		policy: policy,
+	}
+}
+
+// Authenticate проверяет пароль пользователя.
+// Возвращает ErrAccountLocked, если вход для пользователя или IP-адреса заблокирован,
+// и ErrInvalidCredentials при неверных учетных данных.
+func (g *LoginGuard) Authenticate(ctx context.Context, username, password, ip string) error {
+	userKey := usernameKeyPrefix + username
+	ipKey := ipKeyPrefix + ip
+
+	keys := []string{userKey}
+	if ip != "" {
+		keys = append(keys, ipKey)
+	}
+
+	for _, key := range keys {
+		if err := g.checkLock(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	if g.users.CheckPassword(ctx, username, password) {
+		if err := g.store.Reset(ctx, userKey); err != nil {
+			logger.Errorf("ошибка сброса счетчика попыток входа для %s: %v", username, err)
+		}
+		return nil
+	}
+
+	userFailures := g.registerFailure(ctx, userKey, g.policy.MaxAttempts, username, ip)
+	if ip != "" {
+		g.registerFailure(ctx, ipKey, g.policy.IPMaxAttempts, username, ip)
+	}
+
+	if err := g.delay(ctx, userFailures); err != nil {
+		return err
+	}
+
+	return ErrInvalidCredentials
+}
+
+// Unlock снимает блокировку и сбрасывает счетчики неудачных попыток.
+// Пустое имя пользователя или IP-адрес пропускаются.
+func (g *LoginGuard) Unlock(ctx context.Context, username, ip string) error {
+	if username != "" {
+		if err := g.store.Reset(ctx, usernameKeyPrefix+username); err != nil {
+			return err
+		}
+	}
+
+	if ip != "" {
+		if err := g.store.Reset(ctx, ipKeyPrefix+ip); err != nil {
+			return err
+		}
+	}
+
+	g.audit.Log(ctx, model.AuditLog{
+		Type:      model.AuditLogTypeSecurity,
+		Timestamp: time.Now(),
+		IP:        ip,
+		Body: map[string]any{
+			"event":    "login_unlocked",
+			"username": username,
+		},
+	})
+
+	return nil
+}
+
+// checkLock возвращает ErrAccountLocked с оставшимся временем, если ключ заблокирован.
+// При недоступности хранилища вход не блокируется.
+func (g *LoginGuard) checkLock(ctx context.Context, key string) error {
+	ttl, err := g.store.LockTTL(ctx, key)
+	if err != nil {
+		logger.Errorf("ошибка проверки блокировки входа: %v", err)
+		return nil
+	}
+
+	if ttl > 0 {
+		return fmt.Errorf("%w: повторите через %s", ErrAccountLocked, ttl.Round(time.Second))
+	}
+
+	return nil
+}
+
+// registerFailure увеличивает счетчик неудачных попыток и блокирует ключ при достижении порога.
+// Возвращает число неудачных попыток в текущем окне.
+func (g *LoginGuard) registerFailure(ctx context.Context, key string, limit int64, username, ip string) int64 {
+	failures, err := g.store.AddFailure(ctx, key, g.policy.Window)
+	if err != nil {
+		logger.Errorf("ошибка учета неудачной попытки входа: %v", err)
+		return 0
+	}
+
+	if limit <= 0 || failures < limit {
+		return failures
+	}
+
+	if err = g.store.Lock(ctx, key, g.policy.LockDuration); err != nil {
+		logger.Errorf("ошибка блокировки входа: %v", err)
+		return failures
+	}
+
+	logger.Warnf("Вход заблокирован на %s после %d неудачных попыток: %s", g.policy.LockDuration, failures, key)
+
+	g.audit.Log(ctx, model.AuditLog{
+		Type:      model.AuditLogTypeSecurity,
+		Timestamp: time.Now(),
+		IP:        ip,
+		Body: map[string]any{
+			"event":           "login_locked",
+			"key":             key,
+			"username":        username,
+			"failed_attempts": failures,
+			"locked_until":    time.Now().Add(g.policy.LockDuration),
+		},
+	})
+
+	return failures
+}
+
+// failureDelay возвращает задержку ответа после failures неудачных попыток: BaseDelay,
+// удвоенный за каждую предыдущую неудачу, но не больше MaxDelay
+func (p LockoutPolicy) failureDelay(failures int64) time.Duration {
+	if p.BaseDelay <= 0 || failures <= 0 {
+		return 0
+	}
+
+	wait := p.BaseDelay
+	for i := int64(1); i < failures && wait < p.MaxDelay; i++ {
+		wait *= 2
+	}
+	if p.MaxDelay > 0 && wait > p.MaxDelay {
+		wait = p.MaxDelay
+	}
+
+	return wait
+}
+
+// delay замедляет ответ на неудачную попытку на failureDelay, прерываясь при отмене контекста
+func (g *LoginGuard) delay(ctx context.Context, failures int64) error {
+	wait := g.policy.failureDelay(failures)
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
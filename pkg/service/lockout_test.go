@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/gojhw1/pkg/cache"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"go.uber.org/mock/gomock"
+)
+
+const testPassword = "secret"
+
+type loginAttempt struct {
+	username    string
+	password    string
+	ip          string
+	expectedErr error
+}
+
+func TestLoginGuard_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	policy := LockoutPolicy{
+		MaxAttempts:   3,
+		IPMaxAttempts: 5,
+		Window:        time.Minute,
+		LockDuration:  15 * time.Minute,
+	}
+
+	tests := []struct {
+		name           string
+		policy         LockoutPolicy
+		attempts       []loginAttempt
+		expectedEvents []string
+	}{
+		{
+			name:   "account locked after max failures",
+			policy: policy,
+			attempts: []loginAttempt{
+				{username: "user", password: "wrong", ip: "10.0.0.1", expectedErr: ErrInvalidCredentials},
+				{username: "user", password: "wrong", ip: "10.0.0.1", expectedErr: ErrInvalidCredentials},
+				{username: "user", password: "wrong", ip: "10.0.0.1", expectedErr: ErrInvalidCredentials},
+				{username: "user", password: testPassword, ip: "10.0.0.1", expectedErr: ErrAccountLocked},
+				{username: "user", password: testPassword, ip: "10.0.0.2", expectedErr: ErrAccountLocked},
+				{username: "other", password: testPassword, ip: "10.0.0.1"},
+			},
+			expectedEvents: []string{"login_locked"},
+		},
+		{
+			name:   "no lockout below limit",
+			policy: policy,
+			attempts: []loginAttempt{
+				{username: "user", password: "wrong", ip: "10.0.0.1", expectedErr: ErrInvalidCredentials},
+				{username: "user", password: "wrong", ip: "10.0.0.1", expectedErr: ErrInvalidCredentials},
+				{username: "user", password: testPassword, ip: "10.0.0.1"},
+			},
+		},
+		{
+			name:   "success resets failure counter",
+			policy: policy,
+			attempts: []loginAttempt{
+				{username: "user", password: "wrong", ip: "10.0.0.1", expectedErr: ErrInvalidCredentials},
+				{username: "user", password: "wrong", ip: "10.0.0.1", expectedErr: ErrInvalidCredentials},
+				{username: "user", password: testPassword, ip: "10.0.0.1"},
+				{username: "user", password: "wrong", ip: "10.0.0.1", expectedErr: ErrInvalidCredentials},
+				{username: "user", password: "wrong", ip: "10.0.0.1", expectedErr: ErrInvalidCredentials},
+				{username: "user", password: testPassword, ip: "10.0.0.1"},
+			},
+		},
+		{
+			name: "ip locked after failures for different users",
+			policy: LockoutPolicy{
+				MaxAttempts:   3,
+				IPMaxAttempts: 3,
+				Window:        time.Minute,
+				LockDuration:  15 * time.Minute,
+			},
+			attempts: []loginAttempt{
+				{username: "first", password: "wrong", ip: "10.0.0.1", expectedErr: ErrInvalidCredentials},
+				{username: "second", password: "wrong", ip: "10.0.0.1", expectedErr: ErrInvalidCredentials},
+				{username: "third", password: "wrong", ip: "10.0.0.1", expectedErr: ErrInvalidCredentials},
+				{username: "fourth", password: testPassword, ip: "10.0.0.1", expectedErr: ErrAccountLocked},
+				{username: "fourth", password: testPassword, ip: "10.0.0.2"},
+			},
+			expectedEvents: []string{"login_locked"},
+		},
+		{
+			name:   "lockout disabled",
+			policy: LockoutPolicy{Window: time.Minute, LockDuration: 15 * time.Minute},
+			attempts: []loginAttempt{
+				{username: "user", password: "wrong", ip: "10.0.0.1", expectedErr: ErrInvalidCredentials},
+				{username: "user", password: "wrong", ip: "10.0.0.1", expectedErr: ErrInvalidCredentials},
+				{username: "user", password: "wrong", ip: "10.0.0.1", expectedErr: ErrInvalidCredentials},
+				{username: "user", password: "wrong", ip: "10.0.0.1", expectedErr: ErrInvalidCredentials},
+				{username: "user", password: testPassword, ip: "10.0.0.1"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockUsers := NewMockpasswordChecker(ctrl)
+			mockAudit := NewMocksecurityLogger(ctrl)
+
+			mockUsers.EXPECT().
+				CheckPassword(gomock.Any(), gomock.Any(), testPassword).
+				Return(true).
+				AnyTimes()
+
+			mockUsers.EXPECT().
+				CheckPassword(gomock.Any(), gomock.Any(), gomock.Not(testPassword)).
+				Return(false).
+				AnyTimes()
+
+			var events []string
+			mockAudit.EXPECT().
+				Log(gomock.Any(), gomock.Any()).
+				Do(func(_ context.Context, log model.AuditLog) {
+					events = append(events, log.Body.(map[string]any)["event"].(string))
+				}).
+				AnyTimes()
+
+			guard := NewLoginGuard(mockUsers, cache.NewInMemoryAttemptStore(), mockAudit, tt.policy)
+
+			for i, attempt := range tt.attempts {
+				err := guard.Authenticate(context.Background(), attempt.username, attempt.password, attempt.ip)
+				if attempt.expectedErr != nil {
+					assert.ErrorIs(t, err, attempt.expectedErr, "попытка %d", i+1)
+				} else {
+					assert.NoError(t, err, "попытка %d", i+1)
+				}
+			}
+
+			assert.Equal(t, tt.expectedEvents, events)
+		})
+	}
+}
+
+func TestLoginGuard_Unlock(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockUsers := NewMockpasswordChecker(ctrl)
+	mockAudit := NewMocksecurityLogger(ctrl)
+
+	mockUsers.EXPECT().
+		CheckPassword(gomock.Any(), "user", "wrong").
+		Return(false)
+
+	mockUsers.EXPECT().
+		CheckPassword(gomock.Any(), "user", testPassword).
+		Return(true)
+
+	mockAudit.EXPECT().
+		Log(gomock.Any(), gomock.Any()).
+		Times(2)
+
+	guard := NewLoginGuard(mockUsers, cache.NewInMemoryAttemptStore(), mockAudit, LockoutPolicy{
+		MaxAttempts:  1,
+		Window:       time.Minute,
+		LockDuration: 15 * time.Minute,
+	})
+
+	ctx := context.Background()
+	require.ErrorIs(t, guard.Authenticate(ctx, "user", "wrong", ""), ErrInvalidCredentials)
+	require.ErrorIs(t, guard.Authenticate(ctx, "user", testPassword, ""), ErrAccountLocked)
+
+	require.NoError(t, guard.Unlock(ctx, "user", ""))
+	assert.NoError(t, guard.Authenticate(ctx, "user", testPassword, ""))
+}
+
+func TestLoginGuard_AuthenticateDelayCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockUsers := NewMockpasswordChecker(ctrl)
+
+	mockUsers.EXPECT().
+		CheckPassword(gomock.Any(), "user", "wrong").
+		Return(false)
+
+	guard := NewLoginGuard(mockUsers, cache.NewInMemoryAttemptStore(), NewMocksecurityLogger(ctrl), LockoutPolicy{
+		MaxAttempts: 5,
+		Window:      time.Minute,
+		BaseDelay:   time.Hour,
+		MaxDelay:    time.Hour,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := guard.Authenticate(ctx, "user", "wrong", "10.0.0.1")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestLockoutPolicy_FailureDelay(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		policy        LockoutPolicy
+		failures      int64
+		expectedDelay time.Duration
+	}{
+		{
+			name:          "no failures",
+			policy:        LockoutPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second},
+			failures:      0,
+			expectedDelay: 0,
+		},
+		{
+			name:          "first failure",
+			policy:        LockoutPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second},
+			failures:      1,
+			expectedDelay: 100 * time.Millisecond,
+		},
+		{
+			name:          "delay doubles with each failure",
+			policy:        LockoutPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second},
+			failures:      3,
+			expectedDelay: 400 * time.Millisecond,
+		},
+		{
+			name:          "delay capped by max delay",
+			policy:        LockoutPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second},
+			failures:      5,
+			expectedDelay: time.Second,
+		},
+		{
+			name:          "delay stays at max delay",
+			policy:        LockoutPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second},
+			failures:      50,
+			expectedDelay: time.Second,
+		},
+		{
+			name:          "delay disabled",
+			policy:        LockoutPolicy{MaxDelay: time.Second},
+			failures:      3,
+			expectedDelay: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expectedDelay, tt.policy.failureDelay(tt.failures))
+		})
+	}
+}
@@ -0,0 +1,3 @@
+package service
+
+//go:generate mockgen -typed -source=lockout.go -destination=mock_lockout_test.go -package=service
@@ -0,0 +1,319 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: lockout.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -source=lockout.go -destination=mock_lockout_test.go -package=service
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "gitlab.ozon.dev/gojhw1/pkg/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockpasswordChecker is a mock of passwordChecker interface.
+type MockpasswordChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockpasswordCheckerMockRecorder
+	isgomock struct{}
+}
+
+// MockpasswordCheckerMockRecorder is the mock recorder for MockpasswordChecker.
+type MockpasswordCheckerMockRecorder struct {
+	mock *MockpasswordChecker
+}
+
+// NewMockpasswordChecker creates a new mock instance.
+func NewMockpasswordChecker(ctrl *gomock.Controller) *MockpasswordChecker {
+	mock := &MockpasswordChecker{ctrl: ctrl}
+	mock.recorder = &MockpasswordCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpasswordChecker) EXPECT() *MockpasswordCheckerMockRecorder {
+	return m.recorder
+}
+
+// CheckPassword mocks base method.
+func (m *MockpasswordChecker) CheckPassword(ctx context.Context, username, password string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckPassword", ctx, username, password)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CheckPassword indicates an expected call of CheckPassword.
+func (mr *MockpasswordCheckerMockRecorder) CheckPassword(ctx, username, password any) *MockpasswordCheckerCheckPasswordCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPassword", reflect.TypeOf((*MockpasswordChecker)(nil).CheckPassword), ctx, username, password)
+	return &MockpasswordCheckerCheckPasswordCall{Call: call}
+}
+
+// MockpasswordCheckerCheckPasswordCall wrap *gomock.Call
+type MockpasswordCheckerCheckPasswordCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpasswordCheckerCheckPasswordCall) Return(arg0 bool) *MockpasswordCheckerCheckPasswordCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpasswordCheckerCheckPasswordCall) Do(f func(context.Context, string, string) bool) *MockpasswordCheckerCheckPasswordCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpasswordCheckerCheckPasswordCall) DoAndReturn(f func(context.Context, string, string) bool) *MockpasswordCheckerCheckPasswordCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockattemptStore is a mock of attemptStore interface.
+type MockattemptStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockattemptStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockattemptStoreMockRecorder is the mock recorder for MockattemptStore.
+type MockattemptStoreMockRecorder struct {
+	mock *MockattemptStore
+}
+
+// NewMockattemptStore creates a new mock instance.
+func NewMockattemptStore(ctrl *gomock.Controller) *MockattemptStore {
+	mock := &MockattemptStore{ctrl: ctrl}
+	mock.recorder = &MockattemptStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockattemptStore) EXPECT() *MockattemptStoreMockRecorder {
+	return m.recorder
+}
+
+// AddFailure mocks base method.
+func (m *MockattemptStore) AddFailure(ctx context.Context, key string, window time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFailure", ctx, key, window)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddFailure indicates an expected call of AddFailure.
+func (mr *MockattemptStoreMockRecorder) AddFailure(ctx, key, window any) *MockattemptStoreAddFailureCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFailure", reflect.TypeOf((*MockattemptStore)(nil).AddFailure), ctx, key, window)
+	return &MockattemptStoreAddFailureCall{Call: call}
+}
+
+// MockattemptStoreAddFailureCall wrap *gomock.Call
+type MockattemptStoreAddFailureCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockattemptStoreAddFailureCall) Return(arg0 int64, arg1 error) *MockattemptStoreAddFailureCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockattemptStoreAddFailureCall) Do(f func(context.Context, string, time.Duration) (int64, error)) *MockattemptStoreAddFailureCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockattemptStoreAddFailureCall) DoAndReturn(f func(context.Context, string, time.Duration) (int64, error)) *MockattemptStoreAddFailureCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Lock mocks base method.
+func (m *MockattemptStore) Lock(ctx context.Context, key string, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", ctx, key, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockattemptStoreMockRecorder) Lock(ctx, key, ttl any) *MockattemptStoreLockCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockattemptStore)(nil).Lock), ctx, key, ttl)
+	return &MockattemptStoreLockCall{Call: call}
+}
+
+// MockattemptStoreLockCall wrap *gomock.Call
+type MockattemptStoreLockCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockattemptStoreLockCall) Return(arg0 error) *MockattemptStoreLockCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockattemptStoreLockCall) Do(f func(context.Context, string, time.Duration) error) *MockattemptStoreLockCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockattemptStoreLockCall) DoAndReturn(f func(context.Context, string, time.Duration) error) *MockattemptStoreLockCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LockTTL mocks base method.
+func (m *MockattemptStore) LockTTL(ctx context.Context, key string) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockTTL", ctx, key)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockTTL indicates an expected call of LockTTL.
+func (mr *MockattemptStoreMockRecorder) LockTTL(ctx, key any) *MockattemptStoreLockTTLCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockTTL", reflect.TypeOf((*MockattemptStore)(nil).LockTTL), ctx, key)
+	return &MockattemptStoreLockTTLCall{Call: call}
+}
+
+// MockattemptStoreLockTTLCall wrap *gomock.Call
+type MockattemptStoreLockTTLCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockattemptStoreLockTTLCall) Return(arg0 time.Duration, arg1 error) *MockattemptStoreLockTTLCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockattemptStoreLockTTLCall) Do(f func(context.Context, string) (time.Duration, error)) *MockattemptStoreLockTTLCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockattemptStoreLockTTLCall) DoAndReturn(f func(context.Context, string) (time.Duration, error)) *MockattemptStoreLockTTLCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Reset mocks base method.
+func (m *MockattemptStore) Reset(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockattemptStoreMockRecorder) Reset(ctx, key any) *MockattemptStoreResetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockattemptStore)(nil).Reset), ctx, key)
+	return &MockattemptStoreResetCall{Call: call}
+}
+
+// MockattemptStoreResetCall wrap *gomock.Call
+type MockattemptStoreResetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockattemptStoreResetCall) Return(arg0 error) *MockattemptStoreResetCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockattemptStoreResetCall) Do(f func(context.Context, string) error) *MockattemptStoreResetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockattemptStoreResetCall) DoAndReturn(f func(context.Context, string) error) *MockattemptStoreResetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MocksecurityLogger is a mock of securityLogger interface.
+type MocksecurityLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecurityLoggerMockRecorder
+	isgomock struct{}
+}
+
+// MocksecurityLoggerMockRecorder is the mock recorder for MocksecurityLogger.
+type MocksecurityLoggerMockRecorder struct {
+	mock *MocksecurityLogger
+}
+
+// NewMocksecurityLogger creates a new mock instance.
+func NewMocksecurityLogger(ctrl *gomock.Controller) *MocksecurityLogger {
+	mock := &MocksecurityLogger{ctrl: ctrl}
+	mock.recorder = &MocksecurityLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecurityLogger) EXPECT() *MocksecurityLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MocksecurityLogger) Log(ctx context.Context, log model.AuditLog) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", ctx, log)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MocksecurityLoggerMockRecorder) Log(ctx, log any) *MocksecurityLoggerLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MocksecurityLogger)(nil).Log), ctx, log)
+	return &MocksecurityLoggerLogCall{Call: call}
+}
+
+// MocksecurityLoggerLogCall wrap *gomock.Call
+type MocksecurityLoggerLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocksecurityLoggerLogCall) Return() *MocksecurityLoggerLogCall {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocksecurityLoggerLogCall) Do(f func(context.Context, model.AuditLog)) *MocksecurityLoggerLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocksecurityLoggerLogCall) DoAndReturn(f func(context.Context, model.AuditLog)) *MocksecurityLoggerLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
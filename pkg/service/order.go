@@ -7,9 +7,11 @@ import (
 	"sort"
 	"time"
 
+	"gitlab.ozon.dev/gojhw1/pkg/importer"
 	"gitlab.ozon.dev/gojhw1/pkg/logger"
 	"gitlab.ozon.dev/gojhw1/pkg/metrics"
 	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/repository"
 )
 
 var (
@@ -45,23 +47,50 @@ var (
 	ErrNegativeCost = errors.New("стоимость должна быть положительным числом")
 	// ErrInvalidOrderID - ошибка при указании некорректного ID заказа
 	ErrInvalidOrderID = errors.New("недопустимый ID заказа")
+	// ErrOrderNotInCell - ошибка, возникающая когда заказ не размещен в ячейке хранения
+	ErrOrderNotInCell = errors.New("заказ не размещен в ячейке хранения")
+	// ErrDuplicateOrderID - ошибка, возникающая когда заказ указан в запросе несколько раз
+	ErrDuplicateOrderID = errors.New("заказ указан в запросе несколько раз")
+	// ErrVersionMismatch - ошибка, возникающая когда версия заказа не совпадает с ожидаемой клиентом
+	ErrVersionMismatch = errors.New("версия заказа не совпадает с ожидаемой")
 )
 
+// ReturnedAt - срок возврата заказа, если к нему не подходит ни одна политика возврата
 const ReturnedAt = 48 * time.Hour
 const timeLayout = "2006-01-02T15:04:05"
 
 type orderRepository interface {
-	Create(ctx context.Context, order model.Order) error
-	Update(ctx context.Context, order model.Order) error
-	Delete(ctx context.Context, id int64) error
+	Create(ctx context.Context, order model.Order, transition model.OrderStateTransition) (model.Order, error)
+	UpdateState(ctx context.Context, order model.Order, transition model.OrderStateTransition) error
+	UpdateStates(ctx context.Context, orders []model.Order, transitions []model.OrderStateTransition) error
+	CreateBatch(ctx context.Context, orders []model.Order, transitions []model.OrderStateTransition) ([]model.Order, error)
+	DeliverOrders(ctx context.Context, orders []model.Order, transitions []model.OrderStateTransition, payments []model.Payment) error
+	Delete(ctx context.Context, id, version int64) error
 	GetByID(ctx context.Context, id int64) (model.Order, error)
-	List(ctx context.Context, searchTerm string) ([]model.Order, error)
-	ListWithCursor(ctx context.Context, cursorID int64, limit int, customerID int64, filterPVZ bool, searchTerm string) ([]model.Order, error)
-	ListReturnsWithCursor(ctx context.Context, cursorID int64, limit int, searchTerm string) ([]model.Order, error)
+	ImportedByJob(ctx context.Context, orderID, jobID int64) (bool, error)
+	ListTransitions(ctx context.Context, orderID int64) ([]model.OrderStateTransition, error)
+	List(ctx context.Context, pickupPointID int64, searchTerm string) ([]model.Order, error)
+	ListWithCursor(ctx context.Context, cursorID int64, limit int, customerID, pickupPointID int64, filterPVZ bool, searchTerm string) ([]model.Order, error)
+	ListReturnsWithCursor(ctx context.Context, cursorID int64, limit int, pickupPointID int64, searchTerm string, filter model.ReturnFilter) ([]model.Order, error)
+	ReturnOrders(ctx context.Context, orders []model.Order, transitions []model.OrderStateTransition, returns []model.OrderReturn, refunds []model.Refund) error
+	ListOrderReturns(ctx context.Context, orderIDs []int64) ([]model.OrderReturn, error)
+	GetPayment(ctx context.Context, orderID int64) (model.Payment, error)
+	ListPayments(ctx context.Context, orderIDs []int64) ([]model.Payment, error)
+	ListRefunds(ctx context.Context, orderID int64) ([]model.Refund, error)
+	Export(ctx context.Context, filter model.OrderFilter, fn func(order model.Order) error) error
+	ListExpired(ctx context.Context, now time.Time, limit int) ([]model.Order, error)
+	ListCourierReturnsWithCursor(ctx context.Context, cursorID int64, limit int, pickupPointID int64, until time.Time) ([]model.Order, error)
+	Extend(ctx context.Context, order model.Order, extension model.OrderExtension) error
+	ListExtensions(ctx context.Context, orderID int64) ([]model.OrderExtension, error)
+}
+
+type storageCellRepository interface {
+	GetByID(ctx context.Context, id int64) (model.StorageCell, error)
 }
 
 type auditLogger interface {
 	Log(ctx context.Context, log model.AuditLog)
+	LogBatch(ctx context.Context, logs []model.AuditLog)
 	LogOrderStatusChange(ctx context.Context, orderID int64, oldStatus, newStatus string)
 }
 
@@ -73,97 +102,163 @@ type orderCache interface {
 	GetOrderHistory(ctx context.Context) ([]model.Order, error)
 }
 
+type importerRegistry interface {
+	Resolve(filename, contentType, format string) (string, error)
+	Parse(format string, content []byte) ([]importer.Record, error)
+}
+
 // OrderService - структура сервиса для работы с заказами
 type OrderService struct {
-	repo   orderRepository
-	logger auditLogger
-	cache  orderCache
+	repo      orderRepository
+	cells     storageCellRepository
+	logger    auditLogger
+	cache     orderCache
+	importers importerRegistry
+	extension ExtensionPolicy
+	policies  returnPolicyMatcher
+	terminal  paymentTerminal
 }
 
-// NewOrderService - создаёт новый сервис с переданным репозиторием
-func NewOrderService(repo orderRepository, logger auditLogger, cache orderCache) *OrderService {
+// NewOrderService - создаёт новый сервис с переданными репозиториями заказов и ячеек хранения.
+// Файлы с заказами читаются форматами из реестра importers, срок хранения продлевается по правилам extension,
+// возвраты принимаются по политикам из policies, оплата картой и возврат денег на карту проводятся через terminal.
+func NewOrderService(repo orderRepository, cells storageCellRepository, logger auditLogger, cache orderCache, importers importerRegistry, extension ExtensionPolicy, policies returnPolicyMatcher, terminal paymentTerminal) *OrderService {
 	return &OrderService{
-		repo:   repo,
-		logger: logger,
-		cache:  cache,
+		repo:      repo,
+		cells:     cells,
+		logger:    logger,
+		cache:     cache,
+		importers: importers,
+		extension: extension,
+		policies:  policies,
+		terminal:  terminal,
 	}
 }
 
-// AcceptOrder - принимает заказ, если он корректен и не просрочен
-func (s *OrderService) AcceptOrder(ctx context.Context, id, customerID int64, deadline time.Time, weight, cost float64, packageType *model.PackageType, wrapper *model.WrapperType) error {
+// AcceptOrder - принимает заказ в ПВЗ, если он корректен и не просрочен.
+// Если pickupPointID равен 0, заказ принимается в ПВЗ вызывающего пользователя.
+// Пустой paymentMode означает, что заказ оплачен при оформлении.
+func (s *OrderService) AcceptOrder(ctx context.Context, id, customerID, pickupPointID int64, deadline time.Time, weight, cost float64, packageType *model.PackageType, wrapper *model.WrapperType, paymentMode model.PaymentMode) error {
 	now := time.Now()
-	if id <= 0 {
-		logger.Errorf("Невалидный ID заказа: %d", id)
-		return fmt.Errorf("%w: %d", ErrInvalidOrderID, id)
-	}
-	if now.After(deadline) {
-		logger.Errorf("Срок хранения заказа %d уже истек: %v (текущая дата: %v)", id, deadline, now)
-		return fmt.Errorf("%w: %v \n Текущая дата: %v", ErrStorageDeadlinePassed, deadline, now)
-	}
-	if order, _ := s.repo.GetByID(ctx, id); order.ID == id {
-		logger.Errorf("Заказ с ID %d уже существует", id)
-		return fmt.Errorf("%w: Id %d", ErrOrderExists, id)
-	}
-	if weight <= 0 {
-		logger.Errorf("Недопустимый вес заказа %d: %v", id, weight)
-		return fmt.Errorf("%w: %v", ErrNegativeWeight, weight)
-	}
-	if cost <= 0 {
-		logger.Errorf("Недопустимая стоимость заказа %d: %v", id, cost)
-		return fmt.Errorf("%w: %v", ErrNegativeCost, cost)
-	}
-
-	finalCost := cost
 
-	if packageType != nil {
-		factory := newPackagerFactory()
-		packager, err := factory.createPackager(packageType, wrapper)
-		if err != nil {
-			logger.Errorf("Ошибка создания упаковщика для заказа %d: %v", id, err)
-			return fmt.Errorf("ошибка создания упаковщика: %w", err)
-		}
-
-		if err = packager.validateWeight(weight); err != nil {
-			logger.Errorf("Ошибка проверки веса для упаковки %s заказа %d: %v", *packageType, id, err)
-			return fmt.Errorf("ошибка проверки веса для упаковки %s: %w", *packageType, err)
-		}
-
-		finalCost += packager.getAdditionalCost()
-		logger.Debugf("Финальная стоимость заказа %d после добавления упаковки: %v", id, finalCost)
+	paymentMode, err := checkPaymentMode(paymentMode)
+	if err != nil {
+		return err
 	}
 
-	order := model.Order{
-		ID:          id,
-		CustomerID:  customerID,
-		DeadlineAt:  deadline,
-		State:       model.StateAccepted,
-		UpdatedAt:   now,
-		Weight:      weight,
-		Cost:        finalCost,
-		PackageType: packageType,
-		Wrapper:     wrapper,
+	order, err := s.prepareAcceptance(ctx, id, customerID, pickupPointID, deadline, weight, cost, packageType, wrapper, now)
+	if err != nil {
+		return err
 	}
+	order.PaymentMode = paymentMode
+
+	return s.acceptOrder(ctx, order, now)
+}
+
+// acceptOrder - записывает проверенный заказ в БД вместе с размещением в ячейке хранения и кэширует его
+func (s *OrderService) acceptOrder(ctx context.Context, order model.Order, now time.Time) error {
+	id := order.ID
 
-	if err := s.repo.Create(ctx, order); err != nil {
+	order, err := s.repo.Create(ctx, order, newTransition(ctx, id, nil, order.State, now))
+	if err != nil {
 		logger.Errorf("Ошибка создания заказа %d в БД: %v", id, err)
 		return err
 	}
 
+	if order.StorageCellID != nil {
+		logger.Infof("Заказ %d размещен в ячейке %d", id, *order.StorageCellID)
+	} else {
+		logger.Warnf("Заказ %d принят без ячейки: в ПВЗ %d нет ячеек хранения", id, order.PickupPointID)
+	}
+
 	if err := s.cache.SetOrder(ctx, order); err != nil {
 		logger.Warnf("Ошибка сохранения заказа %d в кэше: %v", id, err)
 		return err
 	}
 
 	s.logger.LogOrderStatusChange(ctx, id, "none", string(order.State))
-	logger.Infof("Заказ %d успешно принят", id)
+	logger.Infof("Заказ %d успешно принят в ПВЗ %d", id, order.PickupPointID)
 
 	metrics.OrdersAccepted.Inc()
 
 	return nil
 }
 
-// ReturnOrderToCourier - возвращает заказ курьеру, если условия возврата соблюдены
-func (s *OrderService) ReturnOrderToCourier(ctx context.Context, id int64) error {
+// prepareAcceptance - проверяет параметры принимаемого заказа и то, что заказа с таким ID еще нет,
+// и возвращает заказ, готовый к записи в БД. Проверки, требующие записи (размещение по ячейкам), здесь не выполняются.
+func (s *OrderService) prepareAcceptance(ctx context.Context, id, customerID, pickupPointID int64, deadline time.Time, weight, cost float64, packageType *model.PackageType, wrapper *model.WrapperType, now time.Time) (model.Order, error) {
+	order, err := newAcceptedOrder(ctx, id, customerID, pickupPointID, deadline, weight, cost, packageType, wrapper, now)
+	if err != nil {
+		return model.Order{}, err
+	}
+
+	if existing, _ := s.repo.GetByID(ctx, id); existing.ID == id {
+		logger.Errorf("Заказ с ID %d уже существует", id)
+		return model.Order{}, fmt.Errorf("%w: Id %d", ErrOrderExists, id)
+	}
+
+	return order, nil
+}
+
+// newAcceptedOrder - проверяет параметры принимаемого заказа без обращения к БД
+// и возвращает заказ в статусе принятого с учетом стоимости упаковки
+func newAcceptedOrder(ctx context.Context, id, customerID, pickupPointID int64, deadline time.Time, weight, cost float64, packageType *model.PackageType, wrapper *model.WrapperType, now time.Time) (model.Order, error) {
+	if id <= 0 {
+		logger.Errorf("Невалидный ID заказа: %d", id)
+		return model.Order{}, fmt.Errorf("%w: %d", ErrInvalidOrderID, id)
+	}
+	if customerID <= 0 {
+		logger.Errorf("Невалидный ID клиента заказа %d: %d", id, customerID)
+		return model.Order{}, fmt.Errorf("%w: %d", repository.ErrInvalidCustomerID, customerID)
+	}
+	if now.After(deadline) {
+		logger.Errorf("Срок хранения заказа %d уже истек: %v (текущая дата: %v)", id, deadline, now)
+		return model.Order{}, fmt.Errorf("%w: %v \n Текущая дата: %v", ErrStorageDeadlinePassed, deadline, now)
+	}
+	if weight <= 0 {
+		logger.Errorf("Недопустимый вес заказа %d: %v", id, weight)
+		return model.Order{}, fmt.Errorf("%w: %v", ErrNegativeWeight, weight)
+	}
+	if cost <= 0 {
+		logger.Errorf("Недопустимая стоимость заказа %d: %v", id, cost)
+		return model.Order{}, fmt.Errorf("%w: %v", ErrNegativeCost, cost)
+	}
+
+	pickupPointID, err := resolvePickupPoint(ctx, pickupPointID)
+	if err != nil {
+		logger.Errorf("Не удалось определить ПВЗ для заказа %d: %v", id, err)
+		return model.Order{}, err
+	}
+
+	packaging, err := packagingCost(packageType, wrapper, weight)
+	if err != nil {
+		logger.Errorf("Ошибка расчета упаковки заказа %d: %v", id, err)
+		return model.Order{}, err
+	}
+
+	finalCost := cost + packaging
+	logger.Debugf("Финальная стоимость заказа %d после добавления упаковки: %v", id, finalCost)
+
+	return model.Order{
+		ID:            id,
+		CustomerID:    customerID,
+		PickupPointID: pickupPointID,
+		DeadlineAt:    deadline,
+		State:         model.StateAccepted,
+		UpdatedAt:     now,
+		Weight:        weight,
+		Cost:          finalCost,
+		PackageType:   packageType,
+		Wrapper:       wrapper,
+		Version:       1,
+		PaymentMode:   model.PaymentModePrepaid,
+	}, nil
+}
+
+// ReturnOrderToCourier - возвращает заказ курьеру, если условия возврата соблюдены.
+// Заказ не удаляется, а переходит в конечный статус, чтобы сохранить его историю.
+// Если version больше 0, заказ должен быть именно этой версии.
+func (s *OrderService) ReturnOrderToCourier(ctx context.Context, id, version int64) error {
 	now := time.Now()
 	var order model.Order
 
@@ -177,27 +272,42 @@ func (s *OrderService) ReturnOrderToCourier(ctx context.Context, id int64) error
 		}
 	}
 
-	if order.State == model.StateDelivered {
-		logger.Errorf("Невозможно вернуть курьеру уже доставленный заказ %d", id)
-		return fmt.Errorf("%w: ID %d", ErrOrderAlreadyDelivered, id)
+	if err := checkOrderScope(ctx, order); err != nil {
+		return err
+	}
+
+	order, err = s.checkVersion(ctx, order, version)
+	if err != nil {
+		return err
+	}
+
+	if err := checkTransition(order.State, model.StateReturnedToCourier); err != nil {
+		logger.Errorf("Невозможно вернуть курьеру заказ %d в статусе %s", id, order.State)
+		return err
 	}
-	if now.Before(order.DeadlineAt) && order.State != model.StateReturned {
+	// Невостребованный заказ можно отдать курьеру только после окончания срока хранения
+	if order.State == model.StateAccepted && now.Before(order.DeadlineAt) {
 		logger.Errorf("Срок хранения заказа %d еще не истек: %v (текущая дата: %v)", id, order.DeadlineAt, now)
 		return fmt.Errorf("%w: %v\n текущая дата: %v", ErrDeadlineNotExpired, order.DeadlineAt, now)
 	}
 
-	state := order.State
+	oldState := order.State
 
+	order.State = model.StateReturnedToCourier
+	order.UpdatedAt = now
+	// Переданный курьеру заказ освобождает ячейку хранения
+	order.StorageCellID = nil
+
+	if err := s.repo.UpdateState(ctx, order, newTransition(ctx, id, &oldState, order.State, now)); err != nil {
+		logger.Errorf("Ошибка обновления заказа %d в БД при возврате курьеру: %v", id, err)
+		return s.dropStaleOrder(ctx, id, err)
+	}
 	if err := s.cache.DeleteOrder(ctx, id); err != nil {
 		logger.Warnf("Ошибка удаления заказа %d из кэша: %v", id, err)
 		return err
 	}
-	if err := s.repo.Delete(ctx, id); err != nil {
-		logger.Errorf("Ошибка удаления заказа %d из БД: %v", id, err)
-		return err
-	}
 
-	s.logger.LogOrderStatusChange(ctx, id, string(state), "deleted")
+	s.logger.LogOrderStatusChange(ctx, id, string(oldState), string(order.State))
 	logger.Infof("Заказ %d успешно возвращен курьеру", id)
 
 	metrics.OrdersReturnedToCourier.Inc()
@@ -205,86 +315,322 @@ func (s *OrderService) ReturnOrderToCourier(ctx context.Context, id int64) error
 	return nil
 }
 
-// DeliverOrder - доставляет заказ клиенту, если заказ принадлежит клиенту и не просрочен
-func (s *OrderService) DeliverOrder(ctx context.Context, id, customerID int64, now time.Time) error {
-	order, err := s.cache.GetOrder(ctx, id)
+// DeliverOrder - доставляет заказ клиенту, если заказ принадлежит клиенту и не просрочен.
+// У заказа с товарами выдаются товары из itemIDs, от остальных товаров клиент отказывается.
+// Пустой itemIDs означает выдачу всех товаров.
+// Заказ с оплатой при получении выдается только вместе с оплатой payment на сумму стоимости выдаваемых товаров.
+// Если version больше 0, заказ должен быть именно этой версии.
+func (s *OrderService) DeliverOrder(ctx context.Context, id, version, customerID int64, itemIDs []int64, payment *model.PaymentDetails, now time.Time) error {
+	order, err := s.loadOrder(ctx, id)
 	if err != nil {
-		logger.Debugf("Ошибка получения заказа %d из кэша: %v, обращаемся к БД", id, err)
-		order, err = s.repo.GetByID(ctx, id)
+		return fmt.Errorf("ошибка при доставке заказа Id %d: %w", id, err)
+	}
+
+	order, err = s.checkVersion(ctx, order, version)
+	if err != nil {
+		return err
+	}
+
+	delivered, transition, err := prepareDelivery(ctx, order, customerID, itemIDs, now)
+	if err != nil {
+		return err
+	}
+
+	orders := []model.Order{delivered}
+	payments, err := s.capturePayment(ctx, orders, payment, now)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.DeliverOrders(ctx, orders, []model.OrderStateTransition{transition}, payments); err != nil {
+		logger.Errorf("Ошибка обновления заказа %d в БД: %v", id, err)
+		s.cancelPayments(ctx, payments)
+		return s.dropStaleOrder(ctx, id, err)
+	}
+	delivered.Version++
+	s.logPayments(ctx, payments)
+
+	return s.completeDelivery(ctx, order, delivered)
+}
+
+// ProcessReturnOrder - обрабатывает возврат заказа от клиента, если соблюдены условия возврата.
+// Сведения о возврате details сохраняются вместе с возвратом и записываются в журнал аудита.
+// Деньги за возвращенный заказ возвращаются клиенту тем же способом, которым заказ был оплачен.
+// У заказа с товарами возвращаются выданные товары из itemIDs, пустой itemIDs означает возврат всех выданных товаров.
+// Если version больше 0, заказ должен быть именно этой версии.
+func (s *OrderService) ProcessReturnOrder(ctx context.Context, id, version, customerID int64, itemIDs []int64, details model.ReturnDetails, now time.Time) error {
+	details, err := normalizeReturnDetails(details)
+	if err != nil {
+		return err
+	}
+
+	order, err := s.loadOrder(ctx, id)
+	if err != nil {
+		return fmt.Errorf("ошибка при возврате заказа Id %d: %w", id, err)
+	}
+
+	order, err = s.checkVersion(ctx, order, version)
+	if err != nil {
+		return err
+	}
+
+	policy, err := s.returnPolicy(ctx, order)
+	if err != nil {
+		return err
+	}
+
+	returned, transition, err := prepareReturn(ctx, order, policy, customerID, itemIDs, now)
+	if err != nil {
+		return err
+	}
+
+	orders := []model.Order{returned}
+	returns := []model.OrderReturn{newOrderReturn(ctx, returned, policy, details)}
+	refunds, err := s.refundReturns(ctx, orders, returns, now)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.ReturnOrders(ctx, orders, []model.OrderStateTransition{transition}, returns, refunds); err != nil {
+		logger.Errorf("Ошибка обновления заказа %d в БД при возврате: %v", id, err)
+		s.cancelRefunds(ctx, refunds)
+		return s.dropStaleOrder(ctx, id, err)
+	}
+	s.logRefunds(ctx, refunds)
+
+	return s.completeReturn(ctx, order, returned, returns[0])
+}
+
+// DeliverOrders - выдает клиенту все заказы в одной транзакции.
+// Если хотя бы один заказ выдать нельзя, не выдается ни один из них.
+// Товары itemIDs выбираются так же, как в DeliverOrder, и каждый из них должен входить в один из заказов.
+// Оплата payment вносится одной суммой за все заказы с оплатой при получении.
+func (s *OrderService) DeliverOrders(ctx context.Context, ids []int64, customerID int64, itemIDs []int64, payment *model.PaymentDetails, now time.Time) error {
+	return s.processOrders(ctx, ids, func(order model.Order) (model.Order, model.OrderStateTransition, error) {
+		return prepareDelivery(ctx, order, customerID, itemIDs, now)
+	}, func(ctx context.Context, orders []model.Order, transitions []model.OrderStateTransition) error {
+		if err := checkItemIDs(itemIDs, orders); err != nil {
+			return err
+		}
+
+		payments, err := s.capturePayment(ctx, orders, payment, now)
 		if err != nil {
-			logger.Errorf("Ошибка получения заказа %d из БД: %v", id, err)
-			return fmt.Errorf("ошибка при доставке заказа Id %d: %w", id, err)
+			return err
+		}
+
+		if err := s.repo.DeliverOrders(ctx, orders, transitions, payments); err != nil {
+			s.cancelPayments(ctx, payments)
+			return err
 		}
+		s.logPayments(ctx, payments)
+
+		return nil
+	}, s.completeDelivery)
+}
+
+// ProcessReturnOrders - принимает от клиента возврат всех заказов в одной транзакции.
+// Если хотя бы один заказ вернуть нельзя, не возвращается ни один из них.
+// Сведения о возврате details одинаковы для всех заказов, товары itemIDs выбираются так же, как в ProcessReturnOrder,
+// и каждый из них должен входить в один из заказов.
+func (s *OrderService) ProcessReturnOrders(ctx context.Context, ids []int64, customerID int64, itemIDs []int64, details model.ReturnDetails, now time.Time) error {
+	details, err := normalizeReturnDetails(details)
+	if err != nil {
+		return err
+	}
+
+	returns := make(map[int64]model.OrderReturn, len(ids))
+
+	return s.processOrders(ctx, ids, func(order model.Order) (model.Order, model.OrderStateTransition, error) {
+		policy, err := s.returnPolicy(ctx, order)
+		if err != nil {
+			return model.Order{}, model.OrderStateTransition{}, err
+		}
+
+		returned, transition, err := prepareReturn(ctx, order, policy, customerID, itemIDs, now)
+		if err != nil {
+			return model.Order{}, model.OrderStateTransition{}, err
+		}
+		returns[order.ID] = newOrderReturn(ctx, returned, policy, details)
+
+		return returned, transition, nil
+	}, func(ctx context.Context, orders []model.Order, transitions []model.OrderStateTransition) error {
+		if err := checkItemIDs(itemIDs, orders); err != nil {
+			return err
+		}
+
+		batch := make([]model.OrderReturn, 0, len(orders))
+		for _, order := range orders {
+			batch = append(batch, returns[order.ID])
+		}
+
+		refunds, err := s.refundReturns(ctx, orders, batch, now)
+		if err != nil {
+			return err
+		}
+
+		if err := s.repo.ReturnOrders(ctx, orders, transitions, batch, refunds); err != nil {
+			s.cancelRefunds(ctx, refunds)
+			return err
+		}
+		s.logRefunds(ctx, refunds)
+
+		for _, ret := range batch {
+			returns[ret.OrderID] = ret
+		}
+		return nil
+	}, func(ctx context.Context, before, after model.Order) error {
+		return s.completeReturn(ctx, before, after, returns[after.ID])
+	})
+}
+
+// processOrders - проверяет все заказы и записывает их новые статусы с помощью write в одной транзакции.
+// Кэш, аудит и метрики обновляются только после успешной записи всех заказов.
+func (s *OrderService) processOrders(
+	ctx context.Context,
+	ids []int64,
+	prepare func(order model.Order) (model.Order, model.OrderStateTransition, error),
+	write func(ctx context.Context, orders []model.Order, transitions []model.OrderStateTransition) error,
+	complete func(ctx context.Context, before, after model.Order) error,
+) error {
+	seen := make(map[int64]struct{}, len(ids))
+	before := make([]model.Order, 0, len(ids))
+	after := make([]model.Order, 0, len(ids))
+	transitions := make([]model.OrderStateTransition, 0, len(ids))
+
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			return fmt.Errorf("%w: %d", ErrDuplicateOrderID, id)
+		}
+		seen[id] = struct{}{}
+
+		order, err := s.loadOrder(ctx, id)
+		if err != nil {
+			return fmt.Errorf("заказ %d: %w", id, err)
+		}
+
+		updated, transition, err := prepare(order)
+		if err != nil {
+			return fmt.Errorf("заказ %d: %w", id, err)
+		}
+
+		before = append(before, order)
+		after = append(after, updated)
+		transitions = append(transitions, transition)
+	}
+
+	if len(after) == 0 {
+		return nil
+	}
+
+	if err := write(ctx, after, transitions); err != nil {
+		logger.Errorf("Ошибка обновления заказов %v в БД: %v", ids, err)
+		for _, id := range ids {
+			_ = s.dropStaleOrder(ctx, id, err)
+		}
+		return err
+	}
+
+	for i := range after {
+		after[i].Version++
+		// Заказы уже записаны в БД, поэтому ошибка кэша не отменяет обработку остальных
+		_ = complete(ctx, before[i], after[i])
+	}
+
+	return nil
+}
+
+// loadOrder - получает заказ из кэша, а при его отсутствии - из БД
+func (s *OrderService) loadOrder(ctx context.Context, id int64) (model.Order, error) {
+	order, err := s.cache.GetOrder(ctx, id)
+	if err == nil {
+		return order, nil
+	}
+
+	logger.Debugf("Ошибка получения заказа %d из кэша: %v, обращаемся к БД", id, err)
+	order, err = s.repo.GetByID(ctx, id)
+	if err != nil {
+		logger.Errorf("Ошибка получения заказа %d из БД: %v", id, err)
+		return model.Order{}, err
+	}
+
+	return order, nil
+}
+
+// prepareDelivery - проверяет, что заказ принадлежит клиенту и не просрочен,
+// и возвращает выданный заказ вместе с переходом статуса.
+// Невыбранные товары заказа отмечаются как отказные, и заказ пересчитывается без них.
+func prepareDelivery(ctx context.Context, order model.Order, customerID int64, itemIDs []int64, now time.Time) (model.Order, model.OrderStateTransition, error) {
+	id := order.ID
+
+	if err := checkOrderScope(ctx, order); err != nil {
+		return model.Order{}, model.OrderStateTransition{}, err
 	}
 
 	if order.CustomerID != customerID {
 		logger.Errorf("Заказ %d принадлежит другому клиенту (запрошен %d, владелец %d)",
 			id, customerID, order.CustomerID)
-		return fmt.Errorf("%w: ID %d", ErrWrongCustomer, id)
+		return model.Order{}, model.OrderStateTransition{}, fmt.Errorf("%w: ID %d", ErrWrongCustomer, id)
 	}
-	if order.State != model.StateAccepted {
+	if err := checkTransition(order.State, model.StateDelivered); err != nil {
 		logger.Errorf("Невозможно выдать заказ %d в статусе %s", id, order.State)
-		return fmt.Errorf("%w: ID %d", ErrWrongState, id)
+		return model.Order{}, model.OrderStateTransition{}, err
 	}
 	if now.After(order.DeadlineAt) {
 		logger.Errorf("Срок хранения заказа %d истек: %v (текущая дата: %v)", id, order.DeadlineAt, now)
-		return fmt.Errorf("%w: %v \n Текущая дата: %v", ErrStorageExpired, order.DeadlineAt, now)
+		return model.Order{}, model.OrderStateTransition{}, fmt.Errorf("%w: %v \n Текущая дата: %v", ErrStorageExpired, order.DeadlineAt, now)
 	}
 
-	metricsUpdated := order.UpdatedAt
+	if err := pickItems(&order, itemIDs, model.ItemStateAccepted, model.ItemStateDelivered, now); err != nil {
+		return model.Order{}, model.OrderStateTransition{}, err
+	}
+	if err := refuseItems(&order, now); err != nil {
+		logger.Errorf("Ошибка пересчета заказа %d без отказных товаров: %v", id, err)
+		return model.Order{}, model.OrderStateTransition{}, err
+	}
 
 	oldState := order.State
 
 	order.State = model.StateDelivered
 	order.UpdatedAt = now
 	order.DeliveredAt = &now
+	// Выданный заказ освобождает ячейку хранения
+	order.StorageCellID = nil
 
-	if err := s.repo.Update(ctx, order); err != nil {
-		logger.Errorf("Ошибка обновления заказа %d в БД: %v", id, err)
-		return err
-	}
-	if err := s.cache.SetOrder(ctx, order); err != nil {
-		logger.Warnf("Ошибка сохранения заказа %d в кэше после выдачи: %v", id, err)
-		return err
-	}
-
-	s.logger.LogOrderStatusChange(ctx, id, string(oldState), string(order.State))
-	logger.Infof("Заказ %d успешно выдан клиенту %d", id, customerID)
-
-	metrics.OrdersDelivered.Inc()
-
-	processingTime := order.DeliveredAt.Sub(metricsUpdated).Seconds()
-	metrics.OrdersProcessingTime.Observe(processingTime)
-
-	return nil
+	return order, newTransition(ctx, id, &oldState, order.State, now), nil
 }
 
-// ProcessReturnOrder - обрабатывает возврат заказа от клиента, если соблюдены условия возврата
-func (s *OrderService) ProcessReturnOrder(ctx context.Context, id, customerID int64, now time.Time) error {
-	order, err := s.cache.GetOrder(ctx, id)
-	if err != nil {
-		logger.Debugf("Ошибка получения заказа %d из кэша: %v, обращаемся к БД", id, err)
-		order, err = s.repo.GetByID(ctx, id)
-		if err != nil {
-			logger.Errorf("Ошибка получения заказа %d из БД: %v", id, err)
-			return fmt.Errorf("ошибка при возврате заказа Id %d: %w", id, err)
-		}
+// prepareReturn - проверяет, что заказ выдан клиенту и по политике возврата его можно вернуть,
+// и возвращает возвращенный заказ вместе с переходом статуса.
+// Невыбранные выданные товары заказа остаются у клиента.
+func prepareReturn(ctx context.Context, order model.Order, policy model.ReturnPolicy, customerID int64, itemIDs []int64, now time.Time) (model.Order, model.OrderStateTransition, error) {
+	id := order.ID
+
+	if err := checkOrderScope(ctx, order); err != nil {
+		return model.Order{}, model.OrderStateTransition{}, err
 	}
 
 	if order.CustomerID != customerID {
 		logger.Errorf("Заказ %d принадлежит другому клиенту (запрошен %d, владелец %d)",
 			id, customerID, order.CustomerID)
-		return fmt.Errorf("%w: ID %d", ErrWrongCustomer, id)
+		return model.Order{}, model.OrderStateTransition{}, fmt.Errorf("%w: ID %d", ErrWrongCustomer, id)
 	}
-	if order.State != model.StateDelivered {
+	if err := checkTransition(order.State, model.StateReturned); err != nil {
 		logger.Errorf("Невозможно вернуть заказ %d в статусе %s (требуется статус %s)",
 			id, order.State, model.StateDelivered)
-		return fmt.Errorf("%w: ID %d", ErrNotDelivered, id)
+		return model.Order{}, model.OrderStateTransition{}, err
+	}
+	if policy.NonReturnable {
+		logger.Errorf("Заказ %d не подлежит возврату по политике %q", id, policy.Name)
+		return model.Order{}, model.OrderStateTransition{}, fmt.Errorf("%w: политика %q", ErrOrderNotReturnable, policy.Name)
 	}
-	if now.Sub(*order.DeliveredAt) > ReturnedAt {
+	if now.Sub(*order.DeliveredAt) > policy.ReturnWindow() {
 		logger.Errorf("Срок возврата заказа %d истек: доставлен %v, текущая дата %v, максимальный срок возврата %v",
-			id, order.DeliveredAt, now, ReturnedAt)
-		return fmt.Errorf("%w: %v \n Текущая дата: %v", ErrReturnExpired, order.DeliveredAt, now)
+			id, order.DeliveredAt, now, policy.ReturnWindow())
+		return model.Order{}, model.OrderStateTransition{}, fmt.Errorf("%w: %v \n Текущая дата: %v", ErrReturnExpired, order.DeliveredAt, now)
+	}
+
+	if err := pickItems(&order, itemIDs, model.ItemStateDelivered, model.ItemStateReturned, now); err != nil {
+		return model.Order{}, model.OrderStateTransition{}, err
 	}
 
 	oldState := order.State
@@ -293,27 +639,89 @@ func (s *OrderService) ProcessReturnOrder(ctx context.Context, id, customerID in
 	order.UpdatedAt = now
 	order.ReturnedAt = &now
 
-	if err := s.repo.Update(ctx, order); err != nil {
-		logger.Errorf("Ошибка обновления заказа %d в БД при возврате: %v", id, err)
-		return err
+	return order, newTransition(ctx, id, &oldState, order.State, now), nil
+}
+
+// completeDelivery - обновляет кэш, журнал аудита и метрики после записи выдачи заказа в БД
+func (s *OrderService) completeDelivery(ctx context.Context, before, after model.Order) error {
+	cacheErr := s.cache.SetOrder(ctx, after)
+	if cacheErr != nil {
+		logger.Warnf("Ошибка сохранения заказа %d в кэше после выдачи: %v", after.ID, cacheErr)
 	}
-	if err := s.cache.DeleteOrder(ctx, id); err != nil {
-		logger.Warnf("Ошибка удаления заказа %d из кэша при возврате: %v", id, err)
-		return err
+
+	s.logger.LogOrderStatusChange(ctx, after.ID, string(before.State), string(after.State))
+	logger.Infof("Заказ %d успешно выдан клиенту %d", after.ID, after.CustomerID)
+
+	metrics.OrdersDelivered.Inc()
+
+	processingTime := after.DeliveredAt.Sub(before.UpdatedAt).Seconds()
+	metrics.OrdersProcessingTime.Observe(processingTime)
+
+	return cacheErr
+}
+
+// completeReturn - обновляет кэш, журнал аудита и метрики после записи возврата заказа в БД.
+// В журнал аудита также записываются сведения о возврате ret.
+func (s *OrderService) completeReturn(ctx context.Context, before, after model.Order, ret model.OrderReturn) error {
+	cacheErr := s.cache.DeleteOrder(ctx, after.ID)
+	if cacheErr != nil {
+		logger.Warnf("Ошибка удаления заказа %d из кэша при возврате: %v", after.ID, cacheErr)
 	}
 
-	s.logger.LogOrderStatusChange(ctx, id, string(oldState), string(order.State))
-	logger.Infof("Заказ %d успешно возвращен клиентом %d", id, customerID)
+	s.logger.LogOrderStatusChange(ctx, after.ID, string(before.State), string(after.State))
+	s.logReturn(ctx, ret)
+	logger.Infof("Заказ %d успешно возвращен клиентом %d", after.ID, after.CustomerID)
 
 	metrics.OrdersReturned.Inc()
 
-	return nil
+	return cacheErr
+}
+
+// checkVersion - проверяет, что клиент изменяет ту версию заказа, которую получил.
+// Нулевая версия означает, что клиент не передал ожидаемую версию.
+// При расхождении заказ перечитывается из БД, так как в кэше может лежать устаревшая копия.
+func (s *OrderService) checkVersion(ctx context.Context, order model.Order, version int64) (model.Order, error) {
+	if version <= 0 || order.Version == version {
+		return order, nil
+	}
+
+	id := order.ID
+	order, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		logger.Errorf("Ошибка получения заказа %d из БД: %v", id, err)
+		return model.Order{}, err
+	}
+	if order.Version == version {
+		return order, nil
+	}
+
+	logger.Errorf("Версия заказа %d не совпадает: текущая %d, ожидалась %d", id, order.Version, version)
+	return model.Order{}, s.dropStaleOrder(ctx, id,
+		fmt.Errorf("%w: %w: заказ %d, версия %d, ожидалась %d", ErrVersionMismatch, repository.ErrConcurrentModification, id, order.Version, version))
+}
+
+// dropStaleOrder - удаляет заказ из кэша, если запись не удалась из-за параллельного изменения,
+// чтобы следующий запрос прочитал актуальную версию из БД. Возвращает исходную ошибку.
+func (s *OrderService) dropStaleOrder(ctx context.Context, id int64, err error) error {
+	if !errors.Is(err, repository.ErrConcurrentModification) {
+		return err
+	}
+
+	if cacheErr := s.cache.DeleteOrder(ctx, id); cacheErr != nil {
+		logger.Warnf("Ошибка удаления устаревшего заказа %d из кэша: %v", id, cacheErr)
+	}
+
+	return err
 }
 
-// OrderHistory - возвращает историю заказов с учетом поискового запроса
+// OrderHistory - возвращает историю заказов ПВЗ вызывающего пользователя с учетом поискового запроса
 func (s *OrderService) OrderHistory(ctx context.Context, searchTerm string) ([]model.Order, error) {
+	pickupPointID, err := scopePickupPoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var orders []model.Order
-	var err error
 
 	// Если нет поискового запроса, пробуем использовать кэш
 	if searchTerm == "" {
@@ -321,16 +729,19 @@ func (s *OrderService) OrderHistory(ctx context.Context, searchTerm string) ([]m
 		if err != nil {
 			logger.Debugf("Не удалось получить историю из кеша: %v", err)
 			// При ошибке кеша переключаемся на БД
-			orders, err = s.repo.List(ctx, searchTerm)
+			orders, err = s.repo.List(ctx, pickupPointID, searchTerm)
 			if err != nil {
 				logger.Errorf("Ошибка получения списка заказов из БД: %v", err)
 				return nil, fmt.Errorf("ошибка при получении списка заказов: %w", err)
 			}
+		} else if pickupPointID != 0 {
+			// Кэш хранит историю всех ПВЗ
+			orders = filterByPickupPoint(orders, pickupPointID)
 		}
 	} else {
 		// При наличии поискового запроса сразу идем в БД
 		logger.Debugf("Получение истории заказов с поисковым запросом: %s", searchTerm)
-		orders, err = s.repo.List(ctx, searchTerm)
+		orders, err = s.repo.List(ctx, pickupPointID, searchTerm)
 		if err != nil {
 			logger.Errorf("Ошибка получения списка заказов из БД с поисковым запросом %s: %v", searchTerm, err)
 			return nil, fmt.Errorf("ошибка при получении списка заказов: %w", err)
@@ -346,51 +757,6 @@ func (s *OrderService) OrderHistory(ctx context.Context, searchTerm string) ([]m
 	return orders, nil
 }
 
-// AcceptOrdersFromFile - принимает заказы из файла с форматом JSON
-func (s *OrderService) AcceptOrdersFromFile(ctx context.Context, filename string) error {
-	logger.Infof("Начинаем импорт заказов из файла: %s", filename)
-
-	orders, err := readOrdersFromFile(filename)
-	if err != nil {
-		logger.Errorf("Ошибка чтения заказов из файла %s: %v", filename, err)
-		return err
-	}
-
-	logger.Infof("Успешно прочитано %d заказов из файла", len(orders))
-
-	for _, order := range orders {
-		deadline, err := parseDeadline(order.DeadlineAt)
-		if err != nil {
-			logger.Errorf("Ошибка парсинга дедлайна для заказа %d: %v", order.ID, err)
-			return err
-		}
-
-		packageType, wrapper := processPackaging(order.PackageType, order.Wrapper)
-
-		logger.Debugf("Обработка заказа из файла: ID=%d, CustomerID=%d, Weight=%v, Cost=%v",
-			order.ID, order.CustomerID, order.Weight, order.Cost)
-
-		if err = s.AcceptOrder(
-			ctx,
-			order.ID,
-			order.CustomerID,
-			deadline,
-			order.Weight,
-			order.Cost,
-			packageType,
-			wrapper,
-		); err != nil {
-			logger.Errorf("Ошибка принятия заказа %d из файла: %v", order.ID, err)
-			return fmt.Errorf("ошибка при принятии заказа %d: %w", order.ID, err)
-		}
-
-		s.logger.LogOrderStatusChange(ctx, order.ID, "none", string(model.StateAccepted))
-	}
-
-	logger.Infof("Успешно импортировано %d заказов из файла %s", len(orders), filename)
-	return nil
-}
-
 // GetOrderByID - находит заказ по его ID
 func (s *OrderService) GetOrderByID(ctx context.Context, id int64) (model.Order, error) {
 	logger.Debugf("Запрос заказа по ID: %d", id)
@@ -398,6 +764,9 @@ func (s *OrderService) GetOrderByID(ctx context.Context, id int64) (model.Order,
 	order, err := s.cache.GetOrder(ctx, id)
 	if err == nil {
 		logger.Debugf("Заказ %d найден в кэше", id)
+		if err := checkOrderScope(ctx, order); err != nil {
+			return model.Order{}, err
+		}
 		return order, nil
 	}
 
@@ -409,7 +778,11 @@ func (s *OrderService) GetOrderByID(ctx context.Context, id int64) (model.Order,
 		return model.Order{}, err
 	}
 
-	if order.State != model.StateReturned && order.DeadlineAt.After(time.Now()) {
+	if err := checkOrderScope(ctx, order); err != nil {
+		return model.Order{}, err
+	}
+
+	if order.State != model.StateReturned && !isTerminalState(order.State) && order.DeadlineAt.After(time.Now()) {
 		if err := s.cache.SetOrder(ctx, order); err != nil {
 			logger.Warnf("Ошибка кэширования заказа %d: %v", order.ID, err)
 		} else {
@@ -420,11 +793,48 @@ func (s *OrderService) GetOrderByID(ctx context.Context, id int64) (model.Order,
 	return order, nil
 }
 
+// LocateOrder - возвращает ячейку хранения, в которой лежит заказ
+func (s *OrderService) LocateOrder(ctx context.Context, id int64) (model.StorageCell, error) {
+	order, err := s.GetOrderByID(ctx, id)
+	if err != nil {
+		return model.StorageCell{}, err
+	}
+
+	if order.StorageCellID == nil {
+		logger.Debugf("Заказ %d не размещен в ячейке хранения", id)
+		return model.StorageCell{}, fmt.Errorf("%w: ID %d", ErrOrderNotInCell, id)
+	}
+
+	cell, err := s.cells.GetByID(ctx, *order.StorageCellID)
+	if err != nil {
+		logger.Errorf("Ошибка получения ячейки %d заказа %d: %v", *order.StorageCellID, id, err)
+		return model.StorageCell{}, err
+	}
+
+	return cell, nil
+}
+
+// OrderTimeline - возвращает историю смены статусов заказа
+func (s *OrderService) OrderTimeline(ctx context.Context, id int64) ([]model.OrderStateTransition, error) {
+	// Заказ запрашивается целиком, чтобы проверить, что он относится к ПВЗ пользователя
+	if _, err := s.GetOrderByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	transitions, err := s.repo.ListTransitions(ctx, id)
+	if err != nil {
+		logger.Errorf("Ошибка получения истории статусов заказа %d: %v", id, err)
+		return nil, err
+	}
+
+	return transitions, nil
+}
+
 // ClearDatabase - очищает базу данных
 func (s *OrderService) ClearDatabase(ctx context.Context) error {
 	logger.Infof("Запрос на очистку базы данных заказов")
 
-	orders, err := s.repo.List(ctx, "")
+	orders, err := s.repo.List(ctx, 0, "")
 	if err != nil {
 		logger.Errorf("Ошибка при получении списка заказов для очистки: %v", err)
 		return fmt.Errorf("ошибка при получении списка заказов: %w", err)
@@ -433,7 +843,7 @@ func (s *OrderService) ClearDatabase(ctx context.Context) error {
 	logger.Infof("Найдено %d заказов для удаления", len(orders))
 
 	for _, order := range orders {
-		if err := s.repo.Delete(ctx, order.ID); err != nil {
+		if err := s.repo.Delete(ctx, order.ID, order.Version); err != nil {
 			logger.Errorf("Ошибка при удалении заказа %d: %v", order.ID, err)
 			return fmt.Errorf("ошибка при удалении заказа %d: %w", order.ID, err)
 		}
@@ -449,12 +859,17 @@ func (s *OrderService) ClearDatabase(ctx context.Context) error {
 	return nil
 }
 
-// ListOrdersWithCursor - возвращает список заказов с использованием курсорной пагинации по ID
+// ListOrdersWithCursor - возвращает список заказов ПВЗ вызывающего пользователя с использованием курсорной пагинации по ID
 func (s *OrderService) ListOrdersWithCursor(ctx context.Context, cursorID int64, limit int, customerID int64, filterPVZ bool, searchTerm string) ([]model.Order, error) {
-	logger.Debugf("Запрос списка заказов с курсором: cursorID=%d, limit=%d, customerID=%d, filterPVZ=%v, searchTerm=%s",
-		cursorID, limit, customerID, filterPVZ, searchTerm)
+	pickupPointID, err := scopePickupPoint(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	orders, err := s.repo.ListWithCursor(ctx, cursorID, limit, customerID, filterPVZ, searchTerm)
+	logger.Debugf("Запрос списка заказов с курсором: cursorID=%d, limit=%d, customerID=%d, pickupPointID=%d, filterPVZ=%v, searchTerm=%s",
+		cursorID, limit, customerID, pickupPointID, filterPVZ, searchTerm)
+
+	orders, err := s.repo.ListWithCursor(ctx, cursorID, limit, customerID, pickupPointID, filterPVZ, searchTerm)
 	if err != nil {
 		logger.Errorf("Ошибка получения списка заказов с курсором: %v", err)
 	} else {
@@ -464,17 +879,27 @@ func (s *OrderService) ListOrdersWithCursor(ctx context.Context, cursorID int64,
 	return orders, err
 }
 
-// ListReturnsWithCursor - возвращает список возвращенных заказов с использованием курсорной пагинации по ID
-func (s *OrderService) ListReturnsWithCursor(ctx context.Context, cursorID int64, limit int, searchTerm string) ([]model.Order, error) {
-	logger.Debugf("Запрос списка возвратов с курсором: cursorID=%d, limit=%d, searchTerm=%s",
-		cursorID, limit, searchTerm)
+// ListReturnsWithCursor - возвращает список возвращенных заказов ПВЗ вызывающего пользователя с использованием курсорной пагинации по ID.
+// Каждый заказ возвращается вместе со сведениями о возврате, filter ограничивает выборку по причине возврата и состоянию заказа.
+func (s *OrderService) ListReturnsWithCursor(ctx context.Context, cursorID int64, limit int, searchTerm string, filter model.ReturnFilter) ([]model.ReturnedOrder, error) {
+	if err := checkReturnFilter(filter); err != nil {
+		return nil, err
+	}
+
+	pickupPointID, err := scopePickupPoint(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	returns, err := s.repo.ListReturnsWithCursor(ctx, cursorID, limit, searchTerm)
+	logger.Debugf("Запрос списка возвратов с курсором: cursorID=%d, limit=%d, pickupPointID=%d, searchTerm=%s, reason=%s, condition=%s",
+		cursorID, limit, pickupPointID, searchTerm, filter.Reason, filter.Condition)
+
+	orders, err := s.repo.ListReturnsWithCursor(ctx, cursorID, limit, pickupPointID, searchTerm, filter)
 	if err != nil {
 		logger.Errorf("Ошибка получения списка возвратов с курсором: %v", err)
-	} else {
-		logger.Debugf("Получено %d возвращенных заказов с использованием курсорной пагинации", len(returns))
+		return nil, err
 	}
+	logger.Debugf("Получено %d возвращенных заказов с использованием курсорной пагинации", len(orders))
 
-	return returns, err
+	return s.attachReturns(ctx, orders)
 }
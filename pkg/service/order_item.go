@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"gitlab.ozon.dev/gojhw1/pkg/logger"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/repository"
+)
+
+var (
+	// ErrEmptyItemName - ошибка, возникающая при приеме товара без названия
+	ErrEmptyItemName = errors.New("название товара не может быть пустым")
+	// ErrNoOrderItemsSelected - ошибка, возникающая когда среди выбранных товаров нет ни одного товара заказа
+	ErrNoOrderItemsSelected = errors.New("не выбран ни один товар заказа")
+	// ErrOrderItemUnavailable - ошибка, возникающая при выдаче или возврате товара в неподходящем статусе
+	ErrOrderItemUnavailable = errors.New("товар заказа нельзя выдать или вернуть в текущем статусе")
+)
+
+// AcceptOrderWithItems - принимает в ПВЗ заказ из нескольких товаров.
+// Вес и стоимость заказа складываются из веса и стоимости товаров, к стоимости добавляется стоимость упаковки.
+// Если pickupPointID равен 0, заказ принимается в ПВЗ вызывающего пользователя.
+// Пустой paymentMode означает, что заказ оплачен при оформлении.
+func (s *OrderService) AcceptOrderWithItems(ctx context.Context, id, customerID, pickupPointID int64, deadline time.Time, items []model.OrderItem, packageType *model.PackageType, wrapper *model.WrapperType, paymentMode model.PaymentMode) error {
+	now := time.Now()
+
+	paymentMode, err := checkPaymentMode(paymentMode)
+	if err != nil {
+		return err
+	}
+
+	weight, cost, err := sumItems(id, items)
+	if err != nil {
+		return err
+	}
+
+	order, err := s.prepareAcceptance(ctx, id, customerID, pickupPointID, deadline, weight, cost, packageType, wrapper, now)
+	if err != nil {
+		return err
+	}
+	order.PaymentMode = paymentMode
+
+	order.Items = make([]model.OrderItem, 0, len(items))
+	for _, item := range items {
+		order.Items = append(order.Items, model.OrderItem{
+			OrderID:   id,
+			Name:      strings.TrimSpace(item.Name),
+			Weight:    item.Weight,
+			Cost:      item.Cost,
+			State:     model.ItemStateAccepted,
+			UpdatedAt: now,
+		})
+	}
+
+	return s.acceptOrder(ctx, order, now)
+}
+
+// sumItems - проверяет товары принимаемого заказа и возвращает их суммарные вес и стоимость
+func sumItems(orderID int64, items []model.OrderItem) (float64, float64, error) {
+	var weight, cost float64
+
+	for i, item := range items {
+		if strings.TrimSpace(item.Name) == "" {
+			logger.Errorf("Товар %d заказа %d без названия", i+1, orderID)
+			return 0, 0, fmt.Errorf("%w: товар %d", ErrEmptyItemName, i+1)
+		}
+		if item.Weight <= 0 {
+			logger.Errorf("Недопустимый вес товара %q заказа %d: %v", item.Name, orderID, item.Weight)
+			return 0, 0, fmt.Errorf("%w: товар %q, %v", ErrNegativeWeight, item.Name, item.Weight)
+		}
+		if item.Cost <= 0 {
+			logger.Errorf("Недопустимая стоимость товара %q заказа %d: %v", item.Name, orderID, item.Cost)
+			return 0, 0, fmt.Errorf("%w: товар %q, %v", ErrNegativeCost, item.Name, item.Cost)
+		}
+
+		weight += item.Weight
+		cost += item.Cost
+	}
+
+	return roundCents(weight), roundCents(cost), nil
+}
+
+// pickItems - переводит товары заказа из статуса from в статус to.
+// Если itemIDs пуст, переводятся все товары в статусе from, иначе - только перечисленные товары заказа.
+// Товары других заказов из itemIDs пропускаются. У заказа без товаров ничего не переводится.
+func pickItems(order *model.Order, itemIDs []int64, from, to model.OrderItemState, now time.Time) error {
+	if len(order.Items) == 0 {
+		return nil
+	}
+
+	// Товары копируются, чтобы не изменить заказ, который лежит в кэше
+	order.Items = slices.Clone(order.Items)
+
+	picked := 0
+	for i, item := range order.Items {
+		selected := len(itemIDs) == 0 || slices.Contains(itemIDs, item.ID)
+		if !selected {
+			continue
+		}
+
+		if item.State != from {
+			if len(itemIDs) == 0 {
+				continue
+			}
+			logger.Errorf("Товар %d заказа %d в статусе %s, требуется статус %s", item.ID, order.ID, item.State, from)
+			return fmt.Errorf("%w: товар %d в статусе %s", ErrOrderItemUnavailable, item.ID, item.State)
+		}
+
+		order.Items[i].State = to
+		order.Items[i].UpdatedAt = now
+		picked++
+	}
+
+	if picked == 0 {
+		logger.Errorf("Не выбран ни один товар заказа %d для перевода в статус %s", order.ID, to)
+		return fmt.Errorf("%w: заказ %d", ErrNoOrderItemsSelected, order.ID)
+	}
+
+	return nil
+}
+
+// refuseItems - отмечает невыданные товары заказа как отказные и пересчитывает заказ без них:
+// вычитает их вес и стоимость, перекладывает оставшиеся товары в наименьший подходящий контейнер
+// и заменяет в стоимости заказа стоимость упаковки. Стоимость продления хранения остается в стоимости заказа.
+func refuseItems(order *model.Order, now time.Time) error {
+	accepted := func(item model.OrderItem) bool { return item.State == model.ItemStateAccepted }
+	if !slices.ContainsFunc(order.Items, accepted) {
+		return nil
+	}
+
+	oldPackaging, err := packagingCost(order.PackageType, order.Wrapper, order.Weight)
+	if err != nil {
+		return err
+	}
+
+	for i, item := range order.Items {
+		if !accepted(item) {
+			continue
+		}
+
+		order.Items[i].State = model.ItemStateRefused
+		order.Items[i].UpdatedAt = now
+		order.Weight = roundCents(order.Weight - item.Weight)
+		order.Cost = roundCents(order.Cost - item.Cost)
+	}
+
+	order.PackageType = fitPackageType(order.PackageType, order.Weight)
+	packaging, err := packagingCost(order.PackageType, order.Wrapper, order.Weight)
+	if err != nil {
+		return err
+	}
+	order.Cost = roundCents(order.Cost - oldPackaging + packaging)
+
+	return nil
+}
+
+// returnedCost - возвращает стоимость, от которой считается возврат заказа:
+// стоимость возвращенных товаров или, у заказа без товаров, стоимость всего заказа
+func returnedCost(order model.Order) float64 {
+	if len(order.Items) == 0 {
+		return order.Cost
+	}
+
+	var cost float64
+	for _, item := range order.Items {
+		if item.State == model.ItemStateReturned {
+			cost += item.Cost
+		}
+	}
+
+	return roundCents(cost)
+}
+
+// checkItemIDs - проверяет, что каждый товар из itemIDs входит в один из заказов
+func checkItemIDs(itemIDs []int64, orders []model.Order) error {
+	for _, id := range itemIDs {
+		found := slices.ContainsFunc(orders, func(order model.Order) bool {
+			return slices.ContainsFunc(order.Items, func(item model.OrderItem) bool { return item.ID == id })
+		})
+		if !found {
+			return fmt.Errorf("%w: товар %d", repository.ErrOrderItemNotFound, id)
+		}
+	}
+
+	return nil
+}
+
+// roundCents - округляет значение до сотых
+func roundCents(value float64) float64 {
+	return math.Round(value*100) / 100
+}
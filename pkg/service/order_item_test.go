@@ -0,0 +1,142 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+func TestRefuseItems(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2030, 1, 3, 12, 0, 0, 0, time.UTC)
+	packageType := func(packageType model.PackageType) *model.PackageType { return &packageType }
+	wrapper := model.WrapperFilm
+
+	tests := []struct {
+		name                string
+		order               model.Order
+		expectedWeight      float64
+		expectedCost        float64
+		expectedPackageType *model.PackageType
+		expectedStates      []model.OrderItemState
+	}{
+		{
+			name: "box replaced with bag when remaining items fit",
+			order: model.Order{
+				Weight:      25,
+				Cost:        3020, // 1000 + 2000 + коробка 20
+				PackageType: packageType(model.PackageBox),
+				Items: []model.OrderItem{
+					{ID: 1, Weight: 8, Cost: 1000, State: model.ItemStateDelivered},
+					{ID: 2, Weight: 17, Cost: 2000, State: model.ItemStateAccepted},
+				},
+			},
+			expectedWeight:      8,
+			expectedCost:        1005, // 1000 + пакет 5
+			expectedPackageType: packageType(model.PackageBag),
+			expectedStates:      []model.OrderItemState{model.ItemStateDelivered, model.ItemStateRefused},
+		},
+		{
+			name: "box kept when remaining items do not fit in bag",
+			order: model.Order{
+				Weight:      25,
+				Cost:        3020,
+				PackageType: packageType(model.PackageBox),
+				Items: []model.OrderItem{
+					{ID: 1, Weight: 17, Cost: 2000, State: model.ItemStateDelivered},
+					{ID: 2, Weight: 8, Cost: 1000, State: model.ItemStateAccepted},
+				},
+			},
+			expectedWeight:      17,
+			expectedCost:        2020,
+			expectedPackageType: packageType(model.PackageBox),
+			expectedStates:      []model.OrderItemState{model.ItemStateDelivered, model.ItemStateRefused},
+		},
+		{
+			name: "wrapper kept when box replaced with bag",
+			order: model.Order{
+				Weight:      12,
+				Cost:        321, // 100 + 200 + коробка 20 + обертка 1
+				PackageType: packageType(model.PackageBox),
+				Wrapper:     &wrapper,
+				Items: []model.OrderItem{
+					{ID: 1, Weight: 2, Cost: 100, State: model.ItemStateDelivered},
+					{ID: 2, Weight: 10, Cost: 200, State: model.ItemStateAccepted},
+				},
+			},
+			expectedWeight:      2,
+			expectedCost:        106, // 100 + пакет 5 + обертка 1
+			expectedPackageType: packageType(model.PackageBag),
+			expectedStates:      []model.OrderItemState{model.ItemStateDelivered, model.ItemStateRefused},
+		},
+		{
+			name: "film is not replaced",
+			order: model.Order{
+				Weight:      5,
+				Cost:        301,
+				PackageType: packageType(model.PackageFilm),
+				Items: []model.OrderItem{
+					{ID: 1, Weight: 2, Cost: 100, State: model.ItemStateDelivered},
+					{ID: 2, Weight: 3, Cost: 200, State: model.ItemStateAccepted},
+				},
+			},
+			expectedWeight:      2,
+			expectedCost:        101,
+			expectedPackageType: packageType(model.PackageFilm),
+			expectedStates:      []model.OrderItemState{model.ItemStateDelivered, model.ItemStateRefused},
+		},
+		{
+			name: "order without packaging",
+			order: model.Order{
+				Weight: 5,
+				Cost:   300,
+				Items: []model.OrderItem{
+					{ID: 1, Weight: 2, Cost: 100, State: model.ItemStateDelivered},
+					{ID: 2, Weight: 3, Cost: 200, State: model.ItemStateAccepted},
+				},
+			},
+			expectedWeight: 2,
+			expectedCost:   100,
+			expectedStates: []model.OrderItemState{model.ItemStateDelivered, model.ItemStateRefused},
+		},
+		{
+			name: "nothing refused",
+			order: model.Order{
+				Weight:      25,
+				Cost:        3020,
+				PackageType: packageType(model.PackageBox),
+				Items: []model.OrderItem{
+					{ID: 1, Weight: 8, Cost: 1000, State: model.ItemStateDelivered},
+					{ID: 2, Weight: 17, Cost: 2000, State: model.ItemStateDelivered},
+				},
+			},
+			expectedWeight:      25,
+			expectedCost:        3020,
+			expectedPackageType: packageType(model.PackageBox),
+			expectedStates:      []model.OrderItemState{model.ItemStateDelivered, model.ItemStateDelivered},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			order := tt.order
+			require.NoError(t, refuseItems(&order, now))
+
+			assert.Equal(t, tt.expectedWeight, order.Weight)
+			assert.Equal(t, tt.expectedCost, order.Cost)
+			assert.Equal(t, tt.expectedPackageType, order.PackageType)
+
+			states := make([]model.OrderItemState, 0, len(order.Items))
+			for _, item := range order.Items {
+				states = append(states, item.State)
+			}
+			assert.Equal(t, tt.expectedStates, states)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gitlab.ozon.dev/gojhw1/pkg/logger"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/rbac"
+)
+
+var (
+	// ErrInvalidReturnReason - ошибка, возникающая при неизвестной причине возврата
+	ErrInvalidReturnReason = errors.New("некорректная причина возврата")
+	// ErrInvalidReturnCondition - ошибка, возникающая при неизвестном состоянии возвращаемого заказа
+	ErrInvalidReturnCondition = errors.New("некорректное состояние возвращаемого заказа")
+	// ErrInvalidReturnPhotos - ошибка, возникающая при пустой ссылке на фотографию или слишком большом количестве фотографий
+	ErrInvalidReturnPhotos = errors.New("некорректные фотографии возвращаемого заказа")
+)
+
+// maxReturnPhotos - максимальное количество фотографий, прикладываемых к одному возврату
+const maxReturnPhotos = 10
+
+// normalizeReturnDetails - проверяет сведения о возврате и убирает лишние пробелы из комментария и ссылок на фотографии
+func normalizeReturnDetails(details model.ReturnDetails) (model.ReturnDetails, error) {
+	if !details.Reason.Valid() {
+		return model.ReturnDetails{}, fmt.Errorf("%w: %q", ErrInvalidReturnReason, details.Reason)
+	}
+	if !details.Condition.Valid() {
+		return model.ReturnDetails{}, fmt.Errorf("%w: %q", ErrInvalidReturnCondition, details.Condition)
+	}
+	if len(details.Photos) > maxReturnPhotos {
+		return model.ReturnDetails{}, fmt.Errorf("%w: не больше %d, передано %d", ErrInvalidReturnPhotos, maxReturnPhotos, len(details.Photos))
+	}
+
+	photos := make([]string, 0, len(details.Photos))
+	for _, photo := range details.Photos {
+		photo = strings.TrimSpace(photo)
+		if photo == "" {
+			return model.ReturnDetails{}, fmt.Errorf("%w: пустая ссылка на фотографию", ErrInvalidReturnPhotos)
+		}
+		photos = append(photos, photo)
+	}
+
+	details.Comment = strings.TrimSpace(details.Comment)
+	details.Photos = photos
+
+	return details, nil
+}
+
+// checkReturnFilter - проверяет, что фильтр возвратов содержит только известные причины и состояния
+func checkReturnFilter(filter model.ReturnFilter) error {
+	if filter.Reason != "" && !filter.Reason.Valid() {
+		return fmt.Errorf("%w: %q", ErrInvalidReturnReason, filter.Reason)
+	}
+	if filter.Condition != "" && !filter.Condition.Valid() {
+		return fmt.Errorf("%w: %q", ErrInvalidReturnCondition, filter.Condition)
+	}
+
+	return nil
+}
+
+// newOrderReturn - собирает сведения о возврате заказа order по политике возврата policy.
+// У заказа с товарами удержание и сумма к возврату считаются от стоимости возвращенных товаров.
+func newOrderReturn(ctx context.Context, order model.Order, policy model.ReturnPolicy, details model.ReturnDetails) model.OrderReturn {
+	cost := returnedCost(order)
+	fee := policy.Fee(cost)
+
+	ret := model.OrderReturn{
+		OrderID:            order.ID,
+		CustomerID:         order.CustomerID,
+		ReturnDetails:      details,
+		Policy:             policy.Name,
+		Fee:                fee,
+		Refund:             cost - fee,
+		RequiresInspection: policy.RequiresInspection,
+		ReturnedAt:         *order.ReturnedAt,
+	}
+	for _, item := range order.Items {
+		if item.State == model.ItemStateReturned {
+			ret.ItemIDs = append(ret.ItemIDs, item.ID)
+		}
+	}
+	if policy.ID > 0 {
+		ret.PolicyID = &policy.ID
+	}
+	if user, ok := rbac.UserFromContext(ctx); ok && user.ID > 0 {
+		ret.ReturnedBy = &user.ID
+	}
+
+	return ret
+}
+
+// logReturn - записывает в журнал аудита сведения о возврате и условия, на которых он принят
+func (s *OrderService) logReturn(ctx context.Context, ret model.OrderReturn) {
+	s.logger.Log(ctx, model.AuditLog{
+		Type:      model.AuditLogTypeOrderReturn,
+		Timestamp: ret.ReturnedAt,
+		OrderID:   ret.OrderID,
+		Body:      ret,
+	})
+}
+
+// attachReturns - добавляет к возвращенным заказам сведения о возвратах из БД
+func (s *OrderService) attachReturns(ctx context.Context, orders []model.Order) ([]model.ReturnedOrder, error) {
+	returned := make([]model.ReturnedOrder, 0, len(orders))
+	if len(orders) == 0 {
+		return returned, nil
+	}
+
+	ids := make([]int64, 0, len(orders))
+	for _, order := range orders {
+		ids = append(ids, order.ID)
+	}
+
+	returns, err := s.repo.ListOrderReturns(ctx, ids)
+	if err != nil {
+		logger.Errorf("Ошибка получения сведений о возвратах заказов %v: %v", ids, err)
+		return nil, err
+	}
+
+	byOrder := make(map[int64]*model.OrderReturn, len(returns))
+	for i := range returns {
+		byOrder[returns[i].OrderID] = &returns[i]
+	}
+
+	for _, order := range orders {
+		returned = append(returned, model.ReturnedOrder{Order: order, Return: byOrder[order.ID]})
+	}
+
+	return returned, nil
+}
@@ -0,0 +1,341 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"gitlab.ozon.dev/gojhw1/pkg/logger"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/rbac"
+	"gitlab.ozon.dev/gojhw1/pkg/repository"
+)
+
+var (
+	// ErrInvalidPaymentMode - ошибка, возникающая при неизвестном способе оплаты заказа
+	ErrInvalidPaymentMode = errors.New("неизвестный способ оплаты заказа")
+	// ErrInvalidPaymentMethod - ошибка, возникающая при неизвестном способе расчета с клиентом
+	ErrInvalidPaymentMethod = errors.New("неизвестный способ расчета, используйте cash, card или mixed")
+	// ErrPaymentRequired - ошибка, возникающая при выдаче заказа с оплатой при получении без оплаты
+	ErrPaymentRequired = errors.New("заказ оплачивается при получении, укажите оплату")
+	// ErrPaymentNotRequired - ошибка, возникающая при оплате заказов, которые уже оплачены
+	ErrPaymentNotRequired = errors.New("заказы уже оплачены, оплата при выдаче не требуется")
+	// ErrPaymentAmountMismatch - ошибка, возникающая когда сумма оплаты не совпадает с суммой к оплате
+	ErrPaymentAmountMismatch = errors.New("сумма оплаты не совпадает с суммой к оплате")
+	// ErrInvalidCashAmount - ошибка, возникающая когда при смешанной оплате наличные не являются частью суммы
+	ErrInvalidCashAmount = errors.New("при смешанной оплате наличными оплачивается только часть суммы")
+	// ErrPaymentDeclined - ошибка, возникающая когда платежный терминал отклонил оплату или возврат денег
+	ErrPaymentDeclined = errors.New("платежный терминал отклонил операцию")
+)
+
+// paymentTerminal - платежный терминал ПВЗ, через который проводятся оплата картой и возврат денег на карту
+type paymentTerminal interface {
+	// Charge списывает amount с карты клиента и возвращает ID операции
+	Charge(ctx context.Context, amount float64) (string, error)
+	// Refund возвращает amount на карту клиента по оплате transactionID и возвращает ID операции возврата
+	Refund(ctx context.Context, transactionID string, amount float64) (string, error)
+	// Cancel отменяет проведенную операцию
+	Cancel(ctx context.Context, transactionID string) error
+}
+
+// GetOrderPayments - возвращает оплату заказа и возвраты денег по нему
+func (s *OrderService) GetOrderPayments(ctx context.Context, id int64) (model.OrderPayments, error) {
+	order, err := s.loadOrder(ctx, id)
+	if err != nil {
+		return model.OrderPayments{}, fmt.Errorf("ошибка при получении оплаты заказа Id %d: %w", id, err)
+	}
+
+	if err := checkOrderScope(ctx, order); err != nil {
+		return model.OrderPayments{}, err
+	}
+
+	payments := model.OrderPayments{
+		OrderID:     id,
+		PaymentMode: order.PaymentMode,
+	}
+
+	payment, err := s.repo.GetPayment(ctx, id)
+	switch {
+	case err == nil:
+		payments.Payment = &payment
+	case !errors.Is(err, repository.ErrPaymentNotFound):
+		logger.Errorf("Ошибка получения оплаты заказа %d из БД: %v", id, err)
+		return model.OrderPayments{}, err
+	}
+
+	payments.Refunds, err = s.repo.ListRefunds(ctx, id)
+	if err != nil {
+		logger.Errorf("Ошибка получения возвратов денег по заказу %d из БД: %v", id, err)
+		return model.OrderPayments{}, err
+	}
+	if payments.Refunds == nil {
+		payments.Refunds = []model.Refund{}
+	}
+
+	return payments, nil
+}
+
+// checkPaymentMode - проверяет способ оплаты принимаемого заказа, пустой способ означает предоплату
+func checkPaymentMode(mode model.PaymentMode) (model.PaymentMode, error) {
+	if mode == "" {
+		return model.PaymentModePrepaid, nil
+	}
+	if !mode.Valid() {
+		return "", fmt.Errorf("%w: %q", ErrInvalidPaymentMode, mode)
+	}
+
+	return mode, nil
+}
+
+// capturePayment - проводит оплату выдаваемых заказов: сверяет details с суммой к оплате,
+// списывает безналичную часть через терминал и распределяет оплату между заказами с оплатой при получении.
+// Если среди заказов нет заказов с оплатой при получении, оплата не требуется и возвращается nil.
+func (s *OrderService) capturePayment(ctx context.Context, orders []model.Order, details *model.PaymentDetails, now time.Time) ([]model.Payment, error) {
+	due := amountDue(orders)
+	if due == 0 {
+		if details != nil {
+			return nil, ErrPaymentNotRequired
+		}
+		return nil, nil
+	}
+	if details == nil {
+		logger.Errorf("Выдача заказов с оплатой при получении на сумму %.2f без оплаты", due)
+		return nil, fmt.Errorf("%w: к оплате %.2f", ErrPaymentRequired, due)
+	}
+
+	cash, card, err := splitPayment(*details, due)
+	if err != nil {
+		return nil, err
+	}
+
+	var transactionID *string
+	if card > 0 {
+		id, err := s.terminal.Charge(ctx, card)
+		if err != nil {
+			logger.Errorf("Терминал отклонил оплату картой на сумму %.2f: %v", card, err)
+			return nil, fmt.Errorf("%w: %v", ErrPaymentDeclined, err)
+		}
+		transactionID = &id
+	}
+
+	var operatorID *int64
+	if user, ok := rbac.UserFromContext(ctx); ok && user.ID > 0 {
+		operatorID = &user.ID
+	}
+
+	return allocatePayment(orders, cash, transactionID, operatorID, now), nil
+}
+
+// amountDue - возвращает сумму к оплате при выдаче заказов: стоимость заказов с оплатой при получении
+func amountDue(orders []model.Order) float64 {
+	var due float64
+	for _, order := range orders {
+		if order.PaymentMode == model.PaymentModeOnPickup {
+			due += order.Cost
+		}
+	}
+
+	return roundCents(due)
+}
+
+// splitPayment - проверяет оплату details на сумму due и возвращает ее наличную и безналичную части
+func splitPayment(details model.PaymentDetails, due float64) (float64, float64, error) {
+	amount := roundCents(details.Amount)
+	if amount != due {
+		return 0, 0, fmt.Errorf("%w: к оплате %.2f, оплачено %.2f", ErrPaymentAmountMismatch, due, amount)
+	}
+
+	switch details.Method {
+	case model.PaymentMethodCash:
+		return amount, 0, nil
+	case model.PaymentMethodCard:
+		return 0, amount, nil
+	case model.PaymentMethodMixed:
+		cash := roundCents(details.CashAmount)
+		if cash <= 0 || cash >= amount {
+			return 0, 0, fmt.Errorf("%w: наличными %.2f из %.2f", ErrInvalidCashAmount, cash, amount)
+		}
+		return cash, roundCents(amount - cash), nil
+	default:
+		return 0, 0, fmt.Errorf("%w: %q", ErrInvalidPaymentMethod, details.Method)
+	}
+}
+
+// allocatePayment - распределяет наличную часть оплаты cash между заказами с оплатой при получении,
+// остаток стоимости каждого заказа оплачивается картой операцией transactionID
+func allocatePayment(orders []model.Order, cash float64, transactionID *string, operatorID *int64, now time.Time) []model.Payment {
+	payments := make([]model.Payment, 0, len(orders))
+
+	for _, order := range orders {
+		if order.PaymentMode != model.PaymentModeOnPickup || order.Cost <= 0 {
+			continue
+		}
+
+		payment := model.Payment{
+			OrderID:    order.ID,
+			Amount:     order.Cost,
+			CashAmount: roundCents(math.Min(cash, order.Cost)),
+			OperatorID: operatorID,
+			PaidAt:     now,
+		}
+		payment.CardAmount = roundCents(payment.Amount - payment.CashAmount)
+		cash = roundCents(cash - payment.CashAmount)
+
+		payment.Method = paymentMethod(payment.CashAmount, payment.CardAmount)
+		if payment.CardAmount > 0 {
+			payment.TransactionID = transactionID
+		}
+
+		payments = append(payments, payment)
+	}
+
+	return payments
+}
+
+// refundReturns - возвращает деньги за возвращенные заказы.
+// По заказам с оплатой при получении деньги сначала возвращаются на карту через терминал в пределах оплаты картой,
+// остаток - наличными. По предоплаченным заказам деньги возвращаются онлайн.
+// Если терминал отклонил возврат, уже проведенные возвраты отменяются.
+func (s *OrderService) refundReturns(ctx context.Context, orders []model.Order, returns []model.OrderReturn, now time.Time) ([]model.Refund, error) {
+	modes := make(map[int64]model.PaymentMode, len(orders))
+	paidIDs := make([]int64, 0, len(orders))
+	for _, order := range orders {
+		modes[order.ID] = order.PaymentMode
+		if order.PaymentMode == model.PaymentModeOnPickup {
+			paidIDs = append(paidIDs, order.ID)
+		}
+	}
+
+	payments := make(map[int64]model.Payment, len(paidIDs))
+	if len(paidIDs) > 0 {
+		list, err := s.repo.ListPayments(ctx, paidIDs)
+		if err != nil {
+			logger.Errorf("Ошибка получения оплат заказов %v из БД: %v", paidIDs, err)
+			return nil, err
+		}
+		for _, payment := range list {
+			payments[payment.OrderID] = payment
+		}
+	}
+
+	var operatorID *int64
+	if user, ok := rbac.UserFromContext(ctx); ok && user.ID > 0 {
+		operatorID = &user.ID
+	}
+
+	refunds := make([]model.Refund, 0, len(returns))
+	for _, ret := range returns {
+		amount := roundCents(ret.Refund)
+		if amount <= 0 {
+			continue
+		}
+
+		refund := model.Refund{
+			OrderID:    ret.OrderID,
+			Method:     model.PaymentMethodOnline,
+			Amount:     amount,
+			OperatorID: operatorID,
+			RefundedAt: now,
+		}
+
+		if modes[ret.OrderID] == model.PaymentModeOnPickup {
+			payment, ok := payments[ret.OrderID]
+			if !ok {
+				logger.Warnf("Оплата заказа %d не найдена, деньги возвращаются наличными", ret.OrderID)
+			} else {
+				refund.PaymentID = &payment.ID
+				refund.CardAmount = roundCents(math.Min(amount, payment.CardAmount))
+			}
+			refund.CashAmount = roundCents(amount - refund.CardAmount)
+			refund.Method = paymentMethod(refund.CashAmount, refund.CardAmount)
+
+			if refund.CardAmount > 0 && payment.TransactionID != nil {
+				id, err := s.terminal.Refund(ctx, *payment.TransactionID, refund.CardAmount)
+				if err != nil {
+					logger.Errorf("Терминал отклонил возврат %.2f на карту по заказу %d: %v", refund.CardAmount, ret.OrderID, err)
+					s.cancelRefunds(ctx, refunds)
+					return nil, fmt.Errorf("%w: заказ %d: %v", ErrPaymentDeclined, ret.OrderID, err)
+				}
+				refund.TransactionID = &id
+			}
+		}
+
+		refunds = append(refunds, refund)
+	}
+
+	return refunds, nil
+}
+
+// paymentMethod - возвращает способ расчета по наличной и безналичной частям суммы
+func paymentMethod(cash, card float64) model.PaymentMethod {
+	switch {
+	case card <= 0:
+		return model.PaymentMethodCash
+	case cash <= 0:
+		return model.PaymentMethodCard
+	default:
+		return model.PaymentMethodMixed
+	}
+}
+
+// cancelPayments - отменяет операцию терминала по оплатам, которые не удалось сохранить в БД.
+// Оплаты нескольких заказов, выданных вместе, проводятся одной операцией и отменяются один раз.
+func (s *OrderService) cancelPayments(ctx context.Context, payments []model.Payment) {
+	canceled := make(map[string]struct{}, 1)
+	for _, payment := range payments {
+		if payment.TransactionID == nil {
+			continue
+		}
+		if _, ok := canceled[*payment.TransactionID]; ok {
+			continue
+		}
+		canceled[*payment.TransactionID] = struct{}{}
+
+		s.cancelTransaction(ctx, *payment.TransactionID)
+	}
+}
+
+// cancelRefunds - отменяет операции терминала по возвратам денег, которые не удалось сохранить в БД
+func (s *OrderService) cancelRefunds(ctx context.Context, refunds []model.Refund) {
+	for _, refund := range refunds {
+		if refund.TransactionID != nil {
+			s.cancelTransaction(ctx, *refund.TransactionID)
+		}
+	}
+}
+
+// cancelTransaction - отменяет операцию терминала, ошибка отмены только записывается в лог
+func (s *OrderService) cancelTransaction(ctx context.Context, transactionID string) {
+	if err := s.terminal.Cancel(ctx, transactionID); err != nil {
+		logger.Errorf("Ошибка отмены операции терминала %s: %v", transactionID, err)
+		return
+	}
+
+	logger.Warnf("Операция терминала %s отменена", transactionID)
+}
+
+// logPayments - записывает оплаты заказов в журнал аудита
+func (s *OrderService) logPayments(ctx context.Context, payments []model.Payment) {
+	for _, payment := range payments {
+		s.logger.Log(ctx, model.AuditLog{
+			Type:      model.AuditLogTypePayment,
+			Timestamp: payment.PaidAt,
+			OrderID:   payment.OrderID,
+			Body:      payment,
+		})
+	}
+}
+
+// logRefunds - записывает возвраты денег за возвращенные заказы в журнал аудита
+func (s *OrderService) logRefunds(ctx context.Context, refunds []model.Refund) {
+	for _, refund := range refunds {
+		s.logger.Log(ctx, model.AuditLog{
+			Type:      model.AuditLogTypeRefund,
+			Timestamp: refund.RefundedAt,
+			OrderID:   refund.OrderID,
+			Body:      refund,
+		})
+	}
+}
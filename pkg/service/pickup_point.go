@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gitlab.ozon.dev/gojhw1/pkg/logger"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/rbac"
+)
+
+var (
+	// ErrPickupPointNotAssigned - ошибка, возникающая когда сотрудник не привязан ни к одному ПВЗ
+	ErrPickupPointNotAssigned = errors.New("пользователь не привязан к ПВЗ")
+	// ErrPickupPointRequired - ошибка, возникающая когда для операции не указан ПВЗ
+	ErrPickupPointRequired = errors.New("не указан ПВЗ")
+	// ErrForeignPickupPoint - ошибка, возникающая при попытке работать с заказом чужого ПВЗ
+	ErrForeignPickupPoint = errors.New("заказ относится к другому ПВЗ")
+)
+
+// scopePickupPoint возвращает ПВЗ, которым ограничены операции вызывающего пользователя.
+// Ноль означает доступ ко всем ПВЗ: так работают администратор без привязки
+// и внутренние вызовы без пользователя в контексте.
+func scopePickupPoint(ctx context.Context) (int64, error) {
+	user, ok := rbac.UserFromContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+
+	if user.PickupPointID != nil {
+		return *user.PickupPointID, nil
+	}
+
+	if user.Role == model.RoleAdmin {
+		return 0, nil
+	}
+
+	return 0, fmt.Errorf("%w: %s", ErrPickupPointNotAssigned, user.Username)
+}
+
+// resolvePickupPoint определяет ПВЗ, в который принимается заказ.
+// Сотрудник принимает заказы только в свой ПВЗ, администратор и внутренние вызовы
+// должны указать ПВЗ явно.
+func resolvePickupPoint(ctx context.Context, requested int64) (int64, error) {
+	scope, err := scopePickupPoint(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if scope == 0 {
+		if requested <= 0 {
+			return 0, ErrPickupPointRequired
+		}
+		return requested, nil
+	}
+
+	if requested > 0 && requested != scope {
+		return 0, fmt.Errorf("%w: ПВЗ %d", ErrForeignPickupPoint, requested)
+	}
+
+	return scope, nil
+}
+
+// checkOrderScope проверяет, что заказ относится к ПВЗ вызывающего пользователя
+func checkOrderScope(ctx context.Context, order model.Order) error {
+	scope, err := scopePickupPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	if scope != 0 && order.PickupPointID != scope {
+		logger.Errorf("Заказ %d относится к ПВЗ %d, пользователь работает в ПВЗ %d", order.ID, order.PickupPointID, scope)
+		return fmt.Errorf("%w: ID %d", ErrForeignPickupPoint, order.ID)
+	}
+
+	return nil
+}
+
+// filterByPickupPoint оставляет только заказы указанного ПВЗ
+func filterByPickupPoint(orders []model.Order, pickupPointID int64) []model.Order {
+	filtered := make([]model.Order, 0, len(orders))
+	for _, order := range orders {
+		if order.PickupPointID == pickupPointID {
+			filtered = append(filtered, order)
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gitlab.ozon.dev/gojhw1/pkg/logger"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/repository"
+)
+
+var (
+	// ErrEmptyReturnPolicyName - ошибка, возникающая при создании политики возврата без названия
+	ErrEmptyReturnPolicyName = errors.New("название политики возврата не может быть пустым")
+	// ErrInvalidReturnWindow - ошибка, возникающая при неположительном сроке возврата у возвратной политики
+	ErrInvalidReturnWindow = errors.New("срок возврата должен быть положительным числом часов")
+	// ErrInvalidReturnFee - ошибка, возникающая когда удержание при возврате выходит за пределы от 0 до 100 процентов
+	ErrInvalidReturnFee = errors.New("удержание при возврате должно быть от 0 до 100 процентов")
+	// ErrEmptyCustomerSegment - ошибка, возникающая когда сегмент клиента состоит из одних пробелов
+	ErrEmptyCustomerSegment = errors.New("сегмент клиента не может быть пустым")
+	// ErrOrderNotReturnable - ошибка, возникающая при возврате заказа, который по политике возврата не подлежит возврату
+	ErrOrderNotReturnable = errors.New("заказ не подлежит возврату")
+)
+
+// defaultReturnPolicy - политика возврата для заказов, к которым не подходит ни одна политика из БД
+var defaultReturnPolicy = model.ReturnPolicy{
+	Name:              "По умолчанию",
+	ReturnWindowHours: int(ReturnedAt / time.Hour),
+}
+
+type returnPolicyRepository interface {
+	Create(ctx context.Context, policy model.ReturnPolicy) (model.ReturnPolicy, error)
+	Update(ctx context.Context, policy model.ReturnPolicy) error
+	Delete(ctx context.Context, id int64) error
+	GetByID(ctx context.Context, id int64) (model.ReturnPolicy, error)
+	List(ctx context.Context) ([]model.ReturnPolicy, error)
+	SetCustomerSegment(ctx context.Context, segment model.CustomerSegment) error
+	DeleteCustomerSegment(ctx context.Context, customerID int64) error
+}
+
+type returnPolicyMatcher interface {
+	Match(ctx context.Context, packageType *model.PackageType, customerID int64) (model.ReturnPolicy, error)
+}
+
+// ReturnPolicyService - сервис настройки политик возврата и сегментов клиентов
+type ReturnPolicyService struct {
+	policies returnPolicyRepository
+}
+
+// NewReturnPolicyService - создаёт новый сервис политик возврата
+func NewReturnPolicyService(policies returnPolicyRepository) *ReturnPolicyService {
+	return &ReturnPolicyService{policies: policies}
+}
+
+// CreatePolicy - создает политику возврата.
+// Для каждого сочетания упаковки и сегмента клиентов может быть только одна политика.
+func (s *ReturnPolicyService) CreatePolicy(ctx context.Context, policy model.ReturnPolicy) (model.ReturnPolicy, error) {
+	policy, err := normalizeReturnPolicy(policy)
+	if err != nil {
+		return model.ReturnPolicy{}, err
+	}
+
+	created, err := s.policies.Create(ctx, policy)
+	if err != nil {
+		logger.Errorf("Ошибка создания политики возврата %s: %v", policy.Name, err)
+		return model.ReturnPolicy{}, err
+	}
+
+	logger.Infof("Создана политика возврата %d (%s)", created.ID, created.Name)
+	return created, nil
+}
+
+// UpdatePolicy - заменяет все параметры политики возврата и возвращает измененную политику
+func (s *ReturnPolicyService) UpdatePolicy(ctx context.Context, policy model.ReturnPolicy) (model.ReturnPolicy, error) {
+	policy, err := normalizeReturnPolicy(policy)
+	if err != nil {
+		return model.ReturnPolicy{}, err
+	}
+
+	if err := s.policies.Update(ctx, policy); err != nil {
+		logger.Errorf("Ошибка изменения политики возврата %d: %v", policy.ID, err)
+		return model.ReturnPolicy{}, err
+	}
+
+	logger.Infof("Политика возврата %d изменена", policy.ID)
+	return s.policies.GetByID(ctx, policy.ID)
+}
+
+// DeletePolicy - удаляет политику возврата
+func (s *ReturnPolicyService) DeletePolicy(ctx context.Context, id int64) error {
+	if err := s.policies.Delete(ctx, id); err != nil {
+		logger.Errorf("Ошибка удаления политики возврата %d: %v", id, err)
+		return err
+	}
+
+	logger.Infof("Политика возврата %d удалена", id)
+	return nil
+}
+
+// GetPolicy - возвращает политику возврата по ID
+func (s *ReturnPolicyService) GetPolicy(ctx context.Context, id int64) (model.ReturnPolicy, error) {
+	return s.policies.GetByID(ctx, id)
+}
+
+// ListPolicies - возвращает все политики возврата
+func (s *ReturnPolicyService) ListPolicies(ctx context.Context) ([]model.ReturnPolicy, error) {
+	policies, err := s.policies.List(ctx)
+	if err != nil {
+		logger.Errorf("Ошибка получения списка политик возврата: %v", err)
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// SetCustomerSegment - относит клиента к сегменту. Пустой сегмент снимает клиента с сегмента,
+// и к его заказам применяются политики, не привязанные к сегменту.
+func (s *ReturnPolicyService) SetCustomerSegment(ctx context.Context, customerID int64, segment string) (model.CustomerSegment, error) {
+	if customerID <= 0 {
+		return model.CustomerSegment{}, fmt.Errorf("%w: %d", repository.ErrInvalidCustomerID, customerID)
+	}
+
+	customer := model.CustomerSegment{
+		CustomerID: customerID,
+		Segment:    strings.TrimSpace(segment),
+		UpdatedAt:  time.Now(),
+	}
+
+	if segment == "" {
+		if err := s.policies.DeleteCustomerSegment(ctx, customerID); err != nil {
+			logger.Errorf("Ошибка удаления сегмента клиента %d: %v", customerID, err)
+			return model.CustomerSegment{}, err
+		}
+		logger.Infof("Клиент %d снят с сегмента", customerID)
+		return customer, nil
+	}
+	if customer.Segment == "" {
+		return model.CustomerSegment{}, ErrEmptyCustomerSegment
+	}
+
+	if err := s.policies.SetCustomerSegment(ctx, customer); err != nil {
+		logger.Errorf("Ошибка сохранения сегмента клиента %d: %v", customerID, err)
+		return model.CustomerSegment{}, err
+	}
+
+	logger.Infof("Клиент %d отнесен к сегменту %s", customerID, customer.Segment)
+	return customer, nil
+}
+
+// normalizeReturnPolicy - проверяет параметры политики возврата и убирает лишние пробелы.
+// Пустой сегмент означает, что политика действует для клиентов любого сегмента.
+func normalizeReturnPolicy(policy model.ReturnPolicy) (model.ReturnPolicy, error) {
+	policy.Name = strings.TrimSpace(policy.Name)
+	if policy.Name == "" {
+		return model.ReturnPolicy{}, ErrEmptyReturnPolicyName
+	}
+	if policy.ReturnWindowHours < 0 || (policy.ReturnWindowHours == 0 && !policy.NonReturnable) {
+		return model.ReturnPolicy{}, fmt.Errorf("%w: %d", ErrInvalidReturnWindow, policy.ReturnWindowHours)
+	}
+	if policy.FeePercent < 0 || policy.FeePercent > 100 {
+		return model.ReturnPolicy{}, fmt.Errorf("%w: %v", ErrInvalidReturnFee, policy.FeePercent)
+	}
+	if policy.PackageType != nil {
+		if _, err := newPackagerFactory().createPackager(policy.PackageType, nil); err != nil {
+			return model.ReturnPolicy{}, err
+		}
+	}
+	if policy.CustomerSegment != nil {
+		segment := strings.TrimSpace(*policy.CustomerSegment)
+		if segment == "" {
+			policy.CustomerSegment = nil
+		} else {
+			policy.CustomerSegment = &segment
+		}
+	}
+
+	return policy, nil
+}
+
+// returnPolicy - выбирает политику возврата заказа по его упаковке и сегменту клиента.
+// Если ни одна политика не подходит, действует срок возврата по умолчанию.
+func (s *OrderService) returnPolicy(ctx context.Context, order model.Order) (model.ReturnPolicy, error) {
+	policy, err := s.policies.Match(ctx, order.PackageType, order.CustomerID)
+	if errors.Is(err, repository.ErrReturnPolicyNotFound) {
+		return defaultReturnPolicy, nil
+	}
+	if err != nil {
+		logger.Errorf("Ошибка выбора политики возврата заказа %d: %v", order.ID, err)
+		return model.ReturnPolicy{}, err
+	}
+
+	return policy, nil
+}
@@ -0,0 +1,293 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.ozon.dev/gojhw1/pkg/logger"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/rbac"
+	"gitlab.ozon.dev/gojhw1/pkg/repository"
+)
+
+// ErrInvalidShiftCash - ошибка, возникающая при отрицательной сумме наличных в кассе
+var ErrInvalidShiftCash = errors.New("сумма наличных в кассе не может быть отрицательной")
+
+type shiftRepository interface {
+	Open(ctx context.Context, shift model.Shift) (model.Shift, error)
+	Close(ctx context.Context, shift model.Shift) error
+	GetByID(ctx context.Context, id int64) (model.Shift, error)
+	GetOpen(ctx context.Context, pickupPointID int64) (model.Shift, error)
+	ListWithCursor(ctx context.Context, cursorID int64, limit int, pickupPointID int64) ([]model.Shift, error)
+	ListOperatorTotals(ctx context.Context, pickupPointID int64, from, to time.Time) ([]model.ShiftOperatorTotals, error)
+}
+
+// ShiftService - сервис смен ПВЗ и сверки кассы при их закрытии
+type ShiftService struct {
+	shifts shiftRepository
+	logger auditLogger
+}
+
+// NewShiftService - создаёт новый сервис смен
+func NewShiftService(shifts shiftRepository, logger auditLogger) *ShiftService {
+	return &ShiftService{
+		shifts: shifts,
+		logger: logger,
+	}
+}
+
+// OpenShift - открывает смену в ПВЗ с openingCash наличных в кассе.
+// Сотрудник открывает смену в своем ПВЗ, администратор должен указать ПВЗ явно.
+func (s *ShiftService) OpenShift(ctx context.Context, pickupPointID int64, openingCash float64) (model.Shift, error) {
+	if openingCash < 0 {
+		return model.Shift{}, fmt.Errorf("%w: %.2f", ErrInvalidShiftCash, openingCash)
+	}
+
+	pickupPointID, err := resolvePickupPoint(ctx, pickupPointID)
+	if err != nil {
+		return model.Shift{}, err
+	}
+
+	shift := model.Shift{
+		PickupPointID: pickupPointID,
+		OpeningCash:   roundCents(openingCash),
+		OpenedAt:      time.Now(),
+	}
+	if user, ok := rbac.UserFromContext(ctx); ok && user.ID > 0 {
+		shift.OpenedBy = &user.ID
+	}
+
+	opened, err := s.shifts.Open(ctx, shift)
+	if err != nil {
+		logger.Errorf("Ошибка открытия смены в ПВЗ %d: %v", pickupPointID, err)
+		return model.Shift{}, err
+	}
+
+	s.logger.Log(ctx, model.AuditLog{
+		Type:      model.AuditLogTypeShiftOpen,
+		Timestamp: opened.OpenedAt,
+		Body: map[string]any{
+			"shift_id":        opened.ID,
+			"pickup_point_id": opened.PickupPointID,
+			"opening_cash":    opened.OpeningCash,
+		},
+	})
+	logger.Infof("Открыта смена %d в ПВЗ %d", opened.ID, opened.PickupPointID)
+
+	return opened, nil
+}
+
+// CurrentShift - возвращает открытую смену ПВЗ с предварительным отчетом сверки на текущий момент
+func (s *ShiftService) CurrentShift(ctx context.Context, pickupPointID int64) (model.Shift, error) {
+	pickupPointID, err := resolvePickupPoint(ctx, pickupPointID)
+	if err != nil {
+		return model.Shift{}, err
+	}
+
+	shift, err := s.shifts.GetOpen(ctx, pickupPointID)
+	if err != nil {
+		return model.Shift{}, err
+	}
+
+	report, err := s.shiftReport(ctx, shift)
+	if err != nil {
+		return model.Shift{}, err
+	}
+	shift.Report = &report
+
+	return shift, nil
+}
+
+// GetShift - возвращает смену ПВЗ вызывающего пользователя.
+// У открытой смены отчет сверки предварительный, у закрытой - сохраненный при закрытии.
+func (s *ShiftService) GetShift(ctx context.Context, id int64) (model.Shift, error) {
+	shift, err := s.loadShift(ctx, id)
+	if err != nil {
+		return model.Shift{}, err
+	}
+
+	report, err := s.shiftReport(ctx, shift)
+	if err != nil {
+		return model.Shift{}, err
+	}
+	shift.Report = &report
+
+	return shift, nil
+}
+
+// ListShiftsWithCursor - возвращает смены ПВЗ вызывающего пользователя без отчетов сверки, новые смены первыми
+func (s *ShiftService) ListShiftsWithCursor(ctx context.Context, cursorID int64, limit int) ([]model.Shift, error) {
+	pickupPointID, err := scopePickupPoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shifts, err := s.shifts.ListWithCursor(ctx, cursorID, limit, pickupPointID)
+	if err != nil {
+		logger.Errorf("Ошибка получения списка смен ПВЗ %d: %v", pickupPointID, err)
+		return nil, err
+	}
+
+	for i := range shifts {
+		shifts[i].Report = nil
+	}
+
+	return shifts, nil
+}
+
+// ShiftReport - возвращает отчет сверки смены
+func (s *ShiftService) ShiftReport(ctx context.Context, id int64) (model.ShiftReport, error) {
+	shift, err := s.loadShift(ctx, id)
+	if err != nil {
+		return model.ShiftReport{}, err
+	}
+
+	return s.shiftReport(ctx, shift)
+}
+
+// CloseShift - закрывает смену: собирает итоги операций ПВЗ с начала смены по каждому сотруднику,
+// сверяет пересчитанные наличные countedCash с ожидаемыми и сохраняет окончательный отчет.
+// После закрытия смена и ее отчет не меняются.
+func (s *ShiftService) CloseShift(ctx context.Context, id int64, countedCash float64) (model.Shift, error) {
+	if countedCash < 0 {
+		return model.Shift{}, fmt.Errorf("%w: %.2f", ErrInvalidShiftCash, countedCash)
+	}
+
+	shift, err := s.loadShift(ctx, id)
+	if err != nil {
+		return model.Shift{}, err
+	}
+	if shift.State == model.ShiftStateClosed {
+		logger.Errorf("Смена %d уже закрыта: %v", id, shift.ClosedAt)
+		return model.Shift{}, fmt.Errorf("%w: ID %d", repository.ErrShiftClosed, id)
+	}
+
+	now := time.Now()
+
+	operators, err := s.shifts.ListOperatorTotals(ctx, shift.PickupPointID, shift.OpenedAt, now)
+	if err != nil {
+		logger.Errorf("Ошибка получения итогов смены %d: %v", id, err)
+		return model.Shift{}, err
+	}
+
+	counted := roundCents(countedCash)
+	report := buildShiftReport(shift, operators, now)
+	report.Final = true
+	report.CountedCash = &counted
+	difference := roundCents(counted - report.ExpectedCash)
+	report.CashDifference = &difference
+
+	shift.State = model.ShiftStateClosed
+	shift.CountedCash = &counted
+	shift.ClosedAt = &now
+	shift.Report = &report
+	if user, ok := rbac.UserFromContext(ctx); ok && user.ID > 0 {
+		shift.ClosedBy = &user.ID
+	}
+
+	if err := s.shifts.Close(ctx, shift); err != nil {
+		logger.Errorf("Ошибка закрытия смены %d: %v", id, err)
+		return model.Shift{}, err
+	}
+
+	s.logger.Log(ctx, model.AuditLog{
+		Type:      model.AuditLogTypeShiftClose,
+		Timestamp: now,
+		Body: map[string]any{
+			"shift_id":        shift.ID,
+			"pickup_point_id": shift.PickupPointID,
+			"expected_cash":   report.ExpectedCash,
+			"counted_cash":    counted,
+			"cash_difference": difference,
+			"totals":          report.Totals,
+		},
+	})
+	logger.Infof("Смена %d в ПВЗ %d закрыта, расхождение по кассе %.2f", shift.ID, shift.PickupPointID, difference)
+
+	return shift, nil
+}
+
+// loadShift - получает смену и проверяет, что она относится к ПВЗ вызывающего пользователя
+func (s *ShiftService) loadShift(ctx context.Context, id int64) (model.Shift, error) {
+	shift, err := s.shifts.GetByID(ctx, id)
+	if err != nil {
+		return model.Shift{}, err
+	}
+
+	scope, err := scopePickupPoint(ctx)
+	if err != nil {
+		return model.Shift{}, err
+	}
+	if scope != 0 && shift.PickupPointID != scope {
+		logger.Errorf("Смена %d относится к ПВЗ %d, пользователь работает в ПВЗ %d", id, shift.PickupPointID, scope)
+		return model.Shift{}, fmt.Errorf("%w: смена %d", ErrForeignPickupPoint, id)
+	}
+
+	return shift, nil
+}
+
+// shiftReport - возвращает сохраненный отчет закрытой смены или предварительный отчет открытой смены на текущий момент
+func (s *ShiftService) shiftReport(ctx context.Context, shift model.Shift) (model.ShiftReport, error) {
+	if shift.State == model.ShiftStateClosed && shift.Report != nil {
+		return *shift.Report, nil
+	}
+
+	to := time.Now()
+	if shift.ClosedAt != nil {
+		to = *shift.ClosedAt
+	}
+
+	operators, err := s.shifts.ListOperatorTotals(ctx, shift.PickupPointID, shift.OpenedAt, to)
+	if err != nil {
+		logger.Errorf("Ошибка получения итогов смены %d: %v", shift.ID, err)
+		return model.ShiftReport{}, err
+	}
+
+	return buildShiftReport(shift, operators, to), nil
+}
+
+// buildShiftReport - собирает отчет сверки смены по итогам сотрудников на момент to.
+// Ожидаемые наличные - наличные при открытии смены плюс полученные и минус возвращенные наличными.
+func buildShiftReport(shift model.Shift, operators []model.ShiftOperatorTotals, to time.Time) model.ShiftReport {
+	if operators == nil {
+		operators = []model.ShiftOperatorTotals{}
+	}
+
+	report := model.ShiftReport{
+		ShiftID:       shift.ID,
+		PickupPointID: shift.PickupPointID,
+		From:          shift.OpenedAt,
+		To:            to,
+		Operators:     operators,
+		OpeningCash:   shift.OpeningCash,
+	}
+
+	totals := &report.Totals
+	for _, operator := range operators {
+		totals.Handouts += operator.Handouts
+		totals.HandoutAmount += operator.HandoutAmount
+		totals.Payments += operator.Payments
+		totals.CashReceived += operator.CashReceived
+		totals.CardReceived += operator.CardReceived
+		totals.Returns += operator.Returns
+		totals.ReturnAmount += operator.ReturnAmount
+		totals.CashRefunded += operator.CashRefunded
+		totals.CardRefunded += operator.CardRefunded
+		totals.OnlineRefunded += operator.OnlineRefunded
+		totals.CourierReturns += operator.CourierReturns
+	}
+
+	totals.HandoutAmount = roundCents(totals.HandoutAmount)
+	totals.CashReceived = roundCents(totals.CashReceived)
+	totals.CardReceived = roundCents(totals.CardReceived)
+	totals.ReturnAmount = roundCents(totals.ReturnAmount)
+	totals.CashRefunded = roundCents(totals.CashRefunded)
+	totals.CardRefunded = roundCents(totals.CardRefunded)
+	totals.OnlineRefunded = roundCents(totals.OnlineRefunded)
+
+	report.ExpectedCash = roundCents(shift.OpeningCash + totals.CashReceived - totals.CashRefunded)
+
+	return report
+}
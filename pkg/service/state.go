@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+	"gitlab.ozon.dev/gojhw1/pkg/rbac"
+)
+
+// ErrInvalidTransition - ошибка, возникающая при недопустимой смене статуса заказа
+var ErrInvalidTransition = errors.New("недопустимая смена статуса заказа")
+
+// orderTransitions - допустимые переходы между статусами заказа.
+// Статусы, из которых нет переходов, конечные: заказ покинул ПВЗ и больше не меняется.
+var orderTransitions = map[model.OrderState][]model.OrderState{
+	model.StateAccepted:  {model.StateDelivered, model.StateReturnedToCourier, model.StateExpired, model.StateLost},
+	model.StateDelivered: {model.StateReturned},
+	model.StateReturned:  {model.StateReturnedToCourier, model.StateLost},
+	model.StateExpired:   {model.StateReturnedToCourier, model.StateLost},
+}
+
+// isTerminalState проверяет, что из статуса нет переходов
+func isTerminalState(state model.OrderState) bool {
+	return len(orderTransitions[state]) == 0
+}
+
+// checkTransition проверяет, что заказ можно перевести из статуса from в статус to.
+// Для привычных сценариев возвращает прежние ошибки, чтобы клиенты получали понятное сообщение.
+func checkTransition(from, to model.OrderState) error {
+	if slices.Contains(orderTransitions[from], to) {
+		return nil
+	}
+
+	var err error
+	switch {
+	case to == model.StateDelivered:
+		err = ErrWrongState
+	case to == model.StateReturned:
+		err = ErrNotDelivered
+	case from == model.StateDelivered && to == model.StateReturnedToCourier:
+		err = ErrOrderAlreadyDelivered
+	default:
+		err = ErrInvalidTransition
+	}
+
+	return fmt.Errorf("%w: %s -> %s", err, from, to)
+}
+
+// newTransition создает запись о смене статуса заказа от имени вызывающего пользователя
+func newTransition(ctx context.Context, orderID int64, from *model.OrderState, to model.OrderState, now time.Time) model.OrderStateTransition {
+	transition := model.OrderStateTransition{
+		OrderID:   orderID,
+		FromState: from,
+		ToState:   to,
+		ChangedAt: now,
+	}
+
+	if user, ok := rbac.UserFromContext(ctx); ok && user.ID > 0 {
+		transition.ChangedBy = &user.ID
+	}
+
+	return transition
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gitlab.ozon.dev/gojhw1/pkg/logger"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
+)
+
+var (
+	// ErrEmptyCellCode - ошибка, возникающая при создании ячейки без стеллажа или кода
+	ErrEmptyCellCode = errors.New("стеллаж и код ячейки не могут быть пустыми")
+	// ErrInvalidCellCapacity - ошибка, возникающая при неположительной вместимости ячейки
+	ErrInvalidCellCapacity = errors.New("вместимость ячейки должна быть положительным числом")
+	// ErrInvalidCellMaxWeight - ошибка, возникающая при неположительном допустимом весе ячейки
+	ErrInvalidCellMaxWeight = errors.New("допустимый вес ячейки должен быть положительным числом")
+)
+
+type storageCellManager interface {
+	Create(ctx context.Context, cell model.StorageCell) (model.StorageCell, error)
+	Delete(ctx context.Context, id int64) error
+	ListOccupancy(ctx context.Context, pickupPointID int64) ([]model.CellOccupancy, error)
+}
+
+// StorageService - сервис настройки ячеек хранения и просмотра их заполненности
+type StorageService struct {
+	cells storageCellManager
+}
+
+// NewStorageService - создаёт новый сервис ячеек хранения
+func NewStorageService(cells storageCellManager) *StorageService {
+	return &StorageService{cells: cells}
+}
+
+// CreateCell - создает ячейку хранения в ПВЗ.
+// Если тип упаковки не указан, ячейка принимает заказы в любой упаковке и без нее.
+func (s *StorageService) CreateCell(ctx context.Context, cell model.StorageCell) (model.StorageCell, error) {
+	cell.Rack = strings.TrimSpace(cell.Rack)
+	cell.Code = strings.TrimSpace(cell.Code)
+
+	if cell.Rack == "" || cell.Code == "" {
+		return model.StorageCell{}, ErrEmptyCellCode
+	}
+	if cell.Capacity <= 0 {
+		return model.StorageCell{}, fmt.Errorf("%w: %d", ErrInvalidCellCapacity, cell.Capacity)
+	}
+	if cell.MaxWeight <= 0 {
+		return model.StorageCell{}, fmt.Errorf("%w: %v", ErrInvalidCellMaxWeight, cell.MaxWeight)
+	}
+	if cell.PackageType != nil {
+		if _, err := newPackagerFactory().createPackager(cell.PackageType, nil); err != nil {
+			return model.StorageCell{}, err
+		}
+	}
+
+	pickupPointID, err := resolvePickupPoint(ctx, cell.PickupPointID)
+	if err != nil {
+		return model.StorageCell{}, err
+	}
+	cell.PickupPointID = pickupPointID
+
+	created, err := s.cells.Create(ctx, cell)
+	if err != nil {
+		logger.Errorf("Ошибка создания ячейки %s в ПВЗ %d: %v", cell.Code, cell.PickupPointID, err)
+		return model.StorageCell{}, err
+	}
+
+	logger.Infof("Создана ячейка %s (стеллаж %s) в ПВЗ %d", created.Code, created.Rack, created.PickupPointID)
+	return created, nil
+}
+
+// DeleteCell - удаляет пустую ячейку хранения
+func (s *StorageService) DeleteCell(ctx context.Context, id int64) error {
+	if err := s.cells.Delete(ctx, id); err != nil {
+		logger.Errorf("Ошибка удаления ячейки %d: %v", id, err)
+		return err
+	}
+
+	logger.Infof("Ячейка %d удалена", id)
+	return nil
+}
+
+// Occupancy - возвращает заполненность ячеек ПВЗ вызывающего пользователя
+func (s *StorageService) Occupancy(ctx context.Context) ([]model.CellOccupancy, error) {
+	pickupPointID, err := scopePickupPoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cells, err := s.cells.ListOccupancy(ctx, pickupPointID)
+	if err != nil {
+		logger.Errorf("Ошибка получения заполненности ячеек ПВЗ %d: %v", pickupPointID, err)
+		return nil, err
+	}
+
+	return cells, nil
+}
@@ -1,44 +1,31 @@
 package service
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
-	"os"
 	"time"
 
+	"gitlab.ozon.dev/gojhw1/pkg/importer"
 	"gitlab.ozon.dev/gojhw1/pkg/model"
 )
 
-type orderFileData struct {
-	ID          int64   `json:"id"`
-	CustomerID  int64   `json:"customer_id"`
-	DeadlineAt  string  `json:"deadline_at"`
-	Weight      float64 `json:"weight"`
-	Cost        float64 `json:"cost"`
-	PackageType string  `json:"package_type,omitempty"`
-	Wrapper     string  `json:"wrapper,omitempty"`
-}
-
-// readOrdersFromFile читает и парсит JSON файл с заказами
-func readOrdersFromFile(filename string) ([]orderFileData, error) {
-	file, err := os.Open(filename)
+// parseImportFile определяет формат файла с заказами и читает из него записи.
+// Явно указанный формат важнее имени файла и типа содержимого.
+func (s *OrderService) parseImportFile(file model.ImportFile, format string) (string, []importer.Record, error) {
+	format, err := s.importers.Resolve(file.Name, file.ContentType, format)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %w", ErrOpenFile, err)
+		return "", nil, err
 	}
-	defer file.Close()
 
-	data, err := io.ReadAll(file)
+	orders, err := s.importers.Parse(format, file.Content)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %w", ErrReadFile, err)
-	}
-
-	var orders []orderFileData
-	if err = json.Unmarshal(data, &orders); err != nil {
-		return nil, fmt.Errorf("%w: %w", ErrParseFile, err)
+		if errors.Is(err, importer.ErrUnsupportedFormat) {
+			return "", nil, err
+		}
+		return "", nil, fmt.Errorf("%w (%s): %w", ErrParseFile, format, err)
 	}
 
-	return orders, nil
+	return format, orders, nil
 }
 
 // parseDeadline парсит дедлайн из строки
@@ -0,0 +1,131 @@
+// Package terminal содержит реализации платежного терминала, через который ПВЗ принимает оплату картой
+package terminal
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+)
+
+var (
+	// ErrInvalidAmount - сумма операции не положительна
+	ErrInvalidAmount = errors.New("сумма операции должна быть положительной")
+	// ErrDeclined - терминал отклонил операцию
+	ErrDeclined = errors.New("операция отклонена терминалом")
+	// ErrTransactionNotFound - операция с указанным ID не проводилась терминалом
+	ErrTransactionNotFound = errors.New("операция терминала не найдена")
+	// ErrRefundExceeded - сумма возвратов превышает сумму оплаты
+	ErrRefundExceeded = errors.New("сумма возврата превышает сумму оплаты")
+	// ErrTransactionCanceled - операция уже отменена
+	ErrTransactionCanceled = errors.New("операция терминала отменена")
+)
+
+// operation - операция, проведенная терминалом
+type operation struct {
+	amount   float64
+	refunded float64 // сумма возвратов по оплате
+	parentID string  // оплата, по которой проведен возврат
+	canceled bool
+}
+
+// Fake - локальный платежный терминал, который проводит операции без обращения к банку.
+// Операции хранятся в памяти, поэтому возвраты по оплатам, проведенным до перезапуска, принимаются без проверки суммы.
+type Fake struct {
+	mu           sync.Mutex
+	declineAbove float64
+	operations   map[string]*operation
+}
+
+// NewFake создает локальный платежный терминал.
+// Если declineAbove больше 0, оплаты на большую сумму отклоняются, что позволяет проверить отказ терминала.
+func NewFake(declineAbove float64) *Fake {
+	return &Fake{
+		declineAbove: declineAbove,
+		operations:   make(map[string]*operation),
+	}
+}
+
+// Charge списывает amount с карты клиента и возвращает ID операции
+func (f *Fake) Charge(_ context.Context, amount float64) (string, error) {
+	if amount <= 0 {
+		return "", fmt.Errorf("%w: %.2f", ErrInvalidAmount, amount)
+	}
+	if f.declineAbove > 0 && amount > f.declineAbove {
+		return "", fmt.Errorf("%w: сумма %.2f больше %.2f", ErrDeclined, amount, f.declineAbove)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.register(&operation{amount: amount})
+}
+
+// Refund возвращает amount на карту клиента по оплате transactionID и возвращает ID операции возврата
+func (f *Fake) Refund(_ context.Context, transactionID string, amount float64) (string, error) {
+	if amount <= 0 {
+		return "", fmt.Errorf("%w: %.2f", ErrInvalidAmount, amount)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if charge, ok := f.operations[transactionID]; ok {
+		if charge.parentID != "" {
+			return "", fmt.Errorf("%w: %s не является оплатой", ErrTransactionNotFound, transactionID)
+		}
+		if charge.canceled {
+			return "", fmt.Errorf("%w: %s", ErrTransactionCanceled, transactionID)
+		}
+		if roundCents(charge.refunded+amount) > charge.amount {
+			return "", fmt.Errorf("%w: оплачено %.2f, возвращено %.2f, запрошено %.2f",
+				ErrRefundExceeded, charge.amount, charge.refunded, amount)
+		}
+		charge.refunded = roundCents(charge.refunded + amount)
+	}
+
+	return f.register(&operation{amount: amount, parentID: transactionID})
+}
+
+// Cancel отменяет операцию transactionID, если ее результат не удалось сохранить.
+// Отмена возврата восстанавливает сумму, доступную для возврата по оплате.
+func (f *Fake) Cancel(_ context.Context, transactionID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	op, ok := f.operations[transactionID]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrTransactionNotFound, transactionID)
+	}
+	if op.canceled {
+		return fmt.Errorf("%w: %s", ErrTransactionCanceled, transactionID)
+	}
+	op.canceled = true
+
+	if charge, ok := f.operations[op.parentID]; ok {
+		charge.refunded = roundCents(charge.refunded - op.amount)
+	}
+
+	return nil
+}
+
+// register сохраняет операцию под новым ID. Вызывается под блокировкой mu.
+func (f *Fake) register(op *operation) (string, error) {
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("ошибка генерации ID операции терминала: %w", err)
+	}
+
+	transactionID := "fake-" + hex.EncodeToString(id)
+	f.operations[transactionID] = op
+
+	return transactionID, nil
+}
+
+// roundCents округляет сумму до копеек
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
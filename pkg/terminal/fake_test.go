@@ -0,0 +1,141 @@
+package terminal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFake_Charge(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		declineAbove float64
+		amount       float64
+		expectedErr  error
+	}{
+		{
+			name:   "success",
+			amount: 150.5,
+		},
+		{
+			name:         "under decline limit",
+			declineAbove: 200,
+			amount:       200,
+		},
+		{
+			name:         "declined",
+			declineAbove: 200,
+			amount:       200.01,
+			expectedErr:  ErrDeclined,
+		},
+		{
+			name:        "zero amount",
+			amount:      0,
+			expectedErr: ErrInvalidAmount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			id, err := NewFake(tt.declineAbove).Charge(context.Background(), tt.amount)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Empty(t, id)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotEmpty(t, id)
+		})
+	}
+}
+
+func TestFake_Refund(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("partial refunds up to charged amount", func(t *testing.T) {
+		t.Parallel()
+
+		fake := NewFake(0)
+		chargeID, err := fake.Charge(ctx, 100)
+		require.NoError(t, err)
+
+		_, err = fake.Refund(ctx, chargeID, 60.1)
+		require.NoError(t, err)
+		_, err = fake.Refund(ctx, chargeID, 39.9)
+		require.NoError(t, err)
+
+		_, err = fake.Refund(ctx, chargeID, 0.01)
+		assert.ErrorIs(t, err, ErrRefundExceeded)
+	})
+
+	t.Run("canceled refund restores amount", func(t *testing.T) {
+		t.Parallel()
+
+		fake := NewFake(0)
+		chargeID, err := fake.Charge(ctx, 100)
+		require.NoError(t, err)
+
+		refundID, err := fake.Refund(ctx, chargeID, 100)
+		require.NoError(t, err)
+		require.NoError(t, fake.Cancel(ctx, refundID))
+
+		_, err = fake.Refund(ctx, chargeID, 100)
+		assert.NoError(t, err)
+	})
+
+	t.Run("canceled charge", func(t *testing.T) {
+		t.Parallel()
+
+		fake := NewFake(0)
+		chargeID, err := fake.Charge(ctx, 100)
+		require.NoError(t, err)
+		require.NoError(t, fake.Cancel(ctx, chargeID))
+
+		_, err = fake.Refund(ctx, chargeID, 10)
+		assert.ErrorIs(t, err, ErrTransactionCanceled)
+		assert.ErrorIs(t, fake.Cancel(ctx, chargeID), ErrTransactionCanceled)
+	})
+
+	t.Run("refund of refund", func(t *testing.T) {
+		t.Parallel()
+
+		fake := NewFake(0)
+		chargeID, err := fake.Charge(ctx, 100)
+		require.NoError(t, err)
+		refundID, err := fake.Refund(ctx, chargeID, 10)
+		require.NoError(t, err)
+
+		_, err = fake.Refund(ctx, refundID, 10)
+		assert.ErrorIs(t, err, ErrTransactionNotFound)
+	})
+
+	t.Run("unknown charge is refunded without checks", func(t *testing.T) {
+		t.Parallel()
+
+		refundID, err := NewFake(0).Refund(ctx, "fake-before-restart", 10)
+		require.NoError(t, err)
+		assert.NotEmpty(t, refundID)
+	})
+
+	t.Run("zero amount", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewFake(0).Refund(ctx, "fake-any", 0)
+		assert.ErrorIs(t, err, ErrInvalidAmount)
+	})
+
+	t.Run("cancel unknown operation", func(t *testing.T) {
+		t.Parallel()
+
+		assert.ErrorIs(t, NewFake(0).Cancel(ctx, "fake-unknown"), ErrTransactionNotFound)
+	})
+}
@@ -26,7 +26,8 @@ func InitDefaultUser(repo UserAdminIniter) {
 		logger.Info("Создаем пользователя админа по умолчанию")
 		user := model.User{
 			Username: "admin",
-			Role:     "admin",
+			Role:     model.RoleAdmin,
+			Status:   model.UserStatusActive,
 		}
 
 		password := "admin"
@@ -120,6 +120,28 @@ func (l *AuditLogger) Log(ctx context.Context, log model.AuditLog) {
 	}
 }
 
+// LogBatch отправляет пачку логов: что не помещается в канал, целиком уходит в очередь переполнения,
+// чтобы массовые операции не блокировались на каждой записи
+func (l *AuditLogger) LogBatch(ctx context.Context, logs []model.AuditLog) {
+	for i, log := range logs {
+		select {
+		case l.mainLogCh <- log:
+			continue
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		l.logQueue.mu.Lock()
+		for _, rest := range logs[i:] {
+			l.logQueue.overflow.PushBack(rest)
+		}
+		l.logQueue.mu.Unlock()
+
+		return
+	}
+}
+
 // LogOrderStatusChange логирует изменение статуса заказа
 func (l *AuditLogger) LogOrderStatusChange(ctx context.Context, orderID int64, oldStatus, newStatus string) {
 	l.Log(ctx, model.AuditLog{
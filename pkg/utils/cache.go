@@ -12,7 +12,7 @@ import (
 
 type ordersRepository interface {
 	ListActual(ctx context.Context) ([]model.Order, error)
-	List(ctx context.Context, searchTerm string) ([]model.Order, error)
+	List(ctx context.Context, pickupPointID int64, searchTerm string) ([]model.Order, error)
 }
 
 type orderCache interface {
@@ -23,6 +23,13 @@ type orderCache interface {
 	GetOrderHistory(ctx context.Context) ([]model.Order, error)
 }
 
+type attemptStore interface {
+	AddFailure(ctx context.Context, key string, window time.Duration) (int64, error)
+	Lock(ctx context.Context, key string, ttl time.Duration) error
+	LockTTL(ctx context.Context, key string) (time.Duration, error)
+	Reset(ctx context.Context, key string) error
+}
+
 // SetCashType создает и инициализирует кэш заказов в зависимости от типа кэша, указанного в конфигурации
 func SetCashType(ctx context.Context, cfg *config.Config, orderRepo ordersRepository) (orderCache, error) {
 	switch cfg.CacheType.Name {
@@ -51,3 +58,20 @@ func SetCashType(ctx context.Context, cfg *config.Config, orderRepo ordersReposi
 
 	return nil, fmt.Errorf("неизвестный тип кэша: %s", cfg.CacheType.Name)
 }
+
+// NewAttemptStore создает хранилище неудачных попыток входа того же типа, что и кэш заказов
+func NewAttemptStore(ctx context.Context, cfg *config.Config) (attemptStore, error) {
+	switch cfg.CacheType.Name {
+	case "redis":
+		store, err := cache.NewRedisAttemptStore(ctx, fmt.Sprintf("%s:%s", cfg.Redis.Host, cfg.Redis.Port))
+		if err != nil {
+			return nil, fmt.Errorf("ошибка подключения к Redis: %v", err)
+		}
+
+		return store, nil
+	case "inmem":
+		return cache.NewInMemoryAttemptStore(), nil
+	}
+
+	return nil, fmt.Errorf("неизвестный тип кэша: %s", cfg.CacheType.Name)
+}
@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.ozon.dev/gojhw1/pkg/config"
+	"gitlab.ozon.dev/gojhw1/pkg/terminal"
+)
+
+type paymentTerminal interface {
+	Charge(ctx context.Context, amount float64) (string, error)
+	Refund(ctx context.Context, transactionID string, amount float64) (string, error)
+	Cancel(ctx context.Context, transactionID string) error
+}
+
+// NewPaymentTerminal создает платежный терминал в зависимости от реализации, указанной в конфигурации
+func NewPaymentTerminal(cfg *config.Config) (paymentTerminal, error) {
+	switch cfg.Payment.Terminal {
+	case "fake":
+		return terminal.NewFake(cfg.Payment.DeclineAbove), nil
+	}
+
+	return nil, fmt.Errorf("неизвестный платежный терминал: %s", cfg.Payment.Terminal)
+}
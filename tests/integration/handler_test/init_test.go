@@ -14,8 +14,10 @@ import (
 	"gitlab.ozon.dev/gojhw1/pkg/config"
 	"gitlab.ozon.dev/gojhw1/pkg/db"
 	"gitlab.ozon.dev/gojhw1/pkg/handler"
+	"gitlab.ozon.dev/gojhw1/pkg/importer"
 	"gitlab.ozon.dev/gojhw1/pkg/repository"
 	"gitlab.ozon.dev/gojhw1/pkg/service"
+	"gitlab.ozon.dev/gojhw1/pkg/terminal"
 	"gitlab.ozon.dev/gojhw1/pkg/utils"
 )
 
@@ -138,8 +140,11 @@ func setupOrderTest(t *testing.T) (*fiber.App, *service.OrderService, *db.Pool,
 
 	logger := utils.NewAuditLogger(ctx, auditRepo, 2, 5, 500*time.Millisecond)
 
+	importers, err := importer.NewDefaultRegistry(importer.TableOptions{})
+	require.NoError(t, err)
+
 	// Создаём сервис
-	orderService := service.NewOrderService(orderRepo, logger, redisCache)
+	orderService := service.NewOrderService(orderRepo, repository.NewPostgresStorageCellRepository(pool), logger, redisCache, importers, service.ExtensionPolicy{}, repository.NewPostgresReturnPolicyRepository(pool), terminal.NewFake(0))
 
 	// Создаём хэндлер
 	orderHandler := handler.NewOrderHandler(orderService)
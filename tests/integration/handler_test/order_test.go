@@ -14,7 +14,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"gitlab.ozon.dev/gojhw1/pkg/repository"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
 )
 
 const timeLayout = "2006-01-02T15:04:05"
@@ -33,39 +33,42 @@ func TestOrderHandlerIntegration_CreateOrder(t *testing.T) {
 		{
 			name: "успешное создание заказа",
 			requestBody: map[string]any{
-				"id":           123,
-				"customer_id":  456,
-				"deadline_at":  deadline,
-				"weight":       1.5,
-				"cost":         1000,
-				"package_type": "box",
-				"wrapper":      "film",
+				"id":              123,
+				"customer_id":     456,
+				"pickup_point_id": 1,
+				"deadline_at":     deadline,
+				"weight":          1.5,
+				"cost":            1000,
+				"package_type":    "box",
+				"wrapper":         "film",
 			},
 			expectedStatus: fiber.StatusCreated,
 		},
 		{
 			name: "ошибка - отрицательный вес",
 			requestBody: map[string]any{
-				"id":           124,
-				"customer_id":  456,
-				"deadline_at":  deadline,
-				"weight":       -1.5,
-				"cost":         1000,
-				"package_type": "box",
-				"wrapper":      "film",
+				"id":              124,
+				"customer_id":     456,
+				"pickup_point_id": 1,
+				"deadline_at":     deadline,
+				"weight":          -1.5,
+				"cost":            1000,
+				"package_type":    "box",
+				"wrapper":         "film",
 			},
 			expectedStatus: fiber.StatusBadRequest,
 		},
 		{
 			name: "ошибка - отрицательная стоимость",
 			requestBody: map[string]any{
-				"id":           125,
-				"customer_id":  456,
-				"deadline_at":  deadline,
-				"weight":       1.5,
-				"cost":         -1000,
-				"package_type": "box",
-				"wrapper":      "film",
+				"id":              125,
+				"customer_id":     456,
+				"pickup_point_id": 1,
+				"deadline_at":     deadline,
+				"weight":          1.5,
+				"cost":            -1000,
+				"package_type":    "box",
+				"wrapper":         "film",
 			},
 			expectedStatus: fiber.StatusBadRequest,
 		},
@@ -104,7 +107,7 @@ func TestOrderHandlerIntegration_GetOrder(t *testing.T) {
 	defer cleanup()
 
 	deadline := time.Now().Add(24 * time.Hour)
-	err := orderService.AcceptOrder(context.Background(), 123, 456, deadline, 1.5, 1000, nil, nil)
+	err := orderService.AcceptOrder(context.Background(), 123, 456, 1, deadline, 1.5, 1000, nil, nil, model.PaymentModePrepaid)
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -162,13 +165,13 @@ func TestOrderHandlerIntegration_ListOrders(t *testing.T) {
 	deadline := time.Now().Add(24 * time.Hour)
 
 	// Заказы для клиента 456
-	err := orderService.AcceptOrder(context.Background(), 101, 456, deadline, 1.5, 1000, nil, nil)
+	err := orderService.AcceptOrder(context.Background(), 101, 456, 1, deadline, 1.5, 1000, nil, nil, model.PaymentModePrepaid)
 	require.NoError(t, err)
-	err = orderService.AcceptOrder(context.Background(), 102, 456, deadline, 2.5, 2000, nil, nil)
+	err = orderService.AcceptOrder(context.Background(), 102, 456, 1, deadline, 2.5, 2000, nil, nil, model.PaymentModePrepaid)
 	require.NoError(t, err)
 
 	// Заказ для другого клиента
-	err = orderService.AcceptOrder(context.Background(), 103, 789, deadline, 3.5, 3000, nil, nil)
+	err = orderService.AcceptOrder(context.Background(), 103, 789, 1, deadline, 3.5, 3000, nil, nil, model.PaymentModePrepaid)
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -241,10 +244,10 @@ func TestOrderHandlerIntegration_ProcessCustomer(t *testing.T) {
 
 	deadline := time.Now().Add(24 * time.Hour)
 
-	err := orderService.AcceptOrder(context.Background(), 201, 456, deadline, 1.5, 1000, nil, nil)
+	err := orderService.AcceptOrder(context.Background(), 201, 456, 1, deadline, 1.5, 1000, nil, nil, model.PaymentModePrepaid)
 	require.NoError(t, err)
 
-	err = orderService.AcceptOrder(context.Background(), 202, 456, deadline, 2.5, 2000, nil, nil)
+	err = orderService.AcceptOrder(context.Background(), 202, 456, 1, deadline, 2.5, 2000, nil, nil, model.PaymentModePrepaid)
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -297,6 +300,89 @@ func TestOrderHandlerIntegration_ProcessCustomer(t *testing.T) {
 	}
 }
 
+func TestOrderHandlerIntegration_PartialHandoutAndReturn(t *testing.T) {
+	app, orderService, _, cleanup := setupOrderTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	deadline := time.Now().Add(24 * time.Hour)
+
+	err := orderService.AcceptOrderWithItems(ctx, 301, 456, 1, deadline, []model.OrderItem{
+		{Name: "Чайник", Weight: 1.2, Cost: 2500},
+		{Name: "Кружка", Weight: 0.3, Cost: 400},
+		{Name: "Ложка", Weight: 0.1, Cost: 100},
+	}, nil, nil, model.PaymentModePrepaid)
+	require.NoError(t, err)
+
+	order, err := orderService.GetOrderByID(ctx, 301)
+	require.NoError(t, err)
+	require.Len(t, order.Items, 3)
+	assert.Equal(t, 1.6, order.Weight)
+	assert.Equal(t, float64(3000), order.Cost)
+
+	kettle, mug, spoon := order.Items[0].ID, order.Items[1].ID, order.Items[2].ID
+
+	process := func(body map[string]any) int {
+		reqBody, err := json.Marshal(body)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/orders/process", bytes.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+
+		return resp.StatusCode
+	}
+
+	// Клиент забирает чайник и кружку и отказывается от ложки
+	status := process(map[string]any{
+		"customer_id": 456,
+		"action":      "handout",
+		"order_ids":   []int64{301},
+		"item_ids":    []int64{kettle, mug},
+		"atomic":      true,
+	})
+	require.Equal(t, fiber.StatusOK, status)
+
+	order, err = orderService.GetOrderByID(ctx, 301)
+	require.NoError(t, err)
+	assert.Equal(t, model.StateDelivered, order.State)
+	assert.Equal(t, 1.5, order.Weight)
+	assert.Equal(t, float64(2900), order.Cost)
+	assert.Equal(t, model.ItemStateRefused, order.Items[2].State)
+
+	// Ложку вернуть нельзя: клиент ее не получал
+	status = process(map[string]any{
+		"customer_id": 456,
+		"action":      "return",
+		"order_ids":   []int64{301},
+		"item_ids":    []int64{spoon},
+		"atomic":      true,
+		"reason":      "changed_mind",
+		"condition":   "intact",
+	})
+	assert.Equal(t, fiber.StatusConflict, status)
+
+	// Клиент возвращает только кружку
+	status = process(map[string]any{
+		"customer_id": 456,
+		"action":      "return",
+		"order_ids":   []int64{301},
+		"item_ids":    []int64{mug},
+		"atomic":      true,
+		"reason":      "wrong_item",
+		"condition":   "intact",
+	})
+	require.Equal(t, fiber.StatusOK, status)
+
+	order, err = orderService.GetOrderByID(ctx, 301)
+	require.NoError(t, err)
+	assert.Equal(t, model.StateReturned, order.State)
+	assert.Equal(t, model.ItemStateDelivered, order.Items[0].State)
+	assert.Equal(t, model.ItemStateReturned, order.Items[1].State)
+}
+
 func TestOrderHandlerIntegration_ClearDatabase(t *testing.T) {
 	app, orderService, _, cleanup := setupOrderTest(t)
 	defer cleanup()
@@ -304,9 +390,9 @@ func TestOrderHandlerIntegration_ClearDatabase(t *testing.T) {
 	// Создаем несколько заказов перед очисткой
 	deadline := time.Now().Add(24 * time.Hour)
 
-	err := orderService.AcceptOrder(context.Background(), 301, 456, deadline, 1.5, 1000, nil, nil)
+	err := orderService.AcceptOrder(context.Background(), 301, 456, 1, deadline, 1.5, 1000, nil, nil, model.PaymentModePrepaid)
 	require.NoError(t, err)
-	err = orderService.AcceptOrder(context.Background(), 302, 456, deadline, 2.5, 2000, nil, nil)
+	err = orderService.AcceptOrder(context.Background(), 302, 456, 1, deadline, 2.5, 2000, nil, nil, model.PaymentModePrepaid)
 	require.NoError(t, err)
 
 	// Проверяем, что заказы действительно созданы
@@ -363,15 +449,15 @@ func TestOrderHandlerIntegration_OrderHistory(t *testing.T) {
 	deadline := time.Now().Add(24 * time.Hour)
 
 	// Заказ с историей статусов
-	err := orderService.AcceptOrder(context.Background(), 401, 456, deadline, 1.5, 1000, nil, nil)
+	err := orderService.AcceptOrder(context.Background(), 401, 456, 1, deadline, 1.5, 1000, nil, nil, model.PaymentModePrepaid)
 	require.NoError(t, err)
 
 	// Выдаем заказ клиенту
-	err = orderService.DeliverOrder(context.Background(), 401, 456, time.Now())
+	err = orderService.DeliverOrder(context.Background(), 401, 0, 456, nil, nil, time.Now())
 	require.NoError(t, err)
 
 	// Второй заказ просто создаем
-	err = orderService.AcceptOrder(context.Background(), 402, 789, deadline, 2.5, 2000, nil, nil)
+	err = orderService.AcceptOrder(context.Background(), 402, 789, 1, deadline, 2.5, 2000, nil, nil, model.PaymentModePrepaid)
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -424,19 +510,22 @@ func TestOrderHandlerIntegration_ListReturns(t *testing.T) {
 	deadline := time.Now().Add(24 * time.Hour)
 
 	// Создаем заказ и возвращаем его
-	err := orderService.AcceptOrder(context.Background(), 501, 456, deadline, 1.5, 1000, nil, nil)
+	err := orderService.AcceptOrder(context.Background(), 501, 456, 1, deadline, 1.5, 1000, nil, nil, model.PaymentModePrepaid)
 	require.NoError(t, err)
 
 	// Выдаем заказ клиенту
-	err = orderService.DeliverOrder(context.Background(), 501, 456, time.Now())
+	err = orderService.DeliverOrder(context.Background(), 501, 0, 456, nil, nil, time.Now())
 	require.NoError(t, err)
 
 	// Возвращаем заказ
-	err = orderService.ProcessReturnOrder(context.Background(), 501, 456, time.Now())
+	err = orderService.ProcessReturnOrder(context.Background(), 501, 0, 456, nil, model.ReturnDetails{
+		Reason:    model.ReturnReasonChangedMind,
+		Condition: model.ReturnConditionIntact,
+	}, time.Now())
 	require.NoError(t, err)
 
 	// Создаем второй заказ без возврата
-	err = orderService.AcceptOrder(context.Background(), 502, 456, deadline, 2.5, 2000, nil, nil)
+	err = orderService.AcceptOrder(context.Background(), 502, 456, 1, deadline, 2.5, 2000, nil, nil, model.PaymentModePrepaid)
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -469,6 +558,24 @@ func TestOrderHandlerIntegration_ListReturns(t *testing.T) {
 			expectedStatus: fiber.StatusOK,
 			expectedCount:  1, // Должен найти заказ с customer_id = 456
 		},
+		{
+			name:           "фильтр по причине возврата",
+			queryParams:    "cursor=0&limit=10&reason=changed_mind",
+			expectedStatus: fiber.StatusOK,
+			expectedCount:  1,
+		},
+		{
+			name:           "фильтр по состоянию заказа",
+			queryParams:    "cursor=0&limit=10&condition=damaged",
+			expectedStatus: fiber.StatusOK,
+			expectedCount:  0, // Заказ возвращен в целости
+		},
+		{
+			name:           "ошибка - неизвестная причина возврата",
+			queryParams:    "cursor=0&limit=10&reason=broken",
+			expectedStatus: fiber.StatusBadRequest,
+			expectedCount:  0,
+		},
 	}
 
 	for _, tt := range tests {
@@ -509,15 +616,15 @@ func TestOrderHandlerIntegration_ReturnToCourier(t *testing.T) {
 	deadline := time.Now().Add(24 * time.Hour)
 
 	// Создаем заказы для тестирования возврата
-	err := orderService.AcceptOrder(context.Background(), 601, 456, time.Now().Add(1*time.Second), 1.5, 1000, nil, nil)
+	err := orderService.AcceptOrder(context.Background(), 601, 456, 1, time.Now().Add(1*time.Second), 1.5, 1000, nil, nil, model.PaymentModePrepaid)
 	require.NoError(t, err)
 
 	time.Sleep(1 * time.Second) // Чтобы заказы просрочился
 
 	// Заказ, который уже выдан клиенту
-	err = orderService.AcceptOrder(context.Background(), 602, 456, deadline, 2.5, 2000, nil, nil)
+	err = orderService.AcceptOrder(context.Background(), 602, 456, 1, deadline, 2.5, 2000, nil, nil, model.PaymentModePrepaid)
 	require.NoError(t, err)
-	err = orderService.DeliverOrder(context.Background(), 602, 456, time.Now())
+	err = orderService.DeliverOrder(context.Background(), 602, 0, 456, nil, nil, time.Now())
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -568,8 +675,9 @@ func TestOrderHandlerIntegration_ReturnToCourier(t *testing.T) {
 
 			// Для успешного случая проверяем, что статус заказа изменился
 			if tt.expectedStatus == fiber.StatusOK {
-				_, err := orderService.GetOrderByID(context.Background(), 601)
-				require.Error(t, repository.ErrOrderNotFound, err)
+				order, err := orderService.GetOrderByID(context.Background(), 601)
+				require.NoError(t, err)
+				assert.Equal(t, model.StateReturnedToCourier, order.State)
 			}
 		})
 	}
@@ -32,17 +32,17 @@ func TestUserHandlerIntegration_CreateUser(t *testing.T) {
 			requestBody: map[string]any{
 				"username": "testuser",
 				"password": "testpass",
-				"role":     "user",
+				"role":     "operator",
 			},
 			expectedStatus: fiber.StatusCreated,
-			expectedBody:   `{"message":"Пользователь успешно создан"}`,
+			expectedBody:   `{"message":"Пользователь успешно создан и ожидает подтверждения администратором"}`,
 		},
 		{
 			name: "ошибка - пользователь с таким именем уже существует",
 			requestBody: map[string]any{
 				"username": "testuser",
 				"password": "testpass",
-				"role":     "user",
+				"role":     "operator",
 			},
 			expectedStatus: fiber.StatusConflict,
 			expectedBody:   `{"error":"Пользователь с таким именем уже существует"}`,
@@ -52,7 +52,7 @@ func TestUserHandlerIntegration_CreateUser(t *testing.T) {
 			requestBody: map[string]any{
 				"username": "",
 				"password": "testpass",
-				"role":     "user",
+				"role":     "operator",
 			},
 			expectedStatus: fiber.StatusBadRequest,
 			expectedBody:   `{"error":"имя пользователя не может быть пустым"}`,
@@ -62,7 +62,7 @@ func TestUserHandlerIntegration_CreateUser(t *testing.T) {
 			requestBody: map[string]any{
 				"username": "testuser2",
 				"password": "",
-				"role":     "user",
+				"role":     "operator",
 			},
 			expectedStatus: fiber.StatusBadRequest,
 			expectedBody:   `{"error":"пароль не может быть пустым"}`,
@@ -131,7 +131,7 @@ func TestUserHandlerIntegration_GetUser(t *testing.T) {
 	// Создаем пользователя для тестирования
 	err := userRepo.Create(context.Background(), model.User{
 		Username: "testuser",
-		Role:     "user",
+		Role:     "operator",
 	}, "testpass")
 	require.NoError(t, err)
 
@@ -182,7 +182,7 @@ func TestUserHandlerIntegration_GetUser(t *testing.T) {
 				require.NoError(t, err)
 
 				assert.Equal(t, "testuser", result["username"])
-				assert.Equal(t, "user", result["role"])
+				assert.Equal(t, "operator", result["role"])
 			}
 		})
 	}
@@ -194,7 +194,7 @@ func TestUserHandlerIntegration_UpdateUser(t *testing.T) {
 
 	err := userRepo.Create(context.Background(), model.User{
 		Username: "testuser",
-		Role:     "user",
+		Role:     "operator",
 	}, "testpass")
 	require.NoError(t, err)
 
@@ -262,7 +262,7 @@ func TestUserHandlerIntegration_UpdatePassword(t *testing.T) {
 	// Создаем пользователя для тестирования
 	err := userRepo.Create(context.Background(), model.User{
 		Username: "passworduser",
-		Role:     "user",
+		Role:     "operator",
 	}, "oldpassword")
 	require.NoError(t, err)
 
@@ -342,7 +342,7 @@ func TestUserHandlerIntegration_DeleteUser(t *testing.T) {
 
 	err := userRepo.Create(context.Background(), model.User{
 		Username: "testuser",
-		Role:     "user",
+		Role:     "operator",
 	}, "testpass")
 	require.NoError(t, err)
 
@@ -15,8 +15,11 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"gitlab.ozon.dev/gojhw1/pkg/handler"
+	"gitlab.ozon.dev/gojhw1/pkg/importer"
+	"gitlab.ozon.dev/gojhw1/pkg/model"
 	"gitlab.ozon.dev/gojhw1/pkg/repository"
 	"gitlab.ozon.dev/gojhw1/pkg/service"
+	"gitlab.ozon.dev/gojhw1/pkg/terminal"
 	"gitlab.ozon.dev/gojhw1/pkg/utils"
 )
 
@@ -41,8 +44,11 @@ func (s *OrderHandlerSuite) SetupSuite() {
 	// Создаём репозитории
 	s.orderRepo = repository.NewPostgresOrderRepository(s.pool)
 
+	importers, err := importer.NewDefaultRegistry(importer.TableOptions{})
+	s.Require().NoError(err)
+
 	// Создаём сервис
-	s.orderService = service.NewOrderService(s.orderRepo, s.logger, s.redisCache)
+	s.orderService = service.NewOrderService(s.orderRepo, repository.NewPostgresStorageCellRepository(s.pool), s.logger, s.redisCache, importers, service.ExtensionPolicy{}, repository.NewPostgresReturnPolicyRepository(s.pool), terminal.NewFake(0))
 
 	// Создаём хэндлер
 	orderHandler := handler.NewOrderHandler(s.orderService)
@@ -77,39 +83,42 @@ func (s *OrderHandlerSuite) TestCreateOrder() {
 		{
 			name: "успешное создание заказа",
 			requestBody: map[string]any{
-				"id":           123,
-				"customer_id":  456,
-				"deadline_at":  deadline,
-				"weight":       1.5,
-				"cost":         1000,
-				"package_type": "box",
-				"wrapper":      "film",
+				"id":              123,
+				"customer_id":     456,
+				"pickup_point_id": 1,
+				"deadline_at":     deadline,
+				"weight":          1.5,
+				"cost":            1000,
+				"package_type":    "box",
+				"wrapper":         "film",
 			},
 			expectedStatus: fiber.StatusCreated,
 		},
 		{
 			name: "ошибка - отрицательный вес",
 			requestBody: map[string]any{
-				"id":           124,
-				"customer_id":  456,
-				"deadline_at":  deadline,
-				"weight":       -1.5,
-				"cost":         1000,
-				"package_type": "box",
-				"wrapper":      "film",
+				"id":              124,
+				"customer_id":     456,
+				"pickup_point_id": 1,
+				"deadline_at":     deadline,
+				"weight":          -1.5,
+				"cost":            1000,
+				"package_type":    "box",
+				"wrapper":         "film",
 			},
 			expectedStatus: fiber.StatusBadRequest,
 		},
 		{
 			name: "ошибка - отрицательная стоимость",
 			requestBody: map[string]any{
-				"id":           125,
-				"customer_id":  456,
-				"deadline_at":  deadline,
-				"weight":       1.5,
-				"cost":         -1000,
-				"package_type": "box",
-				"wrapper":      "film",
+				"id":              125,
+				"customer_id":     456,
+				"pickup_point_id": 1,
+				"deadline_at":     deadline,
+				"weight":          1.5,
+				"cost":            -1000,
+				"package_type":    "box",
+				"wrapper":         "film",
 			},
 			expectedStatus: fiber.StatusBadRequest,
 		},
@@ -146,7 +155,7 @@ func (s *OrderHandlerSuite) TestCreateOrder() {
 // TestGetOrder тестирует получение заказа по ID
 func (s *OrderHandlerSuite) TestGetOrder() {
 	deadline := time.Now().Add(24 * time.Hour)
-	err := s.orderService.AcceptOrder(context.Background(), 123, 456, deadline, 1.5, 1000, nil, nil)
+	err := s.orderService.AcceptOrder(context.Background(), 123, 456, 1, deadline, 1.5, 1000, nil, nil, model.PaymentModePrepaid)
 	s.Require().NoError(err)
 
 	tests := []struct {
@@ -202,13 +211,13 @@ func (s *OrderHandlerSuite) TestListOrders() {
 	deadline := time.Now().Add(24 * time.Hour)
 
 	// Заказы для клиента 456
-	err := s.orderService.AcceptOrder(context.Background(), 101, 456, deadline, 1.5, 1000, nil, nil)
+	err := s.orderService.AcceptOrder(context.Background(), 101, 456, 1, deadline, 1.5, 1000, nil, nil, model.PaymentModePrepaid)
 	s.Require().NoError(err)
-	err = s.orderService.AcceptOrder(context.Background(), 102, 456, deadline, 2.5, 2000, nil, nil)
+	err = s.orderService.AcceptOrder(context.Background(), 102, 456, 1, deadline, 2.5, 2000, nil, nil, model.PaymentModePrepaid)
 	s.Require().NoError(err)
 
 	// Заказ для другого клиента
-	err = s.orderService.AcceptOrder(context.Background(), 103, 789, deadline, 3.5, 3000, nil, nil)
+	err = s.orderService.AcceptOrder(context.Background(), 103, 789, 1, deadline, 3.5, 3000, nil, nil, model.PaymentModePrepaid)
 	s.Require().NoError(err)
 
 	tests := []struct {
@@ -277,10 +286,10 @@ func (s *OrderHandlerSuite) TestListOrders() {
 func (s *OrderHandlerSuite) TestProcessCustomer() {
 	deadline := time.Now().Add(24 * time.Hour)
 
-	err := s.orderService.AcceptOrder(context.Background(), 201, 456, deadline, 1.5, 1000, nil, nil)
+	err := s.orderService.AcceptOrder(context.Background(), 201, 456, 1, deadline, 1.5, 1000, nil, nil, model.PaymentModePrepaid)
 	s.Require().NoError(err)
 
-	err = s.orderService.AcceptOrder(context.Background(), 202, 456, deadline, 2.5, 2000, nil, nil)
+	err = s.orderService.AcceptOrder(context.Background(), 202, 456, 1, deadline, 2.5, 2000, nil, nil, model.PaymentModePrepaid)
 	s.Require().NoError(err)
 
 	tests := []struct {
@@ -338,9 +347,9 @@ func (s *OrderHandlerSuite) TestClearDatabase() {
 	// Создаем несколько заказов перед очисткой
 	deadline := time.Now().Add(24 * time.Hour)
 
-	err := s.orderService.AcceptOrder(context.Background(), 301, 456, deadline, 1.5, 1000, nil, nil)
+	err := s.orderService.AcceptOrder(context.Background(), 301, 456, 1, deadline, 1.5, 1000, nil, nil, model.PaymentModePrepaid)
 	s.Require().NoError(err)
-	err = s.orderService.AcceptOrder(context.Background(), 302, 456, deadline, 2.5, 2000, nil, nil)
+	err = s.orderService.AcceptOrder(context.Background(), 302, 456, 1, deadline, 2.5, 2000, nil, nil, model.PaymentModePrepaid)
 	s.Require().NoError(err)
 
 	// Проверяем, что заказы действительно созданы
@@ -395,15 +404,15 @@ func (s *OrderHandlerSuite) TestOrderHistory() {
 	deadline := time.Now().Add(24 * time.Hour)
 
 	// Заказ с историей статусов
-	err := s.orderService.AcceptOrder(context.Background(), 401, 456, deadline, 1.5, 1000, nil, nil)
+	err := s.orderService.AcceptOrder(context.Background(), 401, 456, 1, deadline, 1.5, 1000, nil, nil, model.PaymentModePrepaid)
 	s.Require().NoError(err)
 
 	// Выдаем заказ клиенту
-	err = s.orderService.DeliverOrder(context.Background(), 401, 456, time.Now())
+	err = s.orderService.DeliverOrder(context.Background(), 401, 0, 456, nil, nil, time.Now())
 	s.Require().NoError(err)
 
 	// Второй заказ просто создаем
-	err = s.orderService.AcceptOrder(context.Background(), 402, 789, deadline, 2.5, 2000, nil, nil)
+	err = s.orderService.AcceptOrder(context.Background(), 402, 789, 1, deadline, 2.5, 2000, nil, nil, model.PaymentModePrepaid)
 	s.Require().NoError(err)
 
 	tests := []struct {
@@ -454,19 +463,22 @@ func (s *OrderHandlerSuite) TestListReturns() {
 	deadline := time.Now().Add(24 * time.Hour)
 
 	// Создаем заказ и возвращаем его
-	err := s.orderService.AcceptOrder(context.Background(), 501, 456, deadline, 1.5, 1000, nil, nil)
+	err := s.orderService.AcceptOrder(context.Background(), 501, 456, 1, deadline, 1.5, 1000, nil, nil, model.PaymentModePrepaid)
 	s.Require().NoError(err)
 
 	// Выдаем заказ клиенту
-	err = s.orderService.DeliverOrder(context.Background(), 501, 456, time.Now())
+	err = s.orderService.DeliverOrder(context.Background(), 501, 0, 456, nil, nil, time.Now())
 	s.Require().NoError(err)
 
 	// Возвращаем заказ
-	err = s.orderService.ProcessReturnOrder(context.Background(), 501, 456, time.Now())
+	err = s.orderService.ProcessReturnOrder(context.Background(), 501, 0, 456, nil, model.ReturnDetails{
+		Reason:    model.ReturnReasonChangedMind,
+		Condition: model.ReturnConditionIntact,
+	}, time.Now())
 	s.Require().NoError(err)
 
 	// Создаем второй заказ без возврата
-	err = s.orderService.AcceptOrder(context.Background(), 502, 456, deadline, 2.5, 2000, nil, nil)
+	err = s.orderService.AcceptOrder(context.Background(), 502, 456, 1, deadline, 2.5, 2000, nil, nil, model.PaymentModePrepaid)
 	s.Require().NoError(err)
 
 	tests := []struct {
@@ -499,6 +511,24 @@ func (s *OrderHandlerSuite) TestListReturns() {
 			expectedStatus: fiber.StatusOK,
 			expectedCount:  1, // Должен найти заказ с customer_id = 456
 		},
+		{
+			name:           "фильтр по причине возврата",
+			queryParams:    "cursor=0&limit=10&reason=changed_mind",
+			expectedStatus: fiber.StatusOK,
+			expectedCount:  1,
+		},
+		{
+			name:           "фильтр по состоянию заказа",
+			queryParams:    "cursor=0&limit=10&condition=damaged",
+			expectedStatus: fiber.StatusOK,
+			expectedCount:  0, // Заказ возвращен в целости
+		},
+		{
+			name:           "ошибка - неизвестная причина возврата",
+			queryParams:    "cursor=0&limit=10&reason=broken",
+			expectedStatus: fiber.StatusBadRequest,
+			expectedCount:  0,
+		},
 	}
 
 	for _, tt := range tests {
@@ -537,15 +567,15 @@ func (s *OrderHandlerSuite) TestReturnToCourier() {
 	deadline := time.Now().Add(24 * time.Hour)
 
 	// Создаем заказы для тестирования возврата
-	err := s.orderService.AcceptOrder(context.Background(), 601, 456, time.Now().Add(1*time.Second), 1.5, 1000, nil, nil)
+	err := s.orderService.AcceptOrder(context.Background(), 601, 456, 1, time.Now().Add(1*time.Second), 1.5, 1000, nil, nil, model.PaymentModePrepaid)
 	s.Require().NoError(err)
 
 	time.Sleep(1 * time.Second) // Чтобы заказ просрочился
 
 	// Заказ, который уже выдан клиенту
-	err = s.orderService.AcceptOrder(context.Background(), 602, 456, deadline, 2.5, 2000, nil, nil)
+	err = s.orderService.AcceptOrder(context.Background(), 602, 456, 1, deadline, 2.5, 2000, nil, nil, model.PaymentModePrepaid)
 	s.Require().NoError(err)
-	err = s.orderService.DeliverOrder(context.Background(), 602, 456, time.Now())
+	err = s.orderService.DeliverOrder(context.Background(), 602, 0, 456, nil, nil, time.Now())
 	s.Require().NoError(err)
 
 	tests := []struct {
@@ -596,8 +626,9 @@ func (s *OrderHandlerSuite) TestReturnToCourier() {
 
 			// Для успешного случая проверяем, что статус заказа изменился
 			if tt.expectedStatus == fiber.StatusOK {
-				_, err := s.orderService.GetOrderByID(context.Background(), 601)
-				require.Error(s.T(), repository.ErrOrderNotFound, err)
+				order, err := s.orderService.GetOrderByID(context.Background(), 601)
+				require.NoError(s.T(), err)
+				s.Equal(model.StateReturnedToCourier, order.State)
 			}
 		})
 	}
@@ -58,17 +58,17 @@ func (s *UserHandlerSuite) TestCreateUser() {
 			requestBody: map[string]any{
 				"username": "testuser",
 				"password": "testpass",
-				"role":     "user",
+				"role":     "operator",
 			},
 			expectedStatus: fiber.StatusCreated,
-			expectedBody:   `{"message":"Пользователь успешно создан"}`,
+			expectedBody:   `{"message":"Пользователь успешно создан и ожидает подтверждения администратором"}`,
 		},
 		{
 			name: "ошибка - пользователь с таким именем уже существует",
 			requestBody: map[string]any{
 				"username": "testuser",
 				"password": "testpass",
-				"role":     "user",
+				"role":     "operator",
 			},
 			expectedStatus: fiber.StatusConflict,
 			expectedBody:   `{"error":"Пользователь с таким именем уже существует"}`,
@@ -78,7 +78,7 @@ func (s *UserHandlerSuite) TestCreateUser() {
 			requestBody: map[string]any{
 				"username": "",
 				"password": "testpass",
-				"role":     "user",
+				"role":     "operator",
 			},
 			expectedStatus: fiber.StatusBadRequest,
 			expectedBody:   `{"error":"имя пользователя не может быть пустым"}`,
@@ -88,7 +88,7 @@ func (s *UserHandlerSuite) TestCreateUser() {
 			requestBody: map[string]any{
 				"username": "testuser2",
 				"password": "",
-				"role":     "user",
+				"role":     "operator",
 			},
 			expectedStatus: fiber.StatusBadRequest,
 			expectedBody:   `{"error":"пароль не может быть пустым"}`,
@@ -152,7 +152,7 @@ func (s *UserHandlerSuite) TestGetUser() {
 	// Создаем пользователя для тестирования
 	err := s.userRepo.Create(context.Background(), model.User{
 		Username: "testuser",
-		Role:     "user",
+		Role:     "operator",
 	}, "testpass")
 	s.Require().NoError(err)
 
@@ -203,7 +203,7 @@ func (s *UserHandlerSuite) TestGetUser() {
 				s.Require().NoError(err)
 
 				s.Equal("testuser", result["username"])
-				s.Equal("user", result["role"])
+				s.Equal("operator", result["role"])
 			}
 		})
 	}
@@ -213,7 +213,7 @@ func (s *UserHandlerSuite) TestGetUser() {
 func (s *UserHandlerSuite) TestUpdateUser() {
 	err := s.userRepo.Create(context.Background(), model.User{
 		Username: "testuser",
-		Role:     "user",
+		Role:     "operator",
 	}, "testpass")
 	s.Require().NoError(err)
 
@@ -278,7 +278,7 @@ func (s *UserHandlerSuite) TestUpdatePassword() {
 	// Создаем пользователя для тестирования
 	err := s.userRepo.Create(context.Background(), model.User{
 		Username: "passworduser",
-		Role:     "user",
+		Role:     "operator",
 	}, "oldpassword")
 	s.Require().NoError(err)
 
@@ -356,7 +356,7 @@ func (s *UserHandlerSuite) TestUpdatePassword() {
 func (s *UserHandlerSuite) TestDeleteUser() {
 	err := s.userRepo.Create(context.Background(), model.User{
 		Username: "testuser",
-		Role:     "user",
+		Role:     "operator",
 	}, "testpass")
 	s.Require().NoError(err)
 